@@ -5,9 +5,23 @@ import (
 	"errors"
 	"iter"
 	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/marcuscaisey/lox/golox/ast"
 	"github.com/marcuscaisey/lox/golox/loxerr"
+	"github.com/marcuscaisey/lox/golox/token"
+)
+
+// Messages of errors which tools may need to identify, such as to fix them automatically.
+const (
+	// UnusedDeclMsgSuffix ends the message of the hint reported for a declaration which is never used. The message
+	// starts with the declared identifier.
+	UnusedDeclMsgSuffix = "has been declared but is never used"
+	// LetInsteadOfVarMsg is the message of the warning reported for a var declaration in a block or for loop.
+	LetInsteadOfVarMsg = "'let' should be used instead of 'var' in a block or for loop"
+	// UnreachableCodeMsg is the message of the hint reported for code which can never be executed.
+	UnreachableCodeMsg = "unreachable code"
 )
 
 // Option can be passed to [Program], [ResolveIdents], and [CheckSemantics] to configure analysis behaviour.
@@ -60,10 +74,14 @@ func Program(program *ast.Program, builtins []ast.Decl, opts ...Option) error {
 // Iteration starts from the given class declaration, then successive iterations traverse its superclasses.
 // identBindings is used to superclass identifiers to their declarations. This will typically be the result of
 // [ResolveIdents].
+// Each class is yielded at most once, so iteration terminates even if invalid class declarations form an inheritance
+// cycle.
 func InheritanceChain(decl *ast.ClassDecl, identBindings map[*ast.Ident][]ast.Binding) iter.Seq[*ast.ClassDecl] {
 	return func(yield func(*ast.ClassDecl) bool) {
+		seen := map[*ast.ClassDecl]bool{}
 		curClassDecl := decl
-		for {
+		for !seen[curClassDecl] {
+			seen[curClassDecl] = true
 			if !yield(curClassDecl) {
 				return
 			}
@@ -75,11 +93,29 @@ func InheritanceChain(decl *ast.ClassDecl, identBindings map[*ast.Ident][]ast.Bi
 			if !ok {
 				break
 			}
-			// An invalid class declaration might specify itself as its own superclass.
-			if superclassDecl == curClassDecl {
-				break
-			}
 			curClassDecl = superclassDecl
 		}
 	}
 }
+
+// Deprecation reports whether a declaration has been deprecated with a @deprecated annotation. If the annotation is
+// passed a string, such as @deprecated("use bar instead"), then it's returned as the deprecation message.
+func Deprecation(decl ast.Binding) (msg string, deprecated bool) {
+	annotated, ok := decl.(ast.Annotated)
+	if !ok {
+		return "", false
+	}
+	annotation, ok := annotated.Annotation("deprecated")
+	if !ok {
+		return "", false
+	}
+	if len(annotation.Args) > 0 {
+		if literal, ok := annotation.Args[0].(*ast.LiteralExpr); ok && literal.Value.Type == token.String {
+			// Double-quoted Go strings can't contain new lines.
+			if value, err := strconv.Unquote(strings.ReplaceAll(literal.Value.Lexeme, "\n", `\n`)); err == nil {
+				msg = value
+			}
+		}
+	}
+	return msg, true
+}
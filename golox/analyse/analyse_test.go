@@ -0,0 +1,80 @@
+package analyse_test
+
+import (
+	"iter"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/marcuscaisey/lox/golox/analyse"
+	"github.com/marcuscaisey/lox/golox/ast"
+	"github.com/marcuscaisey/lox/golox/parser"
+)
+
+func TestInheritanceChain(t *testing.T) {
+	tests := []struct {
+		name    string
+		program string
+		want    []string
+	}{
+		{name: "no superclass", program: `class A {}`, want: []string{"A"}},
+		{name: "superclass", program: `class A {} class B < A {}`, want: []string{"B", "A"}},
+		{name: "grandparent", program: `class A {} class B < A {} class C < B {}`, want: []string{"C", "B", "A"}},
+		{name: "undeclared superclass", program: `class A < B {}`, want: []string{"A"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			program, err := parser.Parse(strings.NewReader(test.program), "test.lox")
+			if err != nil {
+				t.Fatalf("parsing program: %s", err)
+			}
+			identBindings, _ := analyse.ResolveIdents(program, nil)
+			classDecls := classDecls(program)
+
+			got := classNames(analyse.InheritanceChain(classDecls[len(classDecls)-1], identBindings))
+
+			if !slices.Equal(got, test.want) {
+				t.Errorf("InheritanceChain() yielded %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestInheritanceChainCycle(t *testing.T) {
+	program, err := parser.Parse(strings.NewReader(`class A < B {} class B < A {}`), "test.lox")
+	if err != nil {
+		t.Fatalf("parsing program: %s", err)
+	}
+	classDecls := classDecls(program)
+	a, b := classDecls[0], classDecls[1]
+	// The resolver doesn't bind A's superclass as B is used before its declaration, so the cycle is created by hand.
+	identBindings := map[*ast.Ident][]ast.Binding{
+		a.Superclass: {b},
+		b.Superclass: {a},
+	}
+
+	got := classNames(analyse.InheritanceChain(a, identBindings))
+
+	want := []string{"A", "B"}
+	if !slices.Equal(got, want) {
+		t.Errorf("InheritanceChain() yielded %q, want %q", got, want)
+	}
+}
+
+func classDecls(program *ast.Program) []*ast.ClassDecl {
+	var decls []*ast.ClassDecl
+	for _, stmt := range program.Stmts {
+		if decl, ok := stmt.(*ast.ClassDecl); ok {
+			decls = append(decls, decl)
+		}
+	}
+	return decls
+}
+
+func classNames(chain iter.Seq[*ast.ClassDecl]) []string {
+	var names []string
+	for decl := range chain {
+		names = append(names, decl.Name.String())
+	}
+	return names
+}
@@ -2,6 +2,7 @@ package analyse
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/marcuscaisey/lox/golox/ast"
@@ -29,19 +30,24 @@ const (
 //   - property getter cannot have parameters
 //   - property setter must have exactly one parameter
 //   - functions cannot have more than 255 parameters
+//   - function parameters without a default value cannot follow those with one
 //   - function calls cannot have more than 255 arguments
+//   - function calls cannot have two named arguments with the same name
 //   - classes cannot inherit from themselves
 //   - classes cannot have two methods with the same name and modifiers
 //   - classes cannot have a property accessor and method with the same name
+//   - functions which return a value don't also return without one (excluding init())
+//   - statements don't follow a return statement in the same block
 //
 // If there is an error, it will be of type [loxerr.Errors].
 func CheckSemantics(program *ast.Program, opts ...Option) error {
 	cfg := newConfig(opts)
-	c := &semanticChecker{extraFeatures: cfg.extraFeatures}
+	c := &semanticChecker{fatalOnly: cfg.fatalOnly, extraFeatures: cfg.extraFeatures}
 	return c.Check(program)
 }
 
 type semanticChecker struct {
+	fatalOnly     bool
 	extraFeatures bool
 
 	inLoop       bool
@@ -59,6 +65,8 @@ func (c *semanticChecker) Check(program *ast.Program) error {
 
 func (c *semanticChecker) walk(node ast.Node) bool {
 	switch node := node.(type) {
+	case *ast.VarDecl:
+		c.checkConstInitialised(node)
 	case *ast.FunDecl:
 		c.walkFun(node.Function, funTypeFunction)
 		return false
@@ -76,6 +84,8 @@ func (c *semanticChecker) walk(node ast.Node) bool {
 	case *ast.ForStmt:
 		c.walkForStmt(node)
 		return false
+	case *ast.Block:
+		c.checkNoUnreachableCode(node.Stmts)
 	case *ast.BreakStmt:
 		c.checkBreakInLoop(node)
 	case *ast.ContinueStmt:
@@ -93,8 +103,10 @@ func (c *semanticChecker) walk(node ast.Node) bool {
 	case *ast.SuperExpr:
 		c.checkSuperInMethod(node)
 		c.checkSuperInSubclass(node)
+		c.checkNoBlankPropertyAccess(node.Name)
 	case *ast.CallExpr:
-		c.checkNumArgs(node.Args)
+		c.checkNumArgs(node)
+		c.checkNoDuplicateNamedArgs(node)
 	case *ast.PropertyExpr:
 		c.checkNoBlankPropertyAccess(node.Name)
 	case *ast.PropertySetExpr:
@@ -111,6 +123,7 @@ func (c *semanticChecker) walkFun(fun *ast.Function, funType funType) {
 	}
 
 	c.checkNumParams(fun.Params)
+	c.checkDefaultParamsLast(fun.Params)
 
 	// Break and continue are not allowed to jump out of a function so reset the loop depth to catch any invalid uses.
 	prevInLoop := c.inLoop
@@ -128,6 +141,8 @@ func (c *semanticChecker) walkFun(fun *ast.Function, funType funType) {
 	}
 
 	ast.WalkChildren(fun, c.walk)
+
+	c.checkConsistentReturns(fun, funType)
 }
 
 func (c *semanticChecker) walkClassDecl(decl *ast.ClassDecl) {
@@ -204,12 +219,29 @@ func (c *semanticChecker) checkMethods(decls []*ast.MethodDecl) {
 	}
 }
 
+func (c *semanticChecker) checkConstInitialised(decl *ast.VarDecl) {
+	if decl.IsConst() && decl.Initialiser == nil && decl.Name.IsValid() {
+		c.errs.Addf(decl.Name, loxerr.Fatal, "constant %m must be initialised", decl.Name)
+	}
+}
+
 func (c *semanticChecker) checkNumParams(params []*ast.ParamDecl) {
 	if len(params) > maxParams {
 		c.errs.Addf(params[maxParams], loxerr.Fatal, "cannot define more than %d function parameters", maxParams)
 	}
 }
 
+func (c *semanticChecker) checkDefaultParamsLast(params []*ast.ParamDecl) {
+	seenDefault := false
+	for _, param := range params {
+		if param.HasDefault() {
+			seenDefault = true
+		} else if seenDefault {
+			c.errs.Addf(param, loxerr.Fatal, "parameter without a default value cannot follow one with a default value")
+		}
+	}
+}
+
 func (c *semanticChecker) checkNoStaticInit(decl *ast.MethodDecl) {
 	if decl.Name.IsValid() && decl.Name.String() == token.IdentInit && decl.IsStatic() {
 		c.errs.Addf(decl.Name, loxerr.Fatal, "%s() cannot be static", token.IdentInit)
@@ -284,6 +316,121 @@ func (c *semanticChecker) checkNoInitReturn(stmt *ast.ReturnStmt) {
 	}
 }
 
+func (c *semanticChecker) checkConsistentReturns(fun *ast.Function, funType funType) {
+	if c.fatalOnly || funType.IsInit() || fun.Body == nil {
+		return
+	}
+	var valueReturns, bareReturns []*ast.ReturnStmt
+	for _, stmt := range funReturnStmts(fun) {
+		if stmt.Value != nil {
+			valueReturns = append(valueReturns, stmt)
+		} else {
+			bareReturns = append(bareReturns, stmt)
+		}
+	}
+	if len(valueReturns) == 0 {
+		return
+	}
+	for _, stmt := range bareReturns {
+		c.errs.Addf(stmt, loxerr.Warning, "%m without a value in function which returns a value elsewhere", token.Return)
+	}
+	if !stmtsTerminate(fun.Body.Stmts) && !fun.Body.RightBrace.IsZero() {
+		c.errs.Addf(fun.Body.RightBrace, loxerr.Warning, "end of function reached without returning a value")
+	}
+}
+
+// checkNoUnreachableCode reports the statements which follow a return statement in a block.
+func (c *semanticChecker) checkNoUnreachableCode(stmts []ast.Stmt) {
+	if c.fatalOnly {
+		return
+	}
+	returnIdx := slices.IndexFunc(stmts, isReturnStmt)
+	if returnIdx == -1 {
+		return
+	}
+	unreachable := slices.DeleteFunc(slices.Clone(stmts[returnIdx+1:]), func(stmt ast.Stmt) bool {
+		_, ok := stmt.(*ast.Comment)
+		return ok
+	})
+	if len(unreachable) == 0 {
+		return
+	}
+	c.errs.AddSpanningRangesf(unreachable[0], unreachable[len(unreachable)-1], loxerr.Hint, UnreachableCodeMsg)
+}
+
+func isReturnStmt(stmt ast.Stmt) bool {
+	if commentedStmt, ok := stmt.(*ast.CommentedStmt); ok {
+		stmt = commentedStmt.Stmt
+	}
+	_, ok := stmt.(*ast.ReturnStmt)
+	return ok
+}
+
+// funReturnStmts returns the return statements of a function, excluding those of any nested functions.
+func funReturnStmts(fun *ast.Function) []*ast.ReturnStmt {
+	var stmts []*ast.ReturnStmt
+	ast.WalkChildren(fun, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.ReturnStmt:
+			stmts = append(stmts, node)
+		case *ast.Function:
+			return false
+		default:
+		}
+		return true
+	})
+	return stmts
+}
+
+// stmtsTerminate reports whether execution of a list of statements always ends with a return statement.
+func stmtsTerminate(stmts []ast.Stmt) bool {
+	return slices.ContainsFunc(stmts, stmtTerminates)
+}
+
+func stmtTerminates(stmt ast.Stmt) bool {
+	switch stmt := stmt.(type) {
+	case *ast.ReturnStmt:
+		return true
+	case *ast.CommentedStmt:
+		return stmtTerminates(stmt.Stmt)
+	case *ast.Block:
+		return stmtsTerminate(stmt.Stmts)
+	case *ast.IfStmt:
+		return stmt.Else != nil && stmtTerminates(stmt.Then) && stmtTerminates(stmt.Else)
+	case *ast.TryStmt:
+		return stmtTerminates(stmt.Body) && stmtTerminates(stmt.CatchBody)
+	case *ast.WithStmt:
+		return stmtTerminates(stmt.Body)
+	case *ast.WhileStmt:
+		return isTrueLiteral(stmt.Condition) && !loopBreaks(stmt.Body)
+	case *ast.ForStmt:
+		return stmt.Condition == nil && !loopBreaks(stmt.Body)
+	default:
+		return false
+	}
+}
+
+func isTrueLiteral(expr ast.Expr) bool {
+	literal, ok := expr.(*ast.LiteralExpr)
+	return ok && literal.Value.Type == token.True
+}
+
+// loopBreaks reports whether the body of a loop contains a break statement which exits the loop.
+func loopBreaks(body ast.Stmt) bool {
+	breaks := false
+	ast.Walk(body, func(node ast.Node) bool {
+		switch node.(type) {
+		case *ast.BreakStmt:
+			breaks = true
+		case *ast.WhileStmt, *ast.ForStmt, *ast.Function:
+			return false
+		default:
+		}
+		return !breaks
+	})
+	return breaks
+}
+
 func (c *semanticChecker) checkNoBlankAccess(expr *ast.IdentExpr) {
 	if c.extraFeatures && expr.Ident.IsValid() && expr.Ident.String() == token.IdentBlank {
 		c.errs.Addf(expr.Ident, loxerr.Fatal, "'%s' cannot be used as a value", token.IdentBlank)
@@ -320,9 +467,25 @@ func (c *semanticChecker) checkSuperInSubclass(expr *ast.SuperExpr) {
 	}
 }
 
-func (c *semanticChecker) checkNumArgs(args []ast.Expr) {
-	if len(args) > maxArgs {
-		c.errs.Addf(args[maxArgs], loxerr.Fatal, "cannot pass more than %d arguments to function", maxArgs)
+func (c *semanticChecker) checkNumArgs(expr *ast.CallExpr) {
+	if len(expr.Args) > maxArgs {
+		c.errs.Addf(expr.Args[maxArgs], loxerr.Fatal, "cannot pass more than %d arguments to function", maxArgs)
+	} else if len(expr.Args)+len(expr.NamedArgs) > maxArgs {
+		c.errs.Addf(expr.NamedArgs[maxArgs-len(expr.Args)], loxerr.Fatal, "cannot pass more than %d arguments to function", maxArgs)
+	}
+}
+
+func (c *semanticChecker) checkNoDuplicateNamedArgs(expr *ast.CallExpr) {
+	seen := map[string]bool{}
+	for _, namedArg := range expr.NamedArgs {
+		if !namedArg.Name.IsValid() {
+			continue
+		}
+		name := namedArg.Name.String()
+		if seen[name] {
+			c.errs.Addf(namedArg.Name, loxerr.Fatal, "%m has already been passed as a named argument", namedArg.Name)
+		}
+		seen[name] = true
 	}
 }
 
@@ -2,6 +2,9 @@ package analyse
 
 import (
 	"iter"
+	"maps"
+	"slices"
+	"unicode/utf8"
 
 	"github.com/marcuscaisey/lox/golox/ast"
 	"github.com/marcuscaisey/lox/golox/builtins"
@@ -20,9 +23,14 @@ import (
 // This function also checks that identifiers are not:
 //   - declared and never used
 //   - declared more than once in the same scope
-//   - used before they are declared (best effort for globals)
-//   - used and not declared (best effort for globals)
+//   - used before they are declared (best effort for globals declared with var)
+//   - used and not declared (best effort for globals), suggesting a declared identifier with a similar name
 //   - used before they are defined (best effort for globals)
+//   - declared with var anywhere other than a top-level statement, where let should be used instead
+//   - global variables used in a function which is declared before them, which is reported as a hint
+//
+// It also checks that named arguments match the name of a parameter of the function being called, if it can be
+// determined.
 //
 // Some checks are best effort for global identifiers as it's not always possible to determine how they're used without
 // running the program. For example, in the following example, whether the program is valid depends on whether the
@@ -83,6 +91,7 @@ func ResolveIdents(program *ast.Program, builtins []ast.Decl, opts ...Option) (m
 		bindingsByName:                            map[string][]ast.Binding{},
 		propAccessorsByPropKeyByClassDecl:         map[*ast.ClassDecl]map[propertyKey][]*ast.MethodDecl{},
 		identBindings:                             map[*ast.Ident][]ast.Binding{},
+		undeclaredIdentSuggestions:                map[*ast.Ident]*ast.Ident{},
 	}
 	return r.Resolve(program)
 }
@@ -96,6 +105,8 @@ type identResolver struct {
 	globalScope                               *scope
 	resolvingBuiltins                         bool
 	globalDecls                               map[string]ast.Decl
+	globalLetDecls                            map[string]ast.Decl
+	topLevelStmts                             map[ast.Stmt]bool
 	forwardDeclaredGlobals                    map[string]bool
 	inFun                                     bool
 	inGlobalFun                               bool
@@ -107,6 +118,7 @@ type identResolver struct {
 	bindingsByClassPropKey                    map[classPropertyKey][]ast.Binding
 	bindingsByName                            map[string][]ast.Binding
 	propAccessorsByPropKeyByClassDecl         map[*ast.ClassDecl]map[propertyKey][]*ast.MethodDecl
+	undeclaredIdentSuggestions                map[*ast.Ident]*ast.Ident
 
 	identBindings map[*ast.Ident][]ast.Binding
 	errs          loxerr.Errors
@@ -125,27 +137,155 @@ type propertyKey struct {
 
 func (r *identResolver) Resolve(program *ast.Program) (map[*ast.Ident][]ast.Binding, error) {
 	ast.Walk(program, r.walk)
+	r.checkDeprecatedUsages()
+	r.checkNamedArgs(program)
 	return r.identBindings, r.errs.Err()
 }
 
-func (r *identResolver) readGlobalDecls(program *ast.Program) map[string]ast.Decl {
+// checkDeprecatedUsages adds a warning for each identifier which refers to a deprecated declaration. If an identifier
+// could refer to more than one declaration, then a warning is only added if they're all deprecated.
+func (r *identResolver) checkDeprecatedUsages() {
+	idents := slices.SortedFunc(maps.Keys(r.identBindings), func(x, y *ast.Ident) int {
+		return x.Start().Compare(y.Start())
+	})
+	for _, ident := range idents {
+		bindings := r.identBindings[ident]
+		if len(bindings) == 0 {
+			continue
+		}
+		var msg string
+		deprecated := true
+		for _, binding := range bindings {
+			if binding.BoundIdent() == ident {
+				deprecated = false
+				break
+			}
+			var ok bool
+			if msg, ok = Deprecation(binding); !ok {
+				deprecated = false
+				break
+			}
+		}
+		if !deprecated {
+			continue
+		}
+		if msg != "" {
+			r.addErrorf(ident, loxerr.Warning, "%m is deprecated: %s", ident, msg)
+		} else {
+			r.addErrorf(ident, loxerr.Warning, "%m is deprecated", ident)
+		}
+	}
+}
+
+// checkNamedArgs adds an error for each named argument which doesn't match the name of a parameter of the function
+// being called. This is only checked if every declaration that the callee could refer to is a user-defined function,
+// method, or class.
+func (r *identResolver) checkNamedArgs(program *ast.Program) {
+	ast.Walk(program, func(expr *ast.CallExpr) bool {
+		if len(expr.NamedArgs) == 0 {
+			return true
+		}
+		var calleeIdent *ast.Ident
+		switch callee := expr.Callee.(type) {
+		case *ast.IdentExpr:
+			calleeIdent = callee.Ident
+		case *ast.PropertyExpr:
+			calleeIdent = callee.Name
+		case *ast.SuperExpr:
+			calleeIdent = callee.Name
+		default:
+			return true
+		}
+		paramNames, ok := r.paramNames(r.identBindings[calleeIdent])
+		if !ok {
+			return true
+		}
+		for _, namedArg := range expr.NamedArgs {
+			if namedArg.Name.IsValid() && !paramNames[namedArg.Name.String()] {
+				r.addErrorf(namedArg.Name, loxerr.Fatal, "%m has no parameter %m", calleeIdent, namedArg.Name)
+			}
+		}
+		return true
+	})
+}
+
+// paramNames returns the names of the parameters which any of the given bindings accept when called and whether they
+// could be determined.
+func (r *identResolver) paramNames(bindings []ast.Binding) (map[string]bool, bool) {
+	if len(bindings) == 0 {
+		return nil, false
+	}
+	names := map[string]bool{}
+	addParams := func(params []*ast.ParamDecl) {
+		for _, param := range params {
+			names[param.Name.String()] = true
+		}
+	}
+	for _, binding := range bindings {
+		if decl, ok := binding.(ast.Decl); ok && slices.Contains(r.builtins, decl) {
+			return nil, false
+		}
+		switch decl := binding.(type) {
+		case *ast.FunDecl:
+			addParams(decl.GetParams())
+		case *ast.MethodDecl:
+			if decl.IsAccessor() {
+				return nil, false
+			}
+			addParams(decl.GetParams())
+		case *ast.ClassDecl:
+		inheritanceChain:
+			for classDecl := range InheritanceChain(decl, r.identBindings) {
+				for _, methodDecl := range classDecl.Methods() {
+					if methodDecl.IsInit() {
+						addParams(methodDecl.GetParams())
+						break inheritanceChain
+					}
+				}
+			}
+		default:
+			return nil, false
+		}
+	}
+	return names, true
+}
+
+// readGlobalDecls returns the global declarations in a program which can be forward declared, along with the global let
+// declarations, which can't be.
+func (r *identResolver) readGlobalDecls(program *ast.Program) (map[string]ast.Decl, map[string]ast.Decl) {
 	decls := map[string]ast.Decl{}
+	letDecls := map[string]ast.Decl{}
 	for _, stmt := range program.Stmts {
 		if commentedStmt, ok := stmt.(*ast.CommentedStmt); ok {
 			stmt = commentedStmt.Stmt
 		}
-		if decl, ok := stmt.(ast.Decl); ok {
+		var stmtDecls []ast.Decl
+		switch stmt := stmt.(type) {
+		case ast.Decl:
+			stmtDecls = []ast.Decl{stmt}
+		case *ast.DestructuringDecl:
+			for _, decl := range stmt.Decls {
+				stmtDecls = append(stmtDecls, decl)
+			}
+		}
+		for _, decl := range stmtDecls {
 			ident := decl.BoundIdent()
 			if !ident.IsValid() {
 				continue
 			}
 			name := ident.String()
+			if isLetDecl(decl) {
+				if _, ok := letDecls[name]; !ok {
+					letDecls[name] = decl
+				}
+				continue
+			}
 			if _, ok := decls[name]; !ok {
 				decls[name] = decl
 			}
 		}
 	}
-	return decls
+	return decls, letDecls
 }
 
 func (r *identResolver) addErrorf(rang token.Range, typ loxerr.Type, format string, args ...any) {
@@ -249,6 +389,17 @@ func (s *scope) IsDefined(name string) bool {
 	return s.decls[name].Status&declStatusDefined != 0
 }
 
+// Declarations returns an iterator over the statements which declared identifiers in the scope.
+func (s *scope) Declarations() iter.Seq[ast.Decl] {
+	return func(yield func(ast.Decl) bool) {
+		for _, decl := range s.decls {
+			if !yield(decl.Stmt) {
+				return
+			}
+		}
+	}
+}
+
 // UnusedDeclarations returns an iterator over the declarations of names in the scope which have not been used.
 func (s *scope) UnusedDeclarations() iter.Seq[ast.Decl] {
 	return func(yield func(ast.Decl) bool) {
@@ -281,11 +432,21 @@ func (r *identResolver) beginScope() func() {
 	return func() {
 		scope := r.scopes.Pop()
 		for decl := range scope.UnusedDeclarations() {
-			r.addErrorf(decl.BoundIdent(), loxerr.Hint, "%m has been declared but is never used", decl.BoundIdent())
+			if isTestFunDecl(decl) {
+				// Test functions are called by golox test rather than by the program.
+				continue
+			}
+			r.addErrorf(decl.BoundIdent(), loxerr.Hint, "%m "+UnusedDeclMsgSuffix, decl.BoundIdent())
 		}
 		for ident := range scope.UndeclaredUsages() {
 			if scope.IsDeclared(ident.String()) {
-				r.addErrorf(ident, loxerr.Warning, "%m has been used before its declaration", ident)
+				typ := loxerr.Warning
+				if isLetDecl(scope.Declaration(ident.String())) {
+					typ = loxerr.Fatal
+				}
+				r.addErrorf(ident, typ, "%m has been used before its declaration", ident)
+			} else if suggestion, ok := r.undeclaredIdentSuggestions[ident]; ok {
+				r.addErrorf(ident, loxerr.Warning, "%m has not been declared, did you mean %m?", ident, suggestion)
 			} else {
 				r.addErrorf(ident, loxerr.Warning, "%m has not been declared", ident)
 			}
@@ -310,7 +471,7 @@ func (r *identResolver) declareIdent(stmt ast.Decl) {
 	}
 	if scope := r.scopes.Peek(); scope.IsDeclared(ident.String()) {
 		typ := loxerr.Fatal
-		if r.inGlobalScope() {
+		if r.inGlobalScope() && !isConstDecl(stmt) && !isConstDecl(scope.Declaration(ident.String())) {
 			typ = loxerr.Hint
 		}
 		r.addErrorf(ident, typ, "%m has already been declared", ident)
@@ -323,6 +484,28 @@ func (r *identResolver) declareIdent(stmt ast.Decl) {
 	}
 }
 
+func isConstDecl(decl ast.Decl) bool {
+	switch decl := decl.(type) {
+	case *ast.VarDecl:
+		return decl.IsConst()
+	case *ast.DestructuredVarDecl:
+		return decl.IsConst()
+	default:
+		return false
+	}
+}
+
+func isLetDecl(decl ast.Decl) bool {
+	switch decl := decl.(type) {
+	case *ast.VarDecl:
+		return decl.IsLet()
+	case *ast.DestructuredVarDecl:
+		return decl.IsLet()
+	default:
+		return false
+	}
+}
+
 func (r *identResolver) defineIdent(ident *ast.Ident) {
 	if !ident.IsValid() || (r.extraFeatures && ident.String() == token.IdentBlank) {
 		return
@@ -374,6 +557,9 @@ func (r *identResolver) resolveIdent(ident *ast.Ident, op identOp) {
 		if scope.IsDeclared(ident.String()) {
 			scope.Use(ident.String())
 			r.identBindings[ident] = append(r.identBindings[ident], scope.Declaration(ident.String()))
+			if scope == r.globalScope {
+				r.checkGlobalVarDeclaredLater(ident, scope.Declaration(ident.String()))
+			}
 			// If we're in a function which was declared in the same or a deeper scope than the identifier was declared
 			// in, then we can't definitely say that the identifier has been defined yet. It might be defined later
 			// before the function is called.
@@ -389,11 +575,96 @@ func (r *identResolver) resolveIdent(ident *ast.Ident, op identOp) {
 		r.globalScope.Use(ident.String())
 		r.forwardDeclaredGlobals[ident.String()] = true
 		r.identBindings[ident] = append(r.identBindings[ident], decl)
+		r.checkGlobalVarDeclaredLater(ident, decl)
+		return
+	}
+	if _, ok := r.globalLetDecls[ident.String()]; ok && r.inGlobalFun {
+		// Global let declarations aren't forward declared, so a function can't refer to one which is declared after it.
+		// This will be reported when the global scope ends.
+		r.globalScope.UseUndeclared(ident)
 		return
 	}
+	if suggestion, ok := r.suggestIdent(ident); ok {
+		r.undeclaredIdentSuggestions[ident] = suggestion
+	}
 	r.scopes.Peek().UseUndeclared(ident)
 }
 
+// suggestIdent returns the identifier in scope whose name is closest to that of an undeclared identifier, if it's close
+// enough that the undeclared identifier is likely to be a misspelling of it. Globals declared later in the program are
+// included as they're in scope inside functions.
+func (r *identResolver) suggestIdent(ident *ast.Ident) (*ast.Ident, bool) {
+	name := ident.String()
+	maxDistance := (utf8.RuneCountInString(name) + 1) / 3
+	var best *ast.Ident
+	bestDistance := maxDistance + 1
+	consider := func(decl ast.Decl) {
+		candidate := decl.BoundIdent()
+		switch candidate.String() {
+		case name, token.This.String(), token.IdentBlank:
+			return
+		}
+		distance := editDistance(name, candidate.String())
+		if distance < bestDistance || distance == bestDistance && best != nil && candidate.String() < best.String() {
+			best, bestDistance = candidate, distance
+		}
+	}
+	for _, scope := range r.scopes.Backward() {
+		for decl := range scope.Declarations() {
+			consider(decl)
+		}
+	}
+	for _, decl := range r.globalDecls {
+		consider(decl)
+	}
+	return best, best != nil
+}
+
+// editDistance returns the number of single character insertions, deletions, substitutions, and transpositions of
+// adjacent characters required to change a into b.
+func editDistance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	// dists[i][j] is the edit distance between s[:i] and t[:j].
+	dists := make([][]int, len(s)+1)
+	for i := range dists {
+		dists[i] = make([]int, len(t)+1)
+		dists[i][0] = i
+	}
+	for j := range dists[0] {
+		dists[0][j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			dists[i][j] = min(dists[i-1][j]+1, dists[i][j-1]+1, dists[i-1][j-1]+cost)
+			if i > 1 && j > 1 && s[i-1] == t[j-2] && s[i-2] == t[j-1] {
+				dists[i][j] = min(dists[i][j], dists[i-2][j-2]+1)
+			}
+		}
+	}
+	return dists[len(s)][len(t)]
+}
+
+// checkGlobalVarDeclaredLater reports a use of a global variable inside a function which is declared after the
+// function. Whether the variable has been defined when it's used then depends on when the function is called.
+func (r *identResolver) checkGlobalVarDeclaredLater(ident *ast.Ident, decl ast.Decl) {
+	switch decl.(type) {
+	case *ast.VarDecl, *ast.DestructuredVarDecl:
+	default:
+		return
+	}
+	name := decl.BoundIdent()
+	if !r.inFun || name.Start().File != ident.Start().File {
+		return
+	}
+	if name.Start().Compare(ident.Start()) > 0 {
+		r.addErrorf(ident, loxerr.Hint, "%m is a global variable which is declared after this function", ident)
+	}
+}
+
 type propertyType int
 
 const (
@@ -469,6 +740,8 @@ func (r *identResolver) walk(node ast.Node) bool {
 		r.walkProgram(node)
 	case *ast.VarDecl:
 		r.walkVarDecl(node)
+	case *ast.DestructuringDecl:
+		r.walkDestructuringDecl(node)
 	case *ast.FunDecl:
 		r.walkFunDecl(node)
 	case *ast.Function:
@@ -481,6 +754,10 @@ func (r *identResolver) walk(node ast.Node) bool {
 		r.walkBlock(node)
 	case *ast.ForStmt:
 		r.walkForStmt(node)
+	case *ast.TryStmt:
+		r.walkTryStmt(node)
+	case *ast.WithStmt:
+		r.walkWithStmt(node)
 	case *ast.FunExpr:
 		r.walkFunExpr(node)
 	case *ast.IdentExpr:
@@ -489,6 +766,8 @@ func (r *identResolver) walk(node ast.Node) bool {
 	case *ast.AssignmentExpr:
 		r.resolveAssignmentExpr(node)
 		return true
+	case *ast.SuperExpr:
+		r.resolveSuperExpr(node)
 	case *ast.PropertyExpr:
 		r.walkPropertyExpr(node)
 	case *ast.PropertySetExpr:
@@ -510,7 +789,14 @@ func (r *identResolver) walkProgram(program *ast.Program) {
 	}
 	r.resolvingBuiltins = false
 
-	r.globalDecls = r.readGlobalDecls(program)
+	r.globalDecls, r.globalLetDecls = r.readGlobalDecls(program)
+	r.topLevelStmts = map[ast.Stmt]bool{}
+	for _, stmt := range program.Stmts {
+		if commentedStmt, ok := stmt.(*ast.CommentedStmt); ok {
+			stmt = commentedStmt.Stmt
+		}
+		r.topLevelStmts[stmt] = true
+	}
 
 	ast.WalkChildren(program, r.walk)
 
@@ -533,8 +819,12 @@ func (r *identResolver) walkProgram(program *ast.Program) {
 }
 
 func (r *identResolver) walkVarDecl(decl *ast.VarDecl) {
+	r.checkLetInsteadOfVar(decl, decl.Var)
 	if decl.Initialiser != nil {
-		if r.inGlobalScope() {
+		// A global variable can be redeclared, so its initialiser may refer to the previous declaration. Constants can't
+		// be redeclared, so any reference to a constant in its own initialiser is to the constant itself. Let declarations
+		// are never forward declared, so they're treated the same as local declarations.
+		if r.inGlobalScope() && !decl.IsConst() && !decl.IsLet() {
 			ast.Walk(decl.Initialiser, r.walk)
 			r.declareIdent(decl)
 		} else {
@@ -549,6 +839,39 @@ func (r *identResolver) walkVarDecl(decl *ast.VarDecl) {
 	}
 }
 
+// walkDestructuringDecl resolves a destructuring declaration in the same way as walkVarDecl resolves a variable
+// declaration with an initialiser.
+func (r *identResolver) walkDestructuringDecl(decl *ast.DestructuringDecl) {
+	r.checkLetInsteadOfVar(decl, decl.Var)
+	if r.inGlobalScope() && decl.Var.Type == token.Var {
+		ast.Walk(decl.Initialiser, r.walk)
+		for _, varDecl := range decl.Decls {
+			r.declareIdent(varDecl)
+		}
+	} else {
+		for _, varDecl := range decl.Decls {
+			r.declareIdent(varDecl)
+			r.startInitialisingIdent(varDecl.Name)
+		}
+		ast.Walk(decl.Initialiser, r.walk)
+		for _, varDecl := range decl.Decls {
+			r.finishInitialisingIdent(varDecl.Name)
+		}
+	}
+	for _, varDecl := range decl.Decls {
+		r.defineIdent(varDecl.Name)
+	}
+}
+
+// checkLetInsteadOfVar adds a warning if stmt is declared with var but isn't a top-level statement of the program. This
+// is decided by where stmt appears rather than by the scope that it's resolved in, so a for loop initialiser is warned
+// about even at the top level of the program.
+func (r *identResolver) checkLetInsteadOfVar(stmt ast.Stmt, varTok token.Token) {
+	if r.extraFeatures && !r.resolvingBuiltins && varTok.Type == token.Var && !r.topLevelStmts[stmt] {
+		r.addErrorf(varTok, loxerr.Warning, LetInsteadOfVarMsg)
+	}
+}
+
 func (r *identResolver) walkFunDecl(decl *ast.FunDecl) {
 	if !builtins.IsInternal(decl) {
 		r.declareIdent(decl)
@@ -561,6 +884,11 @@ func (r *identResolver) walkFunDecl(decl *ast.FunDecl) {
 }
 
 func (r *identResolver) walkFun(fun *ast.Function) {
+	// Default parameter values are evaluated in the scope that the function was declared in.
+	for _, param := range fun.Params {
+		ast.Walk(param.Default, r.walk)
+	}
+
 	endScope := r.beginScope()
 	defer endScope()
 
@@ -672,6 +1000,32 @@ func (r *identResolver) walkForStmt(stmt *ast.ForStmt) {
 	ast.WalkChildren(stmt, r.walk)
 }
 
+func (r *identResolver) walkTryStmt(stmt *ast.TryStmt) {
+	ast.Walk(stmt.Body, r.walk)
+
+	endScope := r.beginScope()
+	defer endScope()
+	if stmt.ErrorVar != nil {
+		r.declareIdent(stmt.ErrorVar)
+		r.defineIdent(stmt.ErrorVar.Name)
+	}
+	// As with function parameters, the catch body is walked without introducing another scope so that the error
+	// variable can't be redeclared inside it.
+	ast.WalkChildren(stmt.CatchBody, r.walk)
+}
+
+func (r *identResolver) walkWithStmt(stmt *ast.WithStmt) {
+	ast.Walk(stmt.Value, r.walk)
+
+	endScope := r.beginScope()
+	defer endScope()
+	r.declareIdent(stmt.Resource)
+	r.defineIdent(stmt.Resource.Name)
+	// As with the error variable of a try statement, the body is walked without introducing another scope so that the
+	// resource variable can't be redeclared inside it.
+	ast.WalkChildren(stmt.Body, r.walk)
+}
+
 func (r *identResolver) walkFunExpr(expr *ast.FunExpr) {
 	prevFunScopeLevel := r.funScopeLevel
 	r.funScopeLevel = r.scopes.Len() - 1
@@ -680,7 +1034,7 @@ func (r *identResolver) walkFunExpr(expr *ast.FunExpr) {
 }
 
 func (r *identResolver) resolveIdentExpr(expr *ast.IdentExpr) {
-	if !r.inGlobalScope() && expr.Ident.IsValid() && r.scopes.Peek().IsInitialising(expr.Ident.String()) {
+	if expr.Ident.IsValid() && r.scopes.Peek().IsInitialising(expr.Ident.String()) {
 		r.addErrorf(expr, loxerr.Fatal, "%m read in its own initialiser", expr.Ident)
 		return
 	}
@@ -689,9 +1043,24 @@ func (r *identResolver) resolveIdentExpr(expr *ast.IdentExpr) {
 
 func (r *identResolver) resolveAssignmentExpr(expr *ast.AssignmentExpr) {
 	r.resolveIdent(expr.Left, identOpWrite)
+	for _, binding := range r.identBindings[expr.Left] {
+		if decl, ok := binding.(ast.Decl); ok && isConstDecl(decl) {
+			r.addErrorf(expr.Left, loxerr.Fatal, "cannot assign to constant %m", expr.Left)
+			break
+		}
+	}
 	r.defineIdent(expr.Left)
 }
 
+func (r *identResolver) resolveSuperExpr(expr *ast.SuperExpr) {
+	if !expr.Name.IsValid() || expr.Name.String() == token.IdentBlank {
+		return
+	}
+	if r.curClassDecl != nil && r.curPropType != propertyTypeNone {
+		r.resolveSuperPropertyIdent(expr.Name, r.curClassDecl, r.curPropType)
+	}
+}
+
 func (r *identResolver) walkPropertyExpr(expr *ast.PropertyExpr) {
 	ast.WalkChildren(expr, r.walk)
 
@@ -709,11 +1078,6 @@ func (r *identResolver) walkPropertyExpr(expr *ast.PropertyExpr) {
 			r.addThisPropIdent(r.curClassDecl, r.curPropType, name, expr.Name)
 		}
 		return
-	case *ast.SuperExpr:
-		if r.curClassDecl != nil && r.curPropType != propertyTypeNone {
-			r.resolveSuperPropertyIdent(expr.Name, r.curClassDecl, r.curPropType)
-		}
-		return
 	case *ast.IdentExpr:
 		bindings, ok := r.identBindings[object.Ident]
 		if !ok {
@@ -780,3 +1144,12 @@ func (r *identResolver) addThisPropIdent(classDecl *ast.ClassDecl, propType prop
 	}
 	thisPropIdentsByName[name] = append(thisPropIdentsByName[name], ident)
 }
+
+func isTestFunDecl(decl ast.Decl) bool {
+	funDecl, ok := decl.(*ast.FunDecl)
+	if !ok {
+		return false
+	}
+	_, ok = funDecl.Annotation("test")
+	return ok
+}
@@ -133,6 +133,43 @@ func docText(docComments []*Comment) string {
 	return strings.Join(lines, "\n")
 }
 
+// Annotated is the interface implemented by all nodes which can be annotated.
+type Annotated interface {
+	Node
+	// Annotation returns the node's annotation with the given name and whether it exists.
+	Annotation(name string) (*Annotation, bool)
+}
+
+func findAnnotation(annotations []*Annotation, name string) (*Annotation, bool) {
+	for _, annotation := range annotations {
+		if annotation.Name.IsValid() && annotation.Name.String() == name {
+			return annotation, true
+		}
+	}
+	return nil, false
+}
+
+// Annotation is an annotation of a function, class, or method declaration, such as @test or
+// @deprecated("use bar instead").
+type Annotation struct {
+	At         token.Token
+	Name       *Ident `print:"named"`
+	LeftParen  token.Token
+	Args       []Expr `print:"named"`
+	Commas     []token.Token
+	RightParen token.Token
+	node
+}
+
+func (a *Annotation) Start() token.Position { return a.At.Start() }
+func (a *Annotation) End() token.Position {
+	return last(a.At, a.Name, a.LeftParen, lastSlice(a.Args), lastSlice(a.Commas), a.RightParen).End()
+}
+func (a *Annotation) IsValid() bool {
+	return a != nil && !a.At.IsZero() && isValid(a.Name) && isValidSlice(a.Args) &&
+		a.LeftParen.IsZero() == a.RightParen.IsZero()
+}
+
 // Decl is the interface which all declaration nodes implement.
 //
 //sumtype:decl
@@ -148,7 +185,8 @@ type decl struct {
 
 func (decl) isDecl() {}
 
-// VarDecl is a variable declaration, such as var a = 123 or var b.
+// VarDecl is a variable declaration, such as var a = 123, var b, or let c = 456, or a constant declaration, such as
+// const d = 789.
 type VarDecl struct {
 	Var         token.Token
 	Name        *Ident `print:"named"`
@@ -164,22 +202,70 @@ func (v *VarDecl) IsValid() bool {
 }
 func (v *VarDecl) BoundIdent() *Ident { return v.Name }
 
+// IsConst reports whether the declaration is a constant declaration.
+func (v *VarDecl) IsConst() bool { return v.Var.Type == token.Const }
+
+// IsLet reports whether the declaration is a let declaration.
+func (v *VarDecl) IsLet() bool { return v.Var.Type == token.Let }
+
+// DestructuringDecl is a declaration of variables which are bound to the elements of a list in order, such as
+// var a, b = minmax(list); or let c, d = [1, 2];.
+type DestructuringDecl struct {
+	Var         token.Token
+	Decls       []*DestructuredVarDecl `print:"named"`
+	Initialiser Expr                   `print:"named"`
+	Semicolon   token.Token
+	stmt
+}
+
+func (d *DestructuringDecl) Start() token.Position { return d.Var.Start() }
+func (d *DestructuringDecl) End() token.Position {
+	return last(d.Var, lastSlice(d.Decls), d.Initialiser, d.Semicolon).End()
+}
+func (d *DestructuringDecl) IsValid() bool {
+	return d != nil && !d.Var.IsZero() && isValidSlice(d.Decls) && isValid(d.Initialiser) && !d.Semicolon.IsZero()
+}
+
+// DestructuredVarDecl is the declaration of one of the variables of a [DestructuringDecl], such as a in
+// var a, b = [1, 2];.
+type DestructuredVarDecl struct {
+	Var  token.Token // Var token of the DestructuringDecl that the declaration is part of
+	Name *Ident      `print:"unnamed"`
+	decl
+}
+
+func (d *DestructuredVarDecl) Start() token.Position { return d.Name.Start() }
+func (d *DestructuredVarDecl) End() token.Position   { return d.Name.End() }
+func (d *DestructuredVarDecl) IsValid() bool         { return d != nil && !d.Var.IsZero() && isValid(d.Name) }
+func (d *DestructuredVarDecl) BoundIdent() *Ident    { return d.Name }
+
+// IsConst reports whether the declaration is part of a constant declaration.
+func (d *DestructuredVarDecl) IsConst() bool { return d.Var.Type == token.Const }
+
+// IsLet reports whether the declaration is part of a let declaration.
+func (d *DestructuredVarDecl) IsLet() bool { return d.Var.Type == token.Let }
+
 // FunDecl is a function declaration, such as fun add(x, y) { return x + y; }.
 type FunDecl struct {
-	DocComments []*Comment `print:"named"`
+	DocComments []*Comment    `print:"named"`
+	Annotations []*Annotation `print:"named"`
 	Fun         token.Token
 	Name        *Ident    `print:"named"`
 	Function    *Function `print:"named"`
 	decl
 }
 
-func (f *FunDecl) Start() token.Position { return f.Fun.Start() }
+func (f *FunDecl) Start() token.Position { return first(firstSlice(f.Annotations), f.Fun).Start() }
 func (f *FunDecl) End() token.Position   { return last(f.Fun, f.Name, f.Function).End() }
 func (f *FunDecl) IsValid() bool {
-	return f != nil && isValidSlice(f.DocComments) && !f.Fun.IsZero() && isValid(f.Name) && isValid(f.Function)
+	return f != nil && isValidSlice(f.DocComments) && isValidSlice(f.Annotations) && !f.Fun.IsZero() &&
+		isValid(f.Name) && isValid(f.Function)
 }
 func (f *FunDecl) BoundIdent() *Ident    { return f.Name }
 func (f *FunDecl) Documentation() string { return docText(f.DocComments) }
+func (f *FunDecl) Annotation(name string) (*Annotation, bool) {
+	return findAnnotation(f.Annotations, name)
+}
 
 // GetParams returns Function.Params or nil if Function is nil.
 func (f *FunDecl) GetParams() []*ParamDecl {
@@ -203,16 +289,22 @@ func (f *Function) IsValid() bool {
 	return f != nil && !f.LeftParen.IsZero() && isValidSlice(f.Params) && isValid(f.Body)
 }
 
-// ParamDecl is a parameter declaration, such as x or y.
+// ParamDecl is a parameter declaration, such as x, or y = 1 if it has a default value.
 type ParamDecl struct {
-	Name *Ident `print:"unnamed"`
+	Name    *Ident `print:"named"`
+	Default Expr   `print:"named"`
 	decl
 }
 
 func (p *ParamDecl) Start() token.Position { return p.Name.Start() }
-func (p *ParamDecl) End() token.Position   { return p.Name.End() }
-func (p *ParamDecl) IsValid() bool         { return p != nil && isValid(p.Name) }
-func (p *ParamDecl) BoundIdent() *Ident    { return p.Name }
+func (p *ParamDecl) End() token.Position   { return last(p.Name, p.Default).End() }
+func (p *ParamDecl) IsValid() bool {
+	return p != nil && isValid(p.Name) && isValidOptional(p.Default)
+}
+func (p *ParamDecl) BoundIdent() *Ident { return p.Name }
+
+// HasDefault reports whether the parameter has a default value.
+func (p *ParamDecl) HasDefault() bool { return p.Default != nil }
 
 // ClassDecl is a class declaration, such as
 //
@@ -222,7 +314,8 @@ func (p *ParamDecl) BoundIdent() *Ident    { return p.Name }
 //	  }
 //	}
 type ClassDecl struct {
-	DocComments []*Comment `print:"named"`
+	DocComments []*Comment    `print:"named"`
+	Annotations []*Annotation `print:"named"`
 	Class       token.Token
 	Name        *Ident `print:"named"`
 	Superclass  *Ident `print:"named"`
@@ -230,13 +323,17 @@ type ClassDecl struct {
 	decl
 }
 
-func (c *ClassDecl) Start() token.Position { return c.Class.Start() }
+func (c *ClassDecl) Start() token.Position { return first(firstSlice(c.Annotations), c.Class).Start() }
 func (c *ClassDecl) End() token.Position   { return last(c.Class, c.Name, c.Body).End() }
 func (c *ClassDecl) IsValid() bool {
-	return c != nil && isValidSlice(c.DocComments) && !c.Class.IsZero() && isValid(c.Name) && isValid(c.Body)
+	return c != nil && isValidSlice(c.DocComments) && isValidSlice(c.Annotations) && !c.Class.IsZero() &&
+		isValid(c.Name) && isValid(c.Body)
 }
 func (c *ClassDecl) BoundIdent() *Ident    { return c.Name }
 func (c *ClassDecl) Documentation() string { return docText(c.DocComments) }
+func (c *ClassDecl) Annotation(name string) (*Annotation, bool) {
+	return findAnnotation(c.Annotations, name)
+}
 
 // Methods returns the methods of the class.
 func (c *ClassDecl) Methods() []*MethodDecl {
@@ -260,21 +357,28 @@ func (c *ClassDecl) Methods() []*MethodDecl {
 type MethodDecl struct {
 	Class       *ClassDecl
 	DocComments []*Comment    `print:"named"`
+	Annotations []*Annotation `print:"named"`
 	Modifiers   []token.Token `print:"named"`
 	Name        *Ident        `print:"named"`
 	Function    *Function     `print:"named"`
 	decl
 }
 
-func (m *MethodDecl) Start() token.Position { return first(firstSlice(m.Modifiers), m.Name).Start() }
+func (m *MethodDecl) Start() token.Position {
+	return first(firstSlice(m.Annotations), firstSlice(m.Modifiers), m.Name).Start()
+}
 func (m *MethodDecl) End() token.Position {
 	return last(lastSlice(m.Modifiers), m.Name, m.Function).End()
 }
 func (m *MethodDecl) IsValid() bool {
-	return m != nil && isValidSlice(m.DocComments) && isValid(m.Name) && isValid(m.Function)
+	return m != nil && isValidSlice(m.DocComments) && isValidSlice(m.Annotations) && isValid(m.Name) &&
+		isValid(m.Function)
 }
 func (m *MethodDecl) BoundIdent() *Ident    { return m.Name }
 func (m *MethodDecl) Documentation() string { return docText(m.DocComments) }
+func (m *MethodDecl) Annotation(name string) (*Annotation, bool) {
+	return findAnnotation(m.Annotations, name)
+}
 
 // GetParams returns Function.Params or nil if Function is nil.
 func (m *MethodDecl) GetParams() []*ParamDecl {
@@ -392,6 +496,54 @@ func (i *IfStmt) IsValid() bool {
 	return i != nil && !i.If.IsZero() && isValid(i.Condition) && isValid(i.Then) && isValidOptional(i.Else)
 }
 
+// TryStmt is a try statement, such as
+//
+//	try {
+//	    print 1 / 0;
+//	} catch (e) {
+//	    print e;
+//	}
+type TryStmt struct {
+	Try       token.Token
+	Body      *Block `print:"named"`
+	Catch     token.Token
+	ErrorVar  *ParamDecl `print:"named"`
+	CatchBody *Block     `print:"named"`
+	stmt
+}
+
+func (t *TryStmt) Start() token.Position { return t.Try.Start() }
+func (t *TryStmt) End() token.Position {
+	return last(t.Try, t.Body, t.Catch, t.ErrorVar, t.CatchBody).End()
+}
+func (t *TryStmt) IsValid() bool {
+	return t != nil && !t.Try.IsZero() && isValid(t.Body) && !t.Catch.IsZero() && isValid(t.ErrorVar) && isValid(t.CatchBody)
+}
+
+// WithStmt is a with statement, such as
+//
+//	with (let file = open(path)) {
+//	    print file.read();
+//	}
+//
+// The close method of the resource is called when the body exits, including when it exits with an error.
+type WithStmt struct {
+	With     token.Token
+	Var      token.Token // Either var or let
+	Resource *ParamDecl  `print:"named"`
+	Value    Expr        `print:"named"`
+	Body     *Block      `print:"named"`
+	stmt
+}
+
+func (w *WithStmt) Start() token.Position { return w.With.Start() }
+func (w *WithStmt) End() token.Position {
+	return last(w.With, w.Var, w.Resource, w.Value, w.Body).End()
+}
+func (w *WithStmt) IsValid() bool {
+	return w != nil && !w.With.IsZero() && !w.Var.IsZero() && isValid(w.Resource) && isValid(w.Value) && isValid(w.Body)
+}
+
 // WhileStmt is a while statement, such as
 //
 //	while (a < 10) {
@@ -560,21 +712,33 @@ func (t *ThisExpr) Start() token.Position { return t.This.Start() }
 func (t *ThisExpr) End() token.Position   { return t.This.End() }
 func (t *ThisExpr) IsValid() bool         { return t != nil && !t.This.IsZero() }
 
-// SuperExpr is a super expression, such as super.b.
+// SuperExpr is a super expression, such as super.b. Dot and Name are empty if the expression is the object of a
+// [PropertySetExpr], such as super.b = 1, which is invalid.
 type SuperExpr struct {
 	Super token.Token
+	Dot   token.Token
+	Name  *Ident `print:"named"`
 	expr
 }
 
 func (s *SuperExpr) Start() token.Position { return s.Super.Start() }
-func (s *SuperExpr) End() token.Position   { return s.Super.End() }
-func (s *SuperExpr) IsValid() bool         { return s != nil && !s.Super.IsZero() }
+func (s *SuperExpr) End() token.Position   { return last(s.Super, s.Dot, s.Name).End() }
+func (s *SuperExpr) IsValid() bool {
+	if s == nil || s.Super.IsZero() {
+		return false
+	}
+	if s.Dot.IsZero() && s.Name == nil {
+		return true
+	}
+	return !s.Dot.IsZero() && isValid(s.Name)
+}
 
-// CallExpr is a call expression, such as add(x, 1).
+// CallExpr is a call expression, such as add(x, 1) or greet(name: "Sam").
 type CallExpr struct {
 	Callee     Expr `print:"named"`
 	LeftParen  token.Token
-	Args       []Expr `print:"named"`
+	Args       []Expr      `print:"named"` // Positional arguments
+	NamedArgs  []*NamedArg `print:"named"` // Named arguments, which follow the positional arguments
 	Commas     []token.Token
 	RightParen token.Token
 	expr
@@ -582,10 +746,24 @@ type CallExpr struct {
 
 func (c *CallExpr) Start() token.Position { return c.Callee.Start() }
 func (c *CallExpr) End() token.Position {
-	return last(c.Callee, c.LeftParen, lastSlice(c.Args), lastSlice(c.Commas), c.RightParen).End()
+	return last(c.Callee, c.LeftParen, lastSlice(c.Args), lastSlice(c.NamedArgs), lastSlice(c.Commas), c.RightParen).End()
 }
 func (c *CallExpr) IsValid() bool {
-	return c != nil && isValid(c.Callee) && isValidSlice(c.Args) && !c.RightParen.IsZero()
+	return c != nil && isValid(c.Callee) && isValidSlice(c.Args) && isValidSlice(c.NamedArgs) && !c.RightParen.IsZero()
+}
+
+// NamedArg is a named argument in a call expression, such as name: "Sam" in greet(name: "Sam").
+type NamedArg struct {
+	Name  *Ident `print:"named"`
+	Colon token.Token
+	Value Expr `print:"named"`
+	node
+}
+
+func (n *NamedArg) Start() token.Position { return n.Name.Start() }
+func (n *NamedArg) End() token.Position   { return last(n.Name, n.Colon, n.Value).End() }
+func (n *NamedArg) IsValid() bool {
+	return n != nil && isValid(n.Name) && !n.Colon.IsZero() && isValid(n.Value)
 }
 
 // IndexExpr is an index expression, such as x[2].
@@ -791,12 +969,18 @@ func isNil(node Node) bool {
 		return node == nil
 	case *VarDecl:
 		return node == nil
+	case *DestructuringDecl:
+		return node == nil
+	case *DestructuredVarDecl:
+		return node == nil
 	case *FunDecl:
 		return node == nil
 	case *Function:
 		return node == nil
 	case *ParamDecl:
 		return node == nil
+	case *Annotation:
+		return node == nil
 	case *ClassDecl:
 		return node == nil
 	case *MethodDecl:
@@ -809,6 +993,10 @@ func isNil(node Node) bool {
 		return node == nil
 	case *IfStmt:
 		return node == nil
+	case *TryStmt:
+		return node == nil
+	case *WithStmt:
+		return node == nil
 	case *WhileStmt:
 		return node == nil
 	case *ForStmt:
@@ -835,6 +1023,8 @@ func isNil(node Node) bool {
 		return node == nil
 	case *CallExpr:
 		return node == nil
+	case *NamedArg:
+		return node == nil
 	case *IndexExpr:
 		return node == nil
 	case *IndexSetExpr:
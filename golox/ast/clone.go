@@ -0,0 +1,94 @@
+package ast
+
+import "reflect"
+
+//go:generate go run ./clonegen
+
+// Clone returns a deep copy of node. Every node in the copy is newly allocated, so the copy can be modified without
+// affecting the original. Tokens are copied by value.
+//
+// References from a node to another node which isn't one of its children, such as from a [MethodDecl] to its
+// [ClassDecl], are also copied. If the referenced node is part of the copied subtree, then the reference will point to
+// its copy.
+func Clone[T Node](node T) T {
+	c := &cloner{clones: map[Node]Node{}}
+	return cloneChild(c, node)
+}
+
+type cloner struct {
+	clones map[Node]Node // Maps each node which has been cloned to its clone
+}
+
+func (c *cloner) clone(node Node) Node {
+	if clone, ok := c.clones[node]; ok {
+		return clone
+	}
+	if clone, ok := c.cloneGenerated(node); ok {
+		return clone
+	}
+	return c.cloneReflect(node)
+}
+
+func cloneChild[T Node](c *cloner, node T) T {
+	if isNil(node) {
+		return node
+	}
+	return c.clone(node).(T)
+}
+
+func cloneChildren[T Node](c *cloner, nodes []T) []T {
+	if nodes == nil {
+		return nil
+	}
+	clones := make([]T, len(nodes))
+	for i, node := range nodes {
+		clones[i] = cloneChild(c, node)
+	}
+	return clones
+}
+
+var nodeType = reflect.TypeFor[Node]()
+
+// cloneReflect clones a node using reflection. It's used for any node types which cloneGenerated doesn't handle.
+func (c *cloner) cloneReflect(node Node) Node {
+	value := reflect.ValueOf(node)
+	clone := reflect.New(value.Type().Elem())
+	c.clones[node] = clone.Interface().(Node)
+	clone.Elem().Set(value.Elem())
+
+	cloneValue := clone.Elem()
+	for i := range cloneValue.NumField() {
+		field := cloneValue.Field(i)
+		if !field.CanSet() {
+			continue
+		}
+		switch {
+		case field.Type().Implements(nodeType):
+			c.cloneReflectChild(field)
+		case field.Kind() == reflect.Slice:
+			if field.IsNil() {
+				continue
+			}
+			elems := reflect.MakeSlice(field.Type(), field.Len(), field.Len())
+			reflect.Copy(elems, field)
+			if field.Type().Elem().Implements(nodeType) {
+				for j := range elems.Len() {
+					c.cloneReflectChild(elems.Index(j))
+				}
+			}
+			field.Set(elems)
+		}
+	}
+
+	return clone.Interface().(Node)
+}
+
+// cloneReflectChild replaces the node stored in value with its clone.
+func (c *cloner) cloneReflectChild(value reflect.Value) {
+	if value.IsNil() {
+		return
+	}
+	if node := value.Interface().(Node); !isNil(node) {
+		value.Set(reflect.ValueOf(c.clone(node)))
+	}
+}
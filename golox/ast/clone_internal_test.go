@@ -0,0 +1,34 @@
+package ast
+
+import (
+	"testing"
+
+	"github.com/marcuscaisey/lox/golox/token"
+)
+
+func TestCloneReflect(t *testing.T) {
+	ident := func(name string) *Ident {
+		return &Ident{Token: token.Token{Type: token.Ident, Lexeme: name}}
+	}
+	call := &CallExpr{
+		Callee: &IdentExpr{Ident: ident("f")},
+		Args:   []Expr{&LiteralExpr{Value: token.Token{Type: token.Number, Lexeme: "1"}}, &IdentExpr{Ident: ident("x")}},
+		Commas: []token.Token{{Type: token.Comma, Lexeme: ","}},
+	}
+	stmt := &ExprStmt{Expr: call}
+
+	c := &cloner{clones: map[Node]Node{}}
+	clone := c.cloneReflect(stmt).(*ExprStmt)
+
+	if got, want := Sprint(clone), Sprint(stmt); got != want {
+		t.Errorf("Sprint(cloneReflect(stmt)) =\n%s\nwant:\n%s", got, want)
+	}
+	cloneCall := clone.Expr.(*CallExpr)
+	if cloneCall == call || cloneCall.Callee == call.Callee || cloneCall.Args[1] == call.Args[1] {
+		t.Error("cloneReflect(stmt) shares nodes with stmt")
+	}
+	cloneCall.Commas[0].Lexeme = ";"
+	if call.Commas[0].Lexeme != "," {
+		t.Error("modifying Commas of clone modified Commas of original")
+	}
+}
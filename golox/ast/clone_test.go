@@ -0,0 +1,120 @@
+package ast_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/marcuscaisey/lox/golox/ast"
+	"github.com/marcuscaisey/lox/golox/parser"
+	"github.com/marcuscaisey/lox/golox/token"
+)
+
+const cloneSrc = `var x = 1 + 2;
+
+// Returns the negation of a.
+@deprecated("use -a")
+fun negate(a) {
+  return -a;
+}
+
+class Foo < Bar {
+  method() {
+    print this.x[0];
+    super.method();
+  }
+
+  static get y() {
+    return [1, 2, fun() {}];
+  }
+}
+`
+
+func nodes(node ast.Node) []ast.Node {
+	var nodes []ast.Node
+	ast.Walk(node, func(n ast.Node) bool {
+		nodes = append(nodes, n)
+		return true
+	})
+	return nodes
+}
+
+func TestClone(t *testing.T) {
+	program, err := parser.Parse(strings.NewReader(cloneSrc), "test.lox", parser.WithComments(true))
+	if err != nil {
+		t.Fatalf("parsing program: %s", err)
+	}
+	want := ast.Sprint(program)
+
+	clone := ast.Clone(program)
+
+	if got := ast.Sprint(clone); got != want {
+		t.Errorf("Sprint(Clone(program)) =\n%s\nwant:\n%s", got, want)
+	}
+
+	originalNodes := nodes(program)
+	cloneNodes := nodes(clone)
+	if len(cloneNodes) != len(originalNodes) {
+		t.Fatalf("clone has %d nodes, want %d", len(cloneNodes), len(originalNodes))
+	}
+	isOriginal := map[ast.Node]bool{}
+	for _, node := range originalNodes {
+		isOriginal[node] = true
+	}
+	for i, node := range cloneNodes {
+		if isOriginal[node] {
+			t.Errorf("clone shares %T node with original", node)
+		}
+		original := originalNodes[i]
+		if node.Start() != original.Start() || node.End() != original.End() {
+			t.Errorf("cloned %T has range %s-%s, want %s-%s", node, node.Start(), node.End(), original.Start(), original.End())
+		}
+	}
+
+	for _, node := range cloneNodes {
+		if method, ok := node.(*ast.MethodDecl); ok && isOriginal[method.Class] {
+			t.Errorf("cloned method %s refers to original class", method.Name)
+		}
+	}
+
+	cloneVarDecl := clone.Stmts[0].(*ast.VarDecl)
+	cloneVarDecl.Name.Token.Lexeme = "y"
+	cloneVarDecl.Initialiser.(*ast.BinaryExpr).Op = token.Token{Type: token.Asterisk, Lexeme: "*"}
+	clone.Stmts = clone.Stmts[:1]
+	if got := ast.Sprint(program); got != want {
+		t.Errorf("Sprint(program) after modifying clone =\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestCloneSubtree(t *testing.T) {
+	program, err := parser.Parse(strings.NewReader(cloneSrc), "test.lox")
+	if err != nil {
+		t.Fatalf("parsing program: %s", err)
+	}
+	classDecl := program.Stmts[2].(*ast.ClassDecl)
+	method := classDecl.Methods()[0]
+
+	clone := ast.Clone(method)
+
+	if clone == method {
+		t.Fatal("Clone(method) returned the original method")
+	}
+	if clone.Class == classDecl {
+		t.Error("cloned method refers to original class")
+	}
+	if got := clone.Class.Methods()[0]; got != clone {
+		t.Error("cloned method's class doesn't contain the cloned method")
+	}
+}
+
+func TestCloneNil(t *testing.T) {
+	if got := ast.Clone[*ast.Ident](nil); got != nil {
+		t.Errorf("Clone[*ast.Ident](nil) = %v, want nil", got)
+	}
+	if got := ast.Clone[ast.Expr](nil); got != nil {
+		t.Errorf("Clone[ast.Expr](nil) = %v, want nil", got)
+	}
+	var stmt ast.Stmt = (*ast.ExprStmt)(nil)
+	if got := ast.Clone(stmt); got != stmt {
+		t.Errorf("Clone((*ast.ExprStmt)(nil)) = %v, want %v", got, stmt)
+	}
+}
@@ -0,0 +1,210 @@
+// Entry point for clonegen.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"slices"
+	"strings"
+)
+
+func main() {
+	os.Exit(cli())
+}
+
+func cli() int {
+	flag.Usage = func() {
+		fmt.Fprint(os.Stderr, strings.TrimSpace(`
+clonegen generates a Go file containing a method which clones each of the node
+types declared in the ast package without using reflection.
+
+Usage: clonegen [options]
+
+Options:
+`), "\n")
+		flag.PrintDefaults()
+	}
+	dir := flag.String("dir", ".", "Directory containing the ast package")
+	output := flag.String("output", "clone_gen.go", "Output file")
+
+	flag.Parse()
+
+	if err := cloneGen(*dir, *output); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	return 0
+}
+
+func cloneGen(dir string, output string) error {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != output
+	}, 0)
+	if err != nil {
+		return err
+	}
+	pkg, ok := pkgs["ast"]
+	if !ok {
+		return errors.New("ast package not found")
+	}
+
+	var files []*ast.File
+	for _, filename := range slices.Sorted(func(yield func(string) bool) {
+		for filename := range pkg.Files {
+			if !yield(filename) {
+				return
+			}
+		}
+	}) {
+		files = append(files, pkg.Files[filename])
+	}
+
+	src := generate(files)
+
+	formattedSrc, err := format.Source([]byte(src))
+	if err != nil {
+		return fmt.Errorf("formatting generated file: %s\ncontents: %s", err, src)
+	}
+
+	return os.WriteFile(output, formattedSrc, 0644)
+}
+
+// nodeEmbeds are the names of the unexported types which are embedded in each node type.
+var nodeEmbeds = []string{"node", "stmt", "decl", "expr"}
+
+func generate(files []*ast.File) string {
+	var nodeTypes []*ast.TypeSpec
+	interfaces := map[string]*ast.InterfaceType{}
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				switch typ := typeSpec.Type.(type) {
+				case *ast.StructType:
+					if typeSpec.Name.IsExported() && embedsNode(typ) {
+						nodeTypes = append(nodeTypes, typeSpec)
+					}
+				case *ast.InterfaceType:
+					interfaces[typeSpec.Name.Name] = typ
+				}
+			}
+		}
+	}
+
+	g := &generator{
+		nodeTypeNames: map[string]bool{},
+		interfaces:    interfaces,
+	}
+	for _, typeSpec := range nodeTypes {
+		g.nodeTypeNames[typeSpec.Name.Name] = true
+	}
+
+	b := new(strings.Builder)
+	fmt.Fprintln(b, `// Code generated by "clonegen"; DO NOT EDIT.`)
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, "package ast")
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, `import "slices"`)
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, "// cloneGenerated clones node if it's one of the node types which clonegen knows about.")
+	fmt.Fprintln(b, "func (c *cloner) cloneGenerated(node Node) (Node, bool) {")
+	fmt.Fprintln(b, "switch node := node.(type) {")
+	for _, typeSpec := range nodeTypes {
+		fmt.Fprintf(b, "case *%s:\n", typeSpec.Name.Name)
+		fmt.Fprintf(b, "clone := &%s{}\n", typeSpec.Name.Name)
+		fmt.Fprintln(b, "c.clones[node] = clone")
+		fmt.Fprintln(b, "*clone = *node")
+		for _, field := range typeSpec.Type.(*ast.StructType).Fields.List {
+			for _, name := range field.Names {
+				switch g.fieldKind(field.Type) {
+				case fieldKindNode:
+					fmt.Fprintf(b, "clone.%[1]s = cloneChild(c, node.%[1]s)\n", name.Name)
+				case fieldKindNodeSlice:
+					fmt.Fprintf(b, "clone.%[1]s = cloneChildren(c, node.%[1]s)\n", name.Name)
+				case fieldKindSlice:
+					fmt.Fprintf(b, "clone.%[1]s = slices.Clone(node.%[1]s)\n", name.Name)
+				case fieldKindValue:
+				}
+			}
+		}
+		fmt.Fprintln(b, "return clone, true")
+	}
+	fmt.Fprintln(b, "}")
+	fmt.Fprintln(b, "return nil, false")
+	fmt.Fprintln(b, "}")
+	return b.String()
+}
+
+func embedsNode(typ *ast.StructType) bool {
+	for _, field := range typ.Fields.List {
+		if ident, ok := field.Type.(*ast.Ident); ok && len(field.Names) == 0 && slices.Contains(nodeEmbeds, ident.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+type fieldKind int
+
+const (
+	fieldKindValue     fieldKind = iota // Copied by value
+	fieldKindNode                       // Node which is cloned
+	fieldKindNodeSlice                  // Slice of nodes which are cloned
+	fieldKindSlice                      // Slice of values which is copied
+)
+
+type generator struct {
+	nodeTypeNames map[string]bool
+	interfaces    map[string]*ast.InterfaceType
+}
+
+func (g *generator) fieldKind(typ ast.Expr) fieldKind {
+	switch typ := typ.(type) {
+	case *ast.StarExpr:
+		if ident, ok := typ.X.(*ast.Ident); ok && g.nodeTypeNames[ident.Name] {
+			return fieldKindNode
+		}
+	case *ast.Ident:
+		if g.isNodeInterface(typ.Name) {
+			return fieldKindNode
+		}
+	case *ast.ArrayType:
+		if typ.Len != nil {
+			return fieldKindValue
+		}
+		if g.fieldKind(typ.Elt) == fieldKindNode {
+			return fieldKindNodeSlice
+		}
+		return fieldKindSlice
+	}
+	return fieldKindValue
+}
+
+// isNodeInterface reports whether name is the name of an interface which is or embeds Node.
+func (g *generator) isNodeInterface(name string) bool {
+	if name == "Node" {
+		return true
+	}
+	iface, ok := g.interfaces[name]
+	if !ok {
+		return false
+	}
+	for _, method := range iface.Methods.List {
+		if ident, ok := method.Type.(*ast.Ident); ok && len(method.Names) == 0 && g.isNodeInterface(ident.Name) {
+			return true
+		}
+	}
+	return false
+}
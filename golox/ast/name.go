@@ -0,0 +1,21 @@
+package ast
+
+import "fmt"
+
+// QualifiedName returns the name of a declaration qualified by the class which declares it. Methods are named
+// ClassName.methodName and all other declarations are named by the identifier which they bind. false is returned if
+// the declaration is missing a name.
+func QualifiedName(decl Decl) (string, bool) {
+	ident := decl.BoundIdent()
+	if !ident.IsValid() {
+		return "", false
+	}
+	methodDecl, ok := decl.(*MethodDecl)
+	if !ok {
+		return ident.String(), true
+	}
+	if methodDecl.Class == nil || !methodDecl.Class.Name.IsValid() {
+		return "", false
+	}
+	return fmt.Sprintf("%s.%s", methodDecl.Class.Name, ident), true
+}
@@ -0,0 +1,52 @@
+package ast_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/marcuscaisey/lox/golox/ast"
+	"github.com/marcuscaisey/lox/golox/parser"
+)
+
+func TestQualifiedName(t *testing.T) {
+	src := `fun add(a, b) {
+  return a + b;
+}
+
+class Circle {
+  area() {}
+
+  static unit() {}
+}
+`
+	program, err := parser.Parse(strings.NewReader(src), "test.lox")
+	if err != nil {
+		t.Fatalf("parsing program: %s", err)
+	}
+	funDecl := program.Stmts[0].(*ast.FunDecl)
+	classDecl := program.Stmts[1].(*ast.ClassDecl)
+	methodDecls := classDecl.Methods()
+
+	tests := []struct {
+		name string
+		decl ast.Decl
+		want string
+	}{
+		{name: "function", decl: funDecl, want: "add"},
+		{name: "parameter", decl: funDecl.Function.Params[0], want: "a"},
+		{name: "class", decl: classDecl, want: "Circle"},
+		{name: "method", decl: methodDecls[0], want: "Circle.area"},
+		{name: "static method", decl: methodDecls[1], want: "Circle.unit"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := ast.QualifiedName(test.decl)
+			if !ok {
+				t.Fatalf("QualifiedName() returned false, want true")
+			}
+			if got != test.want {
+				t.Errorf("QualifiedName() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package ast
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"reflect"
 	"slices"
 	"strings"
@@ -16,15 +18,27 @@ func Print(node Node) {
 
 // Sprint formats an AST Node as an indented s-expression.
 func Sprint(node Node) string {
-	return sprint(node, 0)
+	return sprint(node, 0, false)
 }
 
-func sprint(node Node, depth int) string {
+// PrintWithPositions is like [Print] except that each node is followed by its range in the source code, such as
+// (BinaryExpr [1:7-1:12] ...).
+func PrintWithPositions(node Node) {
+	fmt.Println(SprintWithPositions(node))
+}
+
+// SprintWithPositions is like [Sprint] except that each node is followed by its range in the source code, such as
+// (BinaryExpr [1:7-1:12] ...).
+func SprintWithPositions(node Node) string {
+	return sprint(node, 0, true)
+}
+
+func sprint(node Node, depth int, positions bool) string {
 	switch node := node.(type) {
 	case *LiteralExpr:
-		return node.Value.Lexeme
+		return withRange(node.Value.Lexeme, node, positions)
 	case *IdentExpr:
-		return node.Ident.String()
+		return withRange(node.Ident.String(), node, positions)
 	default:
 	}
 
@@ -39,6 +53,7 @@ func sprint(node Node, depth int) string {
 		nodeType = nodeType.Elem()
 		nodeValue = nodeValue.Elem()
 	}
+	name := withRange(namePrefix+nodeType.Name(), node, positions)
 
 	var children []string
 	printTags := parsePrintTags(nodeType)
@@ -64,7 +79,7 @@ func sprint(node Node, depth int) string {
 				extraDepth = 1
 			}
 			for j := range value.Len() {
-				child, ok := formatValue(value.Index(j), depth+1+extraDepth)
+				child, ok := formatValue(value.Index(j), depth+1+extraDepth, positions)
 				if !ok {
 					panic(fmt.Sprintf("%s field %s element %d has unsupported type: %T", nodeType.Name(), field.Name, j, value.Index(j).Interface()))
 				}
@@ -80,22 +95,22 @@ func sprint(node Node, depth int) string {
 		if tag == "unnamed" {
 			valueDepth = depth
 		}
-		formattedValue, ok := formatValue(value, valueDepth)
+		formattedValue, ok := formatValue(value, valueDepth, positions)
 		if !ok {
 			panic(fmt.Sprintf("%s field %s has unsupported type: %T", nodeType.Name(), field.Name, value.Interface()))
 		}
 
 		if tag == "unnamed" {
-			return fmt.Sprintf("(%s %s)", namePrefix+nodeType.Name(), formattedValue)
+			return fmt.Sprintf("(%s %s)", name, formattedValue)
 		}
 
 		children = append(children, fmt.Sprintf("(%s %s)", field.Name, formattedValue))
 	}
 
-	return sexpr(namePrefix+nodeType.Name(), depth, children...)
+	return sexpr(name, depth, children...)
 }
 
-func formatValue(value reflect.Value, depth int) (string, bool) {
+func formatValue(value reflect.Value, depth int, positions bool) (string, bool) {
 	if (value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface) && value.IsNil() {
 		return "EMPTY", true
 	}
@@ -104,13 +119,11 @@ func formatValue(value reflect.Value, depth int) (string, bool) {
 	case token.Token:
 		child = value.Lexeme
 	case *Ident:
-		child = value.String()
+		child = withRange(value.String(), value, positions)
 	case *ThisExpr:
-		child = value.This.Lexeme
-	case *SuperExpr:
-		child = value.Super.Lexeme
+		child = withRange(value.This.Lexeme, value, positions)
 	case Node:
-		child = sprint(value, depth)
+		child = sprint(value, depth, positions)
 	case bool:
 		child = fmt.Sprint(value)
 	default:
@@ -119,6 +132,145 @@ func formatValue(value reflect.Value, depth int) (string, bool) {
 	return child, true
 }
 
+// PrintJSON writes an AST Node to w as indented JSON.
+// Each node is represented as an object with the following fields:
+//   - field: the name of the parent node's field which contains the node, omitted if the field is unnamed
+//   - type: the type of the node, or "Token" for tokens
+//   - value: the lexeme of a literal, identifier, or token
+//   - start, end: the line and column of the start and end of the node
+//   - children: the node's children, omitted if it has none
+//
+// The same fields as are printed by [Print] are included. Nil children are omitted.
+func PrintJSON(node Node, w io.Writer) error {
+	data, err := json.MarshalIndent(jsonNodeOf(node), "", "  ")
+	if err != nil {
+		return fmt.Errorf("printing AST as JSON: %w", err)
+	}
+	if _, err := fmt.Fprintf(w, "%s\n", data); err != nil {
+		return fmt.Errorf("printing AST as JSON: %w", err)
+	}
+	return nil
+}
+
+type jsonNode struct {
+	Field    string       `json:"field,omitempty"`
+	Type     string       `json:"type"`
+	Value    string       `json:"value,omitempty"`
+	Start    jsonPosition `json:"start"`
+	End      jsonPosition `json:"end"`
+	Children []*jsonNode  `json:"children,omitempty"`
+}
+
+type jsonPosition struct {
+	Line int `json:"line"`
+	Col  int `json:"col"`
+}
+
+func newJSONNode(typ string, rang token.Range) *jsonNode {
+	start, end := rang.Start(), rang.End()
+	return &jsonNode{
+		Type:  typ,
+		Start: jsonPosition{Line: start.Line, Col: start.Column},
+		End:   jsonPosition{Line: end.Line, Col: end.Column},
+	}
+}
+
+func jsonNodeOf(node Node) *jsonNode {
+	nodeType := reflect.TypeOf(node)
+	nodeValue := reflect.ValueOf(node)
+	if nodeType.Kind() == reflect.Pointer {
+		nodeType = nodeType.Elem()
+		nodeValue = nodeValue.Elem()
+	}
+
+	typePrefix := ""
+	if _, isIllegalStmt := node.(*IllegalStmt); !isIllegalStmt && !node.IsValid() {
+		typePrefix = "Invalid"
+	}
+	result := newJSONNode(typePrefix+nodeType.Name(), node)
+
+	switch node := node.(type) {
+	case *LiteralExpr:
+		result.Value = node.Value.Lexeme
+		return result
+	case *Ident:
+		result.Value = node.String()
+		return result
+	default:
+	}
+
+	printTags := parsePrintTags(nodeType)
+	for i := range nodeType.NumField() {
+		field := nodeType.Field(i)
+		value := nodeValue.Field(i)
+
+		tag, ok := printTags[field.Name]
+		if !ok {
+			continue
+		}
+		fieldName := field.Name
+		if tag == "unnamed" {
+			fieldName = ""
+		}
+
+		if field.Type.Kind() == reflect.Slice {
+			for j := range value.Len() {
+				child, ok := jsonValue(value.Index(j))
+				if !ok {
+					panic(fmt.Sprintf("%s field %s element %d has unsupported type: %T", nodeType.Name(), field.Name, j, value.Index(j).Interface()))
+				}
+				if child != nil {
+					child.Field = fieldName
+					result.Children = append(result.Children, child)
+				}
+			}
+			continue
+		}
+
+		child, ok := jsonValue(value)
+		if !ok {
+			panic(fmt.Sprintf("%s field %s has unsupported type: %T", nodeType.Name(), field.Name, value.Interface()))
+		}
+		if child != nil {
+			child.Field = fieldName
+			result.Children = append(result.Children, child)
+		}
+	}
+
+	return result
+}
+
+// jsonValue returns the JSON representation of a field value and whether the value's type is supported. nil is
+// returned if the value is nil.
+func jsonValue(value reflect.Value) (*jsonNode, bool) {
+	if (value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface) && value.IsNil() {
+		return nil, true
+	}
+	switch value := value.Interface().(type) {
+	case token.Token:
+		result := newJSONNode("Token", value)
+		result.Value = value.Lexeme
+		return result, true
+	case Node:
+		return jsonNodeOf(value), true
+	default:
+		return nil, false
+	}
+}
+
+// withRange returns s followed by the range of node if positions is true. The range is omitted if it isn't known, which
+// can be the case for invalid nodes.
+func withRange(s string, node Node, positions bool) string {
+	if !positions {
+		return s
+	}
+	start, end := node.Start(), node.End()
+	if start.File == nil || end.File == nil {
+		return s
+	}
+	return fmt.Sprintf("%s [%s-%s]", s, start, end)
+}
+
 func sexpr(name string, depth int, children ...string) string {
 	b := new(strings.Builder)
 	fmt.Fprint(b, "(", name)
@@ -0,0 +1,72 @@
+package ast_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/marcuscaisey/lox/golox/ast"
+	"github.com/marcuscaisey/lox/golox/parser"
+)
+
+var update = flag.Bool("update", false, "updates the golden files")
+
+func TestPrintJSON(t *testing.T) {
+	src := `var x = 1 + 2;
+
+// Returns the negation of a.
+fun negate(a) {
+  return -a;
+}
+
+class Foo < Bar {
+  method() {
+    print this.x[0];
+    super.method();
+  }
+}
+`
+	program, err := parser.Parse(strings.NewReader(src), "test.lox", parser.WithComments(true))
+	if err != nil {
+		t.Fatalf("parsing program: %s", err)
+	}
+
+	var got bytes.Buffer
+	if err := ast.PrintJSON(program, &got); err != nil {
+		t.Fatalf("PrintJSON() returned error: %s", err)
+	}
+
+	assertGolden(t, "PrintJSON()", got.String(), "testdata/print_json.golden")
+}
+
+func TestSprintWithPositions(t *testing.T) {
+	src := `print -(1 + 2) * x;
+a.b = [c, "d"];
+`
+	program, err := parser.Parse(strings.NewReader(src), "test.lox")
+	if err != nil {
+		t.Fatalf("parsing program: %s", err)
+	}
+
+	got := ast.SprintWithPositions(program) + "\n"
+
+	assertGolden(t, "SprintWithPositions()", got, "testdata/print_positions.golden")
+}
+
+func assertGolden(t *testing.T, call string, got string, goldenPath string) {
+	t.Helper()
+	if *update {
+		if err := os.WriteFile(goldenPath, []byte(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("%s =\n%s\nwant (from %s):\n%s\nRun with -update to update the golden file.", call, got, goldenPath, want)
+	}
+}
@@ -1,5 +1,11 @@
 package ast
 
+import (
+	"slices"
+
+	"github.com/marcuscaisey/lox/golox/token"
+)
+
 // Walk traverses an AST in depth-first order. If node is nil, Walk returns immediately. If node is of type T and
 // f(node) returns false, Walk returns immediately. Otherwise, Walk is called with f for each non-nil child of node.
 // For more control over when node's children are traversed, call [WalkChildren] from f and return false.
@@ -30,8 +36,14 @@ func WalkChildren[T Node](node Node, f func(T) bool) {
 	case *VarDecl:
 		Walk(node.Name, f)
 		Walk(node.Initialiser, f)
+	case *DestructuringDecl:
+		walkSlice(node.Decls, f)
+		Walk(node.Initialiser, f)
+	case *DestructuredVarDecl:
+		Walk(node.Name, f)
 	case *FunDecl:
 		walkSlice(node.DocComments, f)
+		walkSlice(node.Annotations, f)
 		Walk(node.Name, f)
 		Walk(node.Function, f)
 	case *Function:
@@ -39,13 +51,19 @@ func WalkChildren[T Node](node Node, f func(T) bool) {
 		Walk(node.Body, f)
 	case *ParamDecl:
 		Walk(node.Name, f)
+		Walk(node.Default, f)
+	case *Annotation:
+		Walk(node.Name, f)
+		walkSlice(node.Args, f)
 	case *ClassDecl:
 		walkSlice(node.DocComments, f)
+		walkSlice(node.Annotations, f)
 		Walk(node.Name, f)
 		Walk(node.Superclass, f)
 		Walk(node.Body, f)
 	case *MethodDecl:
 		walkSlice(node.DocComments, f)
+		walkSlice(node.Annotations, f)
 		Walk(node.Name, f)
 		Walk(node.Function, f)
 	case *ExprStmt:
@@ -58,6 +76,14 @@ func WalkChildren[T Node](node Node, f func(T) bool) {
 		Walk(node.Condition, f)
 		Walk(node.Then, f)
 		Walk(node.Else, f)
+	case *TryStmt:
+		Walk(node.Body, f)
+		Walk(node.ErrorVar, f)
+		Walk(node.CatchBody, f)
+	case *WithStmt:
+		Walk(node.Resource, f)
+		Walk(node.Value, f)
+		Walk(node.Body, f)
 	case *WhileStmt:
 		Walk(node.Condition, f)
 		Walk(node.Body, f)
@@ -82,9 +108,14 @@ func WalkChildren[T Node](node Node, f func(T) bool) {
 		Walk(node.Right, f)
 	case *ThisExpr:
 	case *SuperExpr:
+		Walk(node.Name, f)
 	case *CallExpr:
 		Walk(node.Callee, f)
 		walkSlice(node.Args, f)
+		walkSlice(node.NamedArgs, f)
+	case *NamedArg:
+		Walk(node.Name, f)
+		Walk(node.Value, f)
 	case *IndexExpr:
 		Walk(node.Subject, f)
 		Walk(node.Index, f)
@@ -152,3 +183,19 @@ func FindLast[T Node](node Node, p Predicate[T]) (T, bool) {
 	})
 	return result, found
 }
+
+// InnermostContaining returns the nodes in program which contain pos, starting with the innermost node and ending with
+// program itself. A node contains pos if pos is in the range [node.Start(), node.End()). If program doesn't contain pos,
+// then nil is returned.
+func InnermostContaining(program *Program, pos token.Position) []Node {
+	var nodes []Node
+	Walk(program, func(n Node) bool {
+		if pos.Compare(n.Start()) < 0 || pos.Compare(n.End()) >= 0 {
+			return false
+		}
+		nodes = append(nodes, n)
+		return true
+	})
+	slices.Reverse(nodes)
+	return nodes
+}
@@ -6,6 +6,7 @@ import (
 	"bytes"
 	_ "embed"
 	"fmt"
+	"slices"
 
 	"github.com/marcuscaisey/lox/golox/ast"
 	"github.com/marcuscaisey/lox/golox/parser"
@@ -17,8 +18,12 @@ var builtinsSrc []byte
 //go:embed builtins_extra_features.lox
 var builtinsExtraFeaturesSrc []byte
 
+//go:embed builtins_debug.lox
+var builtinsDebugSrc []byte
+
 type config struct {
 	extraFeatures bool
+	debug         bool
 }
 
 // Option can be passed to [MustParseStubs] to configure its behaviour.
@@ -33,6 +38,13 @@ func WithExtraFeatures(enabled bool) Option {
 	}
 }
 
+// WithDebug includes the stubs of the built-ins which are only available for debugging.
+func WithDebug(enabled bool) Option {
+	return func(c *config) {
+		c.debug = enabled
+	}
+}
+
 // MustParseStubs parses the stubs of Lox's built-ins and returns them.
 // filename is the name of the file that the declarations will be associated with.
 func MustParseStubs(filename string, opts ...Option) []ast.Decl {
@@ -44,6 +56,9 @@ func MustParseStubs(filename string, opts ...Option) []ast.Decl {
 	if cfg.extraFeatures {
 		src = builtinsExtraFeaturesSrc
 	}
+	if cfg.debug {
+		src = slices.Concat(src, []byte("\n"), builtinsDebugSrc)
+	}
 	program, err := parser.Parse(bytes.NewBuffer(src), filename, parser.WithComments(true))
 	if err != nil {
 		panic(fmt.Sprintf("parsing built-in stubs: %s", err))
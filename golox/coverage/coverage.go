@@ -0,0 +1,88 @@
+// Package coverage implements the recording of which statements of a Lox program are executed and the reporting of
+// the resulting line coverage.
+package coverage
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+
+	"github.com/marcuscaisey/lox/golox/ast"
+)
+
+// Profile records the number of times that each statement of a program is executed.
+type Profile struct {
+	counts map[ast.Stmt]int
+}
+
+// NewProfile returns an empty Profile.
+func NewProfile() *Profile {
+	return &Profile{counts: map[ast.Stmt]int{}}
+}
+
+// Record records an execution of stmt and returns true.
+// Record can be passed to [interpreter.WithStatementHook] to record the statements executed by the interpreter.
+//
+// [interpreter.WithStatementHook]: https://pkg.go.dev/github.com/marcuscaisey/lox/golox/interpreter#WithStatementHook
+func (p *Profile) Record(stmt ast.Stmt) bool {
+	p.counts[stmt]++
+	return true
+}
+
+// WriteLCOV writes the line coverage of program to w in the lcov tracefile format described at
+// https://github.com/linux-test-project/lcov/blob/master/man/geninfo.1.
+// Each line which a statement starts on is instrumented, with its execution count being the highest count of the
+// statements which start on it.
+func (p *Profile) WriteLCOV(w io.Writer, program *ast.Program) error {
+	counts := p.lineCounts(program)
+	lines := slices.Sorted(maps.Keys(counts))
+	linesHit := 0
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "TN:")
+	fmt.Fprintf(bw, "SF:%s\n", program.Start().File.Name)
+	for _, line := range lines {
+		fmt.Fprintf(bw, "DA:%d,%d\n", line, counts[line])
+		if counts[line] > 0 {
+			linesHit++
+		}
+	}
+	fmt.Fprintf(bw, "LF:%d\n", len(lines))
+	fmt.Fprintf(bw, "LH:%d\n", linesHit)
+	fmt.Fprintln(bw, "end_of_record")
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("writing lcov report: %w", err)
+	}
+	return nil
+}
+
+// lineCounts returns the execution counts of each line of program that a statement starts on.
+func (p *Profile) lineCounts(program *ast.Program) map[int]int {
+	counts := map[int]int{}
+	ast.Walk(program, func(node ast.Node) bool {
+		stmt, ok := node.(ast.Stmt)
+		if !ok || !isInstrumented(stmt) {
+			return true
+		}
+		line := stmt.Start().Line
+		counts[line] = max(counts[line], p.counts[stmt])
+		return true
+	})
+	return counts
+}
+
+// isInstrumented reports whether stmt is executed as a unit by the interpreter. Blocks aren't instrumented as the
+// statements inside them are.
+func isInstrumented(stmt ast.Stmt) bool {
+	switch stmt.(type) {
+	case *ast.VarDecl, *ast.DestructuringDecl, *ast.FunDecl, *ast.ClassDecl, *ast.ExprStmt, *ast.PrintStmt,
+		*ast.IfStmt, *ast.WhileStmt, *ast.ForStmt, *ast.TryStmt, *ast.WithStmt, *ast.BreakStmt, *ast.ContinueStmt,
+		*ast.ReturnStmt:
+		return true
+	case *ast.Block, *ast.IllegalStmt, *ast.Comment, *ast.CommentedStmt, *ast.ParamDecl, *ast.DestructuredVarDecl,
+		*ast.MethodDecl:
+		return false
+	}
+	return false
+}
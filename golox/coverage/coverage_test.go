@@ -0,0 +1,53 @@
+package coverage_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/marcuscaisey/lox/golox/coverage"
+	"github.com/marcuscaisey/lox/golox/interpreter"
+	"github.com/marcuscaisey/lox/golox/parser"
+)
+
+func TestWriteLCOV(t *testing.T) {
+	program, err := parser.Parse(strings.NewReader(`var a = 1;
+if (a > 0) {
+  a = 2;
+} else {
+  a = 3;
+}
+fun f() {
+  return a;
+}
+for (var i = 0; i < 2; i = i + 1) a = a + i;
+`), "test.lox")
+	if err != nil {
+		t.Fatalf("parsing program: %s", err)
+	}
+	profile := coverage.NewProfile()
+	if err := interpreter.New(nil, interpreter.WithStatementHook(profile.Record)).Execute(program); err != nil {
+		t.Fatalf("executing program: %s", err)
+	}
+
+	b := new(strings.Builder)
+	if err := profile.WriteLCOV(b, program); err != nil {
+		t.Fatalf("WriteLCOV() returned error: %s", err)
+	}
+
+	want := `TN:
+SF:test.lox
+DA:1,1
+DA:2,1
+DA:3,1
+DA:5,0
+DA:7,1
+DA:8,0
+DA:10,2
+LF:7
+LH:5
+end_of_record
+`
+	if got := b.String(); got != want {
+		t.Errorf("WriteLCOV() wrote:\n%s\nwant:\n%s", got, want)
+	}
+}
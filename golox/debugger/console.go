@@ -0,0 +1,153 @@
+package debugger
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const consoleHelp = `Commands:
+  break [<file>:]<line>  Set a breakpoint on a line of a file, the program's file by default (alias: b)
+  continue               Resume execution until a breakpoint is reached (alias: c)
+  next                   Step over the next statement (alias: n)
+  step                   Step into the next statement (alias: s)
+  out                    Step out of the current function (alias: o)
+  print <name>           Print the value of a variable (alias: p)
+  backtrace              Print the call stack (alias: bt)
+  help                   Print this message (alias: h)
+  quit                   Stop debugging (alias: q)`
+
+// Console reads debugger commands, runs them against a [Session], and prints the results.
+type Console struct {
+	session  *Session
+	filename string
+	in       *bufio.Scanner
+	out      io.Writer
+}
+
+// NewConsole returns a Console which reads commands from in and writes their results to out. filename is the name of
+// the file containing the program being debugged, which is used when a breakpoint is set without a file.
+func NewConsole(session *Session, filename string, in io.Reader, out io.Writer) *Console {
+	return &Console{
+		session:  session,
+		filename: filename,
+		in:       bufio.NewScanner(in),
+		out:      out,
+	}
+}
+
+// Run runs commands until the program finishes executing, the quit command is run, or the input is exhausted.
+// If the program finishes with an error, then it's returned.
+func (c *Console) Run() error {
+	fmt.Fprintln(c.out, "Debugging", c.filename+". Type help for a list of commands.")
+	for {
+		fmt.Fprint(c.out, "(debug) ")
+		if !c.in.Scan() {
+			fmt.Fprintln(c.out)
+			return c.in.Err()
+		}
+		command, arg, _ := strings.Cut(strings.TrimSpace(c.in.Text()), " ")
+		arg = strings.TrimSpace(arg)
+		var err error
+		switch command {
+		case "":
+		case "break", "b":
+			c.setBreakpoint(arg)
+		case "continue", "c":
+			err = c.resume(c.session.Continue)
+		case "next", "n":
+			err = c.resume(c.session.StepOver)
+		case "step", "s":
+			err = c.resume(c.session.StepInto)
+		case "out", "o":
+			err = c.resume(c.session.StepOut)
+		case "print", "p":
+			c.print(arg)
+		case "backtrace", "bt":
+			c.backtrace()
+		case "help", "h":
+			fmt.Fprintln(c.out, consoleHelp)
+		case "quit", "q":
+			return nil
+		default:
+			fmt.Fprintf(c.out, "unknown command %q, type help for a list of commands\n", command)
+		}
+		if errors.Is(err, ErrFinished) {
+			fmt.Fprintln(c.out, "Program finished")
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (c *Console) setBreakpoint(arg string) {
+	file := c.filename
+	lineStr := arg
+	if i := strings.LastIndex(arg, ":"); i != -1 {
+		file, lineStr = arg[:i], arg[i+1:]
+	}
+	line, err := strconv.Atoi(lineStr)
+	if err != nil || line < 1 {
+		fmt.Fprintf(c.out, "invalid line %q\n", lineStr)
+		return
+	}
+	c.session.SetBreakpoint(file, line)
+	fmt.Fprintf(c.out, "Breakpoint set at %s:%d\n", file, line)
+}
+
+func (c *Console) resume(resume func() error) error {
+	if err := resume(); err != nil {
+		return err
+	}
+	frame := c.session.StackTrace()[0]
+	fmt.Fprintf(c.out, "Paused at %s:%d:%d\n", frame.File, frame.Line, frame.Column)
+	return nil
+}
+
+func (c *Console) print(name string) {
+	value, err := c.session.Inspect(name)
+	if err != nil {
+		fmt.Fprintln(c.out, err)
+		return
+	}
+	fmt.Fprintf(c.out, "%s = %s\n", name, formatValue(value))
+}
+
+func (c *Console) backtrace() {
+	frames := c.session.StackTrace()
+	if frames == nil {
+		fmt.Fprintln(c.out, errNotPaused)
+		return
+	}
+	for _, frame := range frames {
+		function := frame.Function
+		if function == "" {
+			function = "<main>"
+		}
+		fmt.Fprintf(c.out, "  %s:%d:%d in %s\n", frame.File, frame.Line, frame.Column, function)
+	}
+}
+
+// formatValue formats a value returned by [Session.Inspect] in the same way as it would be printed by a print
+// statement.
+func formatValue(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return "nil"
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case []any:
+		elements := make([]string, len(value))
+		for i, element := range value {
+			elements[i] = formatValue(element)
+		}
+		return "[" + strings.Join(elements, ", ") + "]"
+	default:
+		return fmt.Sprint(value)
+	}
+}
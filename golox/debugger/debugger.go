@@ -0,0 +1,257 @@
+// Package debugger implements a debugger for Lox programs. The debugger can pause execution of a program at
+// breakpoints, step through it one statement at a time, and inspect its state whilst it's paused.
+package debugger
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/marcuscaisey/lox/golox/ast"
+	"github.com/marcuscaisey/lox/golox/interpreter"
+)
+
+// ErrFinished is returned by the methods of [Session] which resume execution if the program finishes executing without
+// an error, or if it has already finished executing.
+var ErrFinished = errors.New("program has finished executing")
+
+var errNotPaused = errors.New("program is not paused")
+
+// Frame is a frame of the call stack of a paused program.
+type Frame struct {
+	Function string // Name of the function being executed, or empty if not in a function
+	File     string // Name of the file containing the statement or call being executed
+	Line     int    // 1-based line number of the statement or call being executed
+	Column   int    // 1-based byte offset from the start of the line of the statement or call being executed
+}
+
+type state int
+
+const (
+	stateNotStarted state = iota
+	stateRunning
+	statePaused
+	stateFinished
+)
+
+type stepMode int
+
+const (
+	stepModeContinue stepMode = iota // Pause at the next breakpoint.
+	stepModeOver                     // Pause at the next statement in the same or an outer function.
+	stepModeInto                     // Pause at the next statement.
+	stepModeOut                      // Pause at the next statement in an outer function.
+)
+
+// stop describes why execution stopped.
+type stop struct {
+	Finished bool  // Whether execution stopped because the program finished, as opposed to being paused
+	Err      error // Error that the program finished with, if any
+}
+
+// Session is a debugging session of a Lox program. The program is executed in a separate goroutine which is paused
+// whenever it reaches a breakpoint or the end of a step.
+//
+// The methods of a Session which resume execution must not be called concurrently with each other. The other methods
+// can be called at any time, including whilst another goroutine is waiting for execution to pause.
+type Session struct {
+	program     *ast.Program
+	interpreter *interpreter.Interpreter
+	stops       chan stop
+
+	mu          sync.Mutex
+	state       state
+	breakpoints map[string]map[int]bool // Lines with breakpoints keyed by file name
+	stepMode    stepMode
+	stepDepth   int // Depth of the call stack when the current step started
+}
+
+// NewSession returns a Session which executes program with an interpreter configured with the given arguments and
+// options. Execution starts when one of the methods which resume execution is first called.
+func NewSession(program *ast.Program, argv []string, opts ...interpreter.Option) *Session {
+	s := &Session{
+		program:     program,
+		stops:       make(chan stop),
+		breakpoints: map[string]map[int]bool{},
+	}
+	opts = append(opts, interpreter.WithStatementHook(s.beforeStmt))
+	s.interpreter = interpreter.New(argv, opts...)
+	return s
+}
+
+// SetBreakpoint sets a breakpoint on a line of a file. Execution will pause before any statement which starts on this
+// line.
+func (s *Session) SetBreakpoint(file string, line int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	file = filepath.Clean(file)
+	if s.breakpoints[file] == nil {
+		s.breakpoints[file] = map[int]bool{}
+	}
+	s.breakpoints[file][line] = true
+}
+
+// ClearBreakpoint clears a breakpoint previously set by SetBreakpoint.
+func (s *Session) ClearBreakpoint(file string, line int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.breakpoints[filepath.Clean(file)], line)
+}
+
+// ClearBreakpoints clears all breakpoints previously set on lines of a file.
+func (s *Session) ClearBreakpoints(file string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.breakpoints, filepath.Clean(file))
+}
+
+// Continue resumes execution until a breakpoint is reached.
+// Continue blocks until execution pauses, or the program finishes, in which case either the error that the program
+// finished with or [ErrFinished] is returned.
+func (s *Session) Continue() error {
+	return s.resume(stepModeContinue)
+}
+
+// StepOver resumes execution until the next statement in the same or an outer function is reached. Any functions called
+// before then are stepped over.
+// StepOver blocks in the same way as [Session.Continue].
+func (s *Session) StepOver() error {
+	return s.resume(stepModeOver)
+}
+
+// StepInto resumes execution until the next statement is reached, stepping into any function which is called first.
+// StepInto blocks in the same way as [Session.Continue].
+func (s *Session) StepInto() error {
+	return s.resume(stepModeInto)
+}
+
+// StepOut resumes execution until the function currently being executed has returned.
+// StepOut blocks in the same way as [Session.Continue].
+func (s *Session) StepOut() error {
+	return s.resume(stepModeOut)
+}
+
+func (s *Session) resume(mode stepMode) error {
+	s.mu.Lock()
+	prevState := s.state
+	switch prevState {
+	case stateFinished:
+		s.mu.Unlock()
+		return ErrFinished
+	case statePaused:
+		s.stepDepth = len(s.interpreter.CallStack())
+	case stateNotStarted:
+		// The first statement is at the top level of the program, so is treated as being in the same function as the
+		// start of a step.
+		s.stepDepth = 1
+	case stateRunning:
+		panic("resume called whilst program is running")
+	}
+	s.stepMode = mode
+	s.state = stateRunning
+	s.mu.Unlock()
+
+	if prevState == stateNotStarted {
+		go s.run()
+	} else {
+		s.interpreter.Resume()
+	}
+	stop := <-s.stops
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if stop.Finished {
+		s.state = stateFinished
+		if stop.Err != nil {
+			return stop.Err
+		}
+		return ErrFinished
+	}
+	s.state = statePaused
+	return nil
+}
+
+func (s *Session) run() {
+	err := s.interpreter.Execute(s.program)
+	s.stops <- stop{Finished: true, Err: err}
+}
+
+// beforeStmt is the statement hook of the interpreter. It reports whether execution should continue and notifies the
+// goroutine which resumed execution if it shouldn't.
+func (s *Session) beforeStmt(stmt ast.Stmt) bool {
+	if !s.shouldPause(stmt) {
+		return true
+	}
+	s.stops <- stop{}
+	return false
+}
+
+func (s *Session) shouldPause(stmt ast.Stmt) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	start := stmt.Start()
+	if s.breakpoints[filepath.Clean(start.File.Name)][start.Line] {
+		return true
+	}
+	switch s.stepMode {
+	case stepModeContinue:
+		return false
+	case stepModeOver:
+		return len(s.interpreter.CallStack()) <= s.stepDepth
+	case stepModeInto:
+		return true
+	case stepModeOut:
+		return len(s.interpreter.CallStack()) < s.stepDepth
+	default:
+		panic(fmt.Sprintf("unhandled step mode: %d", s.stepMode))
+	}
+}
+
+// Inspect returns the value of the variable with the given name which is visible to the statement that execution is
+// paused at. The value is converted to a Go value as described by [interpreter.Interpreter.Variable].
+func (s *Session) Inspect(name string) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.state != statePaused {
+		return nil, errNotPaused
+	}
+	value, ok := s.interpreter.Variable(name)
+	if !ok {
+		return nil, fmt.Errorf("'%s' has not been declared", name)
+	}
+	return value, nil
+}
+
+// Variables returns the string representations of the values of the variables which are visible to the statement
+// that execution is paused at, keyed by name, as described by [interpreter.Interpreter.Variables].
+func (s *Session) Variables() (map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.state != statePaused {
+		return nil, errNotPaused
+	}
+	return s.interpreter.Variables(), nil
+}
+
+// StackTrace returns the call stack of the statement that execution is paused at, most recent call first. The first
+// frame points to the statement and each following frame points to the call which led to the frame before it. If
+// execution isn't paused, then nil is returned.
+func (s *Session) StackTrace() []Frame {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.state != statePaused {
+		return nil
+	}
+	stackFrames := s.interpreter.CallStack()
+	frames := make([]Frame, len(stackFrames))
+	for i, frame := range stackFrames {
+		frames[i] = Frame{
+			Function: frame.Function,
+			File:     frame.Position.File.Name,
+			Line:     frame.Position.Line,
+			Column:   frame.Position.Column + 1,
+		}
+	}
+	return frames
+}
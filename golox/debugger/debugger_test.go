@@ -0,0 +1,242 @@
+package debugger_test
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/marcuscaisey/lox/golox/ast"
+	"github.com/marcuscaisey/lox/golox/debugger"
+	"github.com/marcuscaisey/lox/golox/interpreter"
+	"github.com/marcuscaisey/lox/golox/parser"
+)
+
+func mustParse(t *testing.T, src string) *ast.Program {
+	t.Helper()
+	program, err := parser.Parse(strings.NewReader(src), "test.lox")
+	if err != nil {
+		t.Fatalf("parsing program: %s", err)
+	}
+	return program
+}
+
+func newSession(t *testing.T, src string) *debugger.Session {
+	t.Helper()
+	return debugger.NewSession(mustParse(t, src), nil, interpreter.WithOutput(io.Discard))
+}
+
+func mustInspect(t *testing.T, session *debugger.Session, name string) any {
+	t.Helper()
+	value, err := session.Inspect(name)
+	if err != nil {
+		t.Fatalf("Inspect(%q) returned error: %s", name, err)
+	}
+	return value
+}
+
+func mustResume(t *testing.T, resume func() error) {
+	t.Helper()
+	if err := resume(); err != nil {
+		t.Fatalf("resuming execution returned error: %s", err)
+	}
+}
+
+func currentLine(t *testing.T, session *debugger.Session) int {
+	t.Helper()
+	frames := session.StackTrace()
+	if len(frames) == 0 {
+		t.Fatal("StackTrace() returned no frames")
+	}
+	return frames[0].Line
+}
+
+func TestBreakpoint(t *testing.T) {
+	session := newSession(t, `var total = 0;
+for (var i = 1; i <= 3; i = i + 1) {
+  total = total + i;
+}
+var list = [total, "done", nil, true];
+print list;
+`)
+	session.SetBreakpoint("test.lox", 3)
+	session.SetBreakpoint("test.lox", 5)
+
+	for _, wantTotal := range []float64{0, 1, 3} {
+		mustResume(t, session.Continue)
+		if got, want := currentLine(t, session), 3; got != want {
+			t.Fatalf("paused at line %d, want %d", got, want)
+		}
+		if got := mustInspect(t, session, "total"); got != wantTotal {
+			t.Errorf("total = %v, want %v", got, wantTotal)
+		}
+	}
+
+	mustResume(t, session.Continue)
+	if got, want := currentLine(t, session), 5; got != want {
+		t.Fatalf("paused at line %d, want %d", got, want)
+	}
+	if _, err := session.Inspect("list"); err == nil {
+		t.Errorf("Inspect(%q) returned no error before list was declared", "list")
+	}
+
+	mustResume(t, session.StepOver)
+	if got, want := mustInspect(t, session, "list"), []any{float64(6), "done", nil, true}; !reflect.DeepEqual(got, want) {
+		t.Errorf("list = %#v, want %#v", got, want)
+	}
+
+	if err := session.Continue(); !errors.Is(err, debugger.ErrFinished) {
+		t.Errorf("Continue() after last statement returned %v, want %v", err, debugger.ErrFinished)
+	}
+	if err := session.Continue(); !errors.Is(err, debugger.ErrFinished) {
+		t.Errorf("Continue() after program finished returned %v, want %v", err, debugger.ErrFinished)
+	}
+}
+
+func TestClearBreakpoints(t *testing.T) {
+	session := newSession(t, `var a = 1;
+a = 2;
+a = 3;
+`)
+	session.SetBreakpoint("test.lox", 2)
+	session.SetBreakpoint("test.lox", 3)
+	session.ClearBreakpoints("test.lox")
+
+	if err := session.Continue(); !errors.Is(err, debugger.ErrFinished) {
+		t.Errorf("Continue() after clearing breakpoints returned %v, want %v", err, debugger.ErrFinished)
+	}
+}
+
+func TestStepping(t *testing.T) {
+	session := newSession(t, `fun double(n) {
+  var result = n * 2;
+  return result;
+}
+var a = double(1);
+var b = double(a);
+print b;
+`)
+
+	type step struct {
+		name       string
+		resume     func() error
+		wantLine   int
+		wantFrames []debugger.Frame
+	}
+	steps := []step{
+		{
+			name:     "step over to first statement",
+			resume:   session.StepOver,
+			wantLine: 1,
+		},
+		{
+			name:     "step over function declaration",
+			resume:   session.StepOver,
+			wantLine: 5,
+		},
+		{
+			name:     "step over call",
+			resume:   session.StepOver,
+			wantLine: 6,
+		},
+		{
+			name:     "step into call",
+			resume:   session.StepInto,
+			wantLine: 2,
+			wantFrames: []debugger.Frame{
+				{Function: "double", File: "test.lox", Line: 2, Column: 3},
+				{Function: "", File: "test.lox", Line: 6, Column: 9},
+			},
+		},
+		{
+			name:     "step over in function",
+			resume:   session.StepOver,
+			wantLine: 3,
+		},
+		{
+			name:     "step out of function",
+			resume:   session.StepOut,
+			wantLine: 7,
+		},
+	}
+	for _, step := range steps {
+		mustResume(t, step.resume)
+		if got := currentLine(t, session); got != step.wantLine {
+			t.Fatalf("%s: paused at line %d, want %d", step.name, got, step.wantLine)
+		}
+		if step.wantFrames != nil {
+			if got := session.StackTrace(); !reflect.DeepEqual(got, step.wantFrames) {
+				t.Errorf("%s: StackTrace() = %+v, want %+v", step.name, got, step.wantFrames)
+			}
+		}
+	}
+
+	if got, want := mustInspect(t, session, "b"), float64(4); got != want {
+		t.Errorf("b = %v, want %v", got, want)
+	}
+}
+
+func TestRuntimeError(t *testing.T) {
+	session := newSession(t, `var a = 1;
+print a + "b";
+`)
+
+	err := session.Continue()
+	if err == nil || errors.Is(err, debugger.ErrFinished) {
+		t.Fatalf("Continue() returned %v, want runtime error", err)
+	}
+	if !strings.Contains(err.Error(), "'+' operator cannot be used with types 'number' and 'string'") {
+		t.Errorf("Continue() returned error %q, want runtime error", err)
+	}
+}
+
+func TestInspectWhilstNotPaused(t *testing.T) {
+	session := newSession(t, `var a = 1;`)
+
+	if _, err := session.Inspect("a"); err == nil {
+		t.Errorf("Inspect() before execution started returned no error")
+	}
+	if frames := session.StackTrace(); frames != nil {
+		t.Errorf("StackTrace() before execution started = %+v, want nil", frames)
+	}
+}
+
+func TestConsole(t *testing.T) {
+	program := mustParse(t, `fun add(a, b) {
+  var sum = a + b;
+  return sum;
+}
+print add(1, 2);
+`)
+	var programOut strings.Builder
+	session := debugger.NewSession(program, nil, interpreter.WithOutput(&programOut))
+	in := strings.NewReader(`break 3
+continue
+print sum
+print missing
+backtrace
+continue
+`)
+	var out strings.Builder
+
+	if err := debugger.NewConsole(session, "test.lox", in, &out).Run(); err != nil {
+		t.Fatalf("Run() returned error: %s", err)
+	}
+
+	want := `Debugging test.lox. Type help for a list of commands.
+(debug) Breakpoint set at test.lox:3
+(debug) Paused at test.lox:3:3
+(debug) sum = 3
+(debug) 'missing' has not been declared
+(debug)   test.lox:3:3 in add
+  test.lox:5:7 in <main>
+(debug) Program finished
+`
+	if got := out.String(); got != want {
+		t.Errorf("console output:\n%s\nwant:\n%s", got, want)
+	}
+	if got, want := programOut.String(), "3\n"; got != want {
+		t.Errorf("program output = %q, want %q", got, want)
+	}
+}
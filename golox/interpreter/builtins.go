@@ -1,20 +1,55 @@
 package interpreter
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
+	"io"
 	"math"
+	"math/big"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/marcuscaisey/lox/golox/token"
 )
 
+// unsafeBuiltins are the names of the built-in functions which can affect things outside of the interpreter, such as
+// the process that it's running in.
+var unsafeBuiltins = []string{"sleep", "readLine", "printerr", "exit"}
+
+// SafeBuiltins returns the names of the built-in functions which can't affect anything outside of the interpreter, such
+// as by exiting the process or writing to standard error. They can be passed to [WithBuiltins] to sandbox a program.
+func SafeBuiltins() []string {
+	var names []string
+	for name := range builtinFunctions {
+		if !slices.Contains(unsafeBuiltins, name) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
 var builtinFunctions = map[string]*loxFunction{
 	"clock": newBuiltinLoxFunction("clock", nil, func([]loxValue) loxValue {
 		return loxNumber(time.Now().UnixNano()) / loxNumber(time.Second)
 	}),
+	"timeit": newInterpreterBuiltinLoxFunction("timeit", []string{"function"}, func(interpreter *Interpreter, args []loxValue) loxValue {
+		function, ok := args[0].(loxCallable)
+		if !ok {
+			return newErrorMsgf("expected timeit argument to be a %m, got %m", loxTypeFunction, args[0].Type())
+		}
+		start := time.Now()
+		if errorMsg, ok := callCallback(interpreter, interpreter.callStack.CallLocation(), function).(errorMsg); ok {
+			return errorMsg
+		}
+		return loxNumber(time.Since(start)) / loxNumber(time.Second)
+	}),
 	"sleep": newBuiltinLoxFunction("sleep", []string{"duration"}, func(args []loxValue) loxValue {
-		durationNumber, ok := args[0].(loxNumber)
+		durationNumber, ok := asNumber(args[0])
 		if !ok {
 			return newErrorMsgf("expected sleep argument to be a %m, got %m", loxTypeNumber, args[0].Type())
 		}
@@ -26,8 +61,18 @@ var builtinFunctions = map[string]*loxFunction{
 		return loxNil{}
 	}),
 	"type": newBuiltinLoxFunction("type", []string{"value"}, func(args []loxValue) loxValue {
+		if instance, ok := args[0].(*loxInstance); ok {
+			return loxString(fmt.Sprintf("%s instance", instance.Class.Name))
+		}
 		return loxString(args[0].Type())
 	}),
+	"len": newBuiltinLoxFunction("len", []string{"value"}, func(args []loxValue) loxValue {
+		lennable, ok := args[0].(loxLennable)
+		if !ok {
+			return newErrorMsgf("expected len argument to be a %m or %m, got %m", loxTypeString, loxTypeList, args[0].Type())
+		}
+		return loxNumber(lennable.Len())
+	}),
 	"parseNumber": newBuiltinLoxFunction("parseNumber", []string{"str"}, func(args []loxValue) loxValue {
 		str, ok := args[0].(loxString)
 		if !ok {
@@ -39,18 +84,81 @@ var builtinFunctions = map[string]*loxFunction{
 		}
 		return loxNumber(f)
 	}),
-	"string": newBuiltinLoxFunction("string", []string{"value"}, func(args []loxValue) loxValue {
-		return loxString(args[0].String())
+	"num": newBuiltinLoxFunction("num", []string{"str"}, func(args []loxValue) loxValue {
+		str, ok := args[0].(loxString)
+		if !ok {
+			return newErrorMsgf("expected num argument to be a %m, got %m", loxTypeString, args[0].Type())
+		}
+		f, err := strconv.ParseFloat(strings.TrimSpace(str.String()), 64)
+		if err != nil {
+			return loxNil{}
+		}
+		return loxNumber(f)
+	}),
+	"string": newInterpreterBuiltinLoxFunction("string", []string{"value"}, func(interpreter *Interpreter, args []loxValue) loxValue {
+		return loxString(interpreter.stringifyArg(args[0]))
+	}),
+	"format": newVariadicInterpreterBuiltinLoxFunction("format", []string{"format"}, func(interpreter *Interpreter, args []loxValue) loxValue {
+		format, ok := args[0].(loxString)
+		if !ok {
+			return newErrorMsgf("expected format argument to be a %m, got %m", loxTypeString, args[0].Type())
+		}
+		values := args[1:]
+		placeholders := strings.Count(string(format), "{}")
+		if placeholders != len(values) {
+			valueSuffix := "s"
+			if placeholders == 1 {
+				valueSuffix = ""
+			}
+			return newErrorMsgf("expected %d value%s to format, got %d", placeholders, valueSuffix, len(values))
+		}
+		b := new(strings.Builder)
+		parts := strings.Split(string(format), "{}")
+		for i, part := range parts {
+			b.WriteString(part)
+			if i < len(values) {
+				b.WriteString(interpreter.stringifyArg(values[i]))
+			}
+		}
+		return loxString(b.String())
 	}),
 	"error": newBuiltinLoxFunction("error", []string{"msg"}, func(args []loxValue) loxValue {
 		return newErrorMsg(args[0].String())
 	}),
-	"printerr": newBuiltinLoxFunction("printerr", []string{"msg"}, func(args []loxValue) loxValue {
-		fmt.Fprintln(os.Stderr, args[0].String())
+	"assert": newVariadicBuiltinLoxFunction("assert", []string{"condition"}, func(args []loxValue) loxValue {
+		if len(args) > 2 {
+			return newErrorMsgf("assert() accepts at most 2 arguments but %d were given", len(args))
+		}
+		if isTruthy(args[0]) {
+			return loxNil{}
+		}
+		if len(args) == 2 {
+			return newErrorMsg(args[1].String())
+		}
+		return newErrorMsg("assertion failed")
+	}),
+	"readLine": newInterpreterBuiltinLoxFunction("readLine", nil, func(interpreter *Interpreter, _ []loxValue) loxValue {
+		line, err := interpreter.input.ReadString('\n')
+		if errors.Is(err, io.EOF) && line == "" {
+			return loxNil{}
+		}
+		if err != nil && !errors.Is(err, io.EOF) {
+			return newErrorMsgf("reading line: %s", err)
+		}
+		line = strings.TrimSuffix(line, "\n")
+		line = strings.TrimSuffix(line, "\r")
+		return loxString(line)
+	}),
+	"write": newInterpreterBuiltinLoxFunction("write", []string{"value"}, func(interpreter *Interpreter, args []loxValue) loxValue {
+		fmt.Fprint(interpreter.output, interpreter.stringifyArg(args[0]))
+		return loxNil{}
+	}),
+	"printerr": newInterpreterBuiltinLoxFunction("printerr", []string{"msg"}, func(interpreter *Interpreter, args []loxValue) loxValue {
+		fmt.Fprintln(interpreter.errorOutput, interpreter.stringifyArg(args[0]))
 		return loxNil{}
 	}),
 	"exit": newBuiltinLoxFunction("exit", []string{"code"}, func(args []loxValue) loxValue {
-		codeNumber, ok := args[0].(loxNumber)
+		codeNumber, ok := asNumber(args[0])
 		if !ok {
 			return newErrorMsgf("expected exit argument to be a %m, got %m", loxTypeNumber, args[0].Type())
 		}
@@ -61,4 +169,285 @@ var builtinFunctions = map[string]*loxFunction{
 		os.Exit(codeInt)
 		return loxNil{}
 	}),
+	"compare": newBuiltinLoxFunction("compare", []string{"a", "b"}, func(args []loxValue) loxValue {
+		result, err := compareValues(args[0], args[1])
+		if err != nil {
+			return newErrorMsg(err.Error())
+		}
+		return loxNumber(result)
+	}),
+	"sort": newVariadicInterpreterBuiltinLoxFunction("sort", []string{"list"}, func(interpreter *Interpreter, args []loxValue) loxValue {
+		if len(args) > 2 {
+			return newErrorMsgf("sort() accepts at most 2 arguments but %d were given", len(args))
+		}
+		list, ok := args[0].(*loxList)
+		if !ok {
+			return newErrorMsgf("expected sort argument to be a %m, got %m", loxTypeList, args[0].Type())
+		}
+		compare := func(a, b loxValue) (int, error) {
+			return compareValues(a, b)
+		}
+		if len(args) == 2 {
+			comparator, ok := args[1].(loxCallable)
+			if !ok {
+				return newErrorMsgf("expected sort comparator to be a %m, got %m", loxTypeFunction, args[1].Type())
+			}
+			if params := comparator.Params(); len(params) != 2 && !(comparator.IsVariadic() && len(params) < 2) {
+				return newErrorMsgf("expected sort comparator to accept 2 arguments, but it accepts %d", len(params))
+			}
+			location := interpreter.callStack.CallLocation()
+			compare = func(a, b loxValue) (int, error) {
+				result := interpreter.call(positionRange(location), comparator, []loxValue{a, b})
+				if errorMsg, ok := result.(errorMsg); ok {
+					return 0, errors.New(string(errorMsg))
+				}
+				number, ok := asNumber(result)
+				if !ok {
+					return 0, fmt.Errorf("expected sort comparator to return a %m, got %m", loxTypeNumber, result.Type())
+				}
+				return cmp.Compare(number, 0), nil
+			}
+		}
+		// Sort a copy of the list so that it's left unchanged if an error occurs.
+		sorted := slices.Clone(*list)
+		var sortErr error
+		slices.SortStableFunc(sorted, func(a, b loxValue) int {
+			if sortErr != nil {
+				return 0
+			}
+			result, err := compare(a, b)
+			if err != nil {
+				sortErr = err
+			}
+			return result
+		})
+		if sortErr != nil {
+			return newErrorMsg(sortErr.Error())
+		}
+		copy(*list, sorted)
+		return loxNil{}
+	}),
+	"map": newInterpreterBuiltinLoxFunction("map", []string{"list", "function"}, func(interpreter *Interpreter, args []loxValue) loxValue {
+		list, ok := args[0].(*loxList)
+		if !ok {
+			return newErrorMsgf("expected map list argument to be a %m, got %m", loxTypeList, args[0].Type())
+		}
+		function, ok := args[1].(loxCallable)
+		if !ok {
+			return newErrorMsgf("expected map function argument to be a %m, got %m", loxTypeFunction, args[1].Type())
+		}
+		location := interpreter.callStack.CallLocation()
+		mapped := make([]loxValue, len(*list))
+		for i, element := range *list {
+			result := callCallback(interpreter, location, function, element)
+			if errorMsg, ok := result.(errorMsg); ok {
+				return errorMsg
+			}
+			mapped[i] = result
+		}
+		return newLoxList(mapped)
+	}),
+	"filter": newInterpreterBuiltinLoxFunction("filter", []string{"list", "predicate"}, func(interpreter *Interpreter, args []loxValue) loxValue {
+		list, ok := args[0].(*loxList)
+		if !ok {
+			return newErrorMsgf("expected filter list argument to be a %m, got %m", loxTypeList, args[0].Type())
+		}
+		predicate, ok := args[1].(loxCallable)
+		if !ok {
+			return newErrorMsgf("expected filter predicate argument to be a %m, got %m", loxTypeFunction, args[1].Type())
+		}
+		location := interpreter.callStack.CallLocation()
+		filtered := []loxValue{}
+		for _, element := range *list {
+			result := callCallback(interpreter, location, predicate, element)
+			if errorMsg, ok := result.(errorMsg); ok {
+				return errorMsg
+			}
+			if isTruthy(result) {
+				filtered = append(filtered, element)
+			}
+		}
+		return newLoxList(filtered)
+	}),
+	"reduce": newInterpreterBuiltinLoxFunction("reduce", []string{"list", "function", "initial"}, func(interpreter *Interpreter, args []loxValue) loxValue {
+		list, ok := args[0].(*loxList)
+		if !ok {
+			return newErrorMsgf("expected reduce list argument to be a %m, got %m", loxTypeList, args[0].Type())
+		}
+		function, ok := args[1].(loxCallable)
+		if !ok {
+			return newErrorMsgf("expected reduce function argument to be a %m, got %m", loxTypeFunction, args[1].Type())
+		}
+		location := interpreter.callStack.CallLocation()
+		accumulator := args[2]
+		for _, element := range *list {
+			accumulator = callCallback(interpreter, location, function, accumulator, element)
+			if errorMsg, ok := accumulator.(errorMsg); ok {
+				return errorMsg
+			}
+		}
+		return accumulator
+	}),
+	"clone": newBuiltinLoxFunction("clone", []string{"value"}, func(args []loxValue) loxValue {
+		return cloneValue(args[0], map[loxValue]loxValue{})
+	}),
+}
+
+// stringifyArg returns the string representation of an argument of a built-in function in the same way as it would be
+// printed by a print statement.
+func (i *Interpreter) stringifyArg(value loxValue) string {
+	return i.stringify(positionRange(i.callStack.CallLocation()), value)
+}
+
+// callCallback calls a function which was passed to a built-in function. An errorMsg is returned if the function can't
+// be called with the given arguments or if it returns one.
+func callCallback(interpreter *Interpreter, location token.Position, callback loxCallable, args ...loxValue) loxValue {
+	if err := checkArity(callback, len(args)); err != nil {
+		return newErrorMsg(err.Error())
+	}
+	return interpreter.call(positionRange(location), callback, args)
+}
+
+// positionRange is a [token.Range] which starts and ends at the same position.
+type positionRange token.Position
+
+func (p positionRange) Start() token.Position { return token.Position(p) }
+func (p positionRange) End() token.Position   { return token.Position(p) }
+
+// cloneValue returns a deep copy of value. Lists, instances, and results are copied recursively. All other values are
+// returned as-is, either because they're immutable or because, like functions and classes, they're not copied.
+// clones maps the values which have already been copied to their copies so that cycles are preserved in the copy
+// instead of being followed forever.
+func cloneValue(value loxValue, clones map[loxValue]loxValue) loxValue {
+	switch value := value.(type) {
+	case *loxList:
+		if clone, ok := clones[value]; ok {
+			return clone
+		}
+		clone := make(loxList, len(*value))
+		clones[value] = &clone
+		for i, element := range *value {
+			clone[i] = cloneValue(element, clones)
+		}
+		return &clone
+	case *loxInstance:
+		if clone, ok := clones[value]; ok {
+			return clone
+		}
+		clone := newLoxInstance(value.Class, value.typ)
+		clones[value] = clone
+		for name, fieldValue := range value.Fields() {
+			clone.setField(name, cloneValue(fieldValue, clones))
+		}
+		return clone
+	case *loxResult:
+		return &loxResult{ok: value.ok, value: cloneValue(value.value, clones)}
+	default:
+		return value
+	}
+}
+
+// orderableTypeRanks defines the order of values of different types when compared with compareValues. Values of the
+// types not in this map can't be compared.
+var orderableTypeRanks = map[loxType]int{
+	loxTypeNil:     0,
+	loxTypeBool:    1,
+	loxTypeNumber:  2,
+	loxTypeDecimal: 3,
+	loxTypeString:  4,
+	loxTypeList:    5,
+}
+
+// compareValues returns -1 if a is less than b, 0 if a is equal to b, and +1 if a is greater than b.
+// Values of different types are ordered nil < bool < number < decimal < string < list. Values of the same type are
+// ordered by value, with lists being ordered lexicographically by their elements. An error is returned if either value
+// isn't of one of these types.
+func compareValues(a, b loxValue) (int, error) {
+	aRank, aOk := orderableTypeRanks[a.Type()]
+	if !aOk {
+		return 0, fmt.Errorf("%m values cannot be compared", a.Type())
+	}
+	bRank, bOk := orderableTypeRanks[b.Type()]
+	if !bOk {
+		return 0, fmt.Errorf("%m values cannot be compared", b.Type())
+	}
+	if aRank != bRank {
+		return cmp.Compare(aRank, bRank), nil
+	}
+	switch a := a.(type) {
+	case loxNil:
+		return 0, nil
+	case loxBool:
+		return cmp.Compare(boolRank(bool(a)), boolRank(bool(b.(loxBool)))), nil
+	case loxNumber, loxBigInt:
+		return compareNumbers(a, b), nil
+	case loxDecimal:
+		x, y, _ := alignDecimals(a, b.(loxDecimal))
+		return x.Cmp(y), nil
+	case loxString:
+		return cmp.Compare(a, b.(loxString)), nil
+	case *loxList:
+		bList := *b.(*loxList)
+		for i := range min(len(*a), len(bList)) {
+			if result, err := compareValues((*a)[i], bList[i]); err != nil || result != 0 {
+				return result, err
+			}
+		}
+		return cmp.Compare(len(*a), len(bList)), nil
+	default:
+		panic(fmt.Sprintf("unexpected orderable value type: %T", a))
+	}
+}
+
+func boolRank(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// compareNumbers compares two numbers, each of which is either a loxNumber or a loxBigInt. NaN is less than all other
+// numbers and equal to itself.
+func compareNumbers(a, b loxValue) int {
+	if a, ok := a.(loxBigInt); ok {
+		if b, ok := b.(loxBigInt); ok {
+			return a.value.Cmp(b.value)
+		}
+	}
+	aFloat, _ := asNumber(a)
+	bFloat, _ := asNumber(b)
+	if math.IsNaN(float64(aFloat)) || math.IsNaN(float64(bFloat)) || math.IsInf(float64(aFloat), 0) || math.IsInf(float64(bFloat), 0) {
+		return cmp.Compare(aFloat, bFloat)
+	}
+	return bigFloat(a).Cmp(bigFloat(b))
+}
+
+// bigFloat returns the exact value of a finite number, which is either a loxNumber or a loxBigInt.
+func bigFloat(value loxValue) *big.Float {
+	switch value := value.(type) {
+	case loxBigInt:
+		return new(big.Float).SetInt(value.value)
+	case loxNumber:
+		return big.NewFloat(float64(value))
+	default:
+		panic(fmt.Sprintf("unexpected number type: %T", value))
+	}
+}
+
+// debugBuiltinFunctions are the built-in functions which are only defined when debugging built-ins have been enabled.
+var debugBuiltinFunctions = map[string]*loxFunction{
+	"approxSize": newBuiltinLoxFunction("approxSize", []string{"value"}, func(args []loxValue) loxValue {
+		return loxNumber(approxSize(args[0]))
+	}),
+	"fieldNames": newBuiltinLoxFunction("fieldNames", []string{"instance"}, func(args []loxValue) loxValue {
+		instance, ok := args[0].(*loxInstance)
+		if !ok {
+			return newErrorMsgf("expected fieldNames argument to be an instance, got %m", args[0].Type())
+		}
+		var names []loxValue
+		for name := range instance.Fields() {
+			names = append(names, loxString(name))
+		}
+		return newLoxList(names)
+	}),
 }
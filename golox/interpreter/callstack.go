@@ -13,9 +13,17 @@ import (
 	"github.com/marcuscaisey/lox/golox/token"
 )
 
+// defaultMaxCallDepth is the default maximum number of calls which can be on the call stack.
+const defaultMaxCallDepth = 1000
+
+// maxStackTraceFrames is the maximum number of frames included in a stack trace. If there are more frames than this,
+// then only the most and least recent frames are included.
+const maxStackTraceFrames = 20
+
 type callStack struct {
 	frames      *stack.Stack[*stackFrame]
 	calledFuncs *stack.Stack[string]
+	maxDepth    int // Maximum number of calls which can be on the stack
 }
 
 // stackFrame points either to a function call or where an error occurred.
@@ -28,6 +36,7 @@ func newCallStack() *callStack {
 	callStack := &callStack{
 		frames:      stack.New[*stackFrame](),
 		calledFuncs: stack.New[string](),
+		maxDepth:    defaultMaxCallDepth,
 	}
 	callStack.calledFuncs.Push("")
 	return callStack
@@ -46,14 +55,47 @@ func (cs *callStack) Pop() {
 	cs.calledFuncs.Pop()
 }
 
+// CallLocation returns the location of the most recent call.
+func (cs *callStack) CallLocation() token.Position {
+	return cs.frames.Peek().Location
+}
+
 func (cs *callStack) Len() int {
 	return cs.frames.Len()
 }
 
+// Full reports whether the stack contains the maximum number of calls.
+func (cs *callStack) Full() bool {
+	return cs.Len() >= cs.maxDepth
+}
+
+// Truncate pops calls from the stack until it contains n frames.
+func (cs *callStack) Truncate(n int) {
+	for cs.Len() > n {
+		cs.Pop()
+	}
+}
+
 func (cs *callStack) Clear() {
+	cs.Reset("")
+}
+
+// Reset clears the stack and sets the function being executed at the bottom of it.
+func (cs *callStack) Reset(function string) {
 	cs.frames.Clear()
 	cs.calledFuncs.Clear()
-	cs.calledFuncs.Push("")
+	cs.calledFuncs.Push(function)
+}
+
+// Frames returns the frames of the call stack, most recent call first, where current is the position currently being
+// executed in the most recent call.
+func (cs *callStack) Frames(current token.Position) []StackFrame {
+	frames := make([]StackFrame, 0, cs.Len()+1)
+	frames = append(frames, StackFrame{Function: cs.calledFuncs.Peek(), Position: current})
+	for _, frame := range cs.frames.Backward() {
+		frames = append(frames, StackFrame{Function: frame.Function, Position: frame.Location})
+	}
+	return frames
 }
 
 func (cs *callStack) StackTrace() string {
@@ -64,6 +106,7 @@ func (cs *callStack) StackTrace() string {
 	functions := make([]string, cs.Len())
 	functionWidth := 0
 	lines := make([]string, cs.Len())
+	caretOffsets := make([]int, cs.Len())
 	for i, frame := range cs.frames.Backward() {
 		locations[i] = fmt.Sprintf("%m", frame.Location)
 		locationWidth = max(locationWidth, runewidth.StringWidth(locations[i]))
@@ -73,13 +116,23 @@ func (cs *callStack) StackTrace() string {
 		}
 		functions[i] = function
 		functionWidth = max(functionWidth, runewidth.StringWidth(functions[i]))
-		trimmedLine := string(bytes.TrimLeftFunc(frame.Location.File.Line(frame.Location.Line), unicode.IsSpace))
-		lines[i] = ansi.Sprint("${FAINT}", trimmedLine, "${RESET_BOLD}")
+		line := frame.Location.File.Line(frame.Location.Line)
+		trimmedLine := bytes.TrimLeftFunc(line, unicode.IsSpace)
+		lines[i] = ansi.Sprint("${FAINT}", string(trimmedLine), "${RESET_BOLD}")
+		indent := line[:len(line)-len(trimmedLine)]
+		caretOffsets[i] = max(runewidth.StringWidth(string(line[:frame.Location.Column]))-runewidth.StringWidth(string(indent)), 0)
 	}
 	for i := cs.Len() - 1; i >= 0; i-- {
+		if omitted := cs.Len() - maxStackTraceFrames; omitted > 0 && i == cs.Len()-maxStackTraceFrames/2-1 {
+			ansi.Fprintf(b, "  ${FAINT}... %d more calls ...${RESET_BOLD}\n", omitted)
+			i -= omitted - 1
+			continue
+		}
 		location := runewidth.FillRight(locations[i], locationWidth)
 		function := runewidth.FillRight(functions[i], functionWidth)
-		fmt.Fprint(b, "  ", location, " ", function, " ", lines[i])
+		fmt.Fprint(b, "  ", location, " ", function, " ", lines[i], "\n")
+		caretIndent := strings.Repeat(" ", 2+locationWidth+1+functionWidth+1+caretOffsets[i])
+		ansi.Fprint(b, caretIndent, "${FAINT}${RED}^${DEFAULT}${RESET_BOLD}")
 		if i > 0 {
 			fmt.Fprintln(b)
 		}
@@ -2,6 +2,7 @@ package interpreter
 
 import (
 	"fmt"
+	"maps"
 
 	"github.com/marcuscaisey/lox/golox/ast"
 	"github.com/marcuscaisey/lox/golox/loxerr"
@@ -27,6 +28,8 @@ type environment interface {
 	// GetByName returns the value of the identifier with the given name.
 	// This should be used for identifiers without a corresponding [*ast.Ident].
 	GetByName(name string) loxValue
+	// Values returns the values of all identifiers visible in this environment, keyed by name.
+	Values() map[string]loxValue
 }
 
 // globalEnvironment is the environment for the global scope.
@@ -88,6 +91,10 @@ func (e *globalEnvironment) GetByName(name string) loxValue {
 	}
 }
 
+func (e *globalEnvironment) Values() map[string]loxValue {
+	return maps.Clone(e.values)
+}
+
 // localEnvironment is the environment for a local scope.
 type localEnvironment struct {
 	parent environment
@@ -150,3 +157,14 @@ func (e *localEnvironment) GetByName(name string) loxValue {
 		panic(fmt.Sprintf("%q has not been declared", name))
 	}
 }
+
+func (e *localEnvironment) Values() map[string]loxValue {
+	values := map[string]loxValue{}
+	if e.parent != nil {
+		values = e.parent.Values()
+	}
+	if e.name != "" {
+		values[e.name] = e.value
+	}
+	return values
+}
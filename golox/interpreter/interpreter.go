@@ -2,7 +2,13 @@
 package interpreter
 
 import (
+	"bufio"
+	"errors"
 	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -19,7 +25,23 @@ type Interpreter struct {
 	callStack    *callStack
 	builtinStubs []ast.Decl
 
-	replMode bool
+	replMode      bool
+	input         *bufio.Reader
+	output        io.Writer
+	errorOutput   io.Writer
+	callTrace     io.Writer
+	debugBuiltins bool
+	builtinNames  map[string]bool // Names of the built-in functions to define, or nil to define all of them
+	bigIntegers   bool
+
+	stmtHook func(stmt ast.Stmt) bool
+	resumeCh chan struct{}
+	hookEnv  environment
+	hookStmt ast.Stmt
+
+	// stringifying contains the instances whose toString methods are currently being called, so that an instance which
+	// stringifies itself inside its toString method is represented by its default string instead.
+	stringifying map[*loxInstance]bool
 }
 
 // Option can be passed to New to configure the interpreter.
@@ -33,61 +55,288 @@ func WithREPLMode(enabled bool) Option {
 	}
 }
 
+// WithInput configures the interpreter to read the input of the program from r instead of standard input.
+func WithInput(r io.Reader) Option {
+	return func(i *Interpreter) {
+		i.input = bufio.NewReader(r)
+	}
+}
+
+// WithOutput configures the interpreter to write the output of the program to w instead of standard output.
+func WithOutput(w io.Writer) Option {
+	return func(i *Interpreter) {
+		i.output = w
+	}
+}
+
+// WithErrorOutput configures the interpreter to write the error output of the program, such as the messages printed by
+// printerr, to w instead of standard error.
+func WithErrorOutput(w io.Writer) Option {
+	return func(i *Interpreter) {
+		i.errorOutput = w
+	}
+}
+
+// WithMaxCallDepth configures the maximum number of calls which can be on the call stack. Calling a function when the
+// stack is full raises an uncatchable stack overflow error, so that unbounded recursion fails with a Lox error instead of
+// overflowing the Go stack. The default is 1000.
+func WithMaxCallDepth(depth int) Option {
+	return func(i *Interpreter) {
+		i.callStack.maxDepth = depth
+	}
+}
+
+// WithCallTrace configures the interpreter to write a trace of every function call to w. The trace includes the
+// arguments and return value of each call and is indented to reflect the depth of the call stack.
+func WithCallTrace(w io.Writer) Option {
+	return func(i *Interpreter) {
+		i.callTrace = w
+	}
+}
+
+// WithDebugBuiltins configures the interpreter to define the built-in functions which are intended for debugging, such
+// as approxSize and fieldNames.
+func WithDebugBuiltins(enabled bool) Option {
+	return func(i *Interpreter) {
+		i.debugBuiltins = enabled
+	}
+}
+
+// WithBuiltins configures the interpreter to only define the built-in functions with the given names, which can be used
+// to sandbox programs by removing the built-in functions which they shouldn't have access to. Programs which reference
+// any other built-in function behave as if it was never declared. Names which don't belong to a built-in function are
+// ignored. All built-in functions are defined by default.
+//
+// [SafeBuiltins] returns the names of the built-in functions which are safe to give to untrusted programs.
+func WithBuiltins(names ...string) Option {
+	return func(i *Interpreter) {
+		i.builtinNames = map[string]bool{}
+		for _, name := range names {
+			i.builtinNames[name] = true
+		}
+	}
+}
+
+// WithBigIntegers configures the interpreter to represent integer literals, and the results of adding, subtracting,
+// multiplying, and taking the remainder of integers, as arbitrary-precision integers instead of floating point numbers.
+// Any operation involving a non-integer number, and division, produce a floating point number as usual.
+func WithBigIntegers(enabled bool) Option {
+	return func(i *Interpreter) {
+		i.bigIntegers = enabled
+	}
+}
+
+// WithStatementHook configures the interpreter to call hook before executing each statement.
+// If hook returns false, then execution is paused until Resume is called.
+// Variables and CallStack can be called from inside hook, or whilst execution is paused, to inspect the variables which
+// are visible to the statement and the calls which led to it.
+func WithStatementHook(hook func(stmt ast.Stmt) bool) Option {
+	return func(i *Interpreter) {
+		i.stmtHook = hook
+	}
+}
+
 // New constructs a new Interpreter with the given options.
 // argv
 func New(argv []string, opts ...Option) *Interpreter {
+	interpreter := &Interpreter{
+		callStack:    newCallStack(),
+		input:        bufio.NewReader(os.Stdin),
+		output:       os.Stdout,
+		errorOutput:  os.Stderr,
+		resumeCh:     make(chan struct{}),
+		stringifying: map[*loxInstance]bool{},
+	}
+	for _, opt := range opts {
+		opt(interpreter)
+	}
+
 	var globals environment = newGlobalEnvironment()
 	for name, builtin := range builtinFunctions {
-		globals = globals.Define(name, builtin)
+		if interpreter.includesBuiltin(name) {
+			globals = globals.Define(name, builtin)
+		}
+	}
+	if interpreter.debugBuiltins {
+		for name, builtin := range debugBuiltinFunctions {
+			if interpreter.includesBuiltin(name) {
+				globals = globals.Define(name, builtin)
+			}
+		}
 	}
 
 	argvValues := make([]loxValue, len(argv))
 	for i, arg := range argv {
 		argvValues[i] = loxString(arg)
 	}
-	globals = globals.Define("argv", newLoxList(argvValues))
+	interpreter.globals = globals.Define("argv", newLoxList(argvValues))
+
+	// The built-in stubs are filtered in the same way as the built-in functions so that references to the excluded
+	// built-in functions are reported by analysis as if they were never declared.
+	stubs := builtins.MustParseStubs("builtins.lox", builtins.WithDebug(interpreter.debugBuiltins))
+	interpreter.builtinStubs = slices.DeleteFunc(stubs, func(decl ast.Decl) bool {
+		funDecl, ok := decl.(*ast.FunDecl)
+		return ok && !interpreter.includesBuiltin(funDecl.Name.String())
+	})
 
-	interpreter := &Interpreter{
-		globals:      globals,
-		callStack:    newCallStack(),
-		builtinStubs: builtins.MustParseStubs("builtins.lox"),
-	}
-	for _, opt := range opts {
-		opt(interpreter)
-	}
 	return interpreter
 }
 
+func (i *Interpreter) includesBuiltin(name string) bool {
+	return i.builtinNames == nil || i.builtinNames[name]
+}
+
 // Execute executes a program and returns an error if one occurred.
 // Execute can be called multiple times with different programs and the state will be maintained between calls.
 func (i *Interpreter) Execute(program *ast.Program) error {
-	if err := analyse.Program(program, i.builtinStubs, analyse.WithFatalOnly(true)); err != nil {
+	var loxErrs loxerr.Errors
+	if err := analyse.Program(program, i.builtinStubs, analyse.WithFatalOnly(true)); err != nil && !errors.As(err, &loxErrs) {
 		return err
 	}
+	if loxErrs.HasFatal() {
+		return loxErrs.Fatal()
+	}
 	return i.interpretProgram(program)
 }
 
-func (i *Interpreter) interpretProgram(node *ast.Program) (err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			if loxErr, ok := r.(*loxerr.Error); ok {
-				err = loxErr
-				if i.callStack.Len() > 0 {
-					i.callStack.Push("", loxErr.Start())
-					err = fmt.Errorf("%w\n\n%s", err, i.callStack.StackTrace())
-					i.callStack.Clear()
-				}
-			} else {
-				panic(r)
-			}
+// Resume resumes execution after it has been paused by the statement hook.
+// Resume blocks until execution has been resumed, so it must be called from a different goroutine to the one which
+// called Execute.
+func (i *Interpreter) Resume() {
+	i.resumeCh <- struct{}{}
+}
+
+// Variables returns the string representations of the values of the variables which are visible to the statement
+// about to be executed, keyed by name. Builtin functions are excluded.
+// Variables must only be called from inside the statement hook or whilst execution is paused.
+func (i *Interpreter) Variables() map[string]string {
+	if i.hookEnv == nil {
+		panic("Variables called outside of statement hook")
+	}
+	vars := map[string]string{}
+	for name, value := range i.hookEnv.Values() {
+		if builtin, ok := builtinFunctions[name]; ok && value == loxValue(builtin) {
+			continue
 		}
-	}()
+		if builtin, ok := debugBuiltinFunctions[name]; ok && value == loxValue(builtin) {
+			continue
+		}
+		vars[name] = value.Repr()
+	}
+	return vars
+}
+
+// Variable returns the value of the variable with the given name which is visible to the statement about to be
+// executed and reports whether there is such a variable. The value is converted to a Go value of the following types:
+//   - nil for nil
+//   - bool for booleans
+//   - float64 for numbers, or *big.Int if the number is a big integer
+//   - string for strings
+//   - []any for lists, with each element converted in the same way
+//
+// Values of any other type are converted to their string representation.
+// Variable must only be called from inside the statement hook or whilst execution is paused.
+func (i *Interpreter) Variable(name string) (any, bool) {
+	if i.hookEnv == nil {
+		panic("Variable called outside of statement hook")
+	}
+	value, ok := i.hookEnv.Values()[name]
+	if !ok {
+		return nil, false
+	}
+	return goValue(value, map[*loxList][]any{}), true
+}
+
+// goValue converts value to a Go value as described by [Interpreter.Variable]. lists maps the lists which have already
+// been converted to their conversions so that cyclic lists can be converted.
+func goValue(value loxValue, lists map[*loxList][]any) any {
+	switch value := value.(type) {
+	case loxNil:
+		return nil
+	case loxBool:
+		return bool(value)
+	case loxNumber:
+		return float64(value)
+	case loxBigInt:
+		return new(big.Int).Set(value.value)
+	case loxString:
+		return string(value)
+	case *loxList:
+		if elements, ok := lists[value]; ok {
+			return elements
+		}
+		elements := make([]any, len(*value))
+		lists[value] = elements
+		for i, element := range *value {
+			elements[i] = goValue(element, lists)
+		}
+		return elements
+	default:
+		return value.Repr()
+	}
+}
+
+// StackFrame is a frame of the call stack of an executing program.
+type StackFrame struct {
+	Function string         // Name of the function being executed, or empty if not in a function
+	Position token.Position // Position of the statement or call being executed
+}
+
+// CallStack returns the call stack of the statement about to be executed, most recent call first. The first frame
+// points to the statement and each following frame points to the call which led to the frame before it.
+// CallStack must only be called from inside the statement hook or whilst execution is paused.
+func (i *Interpreter) CallStack() []StackFrame {
+	if i.hookStmt == nil {
+		panic("CallStack called outside of statement hook")
+	}
+	return i.callStack.Frames(i.hookStmt.Start())
+}
+
+// CallFunction calls a global function which takes no arguments and returns an error if one occurred.
+// The function must have been declared by a program previously passed to Execute.
+func (i *Interpreter) CallFunction(name string) (err error) {
+	value, ok := i.globals.Values()[name]
+	if !ok {
+		return fmt.Errorf("%s has not been declared", name)
+	}
+	callable, ok := value.(loxCallable)
+	if !ok {
+		return fmt.Errorf("%s is not a function", name)
+	}
+	defer i.callStack.Clear()
+	defer i.recoverError(&err)
+	// The function is called from outside of any source code, so it becomes the bottom of the call stack rather than
+	// being pushed onto it with a call location.
+	i.callStack.Reset(callable.CallableName())
+	callable.Call(i, nil)
+	return nil
+}
+
+func (i *Interpreter) interpretProgram(node *ast.Program) (err error) {
+	defer i.recoverError(&err)
 	for _, stmt := range node.Stmts {
 		i.execStmt(i.globals, stmt)
 	}
 	return nil
 }
 
+// recoverError recovers from a panic caused by a Lox error and sets *err to it, including a stack trace if the error
+// occurred inside a function call. It must be deferred.
+func (i *Interpreter) recoverError(err *error) {
+	if r := recover(); r != nil {
+		if loxErr, ok := r.(*loxerr.Error); ok {
+			*err = loxErr
+			if i.callStack.Len() > 0 {
+				i.callStack.Push("", loxErr.Start())
+				*err = fmt.Errorf("%w\n\n%s", *err, i.callStack.StackTrace())
+			}
+			i.callStack.Clear()
+		} else {
+			panic(r)
+		}
+	}
+}
+
 //sumtype:decl
 type stmtResult interface {
 	isStmtResult()
@@ -104,11 +353,14 @@ type (
 )
 
 func (i *Interpreter) execStmt(env environment, stmt ast.Stmt) (stmtResult, environment) {
+	i.beforeStmt(env, stmt)
 	var result stmtResult = stmtResultNone{}
 	newEnv := env
 	switch stmt := stmt.(type) {
 	case *ast.VarDecl:
 		newEnv = i.execVarDecl(env, stmt)
+	case *ast.DestructuringDecl:
+		newEnv = i.execDestructuringDecl(env, stmt)
 	case *ast.FunDecl:
 		newEnv = i.execFunDecl(env, stmt)
 	case *ast.ClassDecl:
@@ -125,18 +377,38 @@ func (i *Interpreter) execStmt(env environment, stmt ast.Stmt) (stmtResult, envi
 		result = i.execWhileStmt(env, stmt)
 	case *ast.ForStmt:
 		result = i.execForStmt(env, stmt)
+	case *ast.TryStmt:
+		result = i.execTryStmt(env, stmt)
+	case *ast.WithStmt:
+		result = i.execWithStmt(env, stmt)
 	case *ast.BreakStmt:
 		result = i.execBreakStmt()
 	case *ast.ContinueStmt:
 		result = i.execContinueStmt()
 	case *ast.ReturnStmt:
 		result = i.execReturnStmt(env, stmt)
-	case *ast.IllegalStmt, *ast.Comment, *ast.CommentedStmt, *ast.ParamDecl, *ast.MethodDecl:
+	case *ast.IllegalStmt, *ast.Comment, *ast.CommentedStmt, *ast.ParamDecl, *ast.DestructuredVarDecl, *ast.MethodDecl:
 		panic(fmt.Sprintf("unexpected statement type: %T", stmt))
 	}
 	return result, newEnv
 }
 
+// beforeStmt calls the statement hook and pauses execution if the hook requests it.
+func (i *Interpreter) beforeStmt(env environment, stmt ast.Stmt) {
+	if i.stmtHook == nil {
+		return
+	}
+	i.hookEnv = env
+	i.hookStmt = stmt
+	defer func() {
+		i.hookEnv = nil
+		i.hookStmt = nil
+	}()
+	if !i.stmtHook(stmt) {
+		<-i.resumeCh
+	}
+}
+
 func (i *Interpreter) execVarDecl(env environment, stmt *ast.VarDecl) environment {
 	var value loxValue
 	if stmt.Initialiser != nil {
@@ -152,6 +424,26 @@ func (i *Interpreter) execVarDecl(env environment, stmt *ast.VarDecl) environmen
 	return newEnv
 }
 
+func (i *Interpreter) execDestructuringDecl(env environment, stmt *ast.DestructuringDecl) environment {
+	value := i.evalExpr(env, stmt.Initialiser)
+	list, ok := value.(*loxList)
+	if !ok {
+		panic(loxerr.Newf(stmt.Initialiser, loxerr.Fatal, "cannot destructure %m value", value.Type()))
+	}
+	if len(*list) != len(stmt.Decls) {
+		panic(loxerr.Newf(stmt.Initialiser, loxerr.Fatal, "cannot destructure list of length %d into %d variables", len(*list), len(stmt.Decls)))
+	}
+	newEnv := env
+	for j, decl := range stmt.Decls {
+		if decl.Name.String() == token.IdentBlank {
+			continue
+		}
+		newEnv = newEnv.Declare(decl.Name)
+		newEnv.Assign(decl.Name, (*list)[j])
+	}
+	return newEnv
+}
+
 func (i *Interpreter) execFunDecl(env environment, stmt *ast.FunDecl) environment {
 	if stmt.Name.String() == token.IdentBlank {
 		return env
@@ -184,13 +476,57 @@ func (i *Interpreter) execClassDecl(env environment, stmt *ast.ClassDecl) enviro
 func (i *Interpreter) execExprStmt(env environment, stmt *ast.ExprStmt) {
 	value := i.evalExpr(env, stmt.Expr)
 	if i.replMode {
-		fmt.Println(value.String())
+		fmt.Fprintln(i.output, i.stringify(stmt.Expr, value))
 	}
 }
 
 func (i *Interpreter) execPrintStmt(env environment, stmt *ast.PrintStmt) {
 	value := i.evalExpr(env, stmt.Expr)
-	fmt.Println(value.String())
+	fmt.Fprintln(i.output, i.stringify(stmt.Expr, value))
+}
+
+// stringify returns the string representation of value. An instance whose class has a toString method is represented
+// by the string returned by calling it. If toString doesn't return a string, or is called again on the same instance
+// whilst it's already being called, then the instance's default string representation is returned instead. The elements
+// of a list and the value of a result are represented in the same way. rang is the range of the code which produced
+// value.
+func (i *Interpreter) stringify(rang token.Range, value loxValue) string {
+	str := func(value loxValue) string { return i.stringify(rang, value) }
+	var instance *loxInstance
+	switch value := value.(type) {
+	case *loxInstance:
+		instance = value
+	case *loxList:
+		return value.format(str)
+	case *loxResult:
+		return value.format(str)
+	default:
+		return value.String()
+	}
+	method, ok := toStringMethod(instance)
+	if !ok || i.stringifying[instance] {
+		return instance.String()
+	}
+	i.stringifying[instance] = true
+	defer delete(i.stringifying, instance)
+	if str, ok := i.call(rang, method.Bind(instance.thisValue), nil).(loxString); ok {
+		return str.String()
+	}
+	return instance.String()
+}
+
+// toStringMethod returns the toString method of value's class if value is an instance and the method accepts no
+// arguments.
+func toStringMethod(value loxValue) (*loxFunction, bool) {
+	instance, ok := value.(*loxInstance)
+	if !ok {
+		return nil, false
+	}
+	method, ok := instance.Class.Method(token.IdentToString)
+	if !ok || len(method.Params()) > 0 {
+		return nil, false
+	}
+	return method, true
 }
 
 func (i *Interpreter) execBlock(env environment, stmt *ast.Block) stmtResult {
@@ -255,6 +591,82 @@ func (i *Interpreter) execForStmt(env environment, stmt *ast.ForStmt) stmtResult
 	return stmtResultNone{}
 }
 
+func (i *Interpreter) execTryStmt(env environment, stmt *ast.TryStmt) stmtResult {
+	result, loxErr := i.safelyExecBlock(env, stmt.Body)
+	if loxErr == nil {
+		return result
+	}
+	childEnv := env.Child().Define(stmt.ErrorVar.Name.String(), loxString(loxErr.Msg))
+	return i.executeBlock(childEnv, stmt.CatchBody.Stmts)
+}
+
+// safelyExecBlock is like [Interpreter.safelyEvalExpr] but executes a block.
+func (i *Interpreter) safelyExecBlock(env environment, block *ast.Block) (result stmtResult, err *loxerr.Error) {
+	callStackLen := i.callStack.Len()
+	defer func() {
+		if r := recover(); r != nil {
+			if loxErr, ok := r.(*loxerr.Error); ok && !loxErr.Uncatchable {
+				err = loxErr
+				i.callStack.Truncate(callStackLen)
+			} else {
+				panic(r)
+			}
+		}
+	}()
+	return i.execBlock(env, block), nil
+}
+
+// execWithStmt executes the body of a with statement and then calls the close method of its resource. The method is
+// also called if the body exits with a catchable error, which is then propagated.
+func (i *Interpreter) execWithStmt(env environment, stmt *ast.WithStmt) stmtResult {
+	value := i.evalExpr(env, stmt.Value)
+	instance, ok := value.(*loxInstance)
+	if !ok {
+		panic(loxerr.Newf(stmt.Value, loxerr.Fatal, "%m value cannot be used as a resource", value.Type()))
+	}
+	method, ok := closeMethod(instance)
+	if !ok {
+		panic(loxerr.Newf(stmt.Value, loxerr.Fatal, "%m object has no %s() method which accepts no arguments",
+			instance.Type(), token.IdentClose))
+	}
+	defer func() {
+		r := recover()
+		if r == nil {
+			i.call(stmt.Resource, method.Bind(instance.thisValue), nil)
+			return
+		}
+		// Uncatchable errors, such as a stack overflow, stop the program without running any more code.
+		if loxErr, ok := r.(*loxerr.Error); ok && !loxErr.Uncatchable {
+			i.closeAfterError(stmt, method.Bind(instance.thisValue))
+		}
+		panic(r)
+	}()
+	childEnv := env.Child().Define(stmt.Resource.Name.String(), value)
+	return i.execBlock(childEnv, stmt.Body)
+}
+
+// closeAfterError calls the bound close method of a with statement's resource after its body has raised an error. A
+// catchable error raised by close is discarded so that it doesn't replace the body's error, which is rethrown by the
+// caller.
+func (i *Interpreter) closeAfterError(stmt *ast.WithStmt, close loxCallable) {
+	defer func() {
+		r := recover()
+		if loxErr, ok := r.(*loxerr.Error); r != nil && (!ok || loxErr.Uncatchable) {
+			panic(r)
+		}
+	}()
+	i.call(stmt.Resource, close, nil)
+}
+
+// closeMethod returns the close method of instance's class if it accepts no arguments.
+func closeMethod(instance *loxInstance) (*loxFunction, bool) {
+	method, ok := instance.Class.Method(token.IdentClose)
+	if !ok || len(method.Params()) > 0 {
+		return nil, false
+	}
+	return method, true
+}
+
 func (i *Interpreter) execBreakStmt() stmtResultBreak {
 	return stmtResultBreak{}
 }
@@ -314,11 +726,22 @@ func (i *Interpreter) evalExpr(env environment, expr ast.Expr) loxValue {
 func (i *Interpreter) evalLiteralExpr(expr *ast.LiteralExpr) loxValue {
 	switch tok := expr.Value; tok.Type {
 	case token.Number:
+		if i.bigIntegers {
+			if value, ok := new(big.Int).SetString(tok.Lexeme, 10); ok {
+				return loxBigInt{value}
+			}
+		}
 		value, err := strconv.ParseFloat(tok.Lexeme, 64)
 		if err != nil {
 			panic(fmt.Sprintf("unexpected error parsing number literal: %s", err))
 		}
 		return loxNumber(value)
+	case token.Decimal:
+		value, ok := parseDecimal(strings.TrimSuffix(tok.Lexeme, "d"))
+		if !ok {
+			panic(fmt.Sprintf("unexpected error parsing decimal literal: %s", tok.Lexeme))
+		}
+		return value
 	case token.String:
 		// Double-quoted Go strings can't contain new lines.
 		singleLineLexeme := strings.ReplaceAll(tok.Lexeme, "\n", `\n`)
@@ -364,13 +787,25 @@ func (i *Interpreter) evalThisExpr(env environment, _ *ast.ThisExpr) loxValue {
 	return env.GetByName(token.This.String())
 }
 
-func (i *Interpreter) evalSuperExpr(env environment, _ *ast.SuperExpr) loxValue {
+// evalSuperExpr evaluates a super expression by looking up the method on the superclass of the class that the enclosing
+// method was declared in, rather than the class of the instance that the method was called on, and binding it to the
+// instance.
+func (i *Interpreter) evalSuperExpr(env environment, expr *ast.SuperExpr) loxValue {
 	superValue := env.GetByName(token.Super.String())
 	superclass, ok := superValue.(*loxClass)
 	if !ok {
 		panic(fmt.Sprintf("unexpected super type: %T", superValue))
 	}
-	return newLoxSuperObject(superclass, env)
+	this := env.GetByName(token.This.String())
+	method, ok := superclass.Method(expr.Name.String())
+	if !ok {
+		static := ""
+		if superclass.IsMetaclass() {
+			static = "static "
+		}
+		panic(loxerr.Newf(expr.Name, loxerr.Fatal, "'%s' class has no %smethod %m", superclass.Name, static, expr.Name))
+	}
+	return method.Bind(this)
 }
 
 func (i *Interpreter) evalCallExpr(env environment, expr *ast.CallExpr) loxValue {
@@ -379,35 +814,87 @@ func (i *Interpreter) evalCallExpr(env environment, expr *ast.CallExpr) loxValue
 	for j, arg := range expr.Args {
 		args[j] = i.evalExpr(env, arg)
 	}
+	namedArgValues := make([]loxValue, len(expr.NamedArgs))
+	for j, namedArg := range expr.NamedArgs {
+		namedArgValues[j] = i.evalExpr(env, namedArg.Value)
+	}
 
 	callable, ok := callee.(loxCallable)
 	if !ok {
 		panic(loxerr.Newf(expr.Callee, loxerr.Fatal, "%m value is not callable", callee.Type()))
 	}
 
-	params := callable.Params()
-	if len(args) != len(params) {
-		wereWas := "were"
-		if len(args) == 1 {
-			wereWas = "was"
-		}
-		argumentSuffix := "s"
-		if len(params) == 1 {
-			argumentSuffix = ""
-		}
-		panic(loxerr.Newf(
-			expr,
-			loxerr.Fatal, "%s() accepts %d argument%s but %d %s given", callable.CallableName(), len(params), argumentSuffix, len(args), wereWas,
-		))
+	if len(expr.NamedArgs) > 0 {
+		args = bindNamedArgs(callable, expr, args, namedArgValues)
+	}
+	if err := checkArity(callable, len(args)); err != nil {
+		panic(loxerr.Newf(expr, loxerr.Fatal, "%s", err))
 	}
 
-	result := i.call(expr.Start(), callable, args)
+	result := i.call(expr, callable, args)
 	if errorMsg, ok := result.(errorMsg); ok {
 		panic(loxerr.Newf(expr, loxerr.Fatal, "%s", string(errorMsg)))
 	}
 	return result
 }
 
+// bindNamedArgs returns the arguments to call callable with, given the values of the positional and named arguments of
+// a call expression. Each named argument is placed in the position of the parameter with the same name. The argument
+// for a parameter with a default value which wasn't passed is left nil.
+func bindNamedArgs(callable loxCallable, expr *ast.CallExpr, args []loxValue, namedArgValues []loxValue) []loxValue {
+	if callable.IsVariadic() {
+		panic(loxerr.Newf(expr.NamedArgs[0], loxerr.Fatal, "%s() doesn't accept named arguments", callable.CallableName()))
+	}
+	params := callable.Params()
+	if numArgs := len(args) + len(namedArgValues); numArgs > len(params) {
+		panic(loxerr.Newf(expr, loxerr.Fatal, "%s", checkArity(callable, numArgs)))
+	}
+
+	boundArgs := make([]loxValue, len(params))
+	copy(boundArgs, args)
+	for j, namedArg := range expr.NamedArgs {
+		k := slices.Index(params, namedArg.Name.String())
+		if k == -1 {
+			panic(loxerr.Newf(namedArg.Name, loxerr.Fatal, "%s() has no parameter %m", callable.CallableName(), namedArg.Name))
+		}
+		if boundArgs[k] != nil {
+			panic(loxerr.Newf(namedArg.Name, loxerr.Fatal, "%s() was given more than one argument for parameter %m", callable.CallableName(), namedArg.Name))
+		}
+		boundArgs[k] = namedArgValues[j]
+	}
+	for k, param := range params[:callable.NumRequiredParams()] {
+		if boundArgs[k] == nil {
+			panic(loxerr.Newf(expr, loxerr.Fatal, "%s() was not given an argument for parameter '%s'", callable.CallableName(), param))
+		}
+	}
+	return boundArgs
+}
+
+// checkArity returns an error if callable can't be called with numArgs arguments.
+func checkArity(callable loxCallable, numArgs int) error {
+	params := callable.Params()
+	numRequired := callable.NumRequiredParams()
+	variadic := callable.IsVariadic()
+	if numArgs >= numRequired && (numArgs <= len(params) || variadic) {
+		return nil
+	}
+	wereWas := "were"
+	if numArgs == 1 {
+		wereWas = "was"
+	}
+	argumentSuffix := "s"
+	if len(params) == 1 && numRequired == 1 {
+		argumentSuffix = ""
+	}
+	numAccepted := strconv.Itoa(len(params))
+	if variadic {
+		numAccepted = fmt.Sprintf("at least %d", numRequired)
+	} else if numRequired < len(params) {
+		numAccepted = fmt.Sprintf("%d to %d", numRequired, len(params))
+	}
+	return fmt.Errorf("%s() accepts %s argument%s but %d %s given", callable.CallableName(), numAccepted, argumentSuffix, numArgs, wereWas)
+}
+
 func (i *Interpreter) evalIndexExpr(env environment, expr *ast.IndexExpr) loxValue {
 	subject := i.evalExpr(env, expr.Subject)
 	indexable := assertIndexable(subject, expr.Subject)
@@ -432,10 +919,36 @@ func assertIndexable(value loxValue, node ast.Node) loxIndexable {
 	return indexable
 }
 
-func (i *Interpreter) call(location token.Position, callable loxCallable, args []loxValue) loxValue {
+// call calls a callable with the given arguments. rang is the range of the code which made the call.
+func (i *Interpreter) call(rang token.Range, callable loxCallable, args []loxValue) loxValue {
+	if i.callStack.Full() {
+		panic(loxerr.NewUncatchablef(rang, "stack overflow"))
+	}
+
+	location := rang.Start()
+	if i.callTrace == nil {
+		i.callStack.Push(callable.CallableName(), location)
+		result := callable.Call(i, args)
+		i.callStack.Pop()
+		return result
+	}
+
+	argReprs := make([]string, len(args))
+	for j, arg := range args {
+		if arg == nil {
+			// The argument for a parameter with a default value wasn't passed.
+			argReprs[j] = token.IdentBlank
+			continue
+		}
+		argReprs[j] = arg.Repr()
+	}
+	call := fmt.Sprintf("%s(%s)", callable.CallableName(), strings.Join(argReprs, ", "))
+	indent := strings.Repeat("  ", i.callStack.Len())
+	fmt.Fprintf(i.callTrace, "%s> %s\n", indent, call)
 	i.callStack.Push(callable.CallableName(), location)
 	result := callable.Call(i, args)
 	i.callStack.Pop()
+	fmt.Fprintf(i.callTrace, "%s< %s = %s\n", indent, call, result.Repr())
 	return result
 }
 
@@ -495,11 +1008,17 @@ func (i *Interpreter) evalBinaryExpr(env environment, expr *ast.BinaryExpr) loxV
 	}
 
 	right := i.evalExpr(env, expr.Right)
-	switch expr.Op.Type {
-	case token.Comma:
+	if expr.Op.Type == token.Comma {
 		// The , operator evaluates both operands and returns the value of the right operand.
 		// It's behavior is independent of the types of the operands, so we can implement it here.
 		return right
+	}
+
+	if result, ok := i.callOperatorMethod(expr, left, right); ok {
+		return result
+	}
+
+	switch expr.Op.Type {
 	case token.EqualEqual:
 		return loxBool(left.Equals(right))
 	case token.BangEqual:
@@ -507,6 +1026,12 @@ func (i *Interpreter) evalBinaryExpr(env environment, expr *ast.BinaryExpr) loxV
 	default:
 	}
 
+	if expr.Op.Type == token.Plus {
+		if result, ok := i.concatenateInstance(expr, left, right); ok {
+			return result
+		}
+	}
+
 	binaryOperand, ok := left.(loxBinaryOperand)
 	if !ok {
 		panic(newInvalidBinaryOpError(expr.Op, left, right))
@@ -514,6 +1039,78 @@ func (i *Interpreter) evalBinaryExpr(env environment, expr *ast.BinaryExpr) loxV
 	return binaryOperand.BinaryOp(expr.Op, right)
 }
 
+// callOperatorMethod calls the method which overloads the operator of a binary expression if left is an instance whose
+// class declares one. The result of calling equals is negated for !=. The results of >, <= and >= are derived from the
+// result of calling less, along with == for > and <=. ok is false if there's no such method.
+func (i *Interpreter) callOperatorMethod(expr *ast.BinaryExpr, left loxValue, right loxValue) (result loxValue, ok bool) {
+	instance, ok := left.(*loxInstance)
+	if !ok {
+		return nil, false
+	}
+	name, ok := expr.Op.Type.OperatorMethodName()
+	if !ok {
+		return nil, false
+	}
+	result, ok = i.callBinaryMethod(expr, instance, name, right)
+	if !ok {
+		return nil, false
+	}
+	switch expr.Op.Type {
+	case token.EqualEqual:
+		return isTruthy(result), true
+	case token.BangEqual:
+		return !isTruthy(result), true
+	case token.Greater:
+		return !isTruthy(result) && !i.instanceEquals(expr, instance, right), true
+	case token.LessEqual:
+		return isTruthy(result) || i.instanceEquals(expr, instance, right), true
+	case token.GreaterEqual:
+		return !isTruthy(result), true
+	default:
+		return result, true
+	}
+}
+
+// callBinaryMethod calls the method of an instance with the given name with the right operand of a binary expression.
+// ok is false if the instance's class doesn't declare or inherit the method.
+func (i *Interpreter) callBinaryMethod(expr *ast.BinaryExpr, instance *loxInstance, name string, right loxValue) (result loxValue, ok bool) {
+	method, ok := instance.Class.Method(name)
+	if !ok {
+		return nil, false
+	}
+	bound := method.Bind(instance.thisValue)
+	if err := checkArity(bound, 1); err != nil {
+		panic(loxerr.Newf(expr.Op, loxerr.Fatal, "%s", err))
+	}
+	return i.call(expr, bound, []loxValue{right}), true
+}
+
+// instanceEquals reports whether an instance is equal to right in the same way as the == operator.
+func (i *Interpreter) instanceEquals(expr *ast.BinaryExpr, instance *loxInstance, right loxValue) loxBool {
+	name, _ := token.EqualEqual.OperatorMethodName()
+	if result, ok := i.callBinaryMethod(expr, instance, name, right); ok {
+		return isTruthy(result)
+	}
+	return loxBool(instance.Equals(right))
+}
+
+// concatenateInstance concatenates a string with an instance whose class has a toString method, in either order. The
+// instance is converted to a string using stringify. ok is false if the operands aren't a string and such an instance.
+func (i *Interpreter) concatenateInstance(expr *ast.BinaryExpr, left loxValue, right loxValue) (result loxValue, ok bool) {
+	leftString, leftIsString := left.(loxString)
+	rightString, rightIsString := right.(loxString)
+	_, leftHasToString := toStringMethod(left)
+	_, rightHasToString := toStringMethod(right)
+	switch {
+	case leftIsString && rightHasToString:
+		return leftString + loxString(i.stringify(expr.Right, right)), true
+	case leftHasToString && rightIsString:
+		return loxString(i.stringify(expr.Left, left)) + rightString, true
+	default:
+		return nil, false
+	}
+}
+
 func (i *Interpreter) evalTernaryExpr(env environment, expr *ast.TernaryExpr) loxValue {
 	condition := i.evalExpr(env, expr.Condition)
 	if isTruthy(condition) {
@@ -530,12 +1127,15 @@ func (i *Interpreter) evalTryExpr(env environment, expr *ast.TryExpr) loxValue {
 	return newLoxResult(true, value)
 }
 
+// safelyEvalExpr evaluates an expression, recovering from any catchable [*loxerr.Error] raised whilst doing so.
+// Uncatchable errors continue to propagate.
 func (i *Interpreter) safelyEvalExpr(env environment, expr ast.Expr) (value loxValue, err *loxerr.Error) {
+	callStackLen := i.callStack.Len()
 	defer func() {
 		if r := recover(); r != nil {
-			if loxErr, ok := r.(*loxerr.Error); ok {
+			if loxErr, ok := r.(*loxerr.Error); ok && !loxErr.Uncatchable {
 				err = loxErr
-				i.callStack.Clear()
+				i.callStack.Truncate(callStackLen)
 			} else {
 				panic(r)
 			}
@@ -0,0 +1,618 @@
+package interpreter_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/marcuscaisey/lox/golox/ast"
+	"github.com/marcuscaisey/lox/golox/interpreter"
+	"github.com/marcuscaisey/lox/golox/loxerr"
+	"github.com/marcuscaisey/lox/golox/parser"
+)
+
+func mustParse(t *testing.T, src string) *ast.Program {
+	t.Helper()
+	program, err := parser.Parse(strings.NewReader(src), "test.lox")
+	if err != nil {
+		t.Fatalf("parsing program: %s", err)
+	}
+	return program
+}
+
+func TestStatementHook(t *testing.T) {
+	program := mustParse(t, `var a = 1;
+fun add(x) {
+  return a + x;
+}
+var b = add(2);
+`)
+
+	var got []string
+	hook := func(stmt ast.Stmt) bool {
+		got = append(got, fmt.Sprintf("%d:%T", stmt.Start().Line, stmt))
+		return true
+	}
+	if err := interpreter.New(nil, interpreter.WithStatementHook(hook)).Execute(program); err != nil {
+		t.Fatalf("executing program: %s", err)
+	}
+
+	want := []string{
+		"1:*ast.VarDecl",
+		"2:*ast.FunDecl",
+		"5:*ast.VarDecl",
+		"3:*ast.ReturnStmt",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("statement hook called with:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestStatementHookPause(t *testing.T) {
+	program := mustParse(t, `var a = 1;
+{
+  var b = a + 1;
+  a = b;
+}
+`)
+
+	var interp *interpreter.Interpreter
+	var gotVars map[string]string
+	paused := make(chan struct{})
+	hook := func(stmt ast.Stmt) bool {
+		switch stmt.Start().Line {
+		case 3:
+			paused <- struct{}{}
+			return false
+		case 4:
+			gotVars = interp.Variables()
+		}
+		return true
+	}
+	interp = interpreter.New(nil, interpreter.WithStatementHook(hook))
+
+	done := make(chan error)
+	go func() {
+		done <- interp.Execute(program)
+	}()
+	<-paused
+	interp.Resume()
+	if err := <-done; err != nil {
+		t.Fatalf("executing program: %s", err)
+	}
+
+	wantVars := map[string]string{"argv": "[]", "a": "1", "b": "2"}
+	if fmt.Sprint(gotVars) != fmt.Sprint(wantVars) {
+		t.Errorf("Variables() = %v, want %v", gotVars, wantVars)
+	}
+}
+
+func TestCallStack(t *testing.T) {
+	program := mustParse(t, `fun inner() {
+  print 1;
+}
+fun outer() {
+  inner();
+}
+outer();
+`)
+
+	var interp *interpreter.Interpreter
+	var got []string
+	hook := func(stmt ast.Stmt) bool {
+		if _, ok := stmt.(*ast.PrintStmt); ok {
+			for _, frame := range interp.CallStack() {
+				got = append(got, fmt.Sprintf("%s %d:%d", frame.Function, frame.Position.Line, frame.Position.Column))
+			}
+		}
+		return true
+	}
+	interp = interpreter.New(nil, interpreter.WithStatementHook(hook))
+	if err := interp.Execute(program); err != nil {
+		t.Fatalf("executing program: %s", err)
+	}
+
+	want := []string{
+		"inner 2:2",
+		"outer 5:2",
+		" 7:0",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("CallStack() =\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestCallTrace(t *testing.T) {
+	program := mustParse(t, `fun fib(n) {
+  if (n < 2) {
+    return n;
+  }
+  return fib(n - 1) + fib(n - 2);
+}
+fib(3);
+`)
+
+	trace := new(strings.Builder)
+	if err := interpreter.New(nil, interpreter.WithCallTrace(trace)).Execute(program); err != nil {
+		t.Fatalf("executing program: %s", err)
+	}
+
+	want := `> fib(3)
+  > fib(2)
+    > fib(1)
+    < fib(1) = 1
+    > fib(0)
+    < fib(0) = 0
+  < fib(2) = 1
+  > fib(1)
+  < fib(1) = 1
+< fib(3) = 2
+`
+	if got := trace.String(); got != want {
+		t.Errorf("call trace:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestOutput(t *testing.T) {
+	program := mustParse(t, "print 1;")
+	out := new(bytes.Buffer)
+	if err := interpreter.New(nil, interpreter.WithOutput(out)).Execute(program); err != nil {
+		t.Fatalf("Execute() returned error: %s", err)
+	}
+
+	if got, want := out.String(), "1\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	program := mustParse(t, `
+write("a");
+printerr("error");
+write(1);
+print "";
+`)
+
+	out := new(strings.Builder)
+	errOut := new(strings.Builder)
+	if err := interpreter.New(nil, interpreter.WithOutput(out), interpreter.WithErrorOutput(errOut)).Execute(program); err != nil {
+		t.Fatalf("Execute() returned error: %s", err)
+	}
+
+	if got, want := out.String(), "a1\n"; got != want {
+		t.Errorf("stdout = %q, want %q", got, want)
+	}
+	if got, want := errOut.String(), "error\n"; got != want {
+		t.Errorf("stderr = %q, want %q", got, want)
+	}
+}
+
+func TestStaticPropertyAccessors(t *testing.T) {
+	program := mustParse(t, `class Temperature {
+  static get celsius() {
+    return Temperature._celsius;
+  }
+
+  static set celsius(value) {
+    Temperature._celsius = value;
+  }
+
+  static get fahrenheit() {
+    return Temperature._celsius * 9 / 5 + 32;
+  }
+}
+
+Temperature.celsius = 100;
+print Temperature.celsius;
+print Temperature.fahrenheit;
+Temperature.fahrenheit = 0;
+`)
+
+	out := new(strings.Builder)
+	err := interpreter.New(nil, interpreter.WithOutput(out)).Execute(program)
+
+	if got, want := out.String(), "100\n212\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+	var loxErr *loxerr.Error
+	if !errors.As(err, &loxErr) {
+		t.Fatalf("Execute() returned error %v, want *loxerr.Error", err)
+	}
+	if got, want := loxErr.Msg, "property 'fahrenheit' of 'class' object is read-only"; got != want {
+		t.Errorf("Execute() returned error with message %q, want %q", got, want)
+	}
+}
+
+func TestReadLine(t *testing.T) {
+	program := mustParse(t, `
+print readLine();
+print readLine();
+print readLine() == nil;
+`)
+	in := bytes.NewReader([]byte("first line\nsecond line\n"))
+	out := new(strings.Builder)
+	if err := interpreter.New(nil, interpreter.WithInput(in), interpreter.WithOutput(out)).Execute(program); err != nil {
+		t.Fatalf("Execute() returned error: %s", err)
+	}
+
+	if got, want := out.String(), "first line\nsecond line\ntrue\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestApproxSize(t *testing.T) {
+	program := mustParse(t, `
+var small = [1];
+var large = [1, 2, 3, 4, 5];
+print approxSize(large) > approxSize(small);
+
+var cyclic = [];
+cyclic.push(cyclic);
+print approxSize(cyclic) > 0;
+`)
+	out := new(strings.Builder)
+	if err := interpreter.New(nil, interpreter.WithDebugBuiltins(true), interpreter.WithOutput(out)).Execute(program); err != nil {
+		t.Fatalf("Execute() returned error: %s", err)
+	}
+
+	if got, want := out.String(), "true\ntrue\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestFieldNames(t *testing.T) {
+	program := mustParse(t, `
+class Foo {}
+var foo = Foo();
+foo.zebra = 1;
+foo.apple = 2;
+foo.mango = 3;
+foo.banana = 4;
+foo.kiwi = 5;
+foo.cherry = 6;
+foo.apple = 7;
+print fieldNames(foo);
+print fieldNames(clone(foo));
+`)
+	out := new(strings.Builder)
+	if err := interpreter.New(nil, interpreter.WithDebugBuiltins(true), interpreter.WithOutput(out)).Execute(program); err != nil {
+		t.Fatalf("Execute() returned error: %s", err)
+	}
+
+	want := "[zebra, apple, mango, banana, kiwi, cherry]\n[zebra, apple, mango, banana, kiwi, cherry]\n"
+	if got := out.String(); got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestTryUncatchableError(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		wantOut string
+		wantErr string
+	}{
+		{
+			name: "user error caught",
+			src: `fun fail() {
+  error("boom");
+}
+print try fail();
+`,
+			wantOut: "result(ok=false, value=boom)\n",
+		},
+		{
+			name: "error caught inside function",
+			src: `fun f() {
+  return try 1 / 0;
+}
+print f();
+`,
+			wantOut: "result(ok=false, value=cannot divide by 0)\n",
+		},
+		{
+			name: "stack overflow not caught",
+			src: `fun recurse() {
+  recurse();
+}
+print try recurse();
+print "unreachable";
+`,
+			wantErr: "stack overflow",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			program := mustParse(t, test.src)
+			out := new(strings.Builder)
+			err := interpreter.New(nil, interpreter.WithOutput(out)).Execute(program)
+
+			if test.wantErr == "" && err != nil {
+				t.Fatalf("Execute() returned error: %s", err)
+			}
+			if test.wantErr != "" && (err == nil || !strings.Contains(err.Error(), test.wantErr)) {
+				t.Fatalf("Execute() returned error %v, want error containing %q", err, test.wantErr)
+			}
+			if got := out.String(); got != test.wantOut {
+				t.Errorf("output = %q, want %q", got, test.wantOut)
+			}
+		})
+	}
+}
+
+func TestMaxCallDepth(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{
+			name: "recursive function",
+			src: `fun f() {
+  f();
+}
+f();
+`,
+		},
+		{
+			name: "recursive getter",
+			src: `class A {
+  get x() {
+    return this.x;
+  }
+}
+A().x;
+`,
+		},
+		{
+			name: "recursive callback",
+			src: `fun f(x) {
+  return map([x], f);
+}
+f(1);
+`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			program := mustParse(t, test.src)
+			err := interpreter.New(nil, interpreter.WithMaxCallDepth(50)).Execute(program)
+
+			var loxErr *loxerr.Error
+			if !errors.As(err, &loxErr) || loxErr.Msg != "stack overflow" {
+				t.Fatalf("Execute() returned error %v, want stack overflow error", err)
+			}
+			if !strings.Contains(err.Error(), "more calls") {
+				t.Errorf("Execute() returned error without partial stack trace:\n%s", err)
+			}
+		})
+	}
+}
+
+func TestStackTrace(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "depth 2",
+			src: `fun inner() {
+  return 1 + nil;
+}
+
+fun outer() {
+    var x = inner();
+}
+
+outer();
+`,
+			want: `test.lox:2:12: error: '+' operator cannot be used with types 'number' and 'nil'
+  return 1 + nil;
+           ~
+
+Stack Trace (most recent call first):
+  2:12 in inner return 1 + nil;
+                         ^
+  6:13 in outer var x = inner();
+                        ^
+  9:1           outer();
+                ^`,
+		},
+		{
+			name: "depth 3",
+			src: `fun c() {
+  return 1 + nil;
+}
+
+fun b() {
+  return c();
+}
+
+fun a() {
+  return b();
+}
+
+a();
+`,
+			want: `test.lox:2:12: error: '+' operator cannot be used with types 'number' and 'nil'
+  return 1 + nil;
+           ~
+
+Stack Trace (most recent call first):
+  2:12  in c return 1 + nil;
+                      ^
+  6:10  in b return c();
+                    ^
+  10:10 in a return b();
+                    ^
+  13:1       a();
+             ^`,
+		},
+		{
+			name: "tail recursion",
+			src: `fun countdown(n) {
+  if (n == 0) {
+    return nil + 1;
+  }
+  return countdown(n - 1);
+}
+
+countdown(2);
+`,
+			want: `test.lox:3:16: error: '+' operator cannot be used with types 'nil' and 'number'
+    return nil + 1;
+               ~
+
+Stack Trace (most recent call first):
+  3:16 in countdown return nil + 1;
+                               ^
+  5:10 in countdown return countdown(n - 1);
+                           ^
+  5:10 in countdown return countdown(n - 1);
+                           ^
+  8:1               countdown(2);
+                    ^`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			program := mustParse(t, test.src)
+
+			err := interpreter.New(nil).Execute(program)
+
+			if err == nil {
+				t.Fatal("Execute() returned no error")
+			}
+			if got := err.Error(); got != test.want {
+				t.Errorf("Execute() returned error:\n%s\nwant:\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestWithBuiltins(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []interpreter.Option
+		src     string
+		wantOut string
+		wantErr string
+	}{
+		{
+			name:    "safe built-in available when sandboxed",
+			opts:    []interpreter.Option{interpreter.WithBuiltins(interpreter.SafeBuiltins()...)},
+			src:     `print type(1);`,
+			wantOut: "number\n",
+		},
+		{
+			name:    "unsafe built-in unavailable when sandboxed",
+			opts:    []interpreter.Option{interpreter.WithBuiltins(interpreter.SafeBuiltins()...)},
+			src:     `exit(1);`,
+			wantErr: "'exit' has not been declared",
+		},
+		{
+			name:    "built-in not in allowlist unavailable",
+			opts:    []interpreter.Option{interpreter.WithBuiltins("clock")},
+			src:     `print type(1);`,
+			wantErr: "'type' has not been declared",
+		},
+		{
+			name:    "debug built-in not in allowlist unavailable",
+			opts:    []interpreter.Option{interpreter.WithDebugBuiltins(true), interpreter.WithBuiltins("type")},
+			src:     `print approxSize(1);`,
+			wantErr: "'approxSize' has not been declared",
+		},
+		{
+			name:    "argv always available",
+			opts:    []interpreter.Option{interpreter.WithBuiltins()},
+			src:     `print argv;`,
+			wantOut: "[]\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			program := mustParse(t, test.src)
+			out := new(strings.Builder)
+			opts := append(test.opts, interpreter.WithOutput(out))
+			err := interpreter.New(nil, opts...).Execute(program)
+
+			if test.wantErr == "" && err != nil {
+				t.Fatalf("Execute() returned error: %s", err)
+			}
+			if test.wantErr != "" && (err == nil || !strings.Contains(err.Error(), test.wantErr)) {
+				t.Fatalf("Execute() returned error %v, want error containing %q", err, test.wantErr)
+			}
+			if got := out.String(); got != test.wantOut {
+				t.Errorf("output = %q, want %q", got, test.wantOut)
+			}
+		})
+	}
+}
+
+func TestBigIntegers(t *testing.T) {
+	program := mustParse(t, `
+print 9007199254740993 + 2;
+print 123456789012345678901234567890 * 1000000000000000000000;
+print -9007199254740993 - 9007199254740993;
+print 100000000000000000007 % 10;
+print 2 ** 100;
+print 2 ** -1;
+print 1 << 100;
+print 123456789012345678901234567890 & 255;
+print 9007199254740993 > 9007199254740992;
+print 9007199254740993 == 9007199254740993;
+print 7 / 2;
+print 1 + 0.5;
+print 2 == 2.0;
+`)
+	out := new(strings.Builder)
+	if err := interpreter.New(nil, interpreter.WithBigIntegers(true), interpreter.WithOutput(out)).Execute(program); err != nil {
+		t.Fatalf("Execute() returned error: %s", err)
+	}
+
+	want := `9007199254740995
+123456789012345678901234567890000000000000000000000
+-18014398509481986
+7
+1267650600228229401496703205376
+0.5
+1267650600228229401496703205376
+210
+true
+true
+3.5
+1.5
+true
+`
+	if got := out.String(); got != want {
+		t.Errorf("output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestAssert(t *testing.T) {
+	program := mustParse(t, `fun check(x) {
+  assert(x > 0, "x must be positive");
+}
+check(1);
+check(-1);
+`)
+
+	err := interpreter.New(nil).Execute(program)
+
+	var loxErr *loxerr.Error
+	if !errors.As(err, &loxErr) {
+		t.Fatalf("Execute() returned error %v, want *loxerr.Error", err)
+	}
+	if got, want := loxErr.Msg, "x must be positive"; got != want {
+		t.Errorf("error message = %q, want %q", got, want)
+	}
+	if got, want := loxErr.Start().String(), "2:3"; got != want {
+		t.Errorf("error start = %s, want %s", got, want)
+	}
+	if got, want := loxErr.End().String(), "2:38"; got != want {
+		t.Errorf("error end = %s, want %s", got, want)
+	}
+}
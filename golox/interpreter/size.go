@@ -0,0 +1,64 @@
+package interpreter
+
+// Rough costs in bytes of the parts of values. These are based on the sizes of the Go values which back them on a
+// 64-bit platform.
+const (
+	valueHeaderSize    = 16 // Interface value which holds every value
+	stringHeaderSize   = 16
+	sliceHeaderSize    = 24
+	bigIntHeaderSize   = 32
+	mapOverheadSize    = 48
+	mapEntryOverhead   = 16
+	opaqueValueSize    = 64 // Functions, classes, and other values whose contents aren't inspected
+	numberPayloadSize  = 8
+	boolPayloadSize    = 1
+	resultPayloadSize  = 16
+	pointerPayloadSize = 8
+)
+
+// approxSize returns an estimate of the number of bytes of memory used by value, including the values that it refers to.
+// Values which are referred to more than once, including by themselves, are only counted once.
+func approxSize(value loxValue) int {
+	return approxSizeOf(value, map[loxValue]bool{})
+}
+
+func approxSizeOf(value loxValue, seen map[loxValue]bool) int {
+	size := valueHeaderSize
+	switch value := value.(type) {
+	case *loxList, *loxInstance, *loxResult:
+		if seen[value] {
+			return size
+		}
+		seen[value] = true
+	default:
+	}
+
+	switch value := value.(type) {
+	case loxNumber:
+		size += numberPayloadSize
+	case loxBigInt:
+		size += pointerPayloadSize + bigIntHeaderSize + len(value.value.Bits())*numberPayloadSize
+	case loxDecimal:
+		size += pointerPayloadSize + bigIntHeaderSize + len(value.unscaled.Bits())*numberPayloadSize + numberPayloadSize
+	case loxBool:
+		size += boolPayloadSize
+	case loxNil:
+	case loxString:
+		size += stringHeaderSize + len(value)
+	case *loxList:
+		size += pointerPayloadSize + sliceHeaderSize
+		for _, element := range *value {
+			size += approxSizeOf(element, seen)
+		}
+	case *loxInstance:
+		size += pointerPayloadSize + mapOverheadSize
+		for name, fieldValue := range value.Fields() {
+			size += mapEntryOverhead + stringHeaderSize + len(name) + approxSizeOf(fieldValue, seen)
+		}
+	case *loxResult:
+		size += resultPayloadSize + approxSizeOf(value.value, seen)
+	default:
+		size += opaqueValueSize
+	}
+	return size
+}
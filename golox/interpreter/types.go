@@ -2,10 +2,13 @@ package interpreter
 
 import (
 	"fmt"
+	"iter"
 	"math"
+	"math/big"
 	"slices"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/marcuscaisey/lox/golox/ast"
 	"github.com/marcuscaisey/lox/golox/loxerr"
@@ -17,6 +20,7 @@ type loxType string
 
 const (
 	loxTypeNumber   loxType = "number"
+	loxTypeDecimal  loxType = "decimal"
 	loxTypeString   loxType = "string"
 	loxTypeBool     loxType = "bool"
 	loxTypeNil      loxType = "nil"
@@ -67,6 +71,10 @@ type loxTruther interface {
 type loxCallable interface {
 	CallableName() string
 	Params() []string
+	// NumRequiredParams returns the number of Params which must be passed an argument. The rest have default values.
+	NumRequiredParams() int
+	// IsVariadic reports whether any number of arguments can be passed after those corresponding to Params.
+	IsVariadic() bool
 	Call(interpreter *Interpreter, args []loxValue) loxValue
 }
 
@@ -83,6 +91,10 @@ type loxPropertySettable interface {
 	SetProperty(interpreter *Interpreter, name *ast.Ident, value loxValue)
 }
 
+type loxLennable interface {
+	Len() int
+}
+
 type loxNumber float64
 
 var (
@@ -104,8 +116,14 @@ func (l loxNumber) Type() loxType {
 }
 
 func (l loxNumber) Equals(other loxValue) bool {
-	otherNumber, ok := other.(loxNumber)
-	return ok && l == otherNumber
+	switch other := other.(type) {
+	case loxNumber:
+		return l == other
+	case loxBigInt:
+		return other.Equals(l)
+	default:
+		return false
+	}
 }
 
 func (l loxNumber) UnaryOp(op token.Token) loxValue {
@@ -118,10 +136,15 @@ func (l loxNumber) UnaryOp(op token.Token) loxValue {
 func (l loxNumber) BinaryOp(op token.Token, right loxValue) loxValue {
 rightSwitch:
 	switch right := right.(type) {
+	case loxBigInt:
+		return l.BinaryOp(op, right.Float())
+
 	case loxNumber:
 		switch op.Type {
 		case token.Asterisk:
 			return l * right
+		case token.AsteriskAsterisk:
+			return loxNumber(math.Pow(float64(l), float64(right)))
 		case token.Slash:
 			if right == 0 {
 				panic(loxerr.Newf(op, loxerr.Fatal, "cannot divide by 0"))
@@ -132,6 +155,8 @@ rightSwitch:
 				panic(loxerr.Newf(op, loxerr.Fatal, "cannot modulo by 0"))
 			}
 			return loxNumber(math.Mod(float64(l), float64(right)))
+		case token.Ampersand, token.Pipe, token.Caret, token.LessLess, token.GreaterGreater:
+			return integerBinaryOp(op, l, right)
 		case token.Plus:
 			return l + right
 		case token.Minus:
@@ -171,6 +196,57 @@ rightSwitch:
 	panic(newInvalidBinaryOpError(op, l, right))
 }
 
+// integerBinaryOp evaluates a bitwise operation. Its operands must be integers which can be represented by an int64.
+func integerBinaryOp(op token.Token, left, right loxNumber) loxNumber {
+	x := numberToInt64(op, left)
+	y := numberToInt64(op, right)
+	switch op.Type {
+	case token.Ampersand:
+		return loxNumber(x & y)
+	case token.Pipe:
+		return loxNumber(x | y)
+	case token.Caret:
+		return loxNumber(x ^ y)
+	case token.LessLess:
+		checkShiftCount(op, y)
+		result := x << y
+		if result>>y != x {
+			panic(loxerr.Newf(op, loxerr.Fatal, "result of %d << %d does not fit in a 64-bit integer", x, y))
+		}
+		return loxNumber(result)
+	case token.GreaterGreater:
+		checkShiftCount(op, y)
+		return loxNumber(x >> y)
+	default:
+		panic(fmt.Sprintf("unexpected bitwise operator: %s", op.Type))
+	}
+}
+
+func numberToInt64(op token.Token, n loxNumber) int64 {
+	if math.Floor(float64(n)) != float64(n) {
+		panic(loxerr.Newf(op, loxerr.Fatal, "%m operator cannot be used with non-integer %s", op.Type, n))
+	}
+	// -2^63 and 2^63 are exactly representable as float64s, unlike math.MaxInt64.
+	if n < math.MinInt64 || n >= -math.MinInt64 {
+		panic(loxerr.Newf(op, loxerr.Fatal, "%m operator cannot be used with %s as it does not fit in a 64-bit integer", op.Type, n))
+	}
+	return int64(n)
+}
+
+// checkShiftCount panics if n can't be used to shift a 64-bit integer.
+func checkShiftCount(op token.Token, n int64) {
+	if n < 0 {
+		panic(newNegativeShiftError(op))
+	}
+	if n >= 64 {
+		panic(loxerr.Newf(op, loxerr.Fatal, "cannot shift a 64-bit integer by %d", n))
+	}
+}
+
+func newNegativeShiftError(op token.Token) error {
+	return loxerr.Newf(op, loxerr.Fatal, "cannot shift by negative %m", loxTypeNumber)
+}
+
 func numberTimesString(n loxNumber, op token.Token, s loxString) loxString {
 	if math.Floor(float64(n)) != float64(n) {
 		panic(loxerr.Newf(op, loxerr.Fatal, "cannot multiply %m by non-integer %m", loxTypeString, loxTypeNumber))
@@ -192,11 +268,289 @@ func numberTimesList(n loxNumber, op token.Token, l *loxList) *loxList {
 	return &result
 }
 
+// loxBigInt is an arbitrary-precision integer. It's used to represent integers when big integers have been enabled and
+// has the same type as loxNumber. Operations which can't produce an exact integer result produce a loxNumber instead.
+// The underlying big.Int must not be modified.
+type loxBigInt struct {
+	value *big.Int
+}
+
+var (
+	_ loxValue         = loxBigInt{}
+	_ loxUnaryOperand  = loxBigInt{}
+	_ loxBinaryOperand = loxBigInt{}
+)
+
+func (b loxBigInt) String() string {
+	return b.value.String()
+}
+
+func (b loxBigInt) Repr() string {
+	return b.String()
+}
+
+func (b loxBigInt) Type() loxType {
+	return loxTypeNumber
+}
+
+func (b loxBigInt) Equals(other loxValue) bool {
+	switch other := other.(type) {
+	case loxBigInt:
+		return b.value.Cmp(other.value) == 0
+	case loxNumber:
+		if math.IsNaN(float64(other)) {
+			return false
+		}
+		return new(big.Float).SetInt(b.value).Cmp(big.NewFloat(float64(other))) == 0
+	default:
+		return false
+	}
+}
+
+// Float returns the closest loxNumber to b.
+func (b loxBigInt) Float() loxNumber {
+	f, _ := new(big.Float).SetInt(b.value).Float64()
+	return loxNumber(f)
+}
+
+func (b loxBigInt) UnaryOp(op token.Token) loxValue {
+	if op.Type == token.Minus {
+		return loxBigInt{new(big.Int).Neg(b.value)}
+	}
+	panic(newInvalidUnaryOpError(op, b))
+}
+
+func (b loxBigInt) BinaryOp(op token.Token, right loxValue) loxValue {
+	rightBigInt, ok := right.(loxBigInt)
+	if !ok {
+		return b.Float().BinaryOp(op, right)
+	}
+	switch op.Type {
+	case token.Asterisk:
+		return loxBigInt{new(big.Int).Mul(b.value, rightBigInt.value)}
+	case token.AsteriskAsterisk:
+		if rightBigInt.value.Sign() < 0 {
+			// A negative power of an integer isn't generally an integer, so the result is a floating point number.
+			return b.Float().BinaryOp(op, rightBigInt.Float())
+		}
+		return loxBigInt{new(big.Int).Exp(b.value, rightBigInt.value, nil)}
+	case token.Slash:
+		// Division of integers isn't generally exact, so the result is a floating point number.
+		return b.Float().BinaryOp(op, rightBigInt.Float())
+	case token.Percent:
+		if rightBigInt.value.Sign() == 0 {
+			panic(loxerr.Newf(op, loxerr.Fatal, "cannot modulo by 0"))
+		}
+		// Rem truncates towards zero, like math.Mod, so the result has the same sign as b.
+		return loxBigInt{new(big.Int).Rem(b.value, rightBigInt.value)}
+	case token.Ampersand:
+		return loxBigInt{new(big.Int).And(b.value, rightBigInt.value)}
+	case token.Pipe:
+		return loxBigInt{new(big.Int).Or(b.value, rightBigInt.value)}
+	case token.Caret:
+		return loxBigInt{new(big.Int).Xor(b.value, rightBigInt.value)}
+	case token.LessLess, token.GreaterGreater:
+		if rightBigInt.value.Sign() < 0 {
+			panic(newNegativeShiftError(op))
+		}
+		if !rightBigInt.value.IsUint64() {
+			panic(loxerr.Newf(op, loxerr.Fatal, "cannot shift by %s", rightBigInt))
+		}
+		n := uint(rightBigInt.value.Uint64())
+		if op.Type == token.LessLess {
+			return loxBigInt{new(big.Int).Lsh(b.value, n)}
+		}
+		return loxBigInt{new(big.Int).Rsh(b.value, n)}
+	case token.Plus:
+		return loxBigInt{new(big.Int).Add(b.value, rightBigInt.value)}
+	case token.Minus:
+		return loxBigInt{new(big.Int).Sub(b.value, rightBigInt.value)}
+	case token.Less:
+		return loxBool(b.value.Cmp(rightBigInt.value) < 0)
+	case token.LessEqual:
+		return loxBool(b.value.Cmp(rightBigInt.value) <= 0)
+	case token.Greater:
+		return loxBool(b.value.Cmp(rightBigInt.value) > 0)
+	case token.GreaterEqual:
+		return loxBool(b.value.Cmp(rightBigInt.value) >= 0)
+	default:
+		panic(newInvalidBinaryOpError(op, b, right))
+	}
+}
+
+// decimalDivisionScale is the minimum number of decimal places that the result of dividing two loxDecimals is rounded
+// to.
+const decimalDivisionScale = 20
+
+// loxDecimal is an exact decimal number. Its value is unscaled / 10^scale. Arithmetic with loxDecimals is exact, except
+// for division which is rounded to at least decimalDivisionScale decimal places.
+// The underlying big.Int must not be modified.
+type loxDecimal struct {
+	unscaled *big.Int
+	scale    int
+}
+
+var (
+	_ loxValue         = loxDecimal{}
+	_ loxUnaryOperand  = loxDecimal{}
+	_ loxBinaryOperand = loxDecimal{}
+)
+
+// parseDecimal parses a decimal literal without its d suffix, such as 12.34.
+func parseDecimal(s string) (loxDecimal, bool) {
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	unscaled, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	return loxDecimal{unscaled: unscaled, scale: len(fracPart)}, ok
+}
+
+func (d loxDecimal) String() string {
+	digits := new(big.Int).Abs(d.unscaled).String()
+	if d.scale > 0 {
+		if len(digits) <= d.scale {
+			digits = strings.Repeat("0", d.scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-d.scale] + "." + digits[len(digits)-d.scale:]
+	}
+	if d.unscaled.Sign() < 0 {
+		digits = "-" + digits
+	}
+	return digits
+}
+
+func (d loxDecimal) Repr() string {
+	return d.String() + "d"
+}
+
+func (d loxDecimal) Type() loxType {
+	return loxTypeDecimal
+}
+
+func (d loxDecimal) Equals(other loxValue) bool {
+	otherDecimal, ok := other.(loxDecimal)
+	if !ok {
+		return false
+	}
+	x, y, _ := alignDecimals(d, otherDecimal)
+	return x.Cmp(y) == 0
+}
+
+func (d loxDecimal) UnaryOp(op token.Token) loxValue {
+	if op.Type == token.Minus {
+		return loxDecimal{unscaled: new(big.Int).Neg(d.unscaled), scale: d.scale}
+	}
+	panic(newInvalidUnaryOpError(op, d))
+}
+
+// BinaryOp implements binary operations between loxDecimals. Operations with any other type, including loxNumber, are
+// invalid so that the precision of a decimal is never silently lost.
+func (d loxDecimal) BinaryOp(op token.Token, right loxValue) loxValue {
+	rightDecimal, ok := right.(loxDecimal)
+	if !ok {
+		panic(newInvalidBinaryOpError(op, d, right))
+	}
+	switch op.Type {
+	case token.Asterisk:
+		return loxDecimal{unscaled: new(big.Int).Mul(d.unscaled, rightDecimal.unscaled), scale: d.scale + rightDecimal.scale}
+	case token.Slash:
+		if rightDecimal.unscaled.Sign() == 0 {
+			panic(loxerr.Newf(op, loxerr.Fatal, "cannot divide by 0"))
+		}
+		return divideDecimals(d, rightDecimal)
+	case token.Percent:
+		if rightDecimal.unscaled.Sign() == 0 {
+			panic(loxerr.Newf(op, loxerr.Fatal, "cannot modulo by 0"))
+		}
+		x, y, scale := alignDecimals(d, rightDecimal)
+		return loxDecimal{unscaled: new(big.Int).Rem(x, y), scale: scale}
+	case token.Plus:
+		x, y, scale := alignDecimals(d, rightDecimal)
+		return loxDecimal{unscaled: new(big.Int).Add(x, y), scale: scale}
+	case token.Minus:
+		x, y, scale := alignDecimals(d, rightDecimal)
+		return loxDecimal{unscaled: new(big.Int).Sub(x, y), scale: scale}
+	case token.Less:
+		x, y, _ := alignDecimals(d, rightDecimal)
+		return loxBool(x.Cmp(y) < 0)
+	case token.LessEqual:
+		x, y, _ := alignDecimals(d, rightDecimal)
+		return loxBool(x.Cmp(y) <= 0)
+	case token.Greater:
+		x, y, _ := alignDecimals(d, rightDecimal)
+		return loxBool(x.Cmp(y) > 0)
+	case token.GreaterEqual:
+		x, y, _ := alignDecimals(d, rightDecimal)
+		return loxBool(x.Cmp(y) >= 0)
+	default:
+		panic(newInvalidBinaryOpError(op, d, right))
+	}
+}
+
+// alignDecimals returns the unscaled values of x and y at the larger of their scales, along with that scale.
+func alignDecimals(x, y loxDecimal) (*big.Int, *big.Int, int) {
+	scale := max(x.scale, y.scale)
+	return rescaleDecimal(x, scale), rescaleDecimal(y, scale), scale
+}
+
+// rescaleDecimal returns the unscaled value of d at the given scale, which must not be less than d.scale.
+func rescaleDecimal(d loxDecimal, scale int) *big.Int {
+	return new(big.Int).Mul(d.unscaled, pow10(scale-d.scale))
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// divideDecimals returns x / y rounded half away from zero to at least decimalDivisionScale decimal places. Trailing
+// zeros are removed from the result down to the larger of the scales of x and y. y must not be zero.
+func divideDecimals(x, y loxDecimal) loxDecimal {
+	minScale := max(x.scale, y.scale)
+	scale := max(minScale, decimalDivisionScale)
+	// x / y * 10^scale = (x.unscaled * 10^(scale - x.scale + y.scale)) / y.unscaled
+	dividend := new(big.Int).Set(x.unscaled)
+	divisor := new(big.Int).Set(y.unscaled)
+	if exp := scale - x.scale + y.scale; exp >= 0 {
+		dividend.Mul(dividend, pow10(exp))
+	} else {
+		divisor.Mul(divisor, pow10(-exp))
+	}
+	quotient, remainder := new(big.Int).QuoRem(dividend, divisor, new(big.Int))
+	if new(big.Int).Abs(new(big.Int).Lsh(remainder, 1)).Cmp(new(big.Int).Abs(divisor)) >= 0 {
+		if dividend.Sign() == divisor.Sign() {
+			quotient.Add(quotient, big.NewInt(1))
+		} else {
+			quotient.Sub(quotient, big.NewInt(1))
+		}
+	}
+	ten := big.NewInt(10)
+	for scale > minScale {
+		q, r := new(big.Int).QuoRem(quotient, ten, new(big.Int))
+		if r.Sign() != 0 {
+			break
+		}
+		quotient = q
+		scale--
+	}
+	return loxDecimal{unscaled: quotient, scale: scale}
+}
+
+// asNumber returns value as a loxNumber if it's a number.
+func asNumber(value loxValue) (loxNumber, bool) {
+	switch value := value.(type) {
+	case loxNumber:
+		return value, true
+	case loxBigInt:
+		return value.Float(), true
+	default:
+		return 0, false
+	}
+}
+
 type loxString string
 
 var (
 	_ loxValue         = loxString("")
 	_ loxBinaryOperand = loxString("")
+	_ loxLennable      = loxString("")
 )
 
 func (s loxString) String() string {
@@ -216,9 +570,17 @@ func (s loxString) Equals(other loxValue) bool {
 	return ok && s == otherString
 }
 
+// Len returns the number of characters in the string.
+func (s loxString) Len() int {
+	return utf8.RuneCountInString(string(s))
+}
+
 func (s loxString) BinaryOp(op token.Token, right loxValue) loxValue {
 rightSwitch:
 	switch right := right.(type) {
+	case loxBigInt:
+		return s.BinaryOp(op, right.Float())
+
 	case loxString:
 		switch op.Type {
 		case token.Plus:
@@ -341,23 +703,33 @@ func methodFunType(decl *ast.MethodDecl) funType {
 
 type nativeFunBody func(args []loxValue) loxValue
 
+// interpreterFunBody is the body of a built-in function which needs to call back into the interpreter, such as to call
+// a function which was passed to it.
+type interpreterFunBody func(interpreter *Interpreter, args []loxValue) loxValue
+
 type loxFunction struct {
-	name         string
-	params       []string
-	body         []ast.Stmt
-	nativeBody   nativeFunBody
-	typ          funType
-	enclosingEnv environment
+	name            string
+	params          []string
+	defaults        []ast.Expr // Default value of each parameter, or nil if it doesn't have one
+	variadic        bool
+	body            []ast.Stmt
+	nativeBody      nativeFunBody
+	interpreterBody interpreterFunBody
+	typ             funType
+	enclosingEnv    environment
 }
 
 func newLoxFunction(name string, fun *ast.Function, typ funType, closure environment) *loxFunction {
 	paramNames := make([]string, len(fun.Params))
+	defaults := make([]ast.Expr, len(fun.Params))
 	for i, param := range fun.Params {
 		paramNames[i] = param.Name.String()
+		defaults[i] = param.Default
 	}
 	f := &loxFunction{
 		name:         name,
 		params:       paramNames,
+		defaults:     defaults,
 		body:         fun.Body.Stmts,
 		typ:          typ,
 		enclosingEnv: closure,
@@ -374,6 +746,33 @@ func newBuiltinLoxFunction(name string, params []string, body nativeFunBody) *lo
 	}
 }
 
+// newVariadicBuiltinLoxFunction is like newBuiltinLoxFunction but the function accepts any number of arguments after
+// those corresponding to params.
+func newVariadicBuiltinLoxFunction(name string, params []string, body nativeFunBody) *loxFunction {
+	f := newBuiltinLoxFunction(name, params, body)
+	f.variadic = true
+	return f
+}
+
+// newInterpreterBuiltinLoxFunction is like newBuiltinLoxFunction but the function's body is also passed the
+// interpreter.
+func newInterpreterBuiltinLoxFunction(name string, params []string, body interpreterFunBody) *loxFunction {
+	return &loxFunction{
+		name:            name,
+		params:          params,
+		interpreterBody: body,
+		typ:             funTypeFunction | funTypeBuiltinFlag,
+	}
+}
+
+// newVariadicInterpreterBuiltinLoxFunction is like newInterpreterBuiltinLoxFunction but the function accepts any number
+// of arguments after those corresponding to params.
+func newVariadicInterpreterBuiltinLoxFunction(name string, params []string, body interpreterFunBody) *loxFunction {
+	f := newInterpreterBuiltinLoxFunction(name, params, body)
+	f.variadic = true
+	return f
+}
+
 func newBuiltinLoxMethod(name string, params []string, body nativeFunBody) *loxFunction {
 	return &loxFunction{
 		name:       name,
@@ -422,14 +821,38 @@ func (f *loxFunction) Params() []string {
 	return f.params
 }
 
+func (f *loxFunction) NumRequiredParams() int {
+	for i, def := range f.defaults {
+		if def != nil {
+			return i
+		}
+	}
+	return len(f.params)
+}
+
+func (f *loxFunction) IsVariadic() bool {
+	return f.variadic
+}
+
 func (f *loxFunction) Call(interpreter *Interpreter, args []loxValue) loxValue {
 	if f.nativeBody != nil {
 		return f.nativeBody(args)
 	}
+	if f.interpreterBody != nil {
+		return f.interpreterBody(interpreter, args)
+	}
 
 	childEnv := f.enclosingEnv.Child()
 	for i, param := range f.params {
-		childEnv = childEnv.Define(param, args[i])
+		var arg loxValue
+		if i < len(args) {
+			arg = args[i]
+		}
+		// Missing arguments are only allowed for parameters with default values, which are evaluated on each call.
+		if arg == nil {
+			arg = interpreter.evalExpr(f.enclosingEnv, f.defaults[i])
+		}
+		childEnv = childEnv.Define(param, arg)
 	}
 	result := interpreter.executeBlock(childEnv, f.body)
 	if f.typ.IsInit() {
@@ -441,10 +864,10 @@ func (f *loxFunction) Call(interpreter *Interpreter, args []loxValue) loxValue {
 	return loxNil{}
 }
 
-func (f *loxFunction) Bind(instance *loxInstance) *loxFunction {
+func (f *loxFunction) Bind(this loxValue) *loxFunction {
 	fCopy := *f
 	fCopyClosure := f.enclosingEnv.Child()
-	fCopy.enclosingEnv = fCopyClosure.Define(token.This.String(), instance)
+	fCopy.enclosingEnv = fCopyClosure.Define(token.This.String(), this)
 	return &fCopy
 }
 
@@ -458,14 +881,14 @@ func newPropertyAccessors(getter, setter *loxFunction) *propertyAccessors {
 }
 
 func (p *propertyAccessors) Get(interpreter *Interpreter, instance *loxInstance, name *ast.Ident) loxValue {
-	return interpreter.call(name.Start(), p.getter.Bind(instance), nil)
+	return interpreter.call(name, p.getter.Bind(instance.thisValue), nil)
 }
 
 func (p *propertyAccessors) Set(interpreter *Interpreter, instance *loxInstance, name *ast.Ident, value loxValue) {
 	if p.setter == nil {
 		panic(loxerr.Newf(name, loxerr.Fatal, "property '%s' of %m object is read-only", name.String(), instance.Type()))
 	}
-	interpreter.call(name.Start(), p.setter.Bind(instance), []loxValue{value})
+	interpreter.call(name, p.setter.Bind(instance.thisValue), []loxValue{value})
 }
 
 type loxClass struct {
@@ -530,6 +953,8 @@ func newLoxClassWithMetaclass(name string, superclass *loxClass, metaclass *loxC
 	}
 	if metaclass != nil {
 		class.metaclassInstance = newLoxInstance(metaclass, loxTypeClass)
+		// Static methods are called on the class rather than an instance of it, so this refers to the class inside them.
+		class.metaclassInstance.thisValue = class
 	}
 	return class
 }
@@ -572,6 +997,20 @@ func (c *loxClass) Params() []string {
 	return nil
 }
 
+func (c *loxClass) NumRequiredParams() int {
+	if init, ok := c.Method(token.IdentInit); ok {
+		return init.NumRequiredParams()
+	}
+	return 0
+}
+
+func (c *loxClass) IsVariadic() bool {
+	if init, ok := c.Method(token.IdentInit); ok {
+		return init.IsVariadic()
+	}
+	return false
+}
+
 func (c *loxClass) Call(interpreter *Interpreter, args []loxValue) loxValue {
 	typ := loxType(c.Name)
 	instance := newLoxInstance(c, typ)
@@ -613,69 +1052,26 @@ func (c *loxClass) IsMetaclass() bool {
 	return c.metaclassInstance == nil
 }
 
-type loxSuperObject struct {
-	superclass   *loxClass
-	enclosingEnv environment
-}
-
-func newLoxSuperObject(superclass *loxClass, enclosingEnv environment) *loxSuperObject {
-	return &loxSuperObject{
-		superclass:   superclass,
-		enclosingEnv: enclosingEnv,
-	}
-}
-
-var (
-	_ loxValue              = &loxSuperObject{}
-	_ loxPropertyAccessible = &loxSuperObject{}
-)
-
-func (s *loxSuperObject) Property(_ *Interpreter, name *ast.Ident) loxValue {
-	instanceValue := s.enclosingEnv.GetByName(token.This.String())
-	instance, ok := instanceValue.(*loxInstance)
-	if !ok {
-		panic(fmt.Sprintf("unexpected instance type: %T", instanceValue))
-	}
-	method, ok := s.superclass.Method(name.String())
-	if !ok {
-		static := ""
-		if s.superclass.IsMetaclass() {
-			static = "static "
-		}
-		panic(loxerr.Newf(name, loxerr.Fatal, "'%s' class has no %smethod %m", s.superclass.Name, static, name))
-	}
-	return method.Bind(instance)
-}
-
-func (s *loxSuperObject) String() string {
-	return s.superclass.String()
-}
-
-func (s *loxSuperObject) Repr() string {
-	return s.String()
-}
-
-func (s *loxSuperObject) Type() loxType {
-	return s.superclass.Type()
-}
-
-func (s *loxSuperObject) Equals(other loxValue) bool {
-	otherSuper, ok := other.(*loxSuperObject)
-	return ok && s == otherSuper
-}
-
 type loxInstance struct {
 	Class             *loxClass
 	typ               loxType
 	fieldValuesByName map[string]loxValue
+	// fieldNames are the names of the fields in the order that they were first assigned to, so that the fields can be
+	// enumerated deterministically.
+	fieldNames []string
+	// thisValue is the value that this refers to inside the methods of the instance. This is the instance itself unless
+	// it's the instance of a metaclass, in which case it's the class that the metaclass belongs to.
+	thisValue loxValue
 }
 
 func newLoxInstance(class *loxClass, typ loxType) *loxInstance {
-	return &loxInstance{
+	instance := &loxInstance{
 		Class:             class,
 		typ:               typ,
 		fieldValuesByName: make(map[string]loxValue),
 	}
+	instance.thisValue = instance
+	return instance
 }
 
 var (
@@ -711,7 +1107,7 @@ func (i *loxInstance) Property(interpreter *Interpreter, name *ast.Ident) loxVal
 	}
 
 	if method, ok := i.Class.Method(name.String()); ok {
-		return method.Bind(i)
+		return method.Bind(i.thisValue)
 	}
 
 	panic(loxerr.Newf(name, loxerr.Fatal, "%m object has no property %m", i.Type(), name))
@@ -723,7 +1119,26 @@ func (i *loxInstance) SetProperty(interpreter *Interpreter, name *ast.Ident, val
 		return
 	}
 
-	i.fieldValuesByName[name.String()] = value
+	i.setField(name.String(), value)
+}
+
+func (i *loxInstance) setField(name string, value loxValue) {
+	if _, ok := i.fieldValuesByName[name]; !ok {
+		i.fieldNames = append(i.fieldNames, name)
+	}
+	i.fieldValuesByName[name] = value
+}
+
+// Fields returns an iterator over the names and values of the instance's fields in the order that they were first
+// assigned to.
+func (i *loxInstance) Fields() iter.Seq2[string, loxValue] {
+	return func(yield func(string, loxValue) bool) {
+		for _, name := range i.fieldNames {
+			if !yield(name, i.fieldValuesByName[name]) {
+				return
+			}
+		}
+	}
 }
 
 type loxList []loxValue
@@ -738,13 +1153,19 @@ var (
 	_ loxBinaryOperand      = (*loxList)(nil)
 	_ loxIndexable          = (*loxList)(nil)
 	_ loxPropertyAccessible = (*loxList)(nil)
+	_ loxLennable           = (*loxList)(nil)
 )
 
 func (l *loxList) String() string {
+	return l.format(loxValue.String)
+}
+
+// format returns the string representation of the list, with each element converted to a string by str.
+func (l *loxList) format(str func(loxValue) string) string {
 	b := new(strings.Builder)
 	fmt.Fprint(b, token.LeftBrack)
 	for i, el := range *l {
-		fmt.Fprint(b, el.String())
+		fmt.Fprint(b, str(el))
 		if i < len(*l)-1 {
 			fmt.Fprint(b, token.Comma, " ")
 		}
@@ -762,18 +1183,38 @@ func (l *loxList) Type() loxType {
 }
 
 func (l *loxList) Equals(other loxValue) bool {
+	return l.equals(other, map[[2]*loxList]bool{})
+}
+
+// equals reports whether other is a list which is element-wise equal to l. compared contains the pairs of lists which
+// are already being compared, which are assumed to be equal so that comparing cyclic lists terminates.
+func (l *loxList) equals(other loxValue, compared map[[2]*loxList]bool) bool {
 	otherList, ok := other.(*loxList)
 	if !ok {
 		return false
 	}
+	pair := [2]*loxList{l, otherList}
+	if l == otherList || compared[pair] {
+		return true
+	}
+	compared[pair] = true
 	return slices.EqualFunc(*l, *otherList, func(x, y loxValue) bool {
+		if xList, ok := x.(*loxList); ok {
+			return xList.equals(y, compared)
+		}
 		return x.Equals(y)
 	})
 }
 
+func (l *loxList) Len() int {
+	return len(*l)
+}
+
 func (l *loxList) BinaryOp(op token.Token, right loxValue) loxValue {
 rightSwitch:
 	switch right := right.(type) {
+	case loxBigInt:
+		return l.BinaryOp(op, right.Float())
 	case *loxList:
 		switch op.Type {
 		case token.Plus:
@@ -807,7 +1248,7 @@ func (l *loxList) SetIndex(index loxValue, node ast.Node, value loxValue) {
 }
 
 func (l *loxList) indexInt(index loxValue, node ast.Node) int {
-	indexNumber, ok := index.(loxNumber)
+	indexNumber, ok := asNumber(index)
 	if !ok {
 		panic(loxerr.Newf(node, loxerr.Fatal, "index (%s) must be a non-negative integer", index.Repr()))
 	}
@@ -873,7 +1314,12 @@ var (
 )
 
 func (r *loxResult) String() string {
-	return fmt.Sprintf("result(ok=%s, value=%s)", r.ok, r.value)
+	return r.format(loxValue.String)
+}
+
+// format returns the string representation of the result, with its value converted to a string by str.
+func (r *loxResult) format(str func(loxValue) string) string {
+	return fmt.Sprintf("result(ok=%s, value=%s)", r.ok, str(r.value))
 }
 
 func (r *loxResult) Repr() string {
@@ -31,12 +31,27 @@ const (
 // Error describes an error that occurred during the execution of a Lox program.
 // It can describe any error which can be attributed to a range of characters in the source code.
 type Error struct {
-	Type  Type
-	Msg   string
+	Type Type
+	Msg  string
+	// Uncatchable reports whether the error can't be caught by a try expression or statement. Only [Fatal] errors can
+	// be uncatchable. Uncatchable errors describe conditions which a program shouldn't be able to recover from, such as
+	// a stack overflow.
+	Uncatchable bool
+	// Fix is an edit to the source code which resolves the error, or nil if there isn't one.
+	Fix   *Fix
 	start token.Position
 	end   token.Position
 }
 
+// Fix is an edit to the source code which resolves an [Error]. The characters from Start up to End are replaced with
+// NewText. If Start and End are the same, then NewText is inserted at Start.
+type Fix struct {
+	Description string
+	Start       token.Position
+	End         token.Position
+	NewText     string
+}
+
 // Newf creates a [*Error].
 // The error message is constructed from the given format string and arguments, as in [fmt.Sprintf].
 func Newf(rang token.Range, typ Type, format string, args ...any) error {
@@ -49,6 +64,14 @@ func NewSpanningRangesf(start, end token.Range, typ Type, message string, args .
 	return newf(start.Start(), end.End(), typ, message, args...)
 }
 
+// NewUncatchablef creates a [*Error] of type [Fatal] which can't be caught by a try expression or statement.
+// The error message is constructed from the given format string and arguments, as in [fmt.Sprintf].
+func NewUncatchablef(rang token.Range, format string, args ...any) error {
+	err := newf(rang.Start(), rang.End(), Fatal, format, args...)
+	err.(*Error).Uncatchable = true
+	return err
+}
+
 func newf(start, end token.Position, typ Type, format string, args ...any) error {
 	return &Error{
 		Type:  typ,
@@ -68,15 +91,43 @@ func (e *Error) End() token.Position {
 	return e.end
 }
 
+// Line returns the 1-based line number of the start of the range affected by the error.
+func (e *Error) Line() int {
+	return e.start.Line
+}
+
+// Column returns the 1-based column number of the start of the range affected by the error. Columns are counted in
+// terms of the display width of the characters before the start of the range.
+func (e *Error) Column() int {
+	line := e.start.File.Line(e.start.Line)
+	return runewidth.StringWidth(string(line[:e.start.Column])) + 1
+}
+
+// location returns the location of the start of the error in the form filename:line:col. The filename is omitted if
+// the error's file has no name.
+func (e *Error) location() string {
+	loc := fmt.Sprintf("%d:%d", e.Line(), e.Column())
+	if name := e.start.File.Name; name != "" {
+		loc = name + ":" + loc
+	}
+	return loc
+}
+
 // Error formats the error by displaying the error message and highlighting the range of characters in the source code
 // that the error applies to.
 //
 // For example:
 //
-//	2:7: error: unterminated string literal
+//	test.lox:2:7: error: unterminated string literal
 //	print "bar;
 //	      ~~~~~
 func (e *Error) Error() string {
+	return formatError(e, 0)
+}
+
+// formatError formats an error as described by [Error.Error]. The location of the error is right-padded to
+// locationWidth so that the messages of multiple errors can be aligned.
+func formatError(e *Error, locationWidth int) string {
 	b := new(strings.Builder)
 	buildString := func() string {
 		return strings.TrimSuffix(b.String(), "\n")
@@ -95,7 +146,12 @@ func (e *Error) Error() string {
 		typeColour = "BLUE"
 		typ = "hint"
 	}
-	ansi.Fprintf(b, "${BOLD}%m: ${%s}%s${DEFAULT}: %s${DEFAULT}${RESET_BOLD}\n", e.start, typeColour, typ, e.Msg)
+	var filename string
+	if e.start.File.Name != "" {
+		filename = e.start.File.Name + ":"
+	}
+	padding := strings.Repeat(" ", max(locationWidth-len(e.location()), 0))
+	ansi.Fprintf(b, "${BOLD}%s%m:%s ${%s}%s${DEFAULT}: %s${DEFAULT}${RESET_BOLD}\n", filename, e.start, padding, typeColour, typ, e.Msg)
 
 	lines := make([]string, e.end.Line-e.start.Line+1)
 	for i := e.start.Line; i <= e.end.Line; i++ {
@@ -162,19 +218,50 @@ func (e Errors) Sort() {
 	})
 }
 
-// Error formats the errors by concatenating their messages after sorting them by their start position.
+// Error formats the errors by concatenating their messages after sorting them by their start position. The messages
+// are aligned by padding the location of each error to the length of the longest one.
 func (e Errors) Error() string {
 	if len(e) == 0 {
 		panic("Error called on empty error list")
 	}
 	e.Sort()
+	locationWidth := 0
+	for _, err := range e {
+		locationWidth = max(locationWidth, len(err.location()))
+	}
 	msgs := make([]string, len(e))
 	for i, err := range e {
-		msgs[i] = err.Error()
+		msgs[i] = formatError(err, locationWidth)
 	}
 	return strings.Join(msgs, "\n")
 }
 
+// Filter returns the errors which have one of the given types.
+func (e Errors) Filter(types ...Type) Errors {
+	var filtered Errors
+	for _, err := range e {
+		if slices.Contains(types, err.Type) {
+			filtered = append(filtered, err)
+		}
+	}
+	return filtered
+}
+
+// Fatal returns the errors of type [Fatal].
+func (e Errors) Fatal() Errors {
+	return e.Filter(Fatal)
+}
+
+// NonFatal returns the errors which aren't of type [Fatal].
+func (e Errors) NonFatal() Errors {
+	return e.Filter(Warning, Hint)
+}
+
+// HasFatal reports whether any of the errors are of type [Fatal].
+func (e Errors) HasFatal() bool {
+	return slices.ContainsFunc(e, func(err *Error) bool { return err.Type == Fatal })
+}
+
 // Err returns the error list unchanged if its non-empty, otherwise nil.
 // This should be used to return an [Errors] from a function as an [error] so that it becomes an untyped nil if there
 // are no errors.
@@ -0,0 +1,66 @@
+package loxerr_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/marcuscaisey/lox/golox/loxerr"
+	"github.com/marcuscaisey/lox/golox/token"
+)
+
+func newErrors(types ...loxerr.Type) loxerr.Errors {
+	var errs loxerr.Errors
+	for _, typ := range types {
+		errs.Addf(token.Token{}, typ, "error of type %d", typ)
+	}
+	return errs
+}
+
+func errorTypes(errs loxerr.Errors) []loxerr.Type {
+	types := make([]loxerr.Type, len(errs))
+	for i, err := range errs {
+		types[i] = err.Type
+	}
+	return types
+}
+
+func TestErrorsFilter(t *testing.T) {
+	errs := newErrors(loxerr.Warning, loxerr.Fatal, loxerr.Hint, loxerr.Fatal, loxerr.Warning)
+
+	tests := []struct {
+		name string
+		got  loxerr.Errors
+		want []loxerr.Type
+	}{
+		{name: "Filter", got: errs.Filter(loxerr.Warning, loxerr.Hint), want: []loxerr.Type{loxerr.Warning, loxerr.Hint, loxerr.Warning}},
+		{name: "Fatal", got: errs.Fatal(), want: []loxerr.Type{loxerr.Fatal, loxerr.Fatal}},
+		{name: "NonFatal", got: errs.NonFatal(), want: []loxerr.Type{loxerr.Warning, loxerr.Hint, loxerr.Warning}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := errorTypes(test.got); !slices.Equal(got, test.want) {
+				t.Errorf("got errors of types %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestErrorsHasFatal(t *testing.T) {
+	tests := []struct {
+		name string
+		errs loxerr.Errors
+		want bool
+	}{
+		{name: "empty", errs: nil, want: false},
+		{name: "all warnings", errs: newErrors(loxerr.Warning, loxerr.Warning), want: false},
+		{name: "warnings and hints", errs: newErrors(loxerr.Warning, loxerr.Hint), want: false},
+		{name: "fatal", errs: newErrors(loxerr.Warning, loxerr.Fatal), want: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.errs.HasFatal(); got != test.want {
+				t.Errorf("HasFatal() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
@@ -7,16 +7,19 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"path"
 	"path/filepath"
 	"slices"
 	"strings"
 
-	"github.com/chzyer/readline"
-
+	"github.com/marcuscaisey/lox/golox/analyse"
 	"github.com/marcuscaisey/lox/golox/ast"
+	"github.com/marcuscaisey/lox/golox/builtins"
+	"github.com/marcuscaisey/lox/golox/coverage"
+	"github.com/marcuscaisey/lox/golox/debugger"
 	"github.com/marcuscaisey/lox/golox/interpreter"
+	"github.com/marcuscaisey/lox/golox/optimise"
 	"github.com/marcuscaisey/lox/golox/parser"
+	"github.com/marcuscaisey/lox/golox/repl"
 )
 
 func main() {
@@ -32,13 +35,22 @@ func (e usageError) Error() string {
 func cli() int {
 	flag.Usage = func() {
 		fmt.Fprintln(os.Stderr, "Usage: golox [options] [<script>] [<argument>...]")
+		fmt.Fprintln(os.Stderr, "       golox [options] test [-v] <path>...")
 		fmt.Fprintln(os.Stderr)
 		fmt.Fprintln(os.Stderr, "Options:")
 		flag.PrintDefaults()
 	}
-	program := flag.String("program", "", "Program passed in as string")
-	printAST := flag.Bool("ast", false, "Print the AST")
-	printTokens := flag.Bool("tokens", false, "Print the lexical tokens")
+	var opts options
+	flag.StringVar(&opts.program, "program", "", "Program passed in as string")
+	flag.Var(&opts.printAST, "ast", "Print the AST, with the source range of each node if -ast=pos or as JSON if -ast=json")
+	flag.BoolVar(&opts.printTokens, "tokens", false, "Print the lexical tokens")
+	flag.BoolVar(&opts.optimize, "optimize", false, "Fold constant expressions before interpreting")
+	flag.BoolVar(&opts.traceCalls, "trace-calls", false, "Print each function call and its return value to stderr")
+	flag.StringVar(&opts.coverageFile, "coverage", "", "Write an lcov report of the lines executed by the program to this file")
+	flag.BoolVar(&opts.debugBuiltins, "debug-builtins", false, "Enable the built-in functions intended for debugging, such as approxSize")
+	flag.BoolVar(&opts.bigIntegers, "big-integers", false, "Represent integers with arbitrary precision")
+	flag.IntVar(&opts.maxCallDepth, "max-call-depth", 1000, "Maximum depth of nested function calls before a stack overflow error is raised")
+	flag.BoolVar(&opts.debug, "debug", false, "Debug the program, reading debugger commands from stdin")
 	printHelp := flag.Bool("help", false, "Print this message")
 
 	flag.Parse()
@@ -48,7 +60,10 @@ func cli() int {
 		return 0
 	}
 
-	if err := golox(flag.Args(), *program, *printTokens, *printAST); err != nil {
+	if err := golox(flag.Args(), opts); err != nil {
+		if errors.Is(err, errTestsFailed) {
+			return 1
+		}
 		fmt.Fprintln(os.Stderr, err)
 		var usageErr usageError
 		if errors.As(err, &usageErr) {
@@ -62,19 +77,88 @@ func cli() int {
 	return 0
 }
 
-func golox(args []string, program string, printTokens bool, printAST bool) error {
-	if printTokens && printAST {
+// options are the options that golox is run with, which are set by command line flags.
+type options struct {
+	program       string    // Program passed in as a string, or empty if it wasn't
+	printTokens   bool      // Whether to print the lexical tokens instead of executing the program
+	printAST      astFormat // Format to print the AST in instead of executing the program
+	optimize      bool      // Whether to fold constant expressions before executing the program
+	traceCalls    bool      // Whether to print each function call and its return value to stderr
+	coverageFile  string    // File to write an lcov report of the executed lines to, or empty if it shouldn't be written
+	debugBuiltins bool      // Whether to enable the built-in functions intended for debugging
+	bigIntegers   bool      // Whether to represent integers with arbitrary precision
+	maxCallDepth  int       // Maximum depth of nested function calls
+	debug         bool      // Whether to debug the program, reading debugger commands from stdin
+}
+
+func golox(args []string, opts options) error {
+	if opts.printTokens && opts.printAST != astFormatNone {
 		return usageError("-ast and -tokens cannot be provided together")
 	}
+	if opts.program == "" && len(args) == 0 && opts.printAST != astFormatNone && opts.printAST != astFormatSExpr {
+		return usageError(fmt.Sprintf("-ast=%s cannot be used with the REPL", opts.printAST))
+	}
+	if opts.program == "" && len(args) == 0 && opts.coverageFile != "" {
+		return usageError("-coverage cannot be used with the REPL")
+	}
+	if opts.program == "" && len(args) == 0 && opts.debug {
+		return usageError("-debug cannot be used with the REPL")
+	}
+	if opts.debug && (opts.printTokens || opts.printAST != astFormatNone || opts.optimize || opts.coverageFile != "") {
+		return usageError("-debug cannot be provided with -ast, -tokens, -optimize, or -coverage")
+	}
+	if opts.optimize && opts.bigIntegers {
+		// Constant folding is performed with floating point numbers, so would lose the precision of big integers.
+		return usageError("-optimize and -big-integers cannot be provided together")
+	}
+
+	if opts.maxCallDepth < 1 {
+		return usageError("-max-call-depth must be at least 1")
+	}
 
-	if program != "" {
+	var report *coverageReport
+	interpreterOpts := []interpreter.Option{interpreter.WithMaxCallDepth(opts.maxCallDepth)}
+	if opts.traceCalls {
+		interpreterOpts = append(interpreterOpts, interpreter.WithCallTrace(os.Stderr))
+	}
+	if opts.debugBuiltins {
+		interpreterOpts = append(interpreterOpts, interpreter.WithDebugBuiltins(true))
+	}
+	if opts.bigIntegers {
+		interpreterOpts = append(interpreterOpts, interpreter.WithBigIntegers(true))
+	}
+	if opts.coverageFile != "" {
+		report = &coverageReport{profile: coverage.NewProfile(), filename: opts.coverageFile}
+		interpreterOpts = append(interpreterOpts, interpreter.WithStatementHook(report.profile.Record))
+	}
+
+	if opts.program == "" && len(args) > 0 && args[0] == "test" {
+		if opts.printTokens || opts.printAST != astFormatNone || opts.optimize || opts.coverageFile != "" || opts.debug {
+			return usageError("test cannot be used with -ast, -tokens, -optimize, -coverage, or -debug")
+		}
+		return runTests(args[1:], interpreterOpts, os.Stdout)
+	}
+
+	if opts.program != "" {
 		filename := "<string>"
 		argv := append([]string{filename}, args...)
-		return exec(filename, strings.NewReader(program), interpreter.New(argv), printTokens, printAST)
+		if opts.debug {
+			return debugProgram(filename, strings.NewReader(opts.program), argv, interpreterOpts)
+		}
+		return exec(filename, strings.NewReader(opts.program), interpreter.New(argv, interpreterOpts...), opts, report)
 	}
 
 	if len(args) == 0 {
-		return repl(printTokens, printAST)
+		r := repl.NewREPL(
+			os.Stdin,
+			os.Stdout,
+			os.Stderr,
+			repl.WithInterpreterOptions(interpreterOpts...),
+			repl.WithPrintTokens(opts.printTokens),
+			repl.WithPrintAST(opts.printAST == astFormatSExpr),
+			repl.WithOptimize(opts.optimize),
+		)
+		return r.Run()
 	}
 
 	filename := args[0]
@@ -85,61 +169,102 @@ func golox(args []string, program string, printTokens bool, printAST bool) error
 	defer f.Close()
 	argv := slices.Clone(args)
 	argv[0] = filepath.Base(argv[0])
-	return exec(filename, f, interpreter.New(argv), printTokens, printAST)
+	if opts.debug {
+		return debugProgram(filename, f, argv, interpreterOpts)
+	}
+	return exec(filename, f, interpreter.New(argv, interpreterOpts...), opts, report)
 }
 
-func exec(filename string, r io.Reader, interpreter *interpreter.Interpreter, printTokens bool, printAST bool) error {
-	program, err := parser.Parse(r, filename, parser.WithPrintTokens(printTokens))
-	if printTokens {
+// astFormat is the format that the AST is printed in by the -ast flag. -ast on its own is equivalent to -ast=sexpr.
+type astFormat string
+
+const (
+	astFormatNone  astFormat = ""
+	astFormatSExpr astFormat = "sexpr"
+	astFormatPos   astFormat = "pos"
+	astFormatJSON  astFormat = "json"
+)
+
+func (f *astFormat) String() string { return string(*f) }
+
+func (f *astFormat) Set(s string) error {
+	switch s {
+	case "true", string(astFormatSExpr):
+		*f = astFormatSExpr
+	case "false":
+		*f = astFormatNone
+	case string(astFormatPos):
+		*f = astFormatPos
+	case string(astFormatJSON):
+		*f = astFormatJSON
+	default:
+		return fmt.Errorf("must be %s, %s, or %s", astFormatSExpr, astFormatPos, astFormatJSON)
+	}
+	return nil
+}
+
+// IsBoolFlag reports that -ast can be provided without a value.
+func (f *astFormat) IsBoolFlag() bool { return true }
+
+// coverageReport is a report of the coverage of an executed program.
+type coverageReport struct {
+	profile  *coverage.Profile
+	filename string // File that the report is written to
+}
+
+func exec(filename string, r io.Reader, interpreter *interpreter.Interpreter, opts options, report *coverageReport) error {
+	program, err := parser.Parse(r, filename, parser.WithPrintTokens(opts.printTokens))
+	if opts.printTokens {
 		return err
 	}
-	if printAST {
+	if opts.optimize && err == nil {
+		// Any errors will be reported when the program is executed.
+		identBindings, _ := analyse.ResolveIdents(program, builtins.MustParseStubs("builtins.lox"))
+		program = optimise.FoldConstants(program, optimise.WithIdentBindings(identBindings))
+	}
+	switch opts.printAST {
+	case astFormatSExpr:
 		ast.Print(program)
 		return err
+	case astFormatPos:
+		ast.PrintWithPositions(program)
+		return err
+	case astFormatJSON:
+		if printErr := ast.PrintJSON(program, os.Stdout); printErr != nil {
+			return errors.Join(err, printErr)
+		}
+		return err
 	}
 	if err != nil {
 		return err
 	}
-	return interpreter.Execute(program)
-}
-
-func repl(printTokens bool, printAST bool) error {
-	cfg := &readline.Config{
-		Prompt: ">>> ",
+	execErr := interpreter.Execute(program)
+	if report != nil {
+		if err := writeCoverageReport(report, program); err != nil {
+			return errors.Join(execErr, err)
+		}
 	}
+	return execErr
+}
 
-	homeDir, err := os.UserHomeDir()
-	if err == nil {
-		cfg.HistoryFile = path.Join(homeDir, ".lox_history")
-	} else {
-		fmt.Fprintf(os.Stderr, "Can't get current user's home directory (%s). Command history will not be saved.\n", err)
+// debugProgram debugs a program, reading debugger commands from stdin.
+func debugProgram(filename string, r io.Reader, argv []string, opts []interpreter.Option) error {
+	program, err := parser.Parse(r, filename)
+	if err != nil {
+		return err
 	}
+	session := debugger.NewSession(program, argv, opts...)
+	return debugger.NewConsole(session, filename, os.Stdin, os.Stdout).Run()
+}
 
-	rl, err := readline.NewEx(cfg)
+func writeCoverageReport(report *coverageReport, program *ast.Program) error {
+	f, err := os.Create(report.filename)
 	if err != nil {
-		return fmt.Errorf("running Lox REPL: %s", err)
-	}
-	defer rl.Close()
-
-	fmt.Fprintln(os.Stderr, "Welcome to the Lox REPL. Press Ctrl-D to exit.")
-
-	argv := []string{"<repl>"}
-	interpreter := interpreter.New(argv, interpreter.WithREPLMode(true))
-	for {
-		line, err := rl.Readline()
-		if err != nil {
-			if errors.Is(err, readline.ErrInterrupt) {
-				continue
-			}
-			if errors.Is(err, io.EOF) {
-				break
-			}
-			panic(fmt.Sprintf("unexpected error from readline: %s", err))
-		}
-		if err := exec("", strings.NewReader(line), interpreter, printTokens, printAST); err != nil {
-			fmt.Fprintln(os.Stderr, err)
-		}
+		return fmt.Errorf("writing coverage report: %w", err)
 	}
-
-	return nil
+	defer f.Close()
+	if err := report.profile.WriteLCOV(f, program); err != nil {
+		return fmt.Errorf("writing coverage report: %w", err)
+	}
+	return f.Close()
 }
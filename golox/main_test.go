@@ -1,11 +1,13 @@
 package main_test
 
 import (
+	"encoding/json"
 	"errors"
 	"flag"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -91,7 +93,7 @@ func (r *runner) mustRunGolox(t *testing.T, path string, scriptArgs []string) *g
 		t.Fatal(err)
 	}
 	var errors []string
-	errorRe := regexp.MustCompile(`(?m)^\d+:\d+: error: (.+)$`)
+	errorRe := regexp.MustCompile(`(?m)^(?:.+:)?\d+:\d+: +error: (.+)$`)
 	for _, match := range errorRe.FindAllStringSubmatch(string(exitErr.Stderr), -1) {
 		errors = append(errors, match[1])
 	}
@@ -179,3 +181,115 @@ func (r *runner) mustParseScriptArguments(t *testing.T, path string, fileContent
 	}
 	return scriptArgs
 }
+
+func TestGoloxTest(t *testing.T) {
+	goloxPath := loxtest.MustBuildBinary(t, "golox")
+	dir := t.TempDir()
+	src := `var calls = 0;
+
+fun call() {
+  calls = calls + 1;
+  return calls;
+}
+
+@test
+fun testPasses() {
+  if (call() != 1) {
+    error("call() != 1");
+  }
+}
+
+@test
+fun testIsolated() {
+  if (call() != 1) {
+    error("tests are not isolated");
+  }
+}
+
+@test
+fun testFails() {
+  error("boom");
+}
+
+fun notATest() {
+  error("not a test");
+}
+
+fun fail() {
+  error("nested boom");
+}
+
+@test
+fun testFailsInCall() {
+  fail();
+}
+`
+	if err := os.WriteFile(filepath.Join(dir, "test.lox"), []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command(goloxPath, "test", "-v", dir)
+	stdout, err := cmd.Output()
+	exitErr := &exec.ExitError{}
+	if err != nil && !errors.As(err, &exitErr) {
+		t.Fatal(err)
+	}
+
+	if got, want := cmd.ProcessState.ExitCode(), 1; got != want {
+		t.Errorf("exit code = %d, want %d\nstdout:\n%s\nstderr:\n%s", got, want, stdout, exitErr.Stderr)
+	}
+	for _, want := range []*regexp.Regexp{
+		regexp.MustCompile(`(?m)^--- PASS: testPasses \(\d+\.\d+s\)$`),
+		regexp.MustCompile(`(?m)^--- PASS: testIsolated \(\d+\.\d+s\)$`),
+		regexp.MustCompile(`(?m)^--- FAIL: testFails \(\d+\.\d+s\)\n    .+test\.lox:24:3: error: boom$`),
+		regexp.MustCompile(`(?m)^--- FAIL: testFailsInCall \(\d+\.\d+s\)\n    .+test\.lox:32:3: error: nested boom$`),
+		regexp.MustCompile(`(?m)^      37:3 in testFailsInCall fail\(\);\n +\^\n(FAIL|=== RUN)`),
+		regexp.MustCompile(`(?m)^FAIL\t.+test\.lox\t\d+\.\d+s$`),
+	} {
+		if !want.Match(stdout) {
+			t.Errorf("stdout doesn't match %s:\n%s", want, stdout)
+		}
+	}
+	if strings.Contains(string(stdout), "<test>") {
+		t.Errorf("stdout contains stack frame outside of test file:\n%s", stdout)
+	}
+	if strings.Contains(string(stdout), "notATest") {
+		t.Errorf("stdout contains result of function not annotated with @test:\n%s", stdout)
+	}
+}
+
+func TestGoloxASTJSON(t *testing.T) {
+	goloxPath := loxtest.MustBuildBinary(t, "golox")
+
+	cmd := exec.Command(goloxPath, "-ast=json", "-program", "print 1 + 2;")
+	stdout, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("%s: %s", cmd, err)
+	}
+
+	var got any
+	if err := json.Unmarshal(stdout, &got); err != nil {
+		t.Fatalf("unmarshalling output: %s\nstdout:\n%s", err, stdout)
+	}
+	var want any
+	wantJSON := `{
+  "type": "Program", "start": {"line": 1, "col": 0}, "end": {"line": 1, "col": 12},
+  "children": [{
+    "type": "PrintStmt", "start": {"line": 1, "col": 0}, "end": {"line": 1, "col": 12},
+    "children": [{
+      "type": "BinaryExpr", "start": {"line": 1, "col": 6}, "end": {"line": 1, "col": 11},
+      "children": [
+        {"field": "Left", "type": "LiteralExpr", "value": "1", "start": {"line": 1, "col": 6}, "end": {"line": 1, "col": 7}},
+        {"field": "Op", "type": "Token", "value": "+", "start": {"line": 1, "col": 8}, "end": {"line": 1, "col": 9}},
+        {"field": "Right", "type": "LiteralExpr", "value": "2", "start": {"line": 1, "col": 10}, "end": {"line": 1, "col": 11}}
+      ]
+    }]
+  }]
+}`
+	if err := json.Unmarshal([]byte(wantJSON), &want); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("%s printed\n%s\nwant\n%s", cmd, stdout, wantJSON)
+	}
+}
@@ -0,0 +1,376 @@
+// Package optimise implements optimisation passes over Lox programs.
+package optimise
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/marcuscaisey/lox/golox/ast"
+	"github.com/marcuscaisey/lox/golox/token"
+)
+
+// Option can be passed to [FoldConstants] to configure its behaviour.
+type Option func(*folder)
+
+// WithIdentBindings configures identifiers which refer to constants whose initialisers are literals to be replaced
+// with those literals. identBindings is used to determine which declaration an identifier refers to. This will
+// typically be the result of [analyse.ResolveIdents].
+func WithIdentBindings(identBindings map[*ast.Ident][]ast.Binding) Option {
+	return func(f *folder) {
+		f.identBindings = identBindings
+	}
+}
+
+// FoldConstants replaces unary and binary expressions whose operands are all literals with the literal that they
+// evaluate to. The short-circuiting operators and and or are also simplified when their left operand is a literal.
+// The program is modified in place and returned.
+//
+// Expressions which would result in a runtime error, such as division by zero, are left unchanged so that the error is
+// still reported when the program is executed.
+func FoldConstants(program *ast.Program, opts ...Option) *ast.Program {
+	f := &folder{foldingConstDecls: map[*ast.VarDecl]bool{}}
+	for _, opt := range opts {
+		opt(f)
+	}
+	ast.Walk(program, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.VarDecl:
+			node.Initialiser = f.foldExpr(node.Initialiser)
+		case *ast.DestructuringDecl:
+			node.Initialiser = f.foldExpr(node.Initialiser)
+		case *ast.WithStmt:
+			node.Value = f.foldExpr(node.Value)
+		case *ast.ExprStmt:
+			node.Expr = f.foldExpr(node.Expr)
+		case *ast.PrintStmt:
+			node.Expr = f.foldExpr(node.Expr)
+		case *ast.IfStmt:
+			node.Condition = f.foldExpr(node.Condition)
+		case *ast.WhileStmt:
+			node.Condition = f.foldExpr(node.Condition)
+		case *ast.ForStmt:
+			node.Condition = f.foldExpr(node.Condition)
+			node.Update = f.foldExpr(node.Update)
+		case *ast.ReturnStmt:
+			node.Value = f.foldExpr(node.Value)
+		case *ast.ListExpr:
+			for i, element := range node.Elements {
+				node.Elements[i] = f.foldExpr(element)
+			}
+		case *ast.AssignmentExpr:
+			node.Right = f.foldExpr(node.Right)
+		case *ast.CallExpr:
+			node.Callee = f.foldExpr(node.Callee)
+			for i, arg := range node.Args {
+				node.Args[i] = f.foldExpr(arg)
+			}
+		case *ast.NamedArg:
+			node.Value = f.foldExpr(node.Value)
+		case *ast.IndexExpr:
+			node.Subject = f.foldExpr(node.Subject)
+			node.Index = f.foldExpr(node.Index)
+		case *ast.IndexSetExpr:
+			node.Subject = f.foldExpr(node.Subject)
+			node.Index = f.foldExpr(node.Index)
+			node.Value = f.foldExpr(node.Value)
+		case *ast.PropertyExpr:
+			node.Object = f.foldExpr(node.Object)
+		case *ast.PropertySetExpr:
+			node.Object = f.foldExpr(node.Object)
+			node.Value = f.foldExpr(node.Value)
+		case *ast.UnaryExpr:
+			node.Right = f.foldExpr(node.Right)
+		case *ast.BinaryExpr:
+			node.Left = f.foldExpr(node.Left)
+			node.Right = f.foldExpr(node.Right)
+		case *ast.TernaryExpr:
+			node.Condition = f.foldExpr(node.Condition)
+			node.Then = f.foldExpr(node.Then)
+			node.Else = f.foldExpr(node.Else)
+		case *ast.TryExpr:
+			node.Expr = f.foldExpr(node.Expr)
+		case *ast.GroupExpr:
+			node.Expr = f.foldExpr(node.Expr)
+		default:
+		}
+		return true
+	})
+	return program
+}
+
+type folder struct {
+	identBindings     map[*ast.Ident][]ast.Binding
+	foldingConstDecls map[*ast.VarDecl]bool
+}
+
+// foldExpr returns the literal that expr evaluates to if it can be determined without executing the program. Otherwise,
+// expr is returned.
+func (f *folder) foldExpr(expr ast.Expr) ast.Expr {
+	if expr == nil || !expr.IsValid() {
+		return expr
+	}
+	switch expr := expr.(type) {
+	case *ast.IdentExpr:
+		if literal, ok := f.constValue(expr.Ident); ok {
+			return newLiteralExpr(expr, literal.Value.Type, literal.Value.Lexeme)
+		}
+		return expr
+	case *ast.GroupExpr:
+		expr.Expr = f.foldExpr(expr.Expr)
+		if literal, ok := expr.Expr.(*ast.LiteralExpr); ok {
+			return newLiteralExpr(expr, literal.Value.Type, literal.Value.Lexeme)
+		}
+		return expr
+	case *ast.UnaryExpr:
+		expr.Right = f.foldExpr(expr.Right)
+		if folded, ok := foldUnaryExpr(expr); ok {
+			return folded
+		}
+		return expr
+	case *ast.BinaryExpr:
+		expr.Left = f.foldExpr(expr.Left)
+		expr.Right = f.foldExpr(expr.Right)
+		if folded, ok := foldBinaryExpr(expr); ok {
+			return folded
+		}
+		return expr
+	default:
+		return expr
+	}
+}
+
+// constValue returns the literal that the constant referred to by ident is initialised with, if it's initialised with
+// one.
+func (f *folder) constValue(ident *ast.Ident) (*ast.LiteralExpr, bool) {
+	bindings := f.identBindings[ident]
+	if len(bindings) != 1 {
+		return nil, false
+	}
+	decl, ok := bindings[0].(*ast.VarDecl)
+	if !ok || !decl.IsConst() || !decl.IsValid() || decl.Initialiser == nil || f.foldingConstDecls[decl] {
+		return nil, false
+	}
+	// The constant may be referred to before its declaration has been walked, so its initialiser is folded here.
+	// Folding is guarded so that a constant which refers to itself doesn't cause infinite recursion.
+	f.foldingConstDecls[decl] = true
+	decl.Initialiser = f.foldExpr(decl.Initialiser)
+	delete(f.foldingConstDecls, decl)
+	literal, ok := decl.Initialiser.(*ast.LiteralExpr)
+	return literal, ok
+}
+
+func foldUnaryExpr(expr *ast.UnaryExpr) (ast.Expr, bool) {
+	right, ok := literalValue(expr.Right)
+	if !ok {
+		return nil, false
+	}
+	switch expr.Op.Type {
+	case token.Bang:
+		return newValueExpr(expr, !isTruthy(right))
+	case token.Minus:
+		if number, ok := right.(float64); ok {
+			return newValueExpr(expr, -number)
+		}
+		return nil, false
+	default:
+		return nil, false
+	}
+}
+
+func foldBinaryExpr(expr *ast.BinaryExpr) (ast.Expr, bool) {
+	left, ok := literalValue(expr.Left)
+	if !ok {
+		return nil, false
+	}
+
+	switch expr.Op.Type {
+	case token.And:
+		if isTruthy(left) {
+			return expr.Right, true
+		}
+		return expr.Left, true
+	case token.Or:
+		if isTruthy(left) {
+			return expr.Left, true
+		}
+		return expr.Right, true
+	default:
+	}
+
+	right, ok := literalValue(expr.Right)
+	if !ok {
+		return nil, false
+	}
+
+	switch expr.Op.Type {
+	case token.Comma:
+		return expr.Right, true
+	case token.EqualEqual:
+		return newValueExpr(expr, left == right)
+	case token.BangEqual:
+		return newValueExpr(expr, left != right)
+	default:
+	}
+
+	switch left := left.(type) {
+	case float64:
+		switch right := right.(type) {
+		case float64:
+			return foldNumberBinaryExpr(expr, left, right)
+		case string:
+			if expr.Op.Type == token.Asterisk {
+				return foldNumberTimesString(expr, left, right)
+			}
+		}
+	case string:
+		switch right := right.(type) {
+		case string:
+			return foldStringBinaryExpr(expr, left, right)
+		case float64:
+			if expr.Op.Type == token.Asterisk {
+				return foldNumberTimesString(expr, right, left)
+			}
+		}
+	}
+	return nil, false
+}
+
+func foldNumberBinaryExpr(expr *ast.BinaryExpr, left, right float64) (ast.Expr, bool) {
+	switch expr.Op.Type {
+	case token.Asterisk:
+		return newValueExpr(expr, left*right)
+	case token.AsteriskAsterisk:
+		return newValueExpr(expr, math.Pow(left, right))
+	case token.Slash:
+		if right == 0 {
+			return nil, false
+		}
+		return newValueExpr(expr, left/right)
+	case token.Percent:
+		if right == 0 {
+			return nil, false
+		}
+		return newValueExpr(expr, math.Mod(left, right))
+	case token.Plus:
+		return newValueExpr(expr, left+right)
+	case token.Minus:
+		return newValueExpr(expr, left-right)
+	case token.Less:
+		return newValueExpr(expr, left < right)
+	case token.LessEqual:
+		return newValueExpr(expr, left <= right)
+	case token.Greater:
+		return newValueExpr(expr, left > right)
+	case token.GreaterEqual:
+		return newValueExpr(expr, left >= right)
+	default:
+		return nil, false
+	}
+}
+
+func foldStringBinaryExpr(expr *ast.BinaryExpr, left, right string) (ast.Expr, bool) {
+	switch expr.Op.Type {
+	case token.Plus:
+		return newValueExpr(expr, left+right)
+	case token.Less:
+		return newValueExpr(expr, left < right)
+	case token.LessEqual:
+		return newValueExpr(expr, left <= right)
+	case token.Greater:
+		return newValueExpr(expr, left > right)
+	case token.GreaterEqual:
+		return newValueExpr(expr, left >= right)
+	default:
+		return nil, false
+	}
+}
+
+// maxFoldedRepetitionLen is the maximum length of a string that a string repetition is folded into. Longer strings are
+// left to be built at runtime, where they might never be, rather than inflating the program.
+const maxFoldedRepetitionLen = 1024
+
+func foldNumberTimesString(expr *ast.BinaryExpr, n float64, s string) (ast.Expr, bool) {
+	if math.Floor(n) != n || n < 0 {
+		return nil, false
+	}
+	if n > maxFoldedRepetitionLen || len(s)*int(n) > maxFoldedRepetitionLen {
+		return nil, false
+	}
+	return newValueExpr(expr, strings.Repeat(s, int(n)))
+}
+
+// nilValue is the value of a nil literal.
+type nilValue struct{}
+
+// literalValue returns the value of expr if it's a literal. The value is one of float64, string, bool, or nilValue.
+func literalValue(expr ast.Expr) (any, bool) {
+	literal, ok := expr.(*ast.LiteralExpr)
+	if !ok {
+		return nil, false
+	}
+	switch tok := literal.Value; tok.Type {
+	case token.Number:
+		value, err := strconv.ParseFloat(tok.Lexeme, 64)
+		if err != nil {
+			return nil, false
+		}
+		return value, true
+	case token.String:
+		// Double-quoted Go strings can't contain new lines.
+		value, err := strconv.Unquote(strings.ReplaceAll(tok.Lexeme, "\n", `\n`))
+		if err != nil {
+			return nil, false
+		}
+		return value, true
+	case token.True, token.False:
+		return tok.Type == token.True, true
+	case token.Nil:
+		return nilValue{}, true
+	default:
+		return nil, false
+	}
+}
+
+func isTruthy(value any) bool {
+	switch value := value.(type) {
+	case bool:
+		return value
+	case nilValue:
+		return false
+	default:
+		return true
+	}
+}
+
+// newValueExpr returns a literal expression which evaluates to value and spans the same range as the expression that it
+// replaces.
+func newValueExpr(replaced ast.Expr, value any) (ast.Expr, bool) {
+	switch value := value.(type) {
+	case float64:
+		if math.IsInf(value, 0) || math.IsNaN(value) {
+			return nil, false
+		}
+		return newLiteralExpr(replaced, token.Number, strconv.FormatFloat(value, 'f', -1, 64)), true
+	case string:
+		return newLiteralExpr(replaced, token.String, strconv.Quote(value)), true
+	case bool:
+		if value {
+			return newLiteralExpr(replaced, token.True, token.True.String()), true
+		}
+		return newLiteralExpr(replaced, token.False, token.False.String()), true
+	default:
+		return nil, false
+	}
+}
+
+func newLiteralExpr(replaced ast.Expr, typ token.Type, lexeme string) *ast.LiteralExpr {
+	return &ast.LiteralExpr{
+		Value: token.Token{
+			StartPos: replaced.Start(),
+			EndPos:   replaced.End(),
+			Type:     typ,
+			Lexeme:   lexeme,
+		},
+	}
+}
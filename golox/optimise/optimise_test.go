@@ -0,0 +1,89 @@
+package optimise_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/marcuscaisey/lox/golox/analyse"
+	"github.com/marcuscaisey/lox/golox/ast"
+	"github.com/marcuscaisey/lox/golox/builtins"
+	"github.com/marcuscaisey/lox/golox/optimise"
+	"github.com/marcuscaisey/lox/golox/parser"
+)
+
+func TestFoldConstants(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{name: "addition", expr: `2 + 3`, want: `5`},
+		{name: "nested arithmetic", expr: `(1 + 2) * -3 / 2`, want: `-4.5`},
+		{name: "modulo", expr: `7 % 4`, want: `3`},
+		{name: "comparison", expr: `1 < 2`, want: `true`},
+		{name: "string concatenation", expr: `"hello" + " world"`, want: `"hello world"`},
+		{name: "string repetition", expr: `"ab" * 2`, want: `"abab"`},
+		{name: "huge string repetition", expr: `"abcdefgh" * 10000000000`, want: "(BinaryExpr\n  (Left \"abcdefgh\")\n  (Op *)\n  (Right 10000000000))"},
+		{name: "empty string huge repetition", expr: `"" * 100000000000000000000000`, want: "(BinaryExpr\n  (Left \"\")\n  (Op *)\n  (Right 100000000000000000000000))"},
+		{name: "equality of different types", expr: `1 == "1"`, want: `false`},
+		{name: "not", expr: `!nil`, want: `true`},
+		{name: "true and", expr: `true and x`, want: `x`},
+		{name: "false and", expr: `false and x`, want: `false`},
+		{name: "nil or", expr: `nil or x`, want: `x`},
+		{name: "non-literal operand", expr: `x + 1 + 2`, want: "(BinaryExpr\n  (Left (BinaryExpr\n    (Left x)\n    (Op +)\n    (Right 1)))\n  (Op +)\n  (Right 2))"},
+		{name: "folded call argument", expr: `f(1 + 1)`, want: "(CallExpr\n  (Callee f)\n  (Args [\n    2\n  ]\n  (NamedArgs []))"},
+		{name: "folded named call argument", expr: `f(x: 1 + 1)`, want: "(CallExpr\n  (Callee f)\n  (Args [])\n  (NamedArgs [\n    (NamedArg\n      (Name x)\n      (Value 2))\n  ])"},
+		{name: "division by zero", expr: `1 / 0`, want: "(BinaryExpr\n  (Left 1)\n  (Op /)\n  (Right 0))"},
+		{name: "invalid operand types", expr: `1 + "a"`, want: "(BinaryExpr\n  (Left 1)\n  (Op +)\n  (Right \"a\"))"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			program, err := parser.Parse(strings.NewReader(test.expr+";"), "test.lox")
+			if err != nil {
+				t.Fatalf("parsing program: %s", err)
+			}
+
+			program = optimise.FoldConstants(program)
+
+			got := ast.Sprint(program.Stmts[0].(*ast.ExprStmt).Expr)
+			if got != test.want {
+				t.Errorf("FoldConstants(%s) = %s, want %s", test.expr, got, test.want)
+			}
+		})
+	}
+}
+
+func TestFoldConstantsWithIdentBindings(t *testing.T) {
+	tests := []struct {
+		name    string
+		program string
+		want    string
+	}{
+		{name: "constant", program: `const a = 1; print a + 1;`, want: `2`},
+		{name: "constant initialised with constant expression", program: `const a = 2 * 3; print a;`, want: `6`},
+		{name: "constant declared later", program: `fun f() { print b; } const b = "b";`, want: `"b"`},
+		{name: "constant initialised with non-literal", program: `const a = clock(); print a;`, want: `a`},
+		{name: "variable", program: `var a = 1; print a;`, want: `a`},
+		{name: "shadowed constant", program: `const a = 1; { var a = 2; print a; }`, want: `a`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			program, err := parser.Parse(strings.NewReader(test.program), "test.lox")
+			if err != nil {
+				t.Fatalf("parsing program: %s", err)
+			}
+			identBindings, _ := analyse.ResolveIdents(program, builtins.MustParseStubs("builtins.lox"))
+
+			program = optimise.FoldConstants(program, optimise.WithIdentBindings(identBindings))
+
+			printStmt, ok := ast.FindLast(program, func(*ast.PrintStmt) bool { return true })
+			if !ok {
+				t.Fatalf("no print statement in program")
+			}
+			got := ast.Sprint(printStmt.Expr)
+			if got != test.want {
+				t.Errorf("FoldConstants(%s) printed %s, want %s", test.program, got, test.want)
+			}
+		})
+	}
+}
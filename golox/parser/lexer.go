@@ -3,6 +3,7 @@ package parser
 import (
 	"io"
 	"slices"
+	"strconv"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -90,6 +91,10 @@ func (l *lexer) Next() token.Token {
 		tok.Type = token.Minus
 	case l.ch == '*':
 		tok.Type = token.Asterisk
+		if l.extraFeatures && l.peek() == '*' {
+			l.next()
+			tok.Type = token.AsteriskAsterisk
+		}
 	case l.ch == '/':
 		if l.peek() == '/' {
 			tok.Type = token.Comment
@@ -102,17 +107,29 @@ func (l *lexer) Next() token.Token {
 		}
 	case l.extraFeatures && l.ch == '%':
 		tok.Type = token.Percent
+	case l.extraFeatures && l.ch == '&':
+		tok.Type = token.Ampersand
+	case l.extraFeatures && l.ch == '|':
+		tok.Type = token.Pipe
+	case l.extraFeatures && l.ch == '^':
+		tok.Type = token.Caret
 	case l.ch == '<':
 		tok.Type = token.Less
 		if l.peek() == '=' {
 			l.next()
 			tok.Type = token.LessEqual
+		} else if l.extraFeatures && l.peek() == '<' {
+			l.next()
+			tok.Type = token.LessLess
 		}
 	case l.ch == '>':
 		tok.Type = token.Greater
 		if l.peek() == '=' {
 			l.next()
 			tok.Type = token.GreaterEqual
+		} else if l.extraFeatures && l.peek() == '>' {
+			l.next()
+			tok.Type = token.GreaterGreater
 		}
 	case l.ch == '!':
 		tok.Type = token.Bang
@@ -136,6 +153,8 @@ func (l *lexer) Next() token.Token {
 		tok.Type = token.LeftBrace
 	case l.ch == '}':
 		tok.Type = token.RightBrace
+	case l.ch == '@' && l.extraFeatures:
+		tok.Type = token.At
 	case l.ch == '"':
 		lit, terminated := l.consumeString()
 		tok.EndPos = l.pos
@@ -150,13 +169,18 @@ func (l *lexer) Next() token.Token {
 	case isDigit(l.ch):
 		tok.Type = token.Number
 		tok.Lexeme = l.consumeNumber()
+		if l.extraFeatures && l.ch == 'd' && !isAlphaNumeric(l.peek()) {
+			tok.Type = token.Decimal
+			tok.Lexeme += string(l.ch)
+			l.next()
+		}
 		tok.EndPos = l.pos
 		return tok
 	case isAlpha(l.ch):
 		ident := l.consumeIdent()
 		tok.EndPos = l.pos
 		tok.Type = token.IdentType(ident)
-		if !l.extraFeatures && slices.Contains([]token.Type{token.Break, token.Continue, token.Static, token.Get, token.Set}, tok.Type) {
+		if !l.extraFeatures && slices.Contains([]token.Type{token.Const, token.Let, token.Break, token.Continue, token.Static, token.Get, token.Set, token.Catch, token.With}, tok.Type) {
 			tok.Type = token.Ident
 		}
 		tok.Lexeme = ident
@@ -242,35 +266,34 @@ func (l *lexer) consumeString() (s string, terminated bool) {
 }
 
 func (l *lexer) consumeEscapeSequence() string {
+	startPos := l.pos
 	var b strings.Builder
 	b.WriteRune('\\')
 	l.next()
 	switch l.ch {
-	case 'n', 't', '\\':
+	case 'n', 't', 'r', '\\', '"':
 		b.WriteRune(l.ch)
 		l.next()
 		return b.String()
 	case 'x':
 		b.WriteRune(l.ch)
 		l.next()
-		if isHexDigit(l.ch) {
-			b.WriteRune(l.ch)
-			l.next()
-		} else {
-			tok := l.consumeIllegalToken()
-			l.errHandler(tok, "invalid hex digit in escape sequence")
-			b.WriteString(tok.Lexeme)
+		digits, _ := l.consumeHexDigits(2)
+		b.WriteString(digits)
+		return b.String()
+	case 'u':
+		b.WriteRune(l.ch)
+		l.next()
+		digits, ok := l.consumeHexDigits(4)
+		b.WriteString(digits)
+		if !ok {
 			return b.String()
 		}
-		if isHexDigit(l.ch) {
-			b.WriteRune(l.ch)
-			l.next()
-		} else {
-			tok := l.consumeIllegalToken()
-			l.errHandler(tok, "invalid hex digit in escape sequence")
-			b.WriteString(tok.Lexeme)
+		if value, _ := strconv.ParseUint(digits, 16, 32); utf8.ValidRune(rune(value)) {
 			return b.String()
 		}
+		tok := token.Token{StartPos: startPos, EndPos: l.pos, Type: token.Illegal, Lexeme: b.String()}
+		l.errHandler(tok, "invalid Unicode code point in escape sequence")
 		return b.String()
 	default:
 		tok := l.consumeIllegalToken()
@@ -280,6 +303,23 @@ func (l *lexer) consumeEscapeSequence() string {
 	}
 }
 
+// consumeHexDigits consumes n hex digits, reporting an error and stopping at the first character which isn't one. The
+// consumed characters are returned along with whether all n were hex digits.
+func (l *lexer) consumeHexDigits(n int) (string, bool) {
+	var b strings.Builder
+	for range n {
+		if !isHexDigit(l.ch) {
+			tok := l.consumeIllegalToken()
+			l.errHandler(tok, "invalid hex digit in escape sequence")
+			b.WriteString(tok.Lexeme)
+			return b.String(), false
+		}
+		b.WriteRune(l.ch)
+		l.next()
+	}
+	return b.String(), true
+}
+
 func isHexDigit(digit rune) bool {
 	switch {
 	case '0' <= digit && digit <= '9':
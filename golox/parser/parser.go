@@ -2,6 +2,8 @@
 package parser
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"slices"
@@ -63,6 +65,27 @@ func Parse(r io.Reader, filename string, opts ...Option) (*ast.Program, error) {
 	return p.Parse()
 }
 
+// IsUnexpectedEOF reports whether every syntax error in err occurred because the end of the source code was
+// reached before a statement was complete. If so, the source code may become valid if more is appended to it.
+func IsUnexpectedEOF(err error) bool {
+	var loxErrs loxerr.Errors
+	if !errors.As(err, &loxErrs) || len(loxErrs) == 0 {
+		return false
+	}
+	for _, loxErr := range loxErrs {
+		if !isEOF(loxErr.Start()) {
+			return false
+		}
+	}
+	return true
+}
+
+func isEOF(pos token.Position) bool {
+	contents := pos.File.Contents
+	lastLineStart := bytes.LastIndexByte(contents, '\n') + 1
+	return pos.Line == bytes.Count(contents, []byte("\n"))+1 && pos.Column == len(contents)-lastLineStart
+}
+
 type parser struct {
 	parseComments bool
 	printTokens   bool
@@ -164,7 +187,7 @@ func (p *parser) sync() token.Token {
 			if p.scopeDepth > 0 {
 				return p.prevTok
 			}
-		case token.EOF, token.Print, token.Var, token.If, token.While, token.For, token.Break, token.Continue, token.Return, token.Class, token.LeftBrace:
+		case token.EOF, token.Print, token.Var, token.Const, token.Let, token.If, token.While, token.For, token.Break, token.Continue, token.Return, token.Class, token.LeftBrace:
 			return finalTok
 		default:
 		}
@@ -181,13 +204,19 @@ func (p *parser) parseDecl() (ast.Stmt, bool) {
 		stmt = p.parseComment(tok)
 	case p.scopeDepth == p.classBodyScopeDepth && p.match(token.Ident, token.Static, token.Get, token.Set):
 		stmt, ok = p.parseMethodDecl(tok)
-	case p.match(token.Var):
-		stmt, ok = p.parseVarDecl(tok)
+	case p.match(token.Var, token.Const, token.Let):
+		if p.extraFeatures && p.tok.Type == token.Ident && p.nextTok.Type == token.Comma {
+			stmt, ok = p.parseDestructuringDecl(tok)
+		} else {
+			stmt, ok = p.parseVarDecl(tok)
+		}
 	case p.tok.Type == token.Fun && p.nextTok.Type == token.Ident:
 		p.match(token.Fun)
 		stmt, ok = p.parseFunDecl(tok)
 	case p.match(token.Class):
 		stmt, ok = p.parseClassDecl(tok)
+	case p.tok.Type == token.At:
+		stmt, ok = p.parseAnnotatedDecl()
 	default:
 		stmt, ok = p.parseStmt()
 	}
@@ -231,6 +260,35 @@ func (p *parser) parseVarDecl(varTok token.Token) (*ast.VarDecl, bool) {
 	return decl, true
 }
 
+// parseDestructuringDecl parses a declaration of several variables, such as var a, b = [1, 2];. Unlike a single
+// variable declaration, the initialiser is required and can't be a comma expression, as var a, b = 1, 2; would then look
+// like it binds a to 1 and b to 2.
+func (p *parser) parseDestructuringDecl(varTok token.Token) (*ast.DestructuringDecl, bool) {
+	decl := &ast.DestructuringDecl{Var: varTok}
+	for {
+		varDecl := &ast.DestructuredVarDecl{Var: varTok}
+		var ok bool
+		if varDecl.Name, ok = p.parseIdent("expected variable name"); !ok {
+			return decl, false
+		}
+		decl.Decls = append(decl.Decls, varDecl)
+		if !p.match(token.Comma) {
+			break
+		}
+	}
+	if !p.expect(token.Equal) {
+		return decl, false
+	}
+	var ok bool
+	if decl.Initialiser, ok = p.parseAssignmentExpr(); !ok {
+		return decl, false
+	}
+	if decl.Semicolon, ok = p.expectSemicolon2(); !ok {
+		return decl, false
+	}
+	return decl, true
+}
+
 func (p *parser) parseFunDecl(funTok token.Token) (*ast.FunDecl, bool) {
 	decl := &ast.FunDecl{Fun: funTok}
 	var ok bool
@@ -308,6 +366,59 @@ func (p *parser) parseMethodDecl(firstTok token.Token) (*ast.MethodDecl, bool) {
 	return decl, true
 }
 
+func (p *parser) parseAnnotatedDecl() (ast.Stmt, bool) {
+	var annotations []*ast.Annotation
+	for tok := p.tok; p.match(token.At); tok = p.tok {
+		annotation, ok := p.parseAnnotation(tok)
+		if !ok {
+			return nil, false
+		}
+		annotations = append(annotations, annotation)
+	}
+
+	switch tok := p.tok; {
+	case p.scopeDepth == p.classBodyScopeDepth:
+		if !p.match(token.Ident, token.Static, token.Get, token.Set) {
+			p.addErrorf(p.tok, "expected method declaration after annotation")
+			return nil, false
+		}
+		decl, ok := p.parseMethodDecl(tok)
+		decl.Annotations = annotations
+		return decl, ok
+	case p.tok.Type == token.Fun && p.nextTok.Type == token.Ident:
+		p.match(token.Fun)
+		decl, ok := p.parseFunDecl(tok)
+		decl.Annotations = annotations
+		return decl, ok
+	case p.match(token.Class):
+		decl, ok := p.parseClassDecl(tok)
+		decl.Annotations = annotations
+		return decl, ok
+	default:
+		p.addErrorf(p.tok, "expected function or class declaration after annotation")
+		return nil, false
+	}
+}
+
+func (p *parser) parseAnnotation(atTok token.Token) (*ast.Annotation, bool) {
+	annotation := &ast.Annotation{At: atTok}
+	var ok bool
+	if annotation.Name, ok = p.parseIdent("expected annotation name"); !ok {
+		return annotation, false
+	}
+	if annotation.LeftParen, ok = p.match2(token.LeftParen); ok {
+		if annotation.RightParen, ok = p.match2(token.RightParen); !ok {
+			if annotation.Args, annotation.Commas, ok = p.parseArgs(); !ok {
+				return annotation, false
+			}
+			if annotation.RightParen, ok = p.expect2(token.RightParen); !ok {
+				return annotation, false
+			}
+		}
+	}
+	return annotation, true
+}
+
 func (p *parser) parseFun() (*ast.Function, bool) {
 	fun := &ast.Function{}
 	var ok bool
@@ -341,6 +452,11 @@ func (p *parser) parseParams() ([]*ast.ParamDecl, bool) {
 			return params, false
 		}
 		params = append(params, decl)
+		if p.extraFeatures && p.match(token.Equal) {
+			if decl.Default, ok = p.parseAssignmentExpr(); !ok {
+				return params, false
+			}
+		}
 		if !p.match(token.Comma) {
 			break
 		}
@@ -368,6 +484,11 @@ func (p *parser) parseStmt() (ast.Stmt, bool) {
 		stmt, ok = p.parseContinueStmt(tok)
 	case p.match(token.Return):
 		stmt, ok = p.parseReturnStmt(tok)
+	case p.extraFeatures && p.tok.Type == token.Try && p.nextTok.Type == token.LeftBrace:
+		p.next()
+		stmt, ok = p.parseTryStmt(tok)
+	case p.match(token.With):
+		stmt, ok = p.parseWithStmt(tok)
 	default:
 		var exprStmt *ast.ExprStmt
 		exprStmt, ok = p.parseExprStmt()
@@ -459,6 +580,70 @@ func (p *parser) parseIfStmt(ifTok token.Token) (*ast.IfStmt, bool) {
 	return stmt, true
 }
 
+func (p *parser) parseTryStmt(tryTok token.Token) (*ast.TryStmt, bool) {
+	stmt := &ast.TryStmt{Try: tryTok}
+	leftBrace, ok := p.expect2(token.LeftBrace)
+	if !ok {
+		return stmt, false
+	}
+	if stmt.Body, ok = p.parseBlock(leftBrace); !ok {
+		return stmt, false
+	}
+	if stmt.Catch, ok = p.expect2(token.Catch); !ok {
+		return stmt, false
+	}
+	if !p.expect(token.LeftParen) {
+		return stmt, false
+	}
+	stmt.ErrorVar = &ast.ParamDecl{}
+	if stmt.ErrorVar.Name, ok = p.parseIdent("expected error variable name"); !ok {
+		return stmt, false
+	}
+	if !p.expect(token.RightParen) {
+		return stmt, false
+	}
+	if leftBrace, ok = p.expect2(token.LeftBrace); !ok {
+		return stmt, false
+	}
+	if stmt.CatchBody, ok = p.parseBlock(leftBrace); !ok {
+		return stmt, false
+	}
+	return stmt, true
+}
+
+func (p *parser) parseWithStmt(withTok token.Token) (*ast.WithStmt, bool) {
+	stmt := &ast.WithStmt{With: withTok}
+	var ok bool
+	if !p.expect(token.LeftParen) {
+		return stmt, false
+	}
+	if stmt.Var, ok = p.match2(token.Var, token.Let); !ok {
+		p.addErrorf(p.tok, "expected %m or %m", token.Var, token.Let)
+		return stmt, false
+	}
+	stmt.Resource = &ast.ParamDecl{}
+	if stmt.Resource.Name, ok = p.parseIdent("expected resource variable name"); !ok {
+		return stmt, false
+	}
+	if !p.expect(token.Equal) {
+		return stmt, false
+	}
+	if stmt.Value, ok = p.parseExpr(); !ok {
+		return stmt, false
+	}
+	if !p.expect(token.RightParen) {
+		return stmt, false
+	}
+	leftBrace, ok := p.expect2(token.LeftBrace)
+	if !ok {
+		return stmt, false
+	}
+	if stmt.Body, ok = p.parseBlock(leftBrace); !ok {
+		return stmt, false
+	}
+	return stmt, true
+}
+
 func (p *parser) parseWhileStmt(whileTok token.Token) (*ast.WhileStmt, bool) {
 	stmt := &ast.WhileStmt{While: whileTok}
 	var ok bool
@@ -485,7 +670,7 @@ func (p *parser) parseForStmt(forTok token.Token) (*ast.ForStmt, bool) {
 		return stmt, false
 	}
 	switch tok := p.tok; {
-	case p.match(token.Var):
+	case p.match(token.Var, token.Let):
 		stmt.Initialise, ok = p.parseVarDecl(tok)
 	case p.match(token.Semicolon):
 		ok = true
@@ -591,6 +776,12 @@ func (p *parser) parseAssignmentExpr() (ast.Expr, bool) {
 			if propertySetExpr.Value, ok = p.parseAssignmentExpr(); !ok {
 				return expr, false
 			}
+		case *ast.SuperExpr:
+			propertySetExpr := &ast.PropertySetExpr{Object: &ast.SuperExpr{Super: left.Super}, Name: left.Name}
+			expr = propertySetExpr
+			if propertySetExpr.Value, ok = p.parseAssignmentExpr(); !ok {
+				return expr, false
+			}
 		default:
 			p.addErrorf(expr, "invalid assignment target")
 		}
@@ -625,7 +816,19 @@ func (p *parser) parseLogicalOrExpr() (ast.Expr, bool) {
 }
 
 func (p *parser) parseLogicalAndExpr() (ast.Expr, bool) {
-	return p.parseBinaryExpr(p.parseEqualityExpr, token.And)
+	return p.parseBinaryExpr(p.parseBitwiseOrExpr, token.And)
+}
+
+func (p *parser) parseBitwiseOrExpr() (ast.Expr, bool) {
+	return p.parseBinaryExpr(p.parseBitwiseXorExpr, token.Pipe)
+}
+
+func (p *parser) parseBitwiseXorExpr() (ast.Expr, bool) {
+	return p.parseBinaryExpr(p.parseBitwiseAndExpr, token.Caret)
+}
+
+func (p *parser) parseBitwiseAndExpr() (ast.Expr, bool) {
+	return p.parseBinaryExpr(p.parseEqualityExpr, token.Ampersand)
 }
 
 func (p *parser) parseEqualityExpr() (ast.Expr, bool) {
@@ -633,7 +836,11 @@ func (p *parser) parseEqualityExpr() (ast.Expr, bool) {
 }
 
 func (p *parser) parseRelationalExpr() (ast.Expr, bool) {
-	return p.parseBinaryExpr(p.parseAdditiveExpr, token.Less, token.LessEqual, token.Greater, token.GreaterEqual)
+	return p.parseBinaryExpr(p.parseShiftExpr, token.Less, token.LessEqual, token.Greater, token.GreaterEqual)
+}
+
+func (p *parser) parseShiftExpr() (ast.Expr, bool) {
+	return p.parseBinaryExpr(p.parseAdditiveExpr, token.LessLess, token.GreaterGreater)
 }
 
 func (p *parser) parseAdditiveExpr() (ast.Expr, bool) {
@@ -673,7 +880,25 @@ func (p *parser) parseUnaryExpr() (ast.Expr, bool) {
 		}
 		return expr, true
 	}
-	return p.parsePostfixExpr()
+	return p.parseExponentExpr()
+}
+
+// parseExponentExpr parses a right-associative exponentiation expression. Its right operand is parsed as a unary
+// expression so that an exponent can be negated without parentheses.
+func (p *parser) parseExponentExpr() (ast.Expr, bool) {
+	var expr ast.Expr
+	var ok bool
+	if expr, ok = p.parsePostfixExpr(); !ok {
+		return expr, false
+	}
+	binaryExpr := &ast.BinaryExpr{Left: expr}
+	if binaryExpr.Op, ok = p.match2(token.AsteriskAsterisk); !ok {
+		return expr, true
+	}
+	if binaryExpr.Right, ok = p.parseUnaryExpr(); !ok {
+		return binaryExpr, false
+	}
+	return binaryExpr, true
 }
 
 func (p *parser) parsePostfixExpr() (ast.Expr, bool) {
@@ -688,7 +913,7 @@ func (p *parser) parsePostfixExpr() (ast.Expr, bool) {
 			callExpr := &ast.CallExpr{Callee: expr, LeftParen: tok}
 			expr = callExpr
 			if callExpr.RightParen, ok = p.match2(token.RightParen); !ok {
-				if callExpr.Args, callExpr.Commas, ok = p.parseArgs(); !ok {
+				if !p.parseCallArgs(callExpr) {
 					return expr, false
 				}
 				if callExpr.RightParen, ok = p.expect2(token.RightParen); !ok {
@@ -732,13 +957,55 @@ func (p *parser) parseArgs() ([]ast.Expr, []token.Token, bool) {
 			break
 		}
 		commas = append(commas, comma)
+		// A trailing comma is allowed so that arguments can be split over multiple lines.
+		if p.extraFeatures && (p.tok.Type == token.RightParen || p.tok.Type == token.RightBrack) {
+			break
+		}
 	}
 	return args, commas, true
 }
 
+// parseCallArgs parses the arguments of a call expression into its Args, NamedArgs, and Commas. Named arguments, such as
+// name: "Sam", are only parsed if extra features are enabled and must follow any positional arguments.
+func (p *parser) parseCallArgs(callExpr *ast.CallExpr) bool {
+	for {
+		if p.extraFeatures && p.tok.Type == token.Ident && p.nextTok.Type == token.Colon {
+			namedArg := &ast.NamedArg{Name: &ast.Ident{Token: p.tok}}
+			p.next()
+			namedArg.Colon, _ = p.match2(token.Colon)
+			callExpr.NamedArgs = append(callExpr.NamedArgs, namedArg)
+			var ok bool
+			if namedArg.Value, ok = p.parseAssignmentExpr(); !ok {
+				return false
+			}
+		} else {
+			arg, ok := p.parseAssignmentExpr()
+			if arg != nil {
+				if len(callExpr.NamedArgs) > 0 {
+					p.addErrorf(arg, "positional argument cannot follow named argument")
+				}
+				callExpr.Args = append(callExpr.Args, arg)
+			}
+			if !ok {
+				return false
+			}
+		}
+		comma, ok := p.match2(token.Comma)
+		if !ok {
+			break
+		}
+		callExpr.Commas = append(callExpr.Commas, comma)
+		// A trailing comma is allowed so that arguments can be split over multiple lines.
+		if p.extraFeatures && p.tok.Type == token.RightParen {
+			break
+		}
+	}
+	return true
+}
+
 func (p *parser) parsePrimaryExpr() (ast.Expr, bool) {
 	switch tok := p.tok; {
-	case p.match(token.Number, token.String, token.True, token.False, token.Nil):
+	case p.match(token.Number, token.Decimal, token.String, token.True, token.False, token.Nil):
 		return &ast.LiteralExpr{Value: tok}, true
 	case p.match(token.Ident):
 		return &ast.IdentExpr{Ident: &ast.Ident{Token: tok}}, true
@@ -746,15 +1013,14 @@ func (p *parser) parsePrimaryExpr() (ast.Expr, bool) {
 		return &ast.ThisExpr{This: tok}, true
 	case p.match(token.Super):
 		superExpr := &ast.SuperExpr{Super: tok}
-		propertyExpr := &ast.PropertyExpr{Object: superExpr}
 		var ok bool
-		if propertyExpr.Dot, ok = p.expect2(token.Dot); !ok {
+		if superExpr.Dot, ok = p.expect2(token.Dot); !ok {
 			return superExpr, false
 		}
-		if propertyExpr.Name, ok = p.parseIdent("expected property name"); !ok {
-			return propertyExpr, false
+		if superExpr.Name, ok = p.parseIdent("expected property name"); !ok {
+			return superExpr, false
 		}
-		return propertyExpr, true
+		return superExpr, true
 	case p.extraFeatures && p.match(token.Fun):
 		return p.parseFunExpr(tok)
 	case p.extraFeatures && p.match(token.Try):
@@ -790,19 +1056,31 @@ func (p *parser) parsePrimaryExpr() (ast.Expr, bool) {
 		p.midStmtComments = append(p.midStmtComments, p.parseComment(tok))
 		return p.parsePrimaryExpr()
 	// Error productions
-	case p.match(token.EqualEqual, token.BangEqual, token.Less, token.LessEqual, token.Greater, token.GreaterEqual, token.Asterisk, token.Slash, token.Plus):
+	case p.match(token.Pipe, token.Caret, token.Ampersand, token.EqualEqual, token.BangEqual, token.Less, token.LessEqual,
+		token.Greater, token.GreaterEqual, token.LessLess, token.GreaterGreater, token.Plus, token.Asterisk,
+		token.AsteriskAsterisk, token.Slash):
 		p.addErrorf(tok, "binary operator %m must have left and right operands", tok.Type)
 		expr := &ast.BinaryExpr{Op: tok}
 		var parseExpr func() (ast.Expr, bool)
 		switch tok.Type {
+		case token.Pipe:
+			parseExpr = p.parseBitwiseOrExpr
+		case token.Caret:
+			parseExpr = p.parseBitwiseXorExpr
+		case token.Ampersand:
+			parseExpr = p.parseBitwiseAndExpr
 		case token.EqualEqual, token.BangEqual:
 			parseExpr = p.parseEqualityExpr
 		case token.Less, token.LessEqual, token.Greater, token.GreaterEqual:
 			parseExpr = p.parseRelationalExpr
+		case token.LessLess, token.GreaterGreater:
+			parseExpr = p.parseShiftExpr
 		case token.Plus:
 			parseExpr = p.parseAdditiveExpr
 		case token.Asterisk, token.Slash:
 			parseExpr = p.parseMultiplicativeExpr
+		case token.AsteriskAsterisk:
+			parseExpr = p.parseUnaryExpr
 		default:
 		}
 		var ok bool
@@ -890,9 +1168,22 @@ func (p *parser) expectSemicolon() bool {
 	return ok
 }
 
-// expectSemicolon2 is like expectSemicolon but also returns the matched token.
+// expectSemicolon2 is like expectSemicolon but also returns the matched token. The error which is added includes a fix
+// which inserts the semicolon immediately after the previous token.
 func (p *parser) expectSemicolon2() (token.Token, bool) {
-	return p.expect2f(token.Semicolon, "expected trailing %m", token.Semicolon)
+	tok, ok := p.match2(token.Semicolon)
+	if !ok {
+		if err := p.addErrorf(p.tok, "expected trailing %m", token.Semicolon); err != nil {
+			err.Fix = &loxerr.Fix{
+				Description: fmt.Sprintf("Insert %s", token.Semicolon),
+				Start:       p.prevTok.End(),
+				End:         p.prevTok.End(),
+				NewText:     token.Semicolon.String(),
+			}
+		}
+		return token.Token{}, false
+	}
+	return tok, true
 }
 
 // next advances the parser to the next token.
@@ -909,11 +1200,14 @@ func (p *parser) next() {
 	}
 }
 
-func (p *parser) addErrorf(rang token.Range, format string, args ...any) {
+// addErrorf adds an error and returns it. If an error has already been added at the same position, then no error is
+// added and nil is returned.
+func (p *parser) addErrorf(rang token.Range, format string, args ...any) *loxerr.Error {
 	start := rang.Start()
 	if len(p.errs) > 0 && start == p.lastErrPos {
-		return
+		return nil
 	}
 	p.lastErrPos = start
 	p.errs.Addf(rang, loxerr.Fatal, format, args...)
+	return p.errs[len(p.errs)-1]
 }
@@ -0,0 +1,204 @@
+// Package repl implements a read-eval-print loop (REPL) for Lox.
+package repl
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/chzyer/readline"
+	"golang.org/x/term"
+
+	"github.com/marcuscaisey/lox/golox/analyse"
+	"github.com/marcuscaisey/lox/golox/ast"
+	"github.com/marcuscaisey/lox/golox/builtins"
+	"github.com/marcuscaisey/lox/golox/interpreter"
+	"github.com/marcuscaisey/lox/golox/optimise"
+	"github.com/marcuscaisey/lox/golox/parser"
+)
+
+// REPL reads lines of Lox source code, executes them, and prints the results.
+// Input is accumulated across lines until it forms complete statements, so a block can be entered over multiple lines.
+// Entering an empty line whilst input is being accumulated discards it.
+type REPL struct {
+	in          io.Reader
+	out         io.Writer
+	errOut      io.Writer
+	interpreter *interpreter.Interpreter
+
+	interpreterOpts []interpreter.Option
+	printTokens     bool
+	printAST        bool
+	optimize        bool
+
+	pendingInput strings.Builder // Input which doesn't yet form complete statements
+}
+
+// Option can be passed to NewREPL to configure the REPL.
+type Option func(*REPL)
+
+// WithInterpreterOptions configures the interpreter which the REPL executes input with.
+func WithInterpreterOptions(opts ...interpreter.Option) Option {
+	return func(r *REPL) {
+		r.interpreterOpts = append(r.interpreterOpts, opts...)
+	}
+}
+
+// WithPrintTokens configures the REPL to print the lexical tokens of the input instead of executing it.
+func WithPrintTokens(enabled bool) Option {
+	return func(r *REPL) {
+		r.printTokens = enabled
+	}
+}
+
+// WithPrintAST configures the REPL to print the AST of the input instead of executing it.
+func WithPrintAST(enabled bool) Option {
+	return func(r *REPL) {
+		r.printAST = enabled
+	}
+}
+
+// WithOptimize configures the REPL to fold constant expressions in the input before executing it.
+func WithOptimize(enabled bool) Option {
+	return func(r *REPL) {
+		r.optimize = enabled
+	}
+}
+
+// NewREPL returns a REPL which reads input from in, writes the output of the executed input to out, and writes errors
+// to errOut.
+func NewREPL(in io.Reader, out io.Writer, errOut io.Writer, opts ...Option) *REPL {
+	r := &REPL{
+		in:     in,
+		out:    out,
+		errOut: errOut,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	argv := []string{"<repl>"}
+	interpreterOpts := append(r.interpreterOpts, interpreter.WithREPLMode(true), interpreter.WithOutput(out))
+	r.interpreter = interpreter.New(argv, interpreterOpts...)
+	return r
+}
+
+// Run reads and evaluates lines of input until the end of the input is reached. Errors from evaluating lines are
+// written to the error output.
+// If the input is a terminal, then lines are read with line editing and history support. Otherwise, they're read as
+// is.
+func (r *REPL) Run() error {
+	if f, ok := r.in.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		return r.runTerminal(f)
+	}
+
+	scanner := bufio.NewScanner(r.in)
+	for scanner.Scan() {
+		r.evalLineAndPrintError(scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("running Lox REPL: %s", err)
+	}
+	return nil
+}
+
+const (
+	prompt             = ">>> "
+	continuationPrompt = "... "
+)
+
+func (r *REPL) runTerminal(in *os.File) error {
+	cfg := &readline.Config{
+		Prompt: prompt,
+		Stdin:  readline.NewCancelableStdin(in),
+		Stdout: r.out,
+		Stderr: r.errOut,
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err == nil {
+		cfg.HistoryFile = path.Join(homeDir, ".lox_history")
+	} else {
+		fmt.Fprintf(r.errOut, "Can't get current user's home directory (%s). Command history will not be saved.\n", err)
+	}
+
+	rl, err := readline.NewEx(cfg)
+	if err != nil {
+		return fmt.Errorf("running Lox REPL: %s", err)
+	}
+	defer rl.Close()
+
+	fmt.Fprintln(r.errOut, "Welcome to the Lox REPL. Press Ctrl-D to exit.")
+
+	for {
+		line, err := rl.Readline()
+		if err != nil {
+			if errors.Is(err, readline.ErrInterrupt) {
+				r.pendingInput.Reset()
+				rl.SetPrompt(prompt)
+				continue
+			}
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("running Lox REPL: %s", err)
+		}
+		r.evalLineAndPrintError(line)
+		if r.InputPending() {
+			rl.SetPrompt(continuationPrompt)
+		} else {
+			rl.SetPrompt(prompt)
+		}
+	}
+}
+
+func (r *REPL) evalLineAndPrintError(line string) {
+	if err := r.EvalLine(line); err != nil {
+		fmt.Fprintln(r.errOut, err)
+	}
+}
+
+// InputPending reports whether there are previous lines of input which haven't been evaluated yet because they don't
+// form complete statements.
+func (r *REPL) InputPending() bool {
+	return r.pendingInput.Len() > 0
+}
+
+// EvalLine evaluates a line of input and returns any error which occurred.
+// If the line, together with any previous lines which haven't been evaluated yet, doesn't form complete statements,
+// then it's not evaluated until enough further lines have been provided to complete them, or an empty line is provided
+// to discard it.
+func (r *REPL) EvalLine(line string) error {
+	if r.InputPending() && strings.TrimSpace(line) == "" {
+		r.pendingInput.Reset()
+		return nil
+	}
+	r.pendingInput.WriteString(line)
+	r.pendingInput.WriteString("\n")
+	src := r.pendingInput.String()
+
+	program, err := parser.Parse(strings.NewReader(src), "", parser.WithPrintTokens(r.printTokens))
+	if err != nil && !r.printTokens && parser.IsUnexpectedEOF(err) {
+		return nil
+	}
+	r.pendingInput.Reset()
+	if r.printTokens {
+		return err
+	}
+	if r.optimize && err == nil {
+		// Any errors will be reported when the program is executed.
+		identBindings, _ := analyse.ResolveIdents(program, builtins.MustParseStubs("builtins.lox"))
+		program = optimise.FoldConstants(program, optimise.WithIdentBindings(identBindings))
+	}
+	if r.printAST {
+		fmt.Fprintln(r.out, ast.Sprint(program))
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	return r.interpreter.Execute(program)
+}
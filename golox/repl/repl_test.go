@@ -0,0 +1,129 @@
+package repl_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/marcuscaisey/lox/golox/repl"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantOut    string
+		wantErrOut string
+	}{
+		{
+			name:    "expression result printed",
+			input:   "1 + 2;\n",
+			wantOut: "3\n",
+		},
+		{
+			name:    "state maintained between lines",
+			input:   "var a = 1;\nprint a + 1;\n",
+			wantOut: "2\n",
+		},
+		{
+			name:    "multi-line block accumulated",
+			input:   "fun add(a, b) {\n  return a + b;\n}\nprint add(1, 2);\n",
+			wantOut: "3\n",
+		},
+		{
+			name:    "incomplete expression accumulated",
+			input:   "print 1 +\n2;\n",
+			wantOut: "3\n",
+		},
+		{
+			name:    "empty line discards incomplete input",
+			input:   "fun add(a, b) {\n\nprint 1;\n",
+			wantOut: "1\n",
+		},
+		{
+			name:       "error in incomplete input discards it",
+			input:      "fun add(a, b) {\n  return a +;\nprint 1;\n",
+			wantOut:    "1\n",
+			wantErrOut: "expected expression",
+		},
+		{
+			name:       "error doesn't stop repl",
+			input:      "print );\nprint 1;\n",
+			wantOut:    "1\n",
+			wantErrOut: "expected expression",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			inReader, inWriter := io.Pipe()
+			out := new(strings.Builder)
+			errOut := new(strings.Builder)
+			done := make(chan error)
+			go func() {
+				done <- repl.NewREPL(inReader, out, errOut).Run()
+			}()
+			if _, err := io.WriteString(inWriter, test.input); err != nil {
+				t.Fatalf("writing input: %s", err)
+			}
+			inWriter.Close()
+			if err := <-done; err != nil {
+				t.Fatalf("Run() returned error: %s", err)
+			}
+
+			if got := out.String(); got != test.wantOut {
+				t.Errorf("output = %q, want %q", got, test.wantOut)
+			}
+			if got := errOut.String(); test.wantErrOut == "" && got != "" || !strings.Contains(got, test.wantErrOut) {
+				t.Errorf("error output = %q, want it to contain %q", got, test.wantErrOut)
+			}
+		})
+	}
+}
+
+func TestEvalLineIncompleteInput(t *testing.T) {
+	out := new(strings.Builder)
+	r := repl.NewREPL(strings.NewReader(""), out, io.Discard)
+
+	for _, line := range []string{"if (true) {", "  print 1;"} {
+		if err := r.EvalLine(line); err != nil {
+			t.Fatalf("EvalLine(%q) returned error: %s", line, err)
+		}
+		if got := out.String(); got != "" {
+			t.Fatalf("output after EvalLine(%q) = %q, want no output", line, got)
+		}
+		if !r.InputPending() {
+			t.Fatalf("InputPending() after EvalLine(%q) = false, want true", line)
+		}
+	}
+	if err := r.EvalLine("}"); err != nil {
+		t.Fatalf(`EvalLine("}") returned error: %s`, err)
+	}
+	if r.InputPending() {
+		t.Errorf(`InputPending() after EvalLine("}") = true, want false`)
+	}
+
+	if got, want := out.String(), "1\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestEvalLineEmptyLineDiscardsIncompleteInput(t *testing.T) {
+	out := new(strings.Builder)
+	r := repl.NewREPL(strings.NewReader(""), out, io.Discard)
+
+	for _, line := range []string{"if (true) {", "  print 1;", ""} {
+		if err := r.EvalLine(line); err != nil {
+			t.Fatalf("EvalLine(%q) returned error: %s", line, err)
+		}
+	}
+	if r.InputPending() {
+		t.Fatalf(`InputPending() after EvalLine("") = true, want false`)
+	}
+	if err := r.EvalLine("print 2;"); err != nil {
+		t.Fatalf(`EvalLine("print 2;") returned error: %s`, err)
+	}
+
+	if got, want := out.String(), "2\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
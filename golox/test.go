@@ -0,0 +1,153 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/marcuscaisey/lox/golox/ast"
+	"github.com/marcuscaisey/lox/golox/interpreter"
+	"github.com/marcuscaisey/lox/golox/parser"
+)
+
+// errTestsFailed is returned by runTests if any tests failed. The failures will have already been reported.
+var errTestsFailed = errors.New("tests failed")
+
+// runTests implements the test subcommand. It runs the functions annotated with @test in the given files, or in the .lox
+// files in the given directories, and reports the results to w.
+func runTests(args []string, opts []interpreter.Option, w io.Writer) error {
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	verbose := flags.Bool("v", false, "Report the result of every test instead of just the failures")
+	if err := flags.Parse(args); err != nil {
+		return usageError(fmt.Sprintf("test: %s", err))
+	}
+	if flags.NArg() == 0 {
+		return usageError("test: at least one file or directory must be provided")
+	}
+
+	var filenames []string
+	for _, path := range flags.Args() {
+		pathFilenames, err := testFilenames(path)
+		if err != nil {
+			return err
+		}
+		filenames = append(filenames, pathFilenames...)
+	}
+
+	failed := false
+	for _, filename := range filenames {
+		ok, err := runTestFile(filename, opts, *verbose, w)
+		if err != nil {
+			return err
+		}
+		failed = failed || !ok
+	}
+	if failed {
+		return errTestsFailed
+	}
+	return nil
+}
+
+// testFilenames returns path if it's a file, or the .lox files in it if it's a directory.
+func testFilenames(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	var filenames []string
+	for _, entry := range entries {
+		if !entry.IsDir() && filepath.Ext(entry.Name()) == ".lox" {
+			filenames = append(filenames, filepath.Join(path, entry.Name()))
+		}
+	}
+	return filenames, nil
+}
+
+// runTestFile runs the tests in a file and reports whether they all passed. Each test is run in isolation by executing
+// the file in a new interpreter and then calling the test function.
+func runTestFile(filename string, opts []interpreter.Option, verbose bool, w io.Writer) (bool, error) {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return false, err
+	}
+	program, err := parser.Parse(strings.NewReader(string(src)), filename)
+	if err != nil {
+		return false, err
+	}
+
+	fileStart := time.Now()
+	passed := true
+	for _, funDecl := range testFunDecls(program) {
+		name := funDecl.Name.String()
+		if verbose {
+			fmt.Fprintf(w, "=== RUN   %s\n", name)
+		}
+
+		testStart := time.Now()
+		err := runTest(filename, program, funDecl, opts)
+		duration := time.Since(testStart)
+
+		if err != nil {
+			passed = false
+			fmt.Fprintf(w, "--- FAIL: %s (%.2fs)\n", name, duration.Seconds())
+			for line := range strings.Lines(err.Error()) {
+				if line != "\n" {
+					fmt.Fprint(w, "    ")
+				}
+				fmt.Fprint(w, line)
+			}
+			fmt.Fprintln(w)
+		} else if verbose {
+			fmt.Fprintf(w, "--- PASS: %s (%.2fs)\n", name, duration.Seconds())
+		}
+	}
+
+	status := "ok  "
+	if !passed {
+		status = "FAIL"
+	}
+	fmt.Fprintf(w, "%s\t%s\t%.3fs\n", status, filename, time.Since(fileStart).Seconds())
+	return passed, nil
+}
+
+// testFunDecls returns the global function declarations in a program which are annotated with @test.
+func testFunDecls(program *ast.Program) []*ast.FunDecl {
+	var funDecls []*ast.FunDecl
+	for _, stmt := range program.Stmts {
+		if commentedStmt, ok := stmt.(*ast.CommentedStmt); ok {
+			stmt = commentedStmt.Stmt
+		}
+		if funDecl, ok := stmt.(*ast.FunDecl); ok {
+			if _, ok := funDecl.Annotation("test"); ok {
+				funDecls = append(funDecls, funDecl)
+			}
+		}
+	}
+	return funDecls
+}
+
+// runTest executes a program in a new interpreter and then calls a test function declared in it. An error is returned if
+// either of these raise an error.
+func runTest(filename string, program *ast.Program, funDecl *ast.FunDecl, opts []interpreter.Option) error {
+	if params := funDecl.GetParams(); len(params) > 0 {
+		return fmt.Errorf("test function %s must not have any parameters", funDecl.Name)
+	}
+	interpreter := interpreter.New([]string{filepath.Base(filename)}, opts...)
+	if err := interpreter.Execute(program); err != nil {
+		return err
+	}
+	return interpreter.CallFunction(funDecl.Name.String())
+}
@@ -19,8 +19,10 @@ func init() {
 
 // Constants for special identifiers.
 const (
-	IdentBlank = "_"
-	IdentInit  = "init"
+	IdentBlank    = "_"
+	IdentInit     = "init"
+	IdentToString = "toString"
+	IdentClose    = "close"
 )
 
 //go:generate go tool stringer -type Type -linecomment
@@ -37,6 +39,8 @@ const (
 	keywordsStart
 	Print    // print
 	Var      // var
+	Const    // const
+	Let      // let
 	True     // true
 	False    // false
 	Nil      // nil
@@ -57,40 +61,50 @@ const (
 	Get      // get
 	Set      // set
 	Try      // try
+	Catch    // catch
+	With     // with
 	keywordsEnd
 
 	// Literals
 	Ident
 	String
 	Number
+	Decimal
 	Comment
 
 	// Symbols
 	symbolsStart
-	Semicolon    // ;
-	Comma        // ,
-	Dot          // .
-	Equal        // =
-	Plus         // +
-	Minus        // -
-	Asterisk     // *
-	Slash        // /
-	Percent      // %
-	Less         // <
-	LessEqual    // <=
-	Greater      // >
-	GreaterEqual // >=
-	EqualEqual   // ==
-	BangEqual    // !=
-	Bang         // !
-	Question     // ?
-	Colon        // :
-	LeftParen    // (
-	RightParen   // )
-	LeftBrack    // [
-	RightBrack   // ]
-	LeftBrace    // {
-	RightBrace   // }
+	Semicolon        // ;
+	Comma            // ,
+	Dot              // .
+	Equal            // =
+	Plus             // +
+	Minus            // -
+	Asterisk         // *
+	AsteriskAsterisk // **
+	Slash            // /
+	Percent          // %
+	Ampersand        // &
+	Pipe             // |
+	Caret            // ^
+	Less             // <
+	LessEqual        // <=
+	LessLess         // <<
+	Greater          // >
+	GreaterEqual     // >=
+	GreaterGreater   // >>
+	EqualEqual       // ==
+	BangEqual        // !=
+	Bang             // !
+	Question         // ?
+	Colon            // :
+	LeftParen        // (
+	RightParen       // )
+	LeftBrack        // [
+	RightBrack       // ]
+	LeftBrace        // {
+	RightBrace       // }
+	At               // @
 	symbolsEnd
 
 	typesEnd
@@ -107,6 +121,28 @@ func IdentType(ident string) Type {
 	return Ident
 }
 
+// operatorMethodNames maps the binary operators which can be overloaded to the names of the methods which overload them.
+var operatorMethodNames = map[Type]string{
+	Plus:         "add",
+	Minus:        "sub",
+	Asterisk:     "mul",
+	Slash:        "div",
+	EqualEqual:   "equals",
+	BangEqual:    "equals",
+	Less:         "less",
+	Greater:      "less",
+	LessEqual:    "less",
+	GreaterEqual: "less",
+}
+
+// OperatorMethodName returns the name of the method which a class can declare to overload the binary operator of this
+// type, and whether the operator can be overloaded. != is overloaded by the same method as == and >, <=, and >= are
+// overloaded by the same method as <.
+func (t Type) OperatorMethodName() (string, bool) {
+	name, ok := operatorMethodNames[t]
+	return name, ok
+}
+
 // Format implements fmt.Formatter. All verbs have the default behaviour, except for 'm' (message) which formats the
 // type for use in an error message.
 func (t Type) Format(f fmt.State, verb rune) {
@@ -173,6 +209,11 @@ func (p Position) Compare(other Position) int {
 	return cmp.Compare(p.Line, other.Line)
 }
 
+// Offset returns the 0-based byte offset of p from the start of its file.
+func (p Position) Offset() int {
+	return p.File.lineOffsets[p.Line-1] + p.Column
+}
+
 func (p Position) String() string {
 	line := p.File.Line(p.Line)
 	col := runewidth.StringWidth(string(line[:p.Column])) + 1
@@ -13,63 +13,75 @@ func _() {
 	_ = x[keywordsStart-2]
 	_ = x[Print-3]
 	_ = x[Var-4]
-	_ = x[True-5]
-	_ = x[False-6]
-	_ = x[Nil-7]
-	_ = x[If-8]
-	_ = x[Else-9]
-	_ = x[And-10]
-	_ = x[Or-11]
-	_ = x[While-12]
-	_ = x[For-13]
-	_ = x[Break-14]
-	_ = x[Continue-15]
-	_ = x[Fun-16]
-	_ = x[Return-17]
-	_ = x[Class-18]
-	_ = x[This-19]
-	_ = x[Super-20]
-	_ = x[Static-21]
-	_ = x[Get-22]
-	_ = x[Set-23]
-	_ = x[Try-24]
-	_ = x[keywordsEnd-25]
-	_ = x[Ident-26]
-	_ = x[String-27]
-	_ = x[Number-28]
-	_ = x[Comment-29]
-	_ = x[symbolsStart-30]
-	_ = x[Semicolon-31]
-	_ = x[Comma-32]
-	_ = x[Dot-33]
-	_ = x[Equal-34]
-	_ = x[Plus-35]
-	_ = x[Minus-36]
-	_ = x[Asterisk-37]
-	_ = x[Slash-38]
-	_ = x[Percent-39]
-	_ = x[Less-40]
-	_ = x[LessEqual-41]
-	_ = x[Greater-42]
-	_ = x[GreaterEqual-43]
-	_ = x[EqualEqual-44]
-	_ = x[BangEqual-45]
-	_ = x[Bang-46]
-	_ = x[Question-47]
-	_ = x[Colon-48]
-	_ = x[LeftParen-49]
-	_ = x[RightParen-50]
-	_ = x[LeftBrack-51]
-	_ = x[RightBrack-52]
-	_ = x[LeftBrace-53]
-	_ = x[RightBrace-54]
-	_ = x[symbolsEnd-55]
-	_ = x[typesEnd-56]
+	_ = x[Const-5]
+	_ = x[Let-6]
+	_ = x[True-7]
+	_ = x[False-8]
+	_ = x[Nil-9]
+	_ = x[If-10]
+	_ = x[Else-11]
+	_ = x[And-12]
+	_ = x[Or-13]
+	_ = x[While-14]
+	_ = x[For-15]
+	_ = x[Break-16]
+	_ = x[Continue-17]
+	_ = x[Fun-18]
+	_ = x[Return-19]
+	_ = x[Class-20]
+	_ = x[This-21]
+	_ = x[Super-22]
+	_ = x[Static-23]
+	_ = x[Get-24]
+	_ = x[Set-25]
+	_ = x[Try-26]
+	_ = x[Catch-27]
+	_ = x[With-28]
+	_ = x[keywordsEnd-29]
+	_ = x[Ident-30]
+	_ = x[String-31]
+	_ = x[Number-32]
+	_ = x[Decimal-33]
+	_ = x[Comment-34]
+	_ = x[symbolsStart-35]
+	_ = x[Semicolon-36]
+	_ = x[Comma-37]
+	_ = x[Dot-38]
+	_ = x[Equal-39]
+	_ = x[Plus-40]
+	_ = x[Minus-41]
+	_ = x[Asterisk-42]
+	_ = x[AsteriskAsterisk-43]
+	_ = x[Slash-44]
+	_ = x[Percent-45]
+	_ = x[Ampersand-46]
+	_ = x[Pipe-47]
+	_ = x[Caret-48]
+	_ = x[Less-49]
+	_ = x[LessEqual-50]
+	_ = x[LessLess-51]
+	_ = x[Greater-52]
+	_ = x[GreaterEqual-53]
+	_ = x[GreaterGreater-54]
+	_ = x[EqualEqual-55]
+	_ = x[BangEqual-56]
+	_ = x[Bang-57]
+	_ = x[Question-58]
+	_ = x[Colon-59]
+	_ = x[LeftParen-60]
+	_ = x[RightParen-61]
+	_ = x[LeftBrack-62]
+	_ = x[RightBrack-63]
+	_ = x[LeftBrace-64]
+	_ = x[RightBrace-65]
+	_ = x[At-66]
+	_ = x[symbolsEnd-67]
+	_ = x[typesEnd-68]
 }
 
-const _Type_name = "IllegalEOFkeywordsStartprintvartruefalsenilifelseandorwhileforbreakcontinuefunreturnclassthissuperstaticgetsettrykeywordsEndIdentStringNumberCommentsymbolsStart;,.=+-*/%<<=>>===!=!?:()[]{}symbolsEndtypesEnd"
+const _Type_name = "IllegalEOFkeywordsStartprintvarconstlettruefalsenilifelseandorwhileforbreakcontinuefunreturnclassthissuperstaticgetsettrycatchwithkeywordsEndIdentStringNumberDecimalCommentsymbolsStart;,.=+-***/%&|^<<=<<>>=>>==!=!?:()[]{}@symbolsEndtypesEnd"
 
-var _Type_index = [...]uint8{0, 7, 10, 23, 28, 31, 35, 40, 43, 45, 49, 52, 54, 59, 62, 67, 75, 78, 84, 89, 93, 98, 104, 107, 110, 113, 124, 129, 135, 141, 148, 160, 161, 162, 163, 164, 165, 166, 167, 168, 169, 170, 172, 173, 175, 177, 179, 180, 181, 182, 183, 184, 185, 186, 187, 188, 198, 206}
+var _Type_index = [...]uint8{0, 7, 10, 23, 28, 31, 36, 39, 43, 48, 51, 53, 57, 60, 62, 67, 70, 75, 83, 86, 92, 97, 101, 106, 112, 115, 118, 121, 126, 130, 141, 146, 152, 158, 165, 172, 184, 185, 186, 187, 188, 189, 190, 191, 193, 194, 195, 196, 197, 198, 199, 201, 203, 204, 206, 208, 210, 212, 213, 214, 215, 216, 217, 218, 219, 220, 221, 222, 232, 240}
 
 func (i Type) String() string {
 	if i < 0 || i >= Type(len(_Type_index)-1) {
@@ -0,0 +1,453 @@
+// Package typecheck implements static inference and checking of the types of values in Lox programs.
+package typecheck
+
+import (
+	"slices"
+
+	"github.com/marcuscaisey/lox/golox/analyse"
+	"github.com/marcuscaisey/lox/golox/ast"
+	"github.com/marcuscaisey/lox/golox/loxerr"
+	"github.com/marcuscaisey/lox/golox/token"
+)
+
+// Types are the types inferred for a program.
+type Types struct {
+	Idents    map[*ast.Ident]Type             // Inferred type of each identifier
+	Functions map[*ast.Function]*TypeFunction // Inferred type of each function, including the type that it returns
+}
+
+// Infer infers the types of the identifiers and functions in a program and reports operations which will always fail
+// at runtime. If an error is returned then the inferred types will still be returned along with it. The error will be
+// of type [loxerr.Errors].
+// builtins is a list of built-in declarations which are available in the global scope.
+//
+// Inference is best effort. The type of a variable is the union of the types of all of the values assigned to it and
+// the type returned by a function is the union of the types of all of the values that it returns. Identifiers whose
+// type can't be inferred have type [TypeUnknown] and no checks are performed on their values.
+//
+// The following operations are reported:
+//   - binary operations whose operands have types which can never be used with the operator
+//   - negation of values which can never be numbers
+//   - property access, property assignment, calls, and indexing of values which are always nil
+func Infer(program *ast.Program, builtins []ast.Decl) (*Types, error) {
+	// Any errors will be reported by analyse.ResolveIdents.
+	identBindings, _ := analyse.ResolveIdents(program, builtins)
+	i := &inferrer{
+		identBindings: identBindings,
+		bindingTypes:  map[ast.Binding]Type{},
+		funTypes:      map[*ast.Function]*TypeFunction{},
+	}
+	for _, decl := range builtins {
+		i.bindingTypes[decl] = builtinType(decl)
+	}
+	i.changed = true
+	for i.changed {
+		i.changed = false
+		i.walk(program)
+	}
+	i.check(program)
+	return &Types{Idents: i.identTypes(), Functions: i.funTypes}, i.errs.Err()
+}
+
+func builtinType(decl ast.Decl) Type {
+	switch decl := decl.(type) {
+	case *ast.FunDecl:
+		return &TypeFunction{Return: TypeUnknown{}}
+	case *ast.ClassDecl:
+		return TypeClass{Decl: decl}
+	default:
+		return TypeUnknown{}
+	}
+}
+
+type inferrer struct {
+	identBindings map[*ast.Ident][]ast.Binding
+	bindingTypes  map[ast.Binding]Type
+	funTypes      map[*ast.Function]*TypeFunction
+	curFun        *TypeFunction
+	changed       bool
+	errs          loxerr.Errors
+}
+
+// walk accumulates the types of the values assigned to bindings and returned from functions.
+func (i *inferrer) walk(node ast.Node) bool {
+	switch node := node.(type) {
+	case *ast.VarDecl:
+		if node.Initialiser != nil {
+			i.addBindingType(node, i.typeOf(node.Initialiser))
+		} else {
+			i.addBindingType(node, TypeNil{})
+		}
+	case *ast.FunDecl:
+		if node.Function != nil {
+			i.addBindingType(node, i.funType(node.Function))
+		}
+	case *ast.ClassDecl:
+		i.addBindingType(node, TypeClass{Decl: node})
+	case *ast.ParamDecl:
+		i.addBindingType(node, TypeUnknown{})
+	case *ast.DestructuredVarDecl:
+		i.addBindingType(node, TypeUnknown{})
+	case *ast.TryStmt:
+		ast.Walk(node.Body, i.walk)
+		if node.ErrorVar != nil {
+			i.addBindingType(node.ErrorVar, TypeString{})
+		}
+		ast.Walk(node.CatchBody, i.walk)
+		return false
+	case *ast.WithStmt:
+		ast.Walk(node.Value, i.walk)
+		if node.Resource != nil {
+			i.addBindingType(node.Resource, i.typeOf(node.Value))
+		}
+		ast.Walk(node.Body, i.walk)
+		return false
+	case *ast.Function:
+		i.walkFunction(node)
+		return false
+	case *ast.ReturnStmt:
+		if i.curFun != nil {
+			if node.Value != nil {
+				i.addReturnType(i.curFun, i.typeOf(node.Value))
+			} else {
+				i.addReturnType(i.curFun, TypeNil{})
+			}
+		}
+	case *ast.AssignmentExpr:
+		for _, binding := range i.identBindings[node.Left] {
+			i.addBindingType(binding, i.typeOf(node.Right))
+		}
+	default:
+	}
+	ast.WalkChildren(node, i.walk)
+	return false
+}
+
+func (i *inferrer) walkFunction(fun *ast.Function) {
+	funType := i.funType(fun)
+	prevFun := i.curFun
+	i.curFun = funType
+	defer func() { i.curFun = prevFun }()
+	ast.WalkChildren(fun, i.walk)
+	if !alwaysReturns(fun.Body) {
+		i.addReturnType(funType, TypeNil{})
+	}
+}
+
+// alwaysReturns reports whether executing stmt will always result in a return statement being executed.
+func alwaysReturns(stmt ast.Stmt) bool {
+	switch stmt := stmt.(type) {
+	case *ast.ReturnStmt:
+		return true
+	case *ast.Block:
+		if stmt == nil {
+			return false
+		}
+		for _, stmt := range stmt.Stmts {
+			if alwaysReturns(stmt) {
+				return true
+			}
+		}
+		return false
+	case *ast.IfStmt:
+		return alwaysReturns(stmt.Then) && stmt.Else != nil && alwaysReturns(stmt.Else)
+	case *ast.TryStmt:
+		return alwaysReturns(stmt.Body) && alwaysReturns(stmt.CatchBody)
+	case *ast.WithStmt:
+		return alwaysReturns(stmt.Body)
+	case *ast.CommentedStmt:
+		return alwaysReturns(stmt.Stmt)
+	default:
+		return false
+	}
+}
+
+func (i *inferrer) funType(fun *ast.Function) *TypeFunction {
+	funType, ok := i.funTypes[fun]
+	if !ok {
+		funType = &TypeFunction{Fun: fun}
+		i.funTypes[fun] = funType
+	}
+	return funType
+}
+
+func (i *inferrer) addBindingType(binding ast.Binding, t Type) {
+	prev := i.bindingTypes[binding]
+	next := union(prev, t)
+	if !equal(prev, next) {
+		i.bindingTypes[binding] = next
+		i.changed = true
+	}
+}
+
+func (i *inferrer) addReturnType(funType *TypeFunction, t Type) {
+	next := union(funType.Return, t)
+	if !equal(funType.Return, next) {
+		funType.Return = next
+		i.changed = true
+	}
+}
+
+// typeOf returns the type of the value that expr evaluates to. nil is returned if no type has been inferred for the
+// value yet.
+func (i *inferrer) typeOf(expr ast.Expr) Type {
+	switch expr := expr.(type) {
+	case *ast.LiteralExpr:
+		return literalType(expr.Value)
+	case *ast.FunExpr:
+		if expr.Function == nil {
+			return TypeUnknown{}
+		}
+		return i.funType(expr.Function)
+	case *ast.IdentExpr:
+		return i.identType(expr.Ident)
+	case *ast.AssignmentExpr:
+		return i.typeOf(expr.Right)
+	case *ast.CallExpr:
+		return callResultType(i.typeOf(expr.Callee))
+	case *ast.UnaryExpr:
+		switch expr.Op.Type {
+		case token.Bang:
+			return TypeBool{}
+		case token.Minus:
+			return TypeNumber{}
+		default:
+			return TypeUnknown{}
+		}
+	case *ast.BinaryExpr:
+		return binaryResultType(expr.Op.Type, i.typeOf(expr.Left), i.typeOf(expr.Right))
+	case *ast.TernaryExpr:
+		return union(i.typeOf(expr.Then), i.typeOf(expr.Else))
+	case *ast.GroupExpr:
+		return i.typeOf(expr.Expr)
+	case *ast.PropertySetExpr:
+		return i.typeOf(expr.Value)
+	case *ast.IndexSetExpr:
+		return i.typeOf(expr.Value)
+	default:
+		return TypeUnknown{}
+	}
+}
+
+func literalType(tok token.Token) Type {
+	switch tok.Type {
+	case token.Number:
+		return TypeNumber{}
+	case token.String:
+		return TypeString{}
+	case token.True, token.False:
+		return TypeBool{}
+	case token.Nil:
+		return TypeNil{}
+	default:
+		return TypeUnknown{}
+	}
+}
+
+// identType returns the union of the types of the bindings that ident refers to.
+func (i *inferrer) identType(ident *ast.Ident) Type {
+	bindings, ok := i.identBindings[ident]
+	if !ok {
+		return TypeUnknown{}
+	}
+	types := make([]Type, len(bindings))
+	for j, binding := range bindings {
+		t, ok := i.bindingTypes[binding]
+		if !ok {
+			return TypeUnknown{}
+		}
+		types[j] = t
+	}
+	return union(types...)
+}
+
+func callResultType(callee Type) Type {
+	var results []Type
+	for _, member := range members(callee) {
+		switch member := member.(type) {
+		case *TypeFunction:
+			results = append(results, member.Return)
+		case TypeClass:
+			results = append(results, TypeInstance{Class: member.Decl})
+		default:
+			results = append(results, TypeUnknown{})
+		}
+	}
+	return union(results...)
+}
+
+// binaryResultType returns the type of the value that a binary expression evaluates to given the types of its
+// operands. TypeUnknown is returned if the operation will always fail.
+func binaryResultType(op token.Type, left, right Type) Type {
+	switch op {
+	case token.And, token.Or:
+		return union(left, right)
+	case token.Comma:
+		return right
+	case token.EqualEqual, token.BangEqual:
+		return TypeBool{}
+	default:
+	}
+	if left == nil || right == nil {
+		return nil
+	}
+	if !isKnown(left) || !isKnown(right) {
+		return TypeUnknown{}
+	}
+	var results []Type
+	for _, leftMember := range members(left) {
+		for _, rightMember := range members(right) {
+			if result, ok := binaryOpResultType(op, leftMember, rightMember); ok {
+				results = append(results, result)
+			}
+		}
+	}
+	if len(results) == 0 {
+		return TypeUnknown{}
+	}
+	return union(results...)
+}
+
+// binaryOpResultType returns the type of the value that a binary operation evaluates to given the types of its operands
+// and whether the operation is valid for those types. left and right must not be unions.
+func binaryOpResultType(op token.Type, left, right Type) (Type, bool) {
+	switch left.(type) {
+	case TypeNumber:
+		switch right.(type) {
+		case TypeNumber:
+			switch op {
+			case token.Asterisk, token.AsteriskAsterisk, token.Slash, token.Percent, token.Plus, token.Minus, token.Ampersand,
+				token.Pipe, token.Caret, token.LessLess, token.GreaterGreater:
+				return TypeNumber{}, true
+			case token.Less, token.LessEqual, token.Greater, token.GreaterEqual:
+				return TypeBool{}, true
+			default:
+			}
+		case TypeString:
+			if op == token.Asterisk {
+				return TypeString{}, true
+			}
+		default:
+		}
+	case TypeString:
+		switch right.(type) {
+		case TypeString:
+			switch op {
+			case token.Plus:
+				return TypeString{}, true
+			case token.Less, token.LessEqual, token.Greater, token.GreaterEqual:
+				return TypeBool{}, true
+			default:
+			}
+		case TypeNumber:
+			if op == token.Asterisk {
+				return TypeString{}, true
+			}
+		case TypeInstance:
+			// Instances can be concatenated with strings if their class has a toString method.
+			if op == token.Plus {
+				return TypeString{}, true
+			}
+		default:
+		}
+	case TypeInstance:
+		if _, ok := right.(TypeString); ok && op == token.Plus {
+			return TypeString{}, true
+		}
+	default:
+	}
+	return nil, false
+}
+
+// check reports operations which will always fail at runtime.
+func (i *inferrer) check(program *ast.Program) {
+	ast.Walk(program, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.UnaryExpr:
+			i.checkUnaryExpr(node)
+		case *ast.BinaryExpr:
+			i.checkBinaryExpr(node)
+		case *ast.CallExpr:
+			i.checkNotNil(node.Callee, "%m value is not callable")
+		case *ast.PropertyExpr:
+			i.checkNotNil(node.Object, "property access is not valid for %m value")
+		case *ast.PropertySetExpr:
+			i.checkNotNil(node.Object, "property assignment is not valid for %m value")
+		case *ast.IndexExpr:
+			i.checkNotNil(node.Subject, "%m value is not indexable")
+		case *ast.IndexSetExpr:
+			i.checkNotNil(node.Subject, "%m value is not indexable")
+		default:
+		}
+		return true
+	})
+}
+
+func (i *inferrer) checkUnaryExpr(expr *ast.UnaryExpr) {
+	if expr.Op.Type != token.Minus {
+		return
+	}
+	right := i.typeOf(expr.Right)
+	if !isKnown(right) {
+		return
+	}
+	for _, member := range members(right) {
+		if _, ok := member.(TypeNumber); ok {
+			return
+		}
+	}
+	i.errs.Addf(expr.Op, loxerr.Warning, "%m operator cannot be used with type %m", expr.Op.Type, right)
+}
+
+func (i *inferrer) checkBinaryExpr(expr *ast.BinaryExpr) {
+	left, right := i.typeOf(expr.Left), i.typeOf(expr.Right)
+	if !isKnown(left) || !isKnown(right) {
+		return
+	}
+	if _, unknown := binaryResultType(expr.Op.Type, left, right).(TypeUnknown); !unknown {
+		return
+	}
+	if slices.ContainsFunc(members(left), func(t Type) bool { return i.overloadsOperator(t, expr.Op.Type) }) {
+		return
+	}
+	i.errs.Addf(expr.Op, loxerr.Warning, "%m operator cannot be used with types %m and %m", expr.Op.Type, left, right)
+}
+
+// overloadsOperator reports whether t is the type of an instance of a class which declares or inherits a method
+// overloading the binary operator op.
+func (i *inferrer) overloadsOperator(t Type, op token.Type) bool {
+	instance, ok := t.(TypeInstance)
+	if !ok {
+		return false
+	}
+	name, ok := op.OperatorMethodName()
+	if !ok {
+		return false
+	}
+	for classDecl := range analyse.InheritanceChain(instance.Class, i.identBindings) {
+		for _, methodDecl := range classDecl.Methods() {
+			if methodDecl.Name.String() == name && !methodDecl.IsStatic() && !methodDecl.IsGetter() && !methodDecl.IsSetter() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (i *inferrer) checkNotNil(expr ast.Expr, format string) {
+	if _, ok := i.typeOf(expr).(TypeNil); ok {
+		i.errs.Addf(expr, loxerr.Warning, format, TypeNil{})
+	}
+}
+
+func (i *inferrer) identTypes() map[*ast.Ident]Type {
+	identTypes := make(map[*ast.Ident]Type, len(i.identBindings))
+	for ident := range i.identBindings {
+		t := i.identType(ident)
+		if t == nil {
+			t = TypeUnknown{}
+		}
+		identTypes[ident] = t
+	}
+	return identTypes
+}
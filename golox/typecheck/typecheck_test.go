@@ -0,0 +1,73 @@
+package typecheck_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/marcuscaisey/lox/golox/ast"
+	"github.com/marcuscaisey/lox/golox/builtins"
+	"github.com/marcuscaisey/lox/golox/parser"
+	"github.com/marcuscaisey/lox/golox/typecheck"
+)
+
+func TestInfer(t *testing.T) {
+	tests := []struct {
+		name    string
+		program string
+		want    string
+	}{
+		{name: "number", program: `var a = 1; print a;`, want: `number`},
+		{name: "uninitialised", program: `var a; print a;`, want: `nil`},
+		{name: "assignment", program: `var a = 1; a = "a"; print a;`, want: `number | string`},
+		{name: "arithmetic", program: `var a = 1 + 2 * 3; print a;`, want: `number`},
+		{name: "string repetition", program: `var a = 3 * "a"; print a;`, want: `string`},
+		{name: "comparison", program: `var a = 1 < 2; print a;`, want: `bool`},
+		{name: "ternary", program: `var a = true ? 1 : nil; print a;`, want: `number | nil`},
+		{name: "function", program: `fun f() {} print f;`, want: `function`},
+		{name: "return", program: `fun f() { return "a"; } var a = f(); print a;`, want: `string`},
+		{name: "implicit return", program: `fun f() {} var a = f(); print a;`, want: `nil`},
+		{
+			name:    "conditional return",
+			program: `fun f(x) { if (x) { return 1; } } var a = f(true); print a;`,
+			want:    `number | nil`,
+		},
+		{
+			name:    "recursive function",
+			program: `fun f(n) { if (n < 1) { return 0; } else { return f(n - 1); } } var a = f(3); print a;`,
+			want:    `number`,
+		},
+		{name: "instance", program: `class Foo {} var a = Foo(); print a;`, want: `Foo`},
+		{name: "class", program: `class Foo {} print Foo;`, want: `class`},
+		{
+			name:    "instance concatenation",
+			program: `class Foo { toString() { return "foo"; } } var a = "a" + Foo(); print a;`,
+			want:    `string`,
+		},
+		{name: "parameter", program: `fun f(x) { print x; }`, want: `unknown`},
+		{name: "built-in function call", program: `var a = clock(); print a;`, want: `unknown`},
+		{name: "union with unknown", program: `var a = 1; a = clock(); print a;`, want: `unknown`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			program, err := parser.Parse(strings.NewReader(test.program), "test.lox")
+			if err != nil {
+				t.Fatalf("parsing program: %s", err)
+			}
+
+			types, _ := typecheck.Infer(program, builtins.MustParseStubs("builtins.lox"))
+
+			printStmt, ok := ast.FindLast(program, func(*ast.PrintStmt) bool { return true })
+			if !ok {
+				t.Fatalf("no print statement in program")
+			}
+			identExpr, ok := printStmt.Expr.(*ast.IdentExpr)
+			if !ok {
+				t.Fatalf("print statement doesn't print an identifier")
+			}
+			got := types.Idents[identExpr.Ident].String()
+			if got != test.want {
+				t.Errorf("Infer(%s) inferred %s to have type %s, want %s", test.program, identExpr.Ident, got, test.want)
+			}
+		})
+	}
+}
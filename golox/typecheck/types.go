@@ -0,0 +1,172 @@
+package typecheck
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/marcuscaisey/lox/golox/ast"
+)
+
+// Type is the statically inferred type of a value.
+//
+//sumtype:decl
+type Type interface {
+	fmt.Formatter
+	String() string
+	isType()
+}
+
+type typ struct{}
+
+func (typ) isType() {}
+
+// TypeNumber is the type of number values.
+type TypeNumber struct{ typ }
+
+func (TypeNumber) String() string                  { return "number" }
+func (t TypeNumber) Format(f fmt.State, verb rune) { format(f, verb, t) }
+
+// TypeString is the type of string values.
+type TypeString struct{ typ }
+
+func (TypeString) String() string                  { return "string" }
+func (t TypeString) Format(f fmt.State, verb rune) { format(f, verb, t) }
+
+// TypeBool is the type of the values true and false.
+type TypeBool struct{ typ }
+
+func (TypeBool) String() string                  { return "bool" }
+func (t TypeBool) Format(f fmt.State, verb rune) { format(f, verb, t) }
+
+// TypeNil is the type of the value nil.
+type TypeNil struct{ typ }
+
+func (TypeNil) String() string                  { return "nil" }
+func (t TypeNil) Format(f fmt.State, verb rune) { format(f, verb, t) }
+
+// TypeFunction is the type of a function.
+// There is a single TypeFunction for each function so that the type of the value that it returns can be inferred
+// incrementally.
+type TypeFunction struct {
+	Fun    *ast.Function // Function that the type describes, or nil if it's a built-in
+	Return Type          // Type of the value returned by the function
+	typ
+}
+
+func (*TypeFunction) String() string                  { return "function" }
+func (t *TypeFunction) Format(f fmt.State, verb rune) { format(f, verb, t) }
+
+// TypeClass is the type of a class.
+type TypeClass struct {
+	Decl *ast.ClassDecl
+	typ
+}
+
+func (TypeClass) String() string                  { return "class" }
+func (t TypeClass) Format(f fmt.State, verb rune) { format(f, verb, t) }
+
+// TypeInstance is the type of an instance of a class.
+type TypeInstance struct {
+	Class *ast.ClassDecl
+	typ
+}
+
+func (t TypeInstance) String() string {
+	if !t.Class.Name.IsValid() {
+		return "instance"
+	}
+	return t.Class.Name.String()
+}
+func (t TypeInstance) Format(f fmt.State, verb rune) { format(f, verb, t) }
+
+// TypeUnion is the type of a value which could have any one of several types.
+type TypeUnion struct {
+	Types []Type // Types that the value could have. These will never be unions themselves.
+	typ
+}
+
+func (t TypeUnion) String() string {
+	strs := make([]string, len(t.Types))
+	for i, typ := range t.Types {
+		strs[i] = typ.String()
+	}
+	return strings.Join(strs, " | ")
+}
+func (t TypeUnion) Format(f fmt.State, verb rune) { format(f, verb, t) }
+
+// TypeUnknown is the type of a value whose type can't be inferred. No checks are performed on values of this type.
+type TypeUnknown struct{ typ }
+
+func (TypeUnknown) String() string                  { return "unknown" }
+func (t TypeUnknown) Format(f fmt.State, verb rune) { format(f, verb, t) }
+
+// format implements fmt.Formatter for types. All verbs have the default behaviour, except for 'm' (message) which
+// formats the type for use in an error message.
+func format(f fmt.State, verb rune, t Type) {
+	switch verb {
+	case 'm':
+		fmt.Fprintf(f, "'%s'", t.String())
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), t.String())
+	}
+}
+
+// members returns the types which a value of type t could have. nil is treated as a type which no value has.
+func members(t Type) []Type {
+	switch t := t.(type) {
+	case nil:
+		return nil
+	case TypeUnion:
+		return t.Types
+	default:
+		return []Type{t}
+	}
+}
+
+// union returns the type of a value which could have any of the given types. nil types are ignored and nil is returned
+// if all of the types are nil.
+func union(types ...Type) Type {
+	var result []Type
+	for _, t := range types {
+		for _, member := range members(t) {
+			if _, ok := member.(TypeUnknown); ok {
+				return TypeUnknown{}
+			}
+			if !slices.Contains(result, member) {
+				result = append(result, member)
+			}
+		}
+	}
+	switch len(result) {
+	case 0:
+		return nil
+	case 1:
+		return result[0]
+	default:
+		return TypeUnion{Types: result}
+	}
+}
+
+// equal reports whether two types are the same.
+func equal(x, y Type) bool {
+	xMembers, yMembers := members(x), members(y)
+	if len(xMembers) != len(yMembers) {
+		return false
+	}
+	for _, member := range xMembers {
+		if !slices.Contains(yMembers, member) {
+			return false
+		}
+	}
+	return true
+}
+
+// isKnown reports whether every type that a value of type t could have is known.
+func isKnown(t Type) bool {
+	if t == nil {
+		return false
+	}
+	_, unknown := t.(TypeUnknown)
+	return !unknown
+}
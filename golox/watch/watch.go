@@ -0,0 +1,47 @@
+// Package watch implements watching a file for changes.
+package watch
+
+import (
+	"context"
+	"os"
+	"time"
+)
+
+// pollInterval is how often the watched file is checked for changes.
+const pollInterval = 10 * time.Millisecond
+
+// debounceWindow is how long the watched file must go without changing before a change is reported. This stops a burst
+// of successive writes from being reported as multiple changes.
+const debounceWindow = 50 * time.Millisecond
+
+// File calls onChange each time the named file changes until ctx is canceled. Changes are detected by polling the
+// file's modification time and size. Successive changes within 50 ms of each other are reported as a single change.
+// An error is only returned if the file can't be accessed when File is called.
+func File(ctx context.Context, filename string, onChange func()) error {
+	info, err := os.Stat(filename)
+	if err != nil {
+		return err
+	}
+	modTime, size := info.ModTime(), info.Size()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	var lastChange time.Time // Zero if there's no change waiting to be reported
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			// The file may briefly not exist if an editor replaces it when saving, so errors are ignored until it
+			// reappears.
+			if info, err := os.Stat(filename); err == nil && (!info.ModTime().Equal(modTime) || info.Size() != size) {
+				modTime, size = info.ModTime(), info.Size()
+				lastChange = now
+			}
+			if !lastChange.IsZero() && now.Sub(lastChange) >= debounceWindow {
+				lastChange = time.Time{}
+				onChange()
+			}
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package watch_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/marcuscaisey/lox/golox/watch"
+)
+
+func TestFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "test.lox")
+	if err := os.WriteFile(filename, []byte("print 1;\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes := make(chan struct{}, 10)
+	done := make(chan error)
+	go func() {
+		done <- watch.File(ctx, filename, func() { changes <- struct{}{} })
+	}()
+
+	// Give the watcher time to record the initial state of the file.
+	time.Sleep(20 * time.Millisecond)
+	go func() {
+		// Successive writes should be debounced into a single change.
+		for _, src := range []string{"print 2;\n", "print 23;\n", "print 234;\n"} {
+			if err := os.WriteFile(filename, []byte(src), 0644); err != nil {
+				t.Error(err)
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}()
+
+	select {
+	case <-changes:
+	case <-time.After(time.Second):
+		t.Fatal("onChange not called after file was written")
+	}
+	select {
+	case <-changes:
+		t.Error("onChange called more than once for successive writes")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("File() returned error: %s", err)
+		}
+	case <-time.After(time.Second):
+		t.Error("File() didn't return after context was canceled")
+	}
+}
+
+func TestFileNotExist(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "missing.lox")
+	if err := watch.File(context.Background(), filename, func() {}); err == nil {
+		t.Error("File() returned no error for file which doesn't exist")
+	}
+}
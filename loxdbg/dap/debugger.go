@@ -0,0 +1,282 @@
+package dap
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	loxdebugger "github.com/marcuscaisey/lox/golox/debugger"
+	"github.com/marcuscaisey/lox/golox/parser"
+)
+
+// Lox programs are single threaded so they're reported to the client as a single thread with this ID.
+const mainThreadID = 1
+
+// localsVariablesReference is the reference of the scope containing the variables visible to the statement that
+// execution is paused at.
+const localsVariablesReference = 1
+
+// handleResult is the result of handling a request.
+type handleResult struct {
+	Body any // Body of the response.
+	// AfterResponse, if not nil, is called after the response has been sent. This allows the debugger to send events or
+	// resume execution without them being observed by the client before the response.
+	AfterResponse func()
+}
+
+// debugger handles DAP requests by executing a Lox program in a debugging session.
+type debugger struct {
+	server *server
+
+	mu                 sync.Mutex
+	session            *loxdebugger.Session
+	stopOnEntry        bool
+	launched           bool
+	configured         bool
+	started            bool
+	paused             bool
+	pendingBreakpoints map[string][]int // Lines with breakpoints set before launch keyed by absolute file path
+}
+
+func newDebugger(server *server) *debugger {
+	return &debugger{
+		server:             server,
+		pendingBreakpoints: map[string][]int{},
+	}
+}
+
+// HandleRequest handles a DAP request and returns the body of its response.
+func (d *debugger) HandleRequest(command string, args *json.RawMessage) (handleResult, error) {
+	switch command {
+	case "initialize":
+		return d.initialize()
+	case "launch":
+		return handleWithArgs(d.launch, args)
+	case "setBreakpoints":
+		return handleWithArgs(d.setBreakpoints, args)
+	case "configurationDone":
+		return d.configurationDone()
+	case "threads":
+		return d.threads()
+	case "stackTrace":
+		return d.stackTrace()
+	case "scopes":
+		return handleWithArgs(d.scopes, args)
+	case "variables":
+		return handleWithArgs(d.variables, args)
+	case "continue":
+		return d.resume((*loxdebugger.Session).Continue, "breakpoint", &continueResponseBody{AllThreadsContinued: true})
+	case "next":
+		return d.resume((*loxdebugger.Session).StepOver, "step", nil)
+	case "stepIn":
+		return d.resume((*loxdebugger.Session).StepInto, "step", nil)
+	case "disconnect":
+		return handleResult{}, nil
+	default:
+		return handleResult{}, fmt.Errorf("unsupported command: %s", command)
+	}
+}
+
+func handleWithArgs[T any](handle func(*T) (handleResult, error), rawArgs *json.RawMessage) (handleResult, error) {
+	var args T
+	if rawArgs == nil {
+		return handleResult{}, errors.New("missing arguments")
+	}
+	if err := json.Unmarshal(*rawArgs, &args); err != nil {
+		return handleResult{}, fmt.Errorf("invalid arguments: %s", err)
+	}
+	return handle(&args)
+}
+
+func (d *debugger) initialize() (handleResult, error) {
+	return handleResult{
+		Body: &capabilities{SupportsConfigurationDoneRequest: true},
+		AfterResponse: func() {
+			d.server.sendEvent("initialized", nil)
+		},
+	}, nil
+}
+
+func (d *debugger) launch(args *launchArguments) (handleResult, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.launched {
+		return handleResult{}, errors.New("program has already been launched")
+	}
+	if args.Program == "" {
+		return handleResult{}, errors.New("program must be provided")
+	}
+
+	path, err := filepath.Abs(args.Program)
+	if err != nil {
+		return handleResult{}, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return handleResult{}, err
+	}
+	defer f.Close()
+	program, err := parser.Parse(f, path)
+	if err != nil {
+		return handleResult{}, err
+	}
+
+	argv := append([]string{filepath.Base(path)}, args.Args...)
+	d.session = loxdebugger.NewSession(program, argv)
+	for file, lines := range d.pendingBreakpoints {
+		for _, line := range lines {
+			d.session.SetBreakpoint(file, line)
+		}
+	}
+	d.pendingBreakpoints = nil
+	d.stopOnEntry = args.StopOnEntry
+	d.launched = true
+	return handleResult{AfterResponse: d.startIfReady}, nil
+}
+
+func (d *debugger) setBreakpoints(args *setBreakpointsArguments) (handleResult, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	path, err := filepath.Abs(args.Source.Path)
+	if err != nil {
+		return handleResult{}, err
+	}
+	lines := make([]int, len(args.Breakpoints))
+	breakpoints := make([]breakpoint, len(args.Breakpoints))
+	for i, sourceBreakpoint := range args.Breakpoints {
+		lines[i] = sourceBreakpoint.Line
+		breakpoints[i] = breakpoint{Verified: true, Source: &args.Source, Line: sourceBreakpoint.Line}
+	}
+	if d.session == nil {
+		d.pendingBreakpoints[path] = lines
+	} else {
+		d.session.ClearBreakpoints(path)
+		for _, line := range lines {
+			d.session.SetBreakpoint(path, line)
+		}
+	}
+	return handleResult{Body: &setBreakpointsResponseBody{Breakpoints: breakpoints}}, nil
+}
+
+func (d *debugger) configurationDone() (handleResult, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.configured = true
+	return handleResult{AfterResponse: d.startIfReady}, nil
+}
+
+// startIfReady starts executing the program once it has been launched and the client has finished configuring the
+// debugger.
+func (d *debugger) startIfReady() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.launched || !d.configured || d.started {
+		return
+	}
+	d.started = true
+	if d.stopOnEntry {
+		go d.execute((*loxdebugger.Session).StepInto, "entry")
+	} else {
+		go d.execute((*loxdebugger.Session).Continue, "breakpoint")
+	}
+}
+
+// execute resumes execution of the session with resume and blocks until it pauses, in which case a stopped event with
+// the given reason is sent, or the program finishes, in which case exited and terminated events are sent.
+func (d *debugger) execute(resume func(*loxdebugger.Session) error, reason string) {
+	err := resume(d.session)
+	if err == nil {
+		d.mu.Lock()
+		d.paused = true
+		d.mu.Unlock()
+		d.server.sendEvent("stopped", &stoppedEventBody{Reason: reason, ThreadID: mainThreadID, AllThreadsStopped: true})
+		return
+	}
+	exitCode := 0
+	if !errors.Is(err, loxdebugger.ErrFinished) {
+		d.server.sendEvent("output", &outputEventBody{Category: "stderr", Output: err.Error() + "\n"})
+		exitCode = 1
+	}
+	d.server.sendEvent("exited", &exitedEventBody{ExitCode: exitCode})
+	d.server.sendEvent("terminated", nil)
+}
+
+func (d *debugger) threads() (handleResult, error) {
+	return handleResult{Body: &threadsResponseBody{Threads: []thread{{ID: mainThreadID, Name: "main"}}}}, nil
+}
+
+func (d *debugger) stackTrace() (handleResult, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.paused {
+		return handleResult{}, errors.New("program is not paused")
+	}
+	frames := d.session.StackTrace()
+	stackFrames := make([]stackFrame, len(frames))
+	for i, frame := range frames {
+		name := frame.Function
+		if name == "" {
+			name = "<main>"
+		}
+		stackFrames[i] = stackFrame{
+			ID:     i,
+			Name:   name,
+			Source: &source{Name: filepath.Base(frame.File), Path: frame.File},
+			Line:   frame.Line,
+			Column: frame.Column,
+		}
+	}
+	return handleResult{Body: &stackTraceResponseBody{StackFrames: stackFrames, TotalFrames: len(stackFrames)}}, nil
+}
+
+func (d *debugger) scopes(args *scopesArguments) (handleResult, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.paused {
+		return handleResult{}, errors.New("program is not paused")
+	}
+	// Only the variables visible to the statement which execution is paused at can be inspected.
+	scopes := []scope{}
+	if args.FrameID == 0 {
+		scopes = append(scopes, scope{Name: "Locals", VariablesReference: localsVariablesReference})
+	}
+	return handleResult{Body: &scopesResponseBody{Scopes: scopes}}, nil
+}
+
+func (d *debugger) variables(args *variablesArguments) (handleResult, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.paused {
+		return handleResult{}, errors.New("program is not paused")
+	}
+	if args.VariablesReference != localsVariablesReference {
+		return handleResult{}, fmt.Errorf("unknown variables reference: %d", args.VariablesReference)
+	}
+	values, err := d.session.Variables()
+	if err != nil {
+		return handleResult{}, err
+	}
+	variables := []variable{}
+	for name, value := range values {
+		variables = append(variables, variable{Name: name, Value: value})
+	}
+	slices.SortFunc(variables, func(x, y variable) int { return strings.Compare(x.Name, y.Name) })
+	return handleResult{Body: &variablesResponseBody{Variables: variables}}, nil
+}
+
+// resume responds with the given body and then resumes execution of the session with resume. reason is the reason given
+// in the stopped event which is sent when execution next pauses.
+func (d *debugger) resume(resume func(*loxdebugger.Session) error, reason string, body any) (handleResult, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.paused {
+		return handleResult{}, errors.New("program is not paused")
+	}
+	d.paused = false
+	return handleResult{Body: body, AfterResponse: func() { go d.execute(resume, reason) }}, nil
+}
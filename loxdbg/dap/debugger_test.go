@@ -0,0 +1,252 @@
+package dap_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/marcuscaisey/lox/loxdbg/dap"
+)
+
+type message struct {
+	Type    string          `json:"type"`
+	Command string          `json:"command"`
+	Event   string          `json:"event"`
+	Success bool            `json:"success"`
+	Message string          `json:"message"`
+	Body    json.RawMessage `json:"body"`
+}
+
+type client struct {
+	t   *testing.T
+	in  io.Writer
+	out *bufio.Reader
+	seq int
+}
+
+func newClient(t *testing.T) *client {
+	t.Helper()
+	clientIn, serverOut := io.Pipe()
+	serverIn, clientOut := io.Pipe()
+	done := make(chan error)
+	go func() {
+		done <- dap.Serve(serverIn, serverOut, nil)
+	}()
+	t.Cleanup(func() {
+		clientOut.Close()
+		if err := <-done; err != nil {
+			t.Errorf("Serve() returned error: %s", err)
+		}
+	})
+	return &client{t: t, in: clientOut, out: bufio.NewReader(clientIn)}
+}
+
+// request sends a request and returns its response body, failing the test if the request was unsuccessful.
+func (c *client) request(command string, args any) json.RawMessage {
+	c.t.Helper()
+	c.seq++
+	content, err := json.Marshal(map[string]any{"seq": c.seq, "type": "request", "command": command, "arguments": args})
+	if err != nil {
+		c.t.Fatalf("marshalling %s request: %s", command, err)
+	}
+	if _, err := fmt.Fprintf(c.in, "Content-Length: %d\r\n\r\n%s", len(content), content); err != nil {
+		c.t.Fatalf("writing %s request: %s", command, err)
+	}
+	resp := c.read()
+	if resp.Type != "response" || resp.Command != command {
+		c.t.Fatalf("got %s %s%s message, want %s response", resp.Type, resp.Command, resp.Event, command)
+	}
+	if !resp.Success {
+		c.t.Fatalf("%s request failed: %s", command, resp.Message)
+	}
+	return resp.Body
+}
+
+// expectEvent reads the next message and returns its body, failing the test if it isn't the given event.
+func (c *client) expectEvent(name string) json.RawMessage {
+	c.t.Helper()
+	msg := c.read()
+	if msg.Type != "event" || msg.Event != name {
+		c.t.Fatalf("got %s %s%s message, want %s event", msg.Type, msg.Command, msg.Event, name)
+	}
+	return msg.Body
+}
+
+func (c *client) read() *message {
+	c.t.Helper()
+	line, err := c.out.ReadString('\n')
+	if err != nil {
+		c.t.Fatalf("reading header: %s", err)
+	}
+	length, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "Content-Length:")))
+	if err != nil {
+		c.t.Fatalf("parsing header %q: %s", line, err)
+	}
+	if _, err := c.out.ReadString('\n'); err != nil {
+		c.t.Fatalf("reading header: %s", err)
+	}
+	content := make([]byte, length)
+	if _, err := io.ReadFull(c.out, content); err != nil {
+		c.t.Fatalf("reading content: %s", err)
+	}
+	msg := &message{}
+	if err := json.Unmarshal(content, msg); err != nil {
+		c.t.Fatalf("unmarshalling message %s: %s", content, err)
+	}
+	return msg
+}
+
+func (c *client) expectStopped(reason string) {
+	c.t.Helper()
+	body := c.expectEvent("stopped")
+	var stopped struct{ Reason string }
+	if err := json.Unmarshal(body, &stopped); err != nil {
+		c.t.Fatal(err)
+	}
+	if stopped.Reason != reason {
+		c.t.Errorf("stopped reason = %q, want %q", stopped.Reason, reason)
+	}
+}
+
+func (c *client) stackTrace() []string {
+	c.t.Helper()
+	var body struct {
+		StackFrames []struct {
+			Name string
+			Line int
+		}
+	}
+	if err := json.Unmarshal(c.request("stackTrace", map[string]any{"threadId": 1}), &body); err != nil {
+		c.t.Fatal(err)
+	}
+	frames := make([]string, len(body.StackFrames))
+	for i, frame := range body.StackFrames {
+		frames[i] = fmt.Sprintf("%s:%d", frame.Name, frame.Line)
+	}
+	return frames
+}
+
+func (c *client) variables() map[string]string {
+	c.t.Helper()
+	var scopes struct {
+		Scopes []struct{ VariablesReference int }
+	}
+	if err := json.Unmarshal(c.request("scopes", map[string]any{"frameId": 0}), &scopes); err != nil {
+		c.t.Fatal(err)
+	}
+	if len(scopes.Scopes) != 1 {
+		c.t.Fatalf("got %d scopes, want 1", len(scopes.Scopes))
+	}
+	var body struct {
+		Variables []struct{ Name, Value string }
+	}
+	args := map[string]any{"variablesReference": scopes.Scopes[0].VariablesReference}
+	if err := json.Unmarshal(c.request("variables", args), &body); err != nil {
+		c.t.Fatal(err)
+	}
+	vars := map[string]string{}
+	for _, v := range body.Variables {
+		vars[v.Name] = v.Value
+	}
+	return vars
+}
+
+func TestDebugSession(t *testing.T) {
+	program := filepath.Join(t.TempDir(), "main.lox")
+	src := `var a = 1;
+fun add(x) {
+  return a + x;
+}
+var b = add(2);
+b = b + 1;
+`
+	if err := os.WriteFile(program, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	c := newClient(t)
+	c.request("initialize", map[string]any{"adapterID": "lox"})
+	c.expectEvent("initialized")
+	c.request("launch", map[string]any{"program": program})
+	c.request("setBreakpoints", map[string]any{
+		"source":      map[string]any{"path": program},
+		"breakpoints": []map[string]any{{"line": 5}},
+	})
+	c.request("configurationDone", nil)
+
+	c.expectStopped("breakpoint")
+	if got, want := fmt.Sprint(c.stackTrace()), "[<main>:5]"; got != want {
+		t.Errorf("stack trace at breakpoint = %s, want %s", got, want)
+	}
+
+	c.request("stepIn", map[string]any{"threadId": 1})
+	c.expectStopped("step")
+	if got, want := fmt.Sprint(c.stackTrace()), "[add:3 <main>:5]"; got != want {
+		t.Errorf("stack trace after stepIn = %s, want %s", got, want)
+	}
+	if got, want := c.variables(), map[string]string{"a": "1", "add": "[function add]", "argv": "[main.lox]", "x": "2"}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("variables after stepIn = %v, want %v", got, want)
+	}
+
+	c.request("next", map[string]any{"threadId": 1})
+	c.expectStopped("step")
+	if got, want := fmt.Sprint(c.stackTrace()), "[<main>:6]"; got != want {
+		t.Errorf("stack trace after next = %s, want %s", got, want)
+	}
+	if got := c.variables()["b"]; got != "3" {
+		t.Errorf("b after next = %s, want 3", got)
+	}
+
+	c.request("continue", map[string]any{"threadId": 1})
+	if got, want := string(c.expectEvent("exited")), `{"exitCode":0}`; got != want {
+		t.Errorf("exited event body = %s, want %s", got, want)
+	}
+	c.expectEvent("terminated")
+	c.request("disconnect", nil)
+}
+
+func TestStopOnEntryWithBreakpointsSetBeforeLaunch(t *testing.T) {
+	program := filepath.Join(t.TempDir(), "main.lox")
+	src := `var a = 1;
+a = a + 1;
+a = a + 1;
+`
+	if err := os.WriteFile(program, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	c := newClient(t)
+	c.request("initialize", map[string]any{"adapterID": "lox"})
+	c.expectEvent("initialized")
+	c.request("setBreakpoints", map[string]any{
+		"source":      map[string]any{"path": program},
+		"breakpoints": []map[string]any{{"line": 3}},
+	})
+	c.request("launch", map[string]any{"program": program, "stopOnEntry": true})
+	c.request("configurationDone", nil)
+
+	c.expectStopped("entry")
+	if got, want := fmt.Sprint(c.stackTrace()), "[<main>:1]"; got != want {
+		t.Errorf("stack trace on entry = %s, want %s", got, want)
+	}
+
+	c.request("continue", map[string]any{"threadId": 1})
+	c.expectStopped("breakpoint")
+	if got, want := fmt.Sprint(c.stackTrace()), "[<main>:3]"; got != want {
+		t.Errorf("stack trace at breakpoint = %s, want %s", got, want)
+	}
+	if got := c.variables()["a"]; got != "2" {
+		t.Errorf("a at breakpoint = %s, want 2", got)
+	}
+
+	c.request("continue", map[string]any{"threadId": 1})
+	c.expectEvent("exited")
+	c.expectEvent("terminated")
+	c.request("disconnect", nil)
+}
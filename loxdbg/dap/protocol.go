@@ -0,0 +1,193 @@
+package dap
+
+import "encoding/json"
+
+// request is a client or debug adapter initiated request.
+//
+// https://microsoft.github.io/debug-adapter-protocol/specification#Base_Protocol_Request
+type request struct {
+	Seq       int              `json:"seq"`                 // Sequence number of the message.
+	Type      string           `json:"type"`                // Always "request".
+	Command   string           `json:"command"`             // The command to execute.
+	Arguments *json.RawMessage `json:"arguments,omitempty"` // Object containing arguments for the command.
+}
+
+// response is the response for a request.
+//
+// https://microsoft.github.io/debug-adapter-protocol/specification#Base_Protocol_Response
+type response struct {
+	Seq        int    `json:"seq"`               // Sequence number of the message.
+	Type       string `json:"type"`              // Always "response".
+	RequestSeq int    `json:"request_seq"`       // Sequence number of the corresponding request.
+	Success    bool   `json:"success"`           // Outcome of the request.
+	Command    string `json:"command"`           // The command requested.
+	Message    string `json:"message,omitempty"` // Contains the raw error in short form if success is false.
+	Body       any    `json:"body,omitempty"`    // Contains request result if success is true.
+}
+
+// event is a debug adapter initiated event.
+//
+// https://microsoft.github.io/debug-adapter-protocol/specification#Base_Protocol_Event
+type event struct {
+	Seq   int    `json:"seq"`            // Sequence number of the message.
+	Type  string `json:"type"`           // Always "event".
+	Event string `json:"event"`          // Type of event.
+	Body  any    `json:"body,omitempty"` // Event-specific information.
+}
+
+// capabilities contains information about the capabilities of a debug adapter.
+//
+// https://microsoft.github.io/debug-adapter-protocol/specification#Types_Capabilities
+type capabilities struct {
+	// The debug adapter supports the configurationDone request.
+	SupportsConfigurationDoneRequest bool `json:"supportsConfigurationDoneRequest,omitempty"`
+}
+
+// launchArguments are the arguments for the launch request.
+//
+// https://microsoft.github.io/debug-adapter-protocol/specification#Requests_Launch
+type launchArguments struct {
+	Program     string   `json:"program"`     // Path of the program to debug.
+	Args        []string `json:"args"`        // Arguments to pass to the program.
+	StopOnEntry bool     `json:"stopOnEntry"` // Whether to stop before the first statement is executed.
+}
+
+// source is a descriptor for source code.
+//
+// https://microsoft.github.io/debug-adapter-protocol/specification#Types_Source
+type source struct {
+	Name string `json:"name,omitempty"` // The short name of the source.
+	Path string `json:"path,omitempty"` // The path of the source to be shown in the UI.
+}
+
+// sourceBreakpoint contains properties for a breakpoint passed to the setBreakpoints request.
+//
+// https://microsoft.github.io/debug-adapter-protocol/specification#Types_SourceBreakpoint
+type sourceBreakpoint struct {
+	Line int `json:"line"` // The source line of the breakpoint.
+}
+
+// setBreakpointsArguments are the arguments for the setBreakpoints request.
+//
+// https://microsoft.github.io/debug-adapter-protocol/specification#Requests_SetBreakpoints
+type setBreakpointsArguments struct {
+	Source      source             `json:"source"`      // The source location of the breakpoints.
+	Breakpoints []sourceBreakpoint `json:"breakpoints"` // The code locations of the breakpoints.
+}
+
+// breakpoint is information about a breakpoint created in the setBreakpoints request.
+//
+// https://microsoft.github.io/debug-adapter-protocol/specification#Types_Breakpoint
+type breakpoint struct {
+	Verified bool    `json:"verified"`          // If true, the breakpoint could be set.
+	Message  string  `json:"message,omitempty"` // An explanation of why a breakpoint could not be set.
+	Source   *source `json:"source,omitempty"`  // The source where the breakpoint is located.
+	Line     int     `json:"line,omitempty"`    // The start line of the actual range covered by the breakpoint.
+}
+
+// setBreakpointsResponseBody is the body of the response to the setBreakpoints request.
+type setBreakpointsResponseBody struct {
+	// Information about the breakpoints, in the same order as the breakpoints in the arguments.
+	Breakpoints []breakpoint `json:"breakpoints"`
+}
+
+// thread is a thread of the debuggee.
+//
+// https://microsoft.github.io/debug-adapter-protocol/specification#Types_Thread
+type thread struct {
+	ID   int    `json:"id"`   // Unique identifier for the thread.
+	Name string `json:"name"` // The name of the thread.
+}
+
+// threadsResponseBody is the body of the response to the threads request.
+type threadsResponseBody struct {
+	Threads []thread `json:"threads"` // All threads.
+}
+
+// stackFrame is a stack frame of the debuggee.
+//
+// https://microsoft.github.io/debug-adapter-protocol/specification#Types_StackFrame
+type stackFrame struct {
+	ID     int     `json:"id"`               // An identifier for the stack frame.
+	Name   string  `json:"name"`             // The name of the stack frame, typically a method name.
+	Source *source `json:"source,omitempty"` // The source of the frame.
+	Line   int     `json:"line"`             // The line within the source of the frame.
+	Column int     `json:"column"`           // Start position of the range covered by the stack frame.
+}
+
+// stackTraceResponseBody is the body of the response to the stackTrace request.
+type stackTraceResponseBody struct {
+	StackFrames []stackFrame `json:"stackFrames"` // The frames of the stack frame, most recent call first.
+	TotalFrames int          `json:"totalFrames"` // The total number of frames available in the stack.
+}
+
+// scopesArguments are the arguments for the scopes request.
+//
+// https://microsoft.github.io/debug-adapter-protocol/specification#Requests_Scopes
+type scopesArguments struct {
+	FrameID int `json:"frameId"` // Retrieve the scopes for the stack frame identified by frameId.
+}
+
+// scope is a named container for variables.
+//
+// https://microsoft.github.io/debug-adapter-protocol/specification#Types_Scope
+type scope struct {
+	Name               string `json:"name"`               // Name of the scope.
+	VariablesReference int    `json:"variablesReference"` // Used to retrieve the variables of this scope.
+	Expensive          bool   `json:"expensive"`          // If true, the variables of this scope are expensive to retrieve.
+}
+
+// scopesResponseBody is the body of the response to the scopes request.
+type scopesResponseBody struct {
+	Scopes []scope `json:"scopes"` // The scopes of the stack frame.
+}
+
+// variablesArguments are the arguments for the variables request.
+//
+// https://microsoft.github.io/debug-adapter-protocol/specification#Requests_Variables
+type variablesArguments struct {
+	VariablesReference int `json:"variablesReference"` // The variable for which to retrieve its children.
+}
+
+// variable is a name/value pair.
+//
+// https://microsoft.github.io/debug-adapter-protocol/specification#Types_Variable
+type variable struct {
+	Name               string `json:"name"`               // The variable's name.
+	Value              string `json:"value"`              // The variable's value.
+	VariablesReference int    `json:"variablesReference"` // If greater than 0, the variable is structured.
+}
+
+// variablesResponseBody is the body of the response to the variables request.
+type variablesResponseBody struct {
+	Variables []variable `json:"variables"` // All (or a range) of variables for the given variable reference.
+}
+
+// continueResponseBody is the body of the response to the continue request.
+type continueResponseBody struct {
+	AllThreadsContinued bool `json:"allThreadsContinued"` // If true, all threads have been resumed.
+}
+
+// stoppedEventBody is the body of the stopped event.
+//
+// https://microsoft.github.io/debug-adapter-protocol/specification#Events_Stopped
+type stoppedEventBody struct {
+	Reason            string `json:"reason"`            // The reason for the event.
+	ThreadID          int    `json:"threadId"`          // The thread which was stopped.
+	AllThreadsStopped bool   `json:"allThreadsStopped"` // If true, all threads have been stopped.
+}
+
+// outputEventBody is the body of the output event.
+//
+// https://microsoft.github.io/debug-adapter-protocol/specification#Events_Output
+type outputEventBody struct {
+	Category string `json:"category"` // The output category.
+	Output   string `json:"output"`   // The output to report.
+}
+
+// exitedEventBody is the body of the exited event.
+//
+// https://microsoft.github.io/debug-adapter-protocol/specification#Events_Exited
+type exitedEventBody struct {
+	ExitCode int `json:"exitCode"` // The exit code returned from the debuggee.
+}
@@ -0,0 +1,191 @@
+// Package dap implements a debug adapter for Lox programs which implements the debug adapter protocol (DAP) as defined
+// at https://microsoft.github.io/debug-adapter-protocol/specification.
+package dap
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Serve reads DAP requests from in, handles them, and writes the responses and events to out. Lines read from
+// programOutput are reported to the client as output events. programOutput should be connected to the standard output
+// of the debuggee and can be nil if it shouldn't be reported.
+// Serve returns once in reaches EOF or the client disconnects.
+func Serve(in io.Reader, out io.Writer, programOutput io.Reader) error {
+	server := newServer(in, out)
+	if programOutput != nil {
+		go server.forwardOutput(programOutput)
+	}
+	return server.Serve()
+}
+
+type server struct {
+	in       *bufio.Reader
+	out      io.Writer
+	writeMu  sync.Mutex
+	seq      int
+	debugger *debugger
+}
+
+func newServer(in io.Reader, out io.Writer) *server {
+	server := &server{
+		in:  bufio.NewReader(in),
+		out: out,
+	}
+	server.debugger = newDebugger(server)
+	return server
+}
+
+func (s *server) Serve() error {
+	for {
+		req, err := s.read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				slog.Info("EOF reached, stopping server")
+				return nil
+			}
+			return fmt.Errorf("serving dap requests: %w", err)
+		}
+
+		disconnect, err := s.handle(req)
+		if err != nil {
+			return fmt.Errorf("serving dap requests: %w", err)
+		}
+		if disconnect {
+			return nil
+		}
+	}
+}
+
+const contentLengthHeader = "Content-Length"
+
+// reads a message according to https://microsoft.github.io/debug-adapter-protocol/overview#base-protocol
+func (s *server) read() (*request, error) {
+	contentLength, err := s.readHeaders()
+	if err != nil {
+		return nil, fmt.Errorf("reading message: %w", err)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(s.in, contentLength))
+	if err != nil {
+		return nil, fmt.Errorf("reading message: reading content: %w", err)
+	}
+
+	req := &request{}
+	if err := json.Unmarshal(content, req); err != nil {
+		return nil, fmt.Errorf("reading message: %w", err)
+	}
+	if req.Type != "request" {
+		return nil, fmt.Errorf("reading message: unexpected message type %q", req.Type)
+	}
+
+	return req, nil
+}
+
+func (s *server) readHeaders() (int64, error) {
+	var contentLength int64
+	contentLengthPresent := false
+	for {
+		line, err := s.in.ReadString('\n')
+		if err != nil {
+			return 0, fmt.Errorf("reading header line: %w", err)
+		}
+		line = strings.TrimSuffix(line, "\r\n")
+		if line == "" {
+			break
+		}
+
+		field, value, found := strings.Cut(line, ":")
+		if !found {
+			return 0, fmt.Errorf("header line does not contain colon: %q", line)
+		}
+		if !strings.EqualFold(field, contentLengthHeader) {
+			return 0, fmt.Errorf("unknown header: %q", line)
+		}
+		value = strings.TrimSpace(value)
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s header %q: %s", contentLengthHeader, value, err)
+		}
+		contentLength = n
+		contentLengthPresent = true
+	}
+
+	if !contentLengthPresent {
+		return 0, fmt.Errorf("missing %s header", contentLengthHeader)
+	}
+
+	return contentLength, nil
+}
+
+// write writes a response or event to the client, assigning it the next sequence number. write is safe to call from
+// multiple goroutines.
+func (s *server) write(msg any) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	s.seq++
+	switch msg := msg.(type) {
+	case *response:
+		msg.Seq = s.seq
+	case *event:
+		msg.Seq = s.seq
+	default:
+		panic(fmt.Sprintf("unexpected message type: %T", msg))
+	}
+	content, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("writing message: %w", err)
+	}
+	if _, err := fmt.Fprintf(s.out, "%s: %d\r\n\r\n%s", contentLengthHeader, len(content), content); err != nil {
+		return fmt.Errorf("writing message: %w", err)
+	}
+	return nil
+}
+
+// sendEvent sends an event to the client, logging any error which occurs.
+func (s *server) sendEvent(name string, body any) {
+	if err := s.write(&event{Type: "event", Event: name, Body: body}); err != nil {
+		slog.Error("Failed to send event", "event", name, "error", err.Error())
+	}
+}
+
+func (s *server) handle(req *request) (disconnect bool, err error) {
+	resp := &response{Type: "response", RequestSeq: req.Seq, Command: req.Command, Success: true}
+	result, handleErr := s.debugger.HandleRequest(req.Command, req.Arguments)
+	if handleErr != nil {
+		resp.Success = false
+		resp.Message = handleErr.Error()
+	} else {
+		resp.Body = result.Body
+	}
+	if err := s.write(resp); err != nil {
+		return false, fmt.Errorf("handling %s request: %w", req.Command, err)
+	}
+	if handleErr == nil && result.AfterResponse != nil {
+		result.AfterResponse()
+	}
+	return req.Command == "disconnect", nil
+}
+
+func (s *server) forwardOutput(r io.Reader) {
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" {
+			s.sendEvent("output", &outputEventBody{Category: "stdout", Output: line})
+		}
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				slog.Error("Failed to read program output", "error", err.Error())
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,30 @@
+// Entry point for the Lox debug adapter.
+package main
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/marcuscaisey/lox/loxdbg/dap"
+)
+
+func main() {
+	handler := slog.NewTextHandler(os.Stderr, nil)
+	logger := slog.New(handler)
+	slog.SetDefault(logger)
+
+	// stdout is used to communicate with the client, so the output of the debuggee is redirected through a pipe and
+	// reported to the client as output events instead.
+	out := os.Stdout
+	programOutput, programOutputWriter, err := os.Pipe()
+	if err != nil {
+		slog.Error("Something went wrong", "error", err.Error())
+		os.Exit(1)
+	}
+	os.Stdout = programOutputWriter
+
+	if err := dap.Serve(os.Stdin, out, programOutput); err != nil {
+		slog.Error("Something went wrong", "error", err.Error())
+		os.Exit(1)
+	}
+}
@@ -2,20 +2,69 @@
 package format
 
 import (
+	"cmp"
 	"fmt"
+	"slices"
 	"strings"
 
+	"github.com/mattn/go-runewidth"
+
 	"github.com/marcuscaisey/lox/golox/ast"
 	"github.com/marcuscaisey/lox/golox/token"
 )
 
 const indentSize = 2
 
+// DefaultMaxLineLength is the maximum line length used by [Node].
+const DefaultMaxLineLength = 100
+
+// Config configures how Lox code is formatted.
+type Config struct {
+	// MaxLineLength is the length that a line can reach before the formatter breaks it over multiple lines. A value of
+	// zero or less means that lines are never broken.
+	MaxLineLength int
+	// SortClassMembers enables the reordering of the members of each class into a canonical order: static methods and
+	// property accessors, then init, then instance methods, then instance property accessors. Members of the same kind
+	// keep their relative order and the comments before a member are moved with it. Class bodies which are already in
+	// the canonical order are left unchanged.
+	SortClassMembers bool
+	// AlignAssignments enables the alignment of the = in runs of consecutive variable declarations or consecutive
+	// assignment statements, including property and index assignments. A run is ended by a blank line or by a statement
+	// of a different kind.
+	AlignAssignments bool
+}
+
 // Node formats node in canonical Lox style and returns the result. node is expected to be a syntactically correct.
 func Node(node ast.Node) string {
+	return NodeWithConfig(node, Config{MaxLineLength: DefaultMaxLineLength})
+}
+
+// NodeWithConfig is like [Node] but formats node according to cfg.
+//
+// If a line would be longer than cfg.MaxLineLength, then the outermost call argument list on it is broken so that each
+// argument is on its own line, followed by a trailing comma, and the closing parenthesis is on its own line. Long
+// conditions of if and while statements are broken in the same way, with each operand of a top-level and / or
+// expression placed on its own line. Doc comments which are too long are broken across multiple lines.
+func NodeWithConfig(node ast.Node, cfg Config) string {
+	f := &formatter{cfg: cfg}
+	return f.node(node)
+}
+
+type formatter struct {
+	cfg Config
+
+	indent int  // column that the line containing the node being formatted starts at
+	col    int  // column that the node being formatted starts at
+	suffix int  // width of the text which follows the node being formatted on the same line
+	flat   bool // whether lines should never be broken
+
+	equalPadding int // number of spaces to write before the = of the next assignment formatted
+}
+
+func (f *formatter) node(node ast.Node) string {
 	switch node := node.(type) {
 	case *ast.Program:
-		return formatProgram(node)
+		return f.formatProgram(node)
 	case *ast.Ident:
 		return formatIdent(node)
 	case *ast.IllegalStmt:
@@ -23,87 +72,193 @@ func Node(node ast.Node) string {
 	case *ast.Comment:
 		return formatComment(node)
 	case *ast.CommentedStmt:
-		return formatCommentedStmt(node)
+		return f.formatCommentedStmt(node)
 	case *ast.VarDecl:
-		return formatVarDecl(node)
+		return f.formatVarDecl(node)
+	case *ast.DestructuringDecl:
+		return f.formatDestructuringDecl(node)
+	case *ast.DestructuredVarDecl:
+		return formatDestructuredVarDecl(node)
 	case *ast.FunDecl:
-		return formatFunDecl(node)
+		return f.formatFunDecl(node)
 	case *ast.Function:
-		return formatFun(node)
+		return f.formatFun(node)
 	case *ast.ParamDecl:
-		return formatParamDecl(node)
+		return f.formatParamDecl(node)
+	case *ast.Annotation:
+		return f.formatAnnotation(node)
 	case *ast.ClassDecl:
-		return formatClassDecl(node)
+		return f.formatClassDecl(node)
 	case *ast.MethodDecl:
-		return formatMethodDecl(node)
+		return f.formatMethodDecl(node)
 	case *ast.ExprStmt:
-		return formatExprStmt(node)
+		return f.formatExprStmt(node)
 	case *ast.PrintStmt:
-		return formatPrintStmt(node)
+		return f.formatPrintStmt(node)
 	case *ast.Block:
-		return formatBlockStmt(node)
+		return f.formatBlockStmt(node)
 	case *ast.IfStmt:
-		return formatIfStmt(node)
+		return f.formatIfStmt(node)
+	case *ast.TryStmt:
+		return f.formatTryStmt(node)
+	case *ast.WithStmt:
+		return f.formatWithStmt(node)
 	case *ast.WhileStmt:
-		return formatWhileStmt(node)
+		return f.formatWhileStmt(node)
 	case *ast.ForStmt:
-		return formatForStmt(node)
+		return f.formatForStmt(node)
 	case *ast.BreakStmt:
 		return formatBreakStmt(node)
 	case *ast.ContinueStmt:
 		return formatContinueStmt(node)
 	case *ast.ReturnStmt:
-		return formatReturnStmt(node)
+		return f.formatReturnStmt(node)
 	case *ast.LiteralExpr:
 		return formatLiteralExpr(node)
 	case *ast.FunExpr:
-		return formatFunExpr(node)
+		return f.formatFunExpr(node)
 	case *ast.ListExpr:
-		return formatListExpr(node)
+		return f.formatListExpr(node)
 	case *ast.IdentExpr:
 		return formatIdentExpr(node)
 	case *ast.AssignmentExpr:
-		return formatAssignmentExpr(node)
+		return f.formatAssignmentExpr(node)
 	case *ast.ThisExpr:
 		return formatThisExpr(node)
 	case *ast.SuperExpr:
-		return formatSuperExpr(node)
+		return f.formatSuperExpr(node)
 	case *ast.CallExpr:
-		return formatCallExpr(node)
+		return f.formatCallExpr(node)
+	case *ast.NamedArg:
+		return f.formatNamedArg(node)
 	case *ast.IndexExpr:
-		return formatIndexExpr(node)
+		return f.formatIndexExpr(node)
 	case *ast.IndexSetExpr:
-		return formatIndexSetExpr(node)
+		return f.formatIndexSetExpr(node)
 	case *ast.PropertyExpr:
-		return formatPropertyExpr(node)
+		return f.formatPropertyExpr(node)
 	case *ast.PropertySetExpr:
-		return formatPropertySetExpr(node)
+		return f.formatPropertySetExpr(node)
 	case *ast.UnaryExpr:
-		return formatUnaryExpr(node)
+		return f.formatUnaryExpr(node)
 	case *ast.BinaryExpr:
-		return formatBinaryExpr(node)
+		return f.formatBinaryExpr(node)
 	case *ast.TernaryExpr:
-		return formatTernaryExpr(node)
+		return f.formatTernaryExpr(node)
 	case *ast.TryExpr:
-		return formatTryExpr(node)
+		return f.formatTryExpr(node)
 	case *ast.GroupExpr:
-		return formatGroupExpr(node)
+		return f.formatGroupExpr(node)
 	}
 	panic("unreachable")
 }
 
+// concat formats and concatenates parts. Parts which are [ast.Node]s are formatted and all other parts are formatted
+// with fmt.Sprint.
+func (f *formatter) concat(parts ...any) string {
+	defer f.save()()
+	startCol, startSuffix := f.col, f.suffix
+	b := new(strings.Builder)
+	for i, part := range parts {
+		if node, ok := part.(ast.Node); ok {
+			f.col = f.columnAfter(startCol, b.String())
+			f.suffix = suffixWidth(parts[i+1:], startSuffix)
+			fmt.Fprint(b, f.node(node))
+		} else {
+			fmt.Fprint(b, part)
+		}
+	}
+	return b.String()
+}
+
+// columnAfter returns the column that follows s, if s is written starting at startCol.
+func (f *formatter) columnAfter(startCol int, s string) int {
+	if i := strings.LastIndexByte(s, '\n'); i >= 0 {
+		return f.indent + runewidth.StringWidth(s[i+1:])
+	}
+	return startCol + runewidth.StringWidth(s)
+}
+
+// suffixWidth returns the width of the text formed by the parts which precede the first node or new line in parts. If
+// there is no node or new line in parts, then the width of the text is added to inherited.
+func suffixWidth(parts []any, inherited int) int {
+	width := 0
+	for _, part := range parts {
+		if _, ok := part.(ast.Node); ok {
+			return width
+		}
+		s := fmt.Sprint(part)
+		if i := strings.IndexByte(s, '\n'); i >= 0 {
+			return width + runewidth.StringWidth(s[:i])
+		}
+		width += runewidth.StringWidth(s)
+	}
+	return width + inherited
+}
+
+// save saves the current state of the formatter and returns a function which restores it.
+func (f *formatter) save() func() {
+	indent, col, suffix, flat := f.indent, f.col, f.suffix, f.flat
+	return func() {
+		f.indent, f.col, f.suffix, f.flat = indent, col, suffix, flat
+	}
+}
+
+// fits reports whether s can be written at the current column without exceeding the maximum line length.
+// Only the first and last lines of s are checked. Any lines in between belong to nested statements which are broken
+// independently.
+func (f *formatter) fits(s string) bool {
+	if f.cfg.MaxLineLength <= 0 {
+		return true
+	}
+	lines := strings.Split(s, "\n")
+	if len(lines) == 1 {
+		return f.col+runewidth.StringWidth(s)+f.suffix <= f.cfg.MaxLineLength
+	}
+	return f.col+runewidth.StringWidth(lines[0]) <= f.cfg.MaxLineLength &&
+		f.indent+runewidth.StringWidth(lines[len(lines)-1])+f.suffix <= f.cfg.MaxLineLength
+}
+
+// formatFlat is like concat except that lines are never broken. It returns the formatted parts and whether they fit on
+// the current line.
+func (f *formatter) formatFlat(parts ...any) (string, bool) {
+	restore := f.save()
+	f.flat = true
+	s := f.concat(parts...)
+	restore()
+	return s, f.fits(s)
+}
+
+// indentedLine formats parts as if they were on their own line, indented one level deeper than the current line.
+func (f *formatter) indentedLine(parts ...any) string {
+	defer f.save()()
+	f.indent += indentSize
+	f.col = f.indent
+	f.suffix = 0
+	return indent(f.concat(parts...))
+}
+
 func formatIdent(ident *ast.Ident) string {
 	return ident.String()
 }
 
-func formatProgram(program *ast.Program) string {
-	return fmt.Sprint(formatStmts(program.Stmts), "\n")
+func (f *formatter) formatProgram(program *ast.Program) string {
+	return fmt.Sprint(formatStmts(f, program.Stmts), "\n")
 }
 
-func formatStmts[T ast.Stmt](stmts []T) string {
+func formatStmts[T ast.Stmt](f *formatter, stmts []T) string {
+	var equalPaddings []int
+	if f.cfg.AlignAssignments {
+		equalPaddings = alignEquals(f, stmts)
+	}
 	b := new(strings.Builder)
 	for i, stmt := range stmts {
-		fmt.Fprint(b, Node(stmt))
+		f.col = f.indent
+		f.suffix = 0
+		if equalPaddings != nil {
+			f.equalPadding = equalPaddings[i]
+		}
+		fmt.Fprint(b, f.node(stmt))
 		if i < len(stmts)-1 {
 			fmt.Fprintln(b)
 			if stmts[i+1].Start().Line-stmts[i].End().Line > 1 {
@@ -114,101 +269,397 @@ func formatStmts[T ast.Stmt](stmts []T) string {
 	return b.String()
 }
 
+type assignmentKind int
+
+const (
+	assignmentKindNone assignmentKind = iota
+	assignmentKindDecl
+	assignmentKindStmt
+)
+
+// alignEquals returns the number of spaces which should be written before the = of each statement so that the = of
+// consecutive statements of the same assignment kind are aligned.
+func alignEquals[T ast.Stmt](f *formatter, stmts []T) []int {
+	paddings := make([]int, len(stmts))
+	for start := 0; start < len(stmts); {
+		kind, _ := f.assignmentTarget(stmts[start])
+		end := start + 1
+		for kind != assignmentKindNone && end < len(stmts) && stmts[end].Start().Line-stmts[end-1].End().Line <= 1 {
+			if nextKind, _ := f.assignmentTarget(stmts[end]); nextKind != kind {
+				break
+			}
+			end++
+		}
+		widths := make([]int, end-start)
+		for i, stmt := range stmts[start:end] {
+			_, target := f.assignmentTarget(stmt)
+			widths[i] = runewidth.StringWidth(target)
+		}
+		maxWidth := slices.Max(widths)
+		for i, width := range widths {
+			paddings[start+i] = maxWidth - width
+		}
+		start = end
+	}
+	return paddings
+}
+
+// assignmentTarget returns the kind of assignment which stmt is and the formatted text which precedes its =.
+func (f *formatter) assignmentTarget(stmt ast.Stmt) (assignmentKind, string) {
+	if commentedStmt, ok := stmt.(*ast.CommentedStmt); ok {
+		stmt = commentedStmt.Stmt
+	}
+	switch stmt := stmt.(type) {
+	case *ast.VarDecl:
+		if stmt.Initialiser != nil {
+			target, _ := f.formatFlat(stmt.Var.Type, " ", stmt.Name)
+			return assignmentKindDecl, target
+		}
+	case *ast.ExprStmt:
+		var target string
+		switch expr := stmt.Expr.(type) {
+		case *ast.AssignmentExpr:
+			target, _ = f.formatFlat(expr.Left)
+		case *ast.PropertySetExpr:
+			target, _ = f.formatFlat(expr.Object, token.Dot, expr.Name)
+		case *ast.IndexSetExpr:
+			target, _ = f.formatFlat(expr.Subject, token.LeftBrack, expr.Index, token.RightBrack)
+		default:
+			return assignmentKindNone, ""
+		}
+		return assignmentKindStmt, target
+	}
+	return assignmentKindNone, ""
+}
+
+// takeEqualPadding returns the padding which should be written before the = of the assignment being formatted. The
+// padding is reset so that it's not also applied to any assignments nested inside it.
+func (f *formatter) takeEqualPadding() string {
+	padding := strings.Repeat(" ", f.equalPadding)
+	f.equalPadding = 0
+	return padding
+}
+
 func formatComment(stmt *ast.Comment) string {
 	return stmt.Comment.Lexeme
 }
 
-func formatCommentedStmt(stmt *ast.CommentedStmt) string {
-	return fmt.Sprint(Node(stmt.Stmt), " ", stmt.Comment.Comment.Lexeme)
+// formatDocComments formats the doc comments of a declaration. Each comment is formatted with a single space after the
+// // and without trailing whitespace. Consecutive non-blank comments form a paragraph. A comment which is longer than the
+// maximum line length is broken and the words which don't fit are moved to the start of the next comment in the
+// paragraph, or to a new comment if it's the last one. Comments which already fit are never joined together, so that
+// deliberate line breaks are preserved.
+func (f *formatter) formatDocComments(comments []*ast.Comment) string {
+	maxWidth := 0
+	if f.cfg.MaxLineLength > 0 {
+		maxWidth = f.cfg.MaxLineLength - f.indent - len("// ")
+	}
+	var lines []string
+	var overflow []string
+	for i, comment := range comments {
+		words := strings.Fields(strings.TrimPrefix(comment.Comment.Lexeme, "//"))
+		if len(words) == 0 {
+			lines = append(lines, "//")
+			continue
+		}
+		words = slices.Concat(overflow, words)
+		overflow = nil
+		line, rest := fillLine(words, maxWidth)
+		lines = append(lines, "// "+line)
+		if i == len(comments)-1 || isBlankComment(comments[i+1]) {
+			for len(rest) > 0 {
+				line, rest = fillLine(rest, maxWidth)
+				lines = append(lines, "// "+line)
+			}
+		} else {
+			overflow = rest
+		}
+	}
+	return strings.Join(lines, "\n")
 }
 
-func formatVarDecl(decl *ast.VarDecl) string {
+// fillLine joins as many words as fit in maxWidth into a line and returns it along with the words which didn't fit. At
+// least one word is always placed on the line. If maxWidth is zero or less, all of the words are placed on the line.
+func fillLine(words []string, maxWidth int) (string, []string) {
+	width := runewidth.StringWidth(words[0])
+	n := 1
+	for ; n < len(words); n++ {
+		wordWidth := runewidth.StringWidth(words[n])
+		if maxWidth > 0 && width+1+wordWidth > maxWidth {
+			break
+		}
+		width += 1 + wordWidth
+	}
+	return strings.Join(words[:n], " "), words[n:]
+}
+
+func isBlankComment(comment *ast.Comment) bool {
+	return strings.TrimSpace(strings.TrimPrefix(comment.Comment.Lexeme, "//")) == ""
+}
+
+func (f *formatter) formatCommentedStmt(stmt *ast.CommentedStmt) string {
+	return fmt.Sprint(f.node(stmt.Stmt), " ", stmt.Comment.Comment.Lexeme)
+}
+
+func (f *formatter) formatVarDecl(decl *ast.VarDecl) string {
 	if decl.Initialiser != nil {
-		return fmt.Sprint(token.Var, " ", Node(decl.Name), " ", token.Equal, " ", Node(decl.Initialiser), token.Semicolon)
+		return f.concat(decl.Var.Type, " ", decl.Name, f.takeEqualPadding(), " ", token.Equal, " ", decl.Initialiser,
+			token.Semicolon)
 	} else {
-		return fmt.Sprint(token.Var, " ", Node(decl.Name), token.Semicolon)
+		return f.concat(decl.Var.Type, " ", decl.Name, token.Semicolon)
 	}
 }
 
-func formatFunDecl(decl *ast.FunDecl) string {
+func (f *formatter) formatDestructuringDecl(decl *ast.DestructuringDecl) string {
+	parts := []any{decl.Var.Type, " "}
+	for i, varDecl := range decl.Decls {
+		parts = append(parts, varDecl)
+		if i < len(decl.Decls)-1 {
+			parts = append(parts, token.Comma, " ")
+		}
+	}
+	parts = append(parts, " ", token.Equal, " ", decl.Initialiser, token.Semicolon)
+	return f.concat(parts...)
+}
+
+func formatDestructuredVarDecl(decl *ast.DestructuredVarDecl) string {
+	return formatIdent(decl.Name)
+}
+
+func (f *formatter) formatFunDecl(decl *ast.FunDecl) string {
 	b := new(strings.Builder)
 	if len(decl.DocComments) > 0 {
-		fmt.Fprintln(b, formatStmts(decl.DocComments))
+		fmt.Fprintln(b, f.formatDocComments(decl.DocComments))
+	}
+	for _, annotation := range decl.Annotations {
+		fmt.Fprintln(b, f.node(annotation))
 	}
-	fmt.Fprint(b, token.Fun, " ", Node(decl.Name), Node(decl.Function))
+	fmt.Fprint(b, f.concat(token.Fun, " ", decl.Name, decl.Function))
 	return b.String()
 }
 
-func formatFun(fun *ast.Function) string {
+func (f *formatter) formatFun(fun *ast.Function) string {
 	b := new(strings.Builder)
 	fmt.Fprint(b, token.LeftParen)
 	for i, param := range fun.Params {
-		fmt.Fprint(b, Node(param))
+		fmt.Fprint(b, f.node(param))
 		if i < len(fun.Params)-1 {
 			fmt.Fprint(b, token.Comma, " ")
 		}
 	}
-	fmt.Fprint(b, token.RightParen, " ", formatBlock(fun.Body.Stmts))
+	fmt.Fprint(b, token.RightParen, " ", f.formatBlock(fun.Body.Stmts))
 	return b.String()
 }
 
-func formatParamDecl(decl *ast.ParamDecl) string {
-	return formatIdent(decl.Name)
+func (f *formatter) formatParamDecl(decl *ast.ParamDecl) string {
+	if decl.Default != nil {
+		return f.concat(decl.Name, " ", token.Equal, " ", decl.Default)
+	} else {
+		return formatIdent(decl.Name)
+	}
 }
 
-func formatClassDecl(decl *ast.ClassDecl) string {
+func (f *formatter) formatAnnotation(annotation *ast.Annotation) string {
+	if annotation.LeftParen.IsZero() {
+		return f.concat(token.At, annotation.Name)
+	}
+	parts := []any{token.At, annotation.Name, token.LeftParen}
+	for i, arg := range annotation.Args {
+		parts = append(parts, arg)
+		if i < len(annotation.Args)-1 {
+			parts = append(parts, token.Comma, " ")
+		}
+	}
+	parts = append(parts, token.RightParen)
+	return f.concat(parts...)
+}
+
+func (f *formatter) formatClassDecl(decl *ast.ClassDecl) string {
 	b := new(strings.Builder)
 	if len(decl.DocComments) > 0 {
-		fmt.Fprintln(b, formatStmts(decl.DocComments))
+		fmt.Fprintln(b, f.formatDocComments(decl.DocComments))
 	}
-	fmt.Fprint(b, token.Class, " ", Node(decl.Name), " ")
+	for _, annotation := range decl.Annotations {
+		fmt.Fprintln(b, f.node(annotation))
+	}
+	fmt.Fprint(b, token.Class, " ", f.node(decl.Name), " ")
 	if decl.Superclass.IsValid() {
-		fmt.Fprint(b, token.Less, " ", Node(decl.Superclass), " ")
+		fmt.Fprint(b, token.Less, " ", f.node(decl.Superclass), " ")
 	}
-	fmt.Fprint(b, Node(decl.Body))
+	fmt.Fprint(b, f.formatClassBody(decl.Body))
 	return b.String()
 }
 
-func formatMethodDecl(decl *ast.MethodDecl) string {
+// classMemberKind is the kind of a member of a class. The kinds are ordered by where they appear in a class body when
+// its members are sorted.
+type classMemberKind int
+
+const (
+	classMemberKindStatic classMemberKind = iota
+	classMemberKindInit
+	classMemberKindMethod
+	classMemberKindAccessor
+	classMemberKindNone // comments which don't precede a member
+)
+
+// classMember is a member of a class along with the comments which precede it.
+type classMember struct {
+	stmts []ast.Stmt
+	kind  classMemberKind
+}
+
+func (f *formatter) formatClassBody(body *ast.Block) string {
+	if !f.cfg.SortClassMembers {
+		return f.node(body)
+	}
+	members := classMembers(body.Stmts)
+	sortedMembers := slices.Clone(members)
+	slices.SortStableFunc(sortedMembers, func(x, y *classMember) int {
+		return cmp.Compare(x.kind, y.kind)
+	})
+	if slices.Equal(members, sortedMembers) {
+		return f.node(body)
+	}
+	defer f.save()()
+	f.indent += indentSize
+	// The source lines of reordered members don't say anything about how they should be separated, so they're always
+	// separated by a blank line.
+	formattedMembers := make([]string, len(sortedMembers))
+	for i, member := range sortedMembers {
+		formattedMembers[i] = formatStmts(f, member.stmts)
+	}
+	return fmt.Sprint(token.LeftBrace, "\n", indent(strings.Join(formattedMembers, "\n\n")), "\n", token.RightBrace)
+}
+
+// classMembers groups the statements of a class body into members. Each comment is grouped with the member which
+// follows it. Any comments after the last member are grouped on their own.
+func classMembers(stmts []ast.Stmt) []*classMember {
+	var members []*classMember
+	var comments []ast.Stmt
+	for _, stmt := range stmts {
+		if _, ok := stmt.(*ast.Comment); ok {
+			comments = append(comments, stmt)
+			continue
+		}
+		members = append(members, &classMember{stmts: append(comments, stmt), kind: classMemberKindOf(stmt)})
+		comments = nil
+	}
+	if len(comments) > 0 {
+		members = append(members, &classMember{stmts: comments, kind: classMemberKindNone})
+	}
+	return members
+}
+
+func classMemberKindOf(stmt ast.Stmt) classMemberKind {
+	if commentedStmt, ok := stmt.(*ast.CommentedStmt); ok {
+		stmt = commentedStmt.Stmt
+	}
+	decl, ok := stmt.(*ast.MethodDecl)
+	switch {
+	case !ok:
+		return classMemberKindNone
+	case decl.IsStatic():
+		return classMemberKindStatic
+	case decl.IsInit():
+		return classMemberKindInit
+	case decl.IsAccessor():
+		return classMemberKindAccessor
+	default:
+		return classMemberKindMethod
+	}
+}
+
+func (f *formatter) formatMethodDecl(decl *ast.MethodDecl) string {
 	b := new(strings.Builder)
 	if len(decl.DocComments) > 0 {
-		fmt.Fprintln(b, formatStmts(decl.DocComments))
+		fmt.Fprintln(b, f.formatDocComments(decl.DocComments))
+	}
+	for _, annotation := range decl.Annotations {
+		fmt.Fprintln(b, f.node(annotation))
 	}
 	for _, modifier := range decl.Modifiers {
 		fmt.Fprint(b, modifier.Type, " ")
 	}
-	fmt.Fprint(b, Node(decl.Name), Node(decl.Function))
+	fmt.Fprint(b, f.node(decl.Name), f.node(decl.Function))
 	return b.String()
 }
 
-func formatExprStmt(stmt *ast.ExprStmt) string {
-	return fmt.Sprint(Node(stmt.Expr), token.Semicolon)
+func (f *formatter) formatExprStmt(stmt *ast.ExprStmt) string {
+	return f.concat(stmt.Expr, token.Semicolon)
 }
 
-func formatPrintStmt(stmt *ast.PrintStmt) string {
-	return fmt.Sprint(token.Print, " ", Node(stmt.Expr), token.Semicolon)
+func (f *formatter) formatPrintStmt(stmt *ast.PrintStmt) string {
+	return f.concat(token.Print, " ", stmt.Expr, token.Semicolon)
 }
 
-func formatBlockStmt(stmt *ast.Block) string {
-	return formatBlock(stmt.Stmts)
+func (f *formatter) formatBlockStmt(stmt *ast.Block) string {
+	return f.formatBlock(stmt.Stmts)
 }
 
-func formatBlock[T ast.Stmt](stmts []T) string {
+func (f *formatter) formatBlock(stmts []ast.Stmt) string {
 	if len(stmts) > 0 {
-		return fmt.Sprint(token.LeftBrace, "\n", indent(formatStmts(stmts)), "\n", token.RightBrace)
+		defer f.save()()
+		f.indent += indentSize
+		return fmt.Sprint(token.LeftBrace, "\n", indent(formatStmts(f, stmts)), "\n", token.RightBrace)
 	} else {
 		return fmt.Sprint(token.LeftBrace, "", token.RightBrace)
 	}
 }
 
-func formatIfStmt(stmt *ast.IfStmt) string {
+// formatCondition formats the keyword and parenthesised condition at the start of an if or while statement.
+// If they don't fit on the current line, then the condition is moved onto its own lines.
+func (f *formatter) formatCondition(keyword token.Type, condition ast.Expr, body ast.Stmt) string {
+	defer f.save()()
+	if _, ok := body.(*ast.Block); ok {
+		f.suffix = len(" ") + len(token.LeftBrace.String())
+	} else {
+		f.suffix = 0
+	}
+	flat, fits := f.formatFlat(keyword, " ", token.LeftParen, condition, token.RightParen)
+	if f.flat || fits {
+		return flat
+	}
+
+	b := new(strings.Builder)
+	fmt.Fprint(b, keyword, " ", token.LeftParen, "\n")
+	binaryExpr, ok := condition.(*ast.BinaryExpr)
+	if ok && (binaryExpr.Op.Type == token.And || binaryExpr.Op.Type == token.Or) {
+		operands, ops := logicalOperands(binaryExpr)
+		for i, operand := range operands {
+			if i < len(ops) {
+				fmt.Fprint(b, f.indentedLine(operand, " ", ops[i].Lexeme), "\n")
+			} else {
+				fmt.Fprint(b, f.indentedLine(operand), "\n")
+			}
+		}
+	} else {
+		fmt.Fprint(b, f.indentedLine(condition), "\n")
+	}
+	fmt.Fprint(b, token.RightParen)
+	return b.String()
+}
+
+// logicalOperands returns the operands of a chain of binary expressions with the same logical operator, along with the
+// operators which separate them.
+func logicalOperands(expr *ast.BinaryExpr) ([]ast.Expr, []token.Token) {
+	var operands []ast.Expr
+	var ops []token.Token
+	if left, ok := expr.Left.(*ast.BinaryExpr); ok && left.Op.Type == expr.Op.Type {
+		operands, ops = logicalOperands(left)
+	} else {
+		operands = []ast.Expr{expr.Left}
+	}
+	return append(operands, expr.Right), append(ops, expr.Op)
+}
+
+func (f *formatter) formatIfStmt(stmt *ast.IfStmt) string {
 	b := new(strings.Builder)
-	fmt.Fprint(b, token.If, " ", token.LeftParen, Node(stmt.Condition), token.RightParen)
+	fmt.Fprint(b, f.formatCondition(token.If, stmt.Condition, stmt.Then))
 	var thenIsBlock bool
 	if _, thenIsBlock = stmt.Then.(*ast.Block); thenIsBlock {
-		fmt.Fprint(b, " ", Node(stmt.Then))
+		fmt.Fprint(b, " ", f.node(stmt.Then))
 	} else {
-		fmt.Fprint(b, "\n", indent(Node(stmt.Then)))
+		fmt.Fprint(b, "\n", f.indentedLine(stmt.Then))
 	}
 	if stmt.Else != nil {
 		if thenIsBlock {
@@ -218,42 +669,55 @@ func formatIfStmt(stmt *ast.IfStmt) string {
 		}
 		switch stmt.Else.(type) {
 		case *ast.IfStmt, *ast.Block:
-			fmt.Fprint(b, token.Else, " ", Node(stmt.Else))
+			fmt.Fprint(b, token.Else, " ", f.node(stmt.Else))
 		default:
-			fmt.Fprint(b, token.Else, "\n", indent(Node(stmt.Else)))
+			fmt.Fprint(b, token.Else, "\n", f.indentedLine(stmt.Else))
 		}
 	}
 	return b.String()
 }
 
-func formatWhileStmt(stmt *ast.WhileStmt) string {
+func (f *formatter) formatTryStmt(stmt *ast.TryStmt) string {
+	return fmt.Sprint(token.Try, " ", f.node(stmt.Body), " ", token.Catch, " ", token.LeftParen, f.node(stmt.ErrorVar),
+		token.RightParen, " ", f.node(stmt.CatchBody))
+}
+
+func (f *formatter) formatWithStmt(stmt *ast.WithStmt) string {
+	header := f.concat(token.With, " ", token.LeftParen, stmt.Var.Type, " ", stmt.Resource, " ", token.Equal, " ", stmt.Value,
+		token.RightParen)
+	return fmt.Sprint(header, " ", f.node(stmt.Body))
+}
+
+func (f *formatter) formatWhileStmt(stmt *ast.WhileStmt) string {
+	condition := f.formatCondition(token.While, stmt.Condition, stmt.Body)
 	if _, ok := stmt.Body.(*ast.Block); ok {
-		return fmt.Sprint(token.While, " ", token.LeftParen, Node(stmt.Condition), token.RightParen, " ", Node(stmt.Body))
+		return fmt.Sprint(condition, " ", f.node(stmt.Body))
 	} else {
-		return fmt.Sprint(token.While, " ", token.LeftParen, Node(stmt.Condition), token.RightParen, "\n", indent(Node(stmt.Body)))
+		return fmt.Sprint(condition, "\n", f.indentedLine(stmt.Body))
 	}
 }
 
-func formatForStmt(stmt *ast.ForStmt) string {
-	b := new(strings.Builder)
-	fmt.Fprint(b, token.For, " ", token.LeftParen)
+func (f *formatter) formatForStmt(stmt *ast.ForStmt) string {
+	parts := []any{token.For, " ", token.LeftParen}
 	if stmt.Initialise != nil {
-		fmt.Fprint(b, Node(stmt.Initialise))
+		parts = append(parts, stmt.Initialise)
 	} else {
-		fmt.Fprint(b, token.Semicolon)
+		parts = append(parts, token.Semicolon)
 	}
 	if stmt.Condition != nil {
-		fmt.Fprint(b, " ", Node(stmt.Condition))
+		parts = append(parts, " ", stmt.Condition)
 	}
-	fmt.Fprint(b, token.Semicolon)
+	parts = append(parts, token.Semicolon)
 	if stmt.Update != nil {
-		fmt.Fprint(b, " ", Node(stmt.Update))
+		parts = append(parts, " ", stmt.Update)
 	}
-	fmt.Fprint(b, token.RightParen)
+	parts = append(parts, token.RightParen)
+	b := new(strings.Builder)
+	fmt.Fprint(b, f.concat(parts...))
 	if _, ok := stmt.Body.(*ast.Block); ok {
-		fmt.Fprint(b, " ", Node(stmt.Body))
+		fmt.Fprint(b, " ", f.node(stmt.Body))
 	} else {
-		fmt.Fprint(b, "\n", indent(Node(stmt.Body)))
+		fmt.Fprint(b, "\n", f.indentedLine(stmt.Body))
 	}
 	return b.String()
 }
@@ -266,9 +730,9 @@ func formatContinueStmt(*ast.ContinueStmt) string {
 	return fmt.Sprint(token.Continue, "", token.Semicolon)
 }
 
-func formatReturnStmt(stmt *ast.ReturnStmt) string {
+func (f *formatter) formatReturnStmt(stmt *ast.ReturnStmt) string {
 	if stmt.Value != nil {
-		return fmt.Sprint(token.Return, " ", Node(stmt.Value), token.Semicolon)
+		return f.concat(token.Return, " ", stmt.Value, token.Semicolon)
 	} else {
 		return fmt.Sprint(token.Return, "", token.Semicolon)
 	}
@@ -278,92 +742,125 @@ func formatLiteralExpr(expr *ast.LiteralExpr) string {
 	return expr.Value.Lexeme
 }
 
-func formatFunExpr(expr *ast.FunExpr) string {
-	return fmt.Sprint(token.Fun, Node(expr.Function))
+func (f *formatter) formatFunExpr(expr *ast.FunExpr) string {
+	return f.concat(token.Fun, expr.Function)
 }
 
-func formatListExpr(expr *ast.ListExpr) string {
-	b := new(strings.Builder)
-	fmt.Fprint(b, token.LeftBrack)
+func (f *formatter) formatListExpr(expr *ast.ListExpr) string {
+	parts := []any{token.LeftBrack}
 	for i, el := range expr.Elements {
-		fmt.Fprint(b, Node(el))
+		parts = append(parts, el)
 		if i < len(expr.Elements)-1 {
-			fmt.Fprint(b, token.Comma, " ")
+			parts = append(parts, token.Comma, " ")
 		}
 	}
-	fmt.Fprint(b, token.RightBrack)
-	return b.String()
+	parts = append(parts, token.RightBrack)
+	return f.concat(parts...)
 }
 
 func formatIdentExpr(expr *ast.IdentExpr) string {
 	return expr.Ident.String()
 }
 
-func formatAssignmentExpr(expr *ast.AssignmentExpr) string {
-	return fmt.Sprint(Node(expr.Left), " ", token.Equal, " ", Node(expr.Right))
+func (f *formatter) formatAssignmentExpr(expr *ast.AssignmentExpr) string {
+	return f.concat(expr.Left, f.takeEqualPadding(), " ", token.Equal, " ", expr.Right)
 }
 
 func formatThisExpr(*ast.ThisExpr) string {
 	return token.This.String()
 }
 
-func formatSuperExpr(*ast.SuperExpr) string {
-	return token.Super.String()
+func (f *formatter) formatSuperExpr(expr *ast.SuperExpr) string {
+	if expr.Name == nil {
+		return token.Super.String()
+	}
+	return f.concat(token.Super, token.Dot, expr.Name)
 }
 
-func formatCallExpr(expr *ast.CallExpr) string {
-	b := new(strings.Builder)
-	fmt.Fprint(b, Node(expr.Callee), token.LeftParen)
-	for i, arg := range expr.Args {
-		fmt.Fprint(b, Node(arg))
-		if i < len(expr.Args)-1 {
-			fmt.Fprint(b, token.Comma, " ")
+func (f *formatter) formatCallExpr(expr *ast.CallExpr) string {
+	args := make([]ast.Node, 0, len(expr.Args)+len(expr.NamedArgs))
+	for _, arg := range expr.Args {
+		args = append(args, arg)
+	}
+	for _, namedArg := range expr.NamedArgs {
+		args = append(args, namedArg)
+	}
+
+	parts := []any{expr.Callee, token.LeftParen}
+	for i, arg := range args {
+		parts = append(parts, arg)
+		if i < len(args)-1 {
+			parts = append(parts, token.Comma, " ")
 		}
 	}
+	parts = append(parts, token.RightParen)
+	if f.flat || len(args) == 0 {
+		return f.concat(parts...)
+	}
+	if flat, fits := f.formatFlat(parts...); fits {
+		return flat
+	}
+
+	b := new(strings.Builder)
+	fmt.Fprint(b, f.concat(expr.Callee, token.LeftParen, "\n"))
+	for _, arg := range args {
+		fmt.Fprint(b, f.indentedLine(arg, token.Comma), "\n")
+	}
 	fmt.Fprint(b, token.RightParen)
 	return b.String()
 }
 
-func formatIndexExpr(expr *ast.IndexExpr) string {
-	return fmt.Sprint(Node(expr.Subject), token.LeftBrack, Node(expr.Index), token.RightBrack)
+func (f *formatter) formatNamedArg(arg *ast.NamedArg) string {
+	return f.concat(arg.Name, token.Colon, " ", arg.Value)
 }
 
-func formatIndexSetExpr(expr *ast.IndexSetExpr) string {
-	return fmt.Sprint(Node(expr.Subject), token.LeftBrack, Node(expr.Index), token.RightBrack, " ", token.Equal, " ", Node(expr.Value))
+func (f *formatter) formatIndexExpr(expr *ast.IndexExpr) string {
+	return f.concat(expr.Subject, token.LeftBrack, expr.Index, token.RightBrack)
 }
 
-func formatPropertyExpr(expr *ast.PropertyExpr) string {
-	return fmt.Sprint(Node(expr.Object), token.Dot, Node(expr.Name))
+func (f *formatter) formatIndexSetExpr(expr *ast.IndexSetExpr) string {
+	padding := f.takeEqualPadding()
+	return f.concat(expr.Subject, token.LeftBrack, expr.Index, token.RightBrack, padding, " ", token.Equal, " ", expr.Value)
 }
 
-func formatPropertySetExpr(expr *ast.PropertySetExpr) string {
-	return fmt.Sprint(Node(expr.Object), token.Dot, Node(expr.Name), " ", token.Equal, " ", Node(expr.Value))
+func (f *formatter) formatPropertyExpr(expr *ast.PropertyExpr) string {
+	return f.concat(expr.Object, token.Dot, expr.Name)
 }
 
-func formatUnaryExpr(expr *ast.UnaryExpr) string {
-	return fmt.Sprint(expr.Op.Lexeme, Node(expr.Right))
+func (f *formatter) formatPropertySetExpr(expr *ast.PropertySetExpr) string {
+	padding := f.takeEqualPadding()
+	return f.concat(expr.Object, token.Dot, expr.Name, padding, " ", token.Equal, " ", expr.Value)
 }
 
-func formatBinaryExpr(expr *ast.BinaryExpr) string {
+func (f *formatter) formatUnaryExpr(expr *ast.UnaryExpr) string {
+	return f.concat(expr.Op.Lexeme, expr.Right)
+}
+
+func (f *formatter) formatBinaryExpr(expr *ast.BinaryExpr) string {
 	leftSpace := " "
 	if expr.Op.Type == token.Comma {
 		// Comma operator is a special case where we don't want a space before it. A binary expression with a comma
 		// operator should be formatted as "a, b" rather than "a , b".
 		leftSpace = ""
 	}
-	return fmt.Sprint(Node(expr.Left), leftSpace, expr.Op.Lexeme, " ", Node(expr.Right))
+	return f.concat(expr.Left, leftSpace, expr.Op.Lexeme, " ", expr.Right)
+}
+
+func (f *formatter) formatTernaryExpr(expr *ast.TernaryExpr) string {
+	return f.concat(expr.Condition, " ", token.Question, " ", expr.Then, " ", token.Colon, " ", expr.Else)
 }
 
-func formatTernaryExpr(expr *ast.TernaryExpr) string {
-	return fmt.Sprint(Node(expr.Condition), " ", token.Question, " ", Node(expr.Then), " ", token.Colon, " ", Node(expr.Else))
+func (f *formatter) formatTryExpr(expr *ast.TryExpr) string {
+	return f.concat(token.Try, " ", expr.Expr)
 }
 
-func formatTryExpr(expr *ast.TryExpr) string {
-	return fmt.Sprint(token.Try, " ", Node(expr.Expr))
+func (f *formatter) formatGroupExpr(expr *ast.GroupExpr) string {
+	return f.concat(token.LeftParen, expr.Expr, token.RightParen)
 }
 
-func formatGroupExpr(expr *ast.GroupExpr) string {
-	return fmt.Sprint(token.LeftParen, Node(expr.Expr), token.RightParen)
+// Indentation returns the indentation of a line which is nested inside depth blocks.
+func Indentation(depth int) string {
+	return strings.Repeat(" ", depth*indentSize)
 }
 
 func indent(s string) string {
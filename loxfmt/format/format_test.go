@@ -0,0 +1,339 @@
+package format_test
+
+import (
+	"flag"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/marcuscaisey/lox/golox/ast"
+	"github.com/marcuscaisey/lox/golox/parser"
+	"github.com/marcuscaisey/lox/loxfmt/format"
+)
+
+var update = flag.Bool("update", false, "updates the golden files")
+
+func TestNodeWithConfigDocComments(t *testing.T) {
+	tests := []struct {
+		name          string
+		src           string
+		maxLineLength int
+		want          string
+	}{
+		{
+			name:          "space after slashes normalised",
+			src:           "//Adds.\n//    Returns sum.   \nfun add() {}",
+			maxLineLength: 100,
+			want:          "// Adds.\n// Returns sum.\nfun add() {}\n",
+		},
+		{
+			name:          "short lines not joined",
+			src:           "// First line.\n// Second line.\nclass Foo {}",
+			maxLineLength: 100,
+			want:          "// First line.\n// Second line.\nclass Foo {}\n",
+		},
+		{
+			name:          "long line broken",
+			src:           "// one two three four\nfun f() {}",
+			maxLineLength: 13,
+			want:          "// one two\n// three four\nfun f() {}\n",
+		},
+		{
+			name:          "overflow moved to next line in paragraph",
+			src:           "// one two three\n// four\nfun f() {}",
+			maxLineLength: 13,
+			want:          "// one two\n// three four\nfun f() {}\n",
+		},
+		{
+			name:          "overflow not moved past blank line",
+			src:           "// one two three\n//\n// four\nfun f() {}",
+			maxLineLength: 12,
+			want:          "// one two\n// three\n//\n// four\nfun f() {}\n",
+		},
+		{
+			name:          "trailing blank line preserved",
+			src:           "// Doc.\n//\nfun f() {}",
+			maxLineLength: 100,
+			want:          "// Doc.\n//\nfun f() {}\n",
+		},
+		{
+			name:          "method doc comment accounts for indentation",
+			src:           "class Foo {\n  // one two three\n  method() {}\n}",
+			maxLineLength: 14,
+			want:          "class Foo {\n  // one two\n  // three\n  method() {}\n}\n",
+		},
+		{
+			name:          "non-doc comment unchanged",
+			src:           "//Not   a doc comment.   \nprint 1;",
+			maxLineLength: 10,
+			want:          "//Not   a doc comment.   \nprint 1;\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			program, err := parser.Parse(strings.NewReader(test.src), "test.lox", parser.WithComments(true))
+			if err != nil {
+				t.Fatalf("parsing program: %s", err)
+			}
+
+			got := format.NodeWithConfig(program, format.Config{MaxLineLength: test.maxLineLength})
+
+			if got != test.want {
+				t.Errorf("NodeWithConfig(%q) =\n%s\nwant:\n%s", test.src, got, test.want)
+			}
+		})
+	}
+}
+
+func TestNodeDocCommentsIdempotent(t *testing.T) {
+	src := `class Circle {
+    // Creates a circle.
+    // r is the radius.
+  init(r) {
+    this.r = r;
+  }
+  // Returns the area.
+  area() {
+    return 3 * this.r * this.r;
+  }
+  // The unit circle.
+  static unit() {
+    return Circle(1);
+  }
+}
+`
+	want := `class Circle {
+  // Creates a circle.
+  // r is the radius.
+  init(r) {
+    this.r = r;
+  }
+
+  // Returns the area.
+  area() {
+    return 3 * this.r * this.r;
+  }
+
+  // The unit circle.
+  static unit() {
+    return Circle(1);
+  }
+}
+`
+	program, err := parser.Parse(strings.NewReader(src), "test.lox", parser.WithComments(true))
+	if err != nil {
+		t.Fatalf("parsing program: %s", err)
+	}
+
+	got := format.Node(program)
+
+	if got != want {
+		t.Errorf("Node() =\n%s\nwant:\n%s", got, want)
+	}
+
+	program, err = parser.Parse(strings.NewReader(got), "test.lox", parser.WithComments(true))
+	if err != nil {
+		t.Fatalf("parsing formatted program: %s", err)
+	}
+	if gotAgain := format.Node(program); gotAgain != got {
+		t.Errorf("Node() of formatted program =\n%s\nwant formatted program unchanged:\n%s", gotAgain, got)
+	}
+}
+
+func TestNodeWithConfigMaxLineLength(t *testing.T) {
+	tests := []struct {
+		name          string
+		src           string
+		maxLineLength int
+		want          string
+	}{
+		{
+			name:          "short call not wrapped",
+			src:           `f(a, b);`,
+			maxLineLength: 100,
+			want:          "f(a, b);\n",
+		},
+		{
+			name:          "call exactly at limit not wrapped",
+			src:           `f(aaaa, bbbb);`,
+			maxLineLength: 14,
+			want:          "f(aaaa, bbbb);\n",
+		},
+		{
+			name:          "call one over limit wrapped",
+			src:           `f(aaaa, bbbb);`,
+			maxLineLength: 13,
+			want:          "f(\n  aaaa,\n  bbbb,\n);\n",
+		},
+		{
+			name:          "outermost call wrapped first",
+			src:           `var x = outer(inner(a, b), c);`,
+			maxLineLength: 25,
+			want:          "var x = outer(\n  inner(a, b),\n  c,\n);\n",
+		},
+		{
+			name:          "nested call wrapped when still too long",
+			src:           `outer(inner(argumentOne, argumentTwo));`,
+			maxLineLength: 20,
+			want:          "outer(\n  inner(\n    argumentOne,\n    argumentTwo,\n  ),\n);\n",
+		},
+		{
+			name:          "call in block accounts for indentation",
+			src:           `{ f(aaaa, bbbb); }`,
+			maxLineLength: 15,
+			want:          "{\n  f(\n    aaaa,\n    bbbb,\n  );\n}\n",
+		},
+		{
+			name:          "zero max line length never wraps",
+			src:           `f(aaaa, bbbb);`,
+			maxLineLength: 0,
+			want:          "f(aaaa, bbbb);\n",
+		},
+		{
+			name:          "short condition not wrapped",
+			src:           `if (a and b) { print a; }`,
+			maxLineLength: 100,
+			want:          "if (a and b) {\n  print a;\n}\n",
+		},
+		{
+			name:          "long logical condition wrapped",
+			src:           `while (conditionOne and conditionTwo and conditionThree) { print a; }`,
+			maxLineLength: 40,
+			want:          "while (\n  conditionOne and\n  conditionTwo and\n  conditionThree\n) {\n  print a;\n}\n",
+		},
+		{
+			name:          "long non-logical condition wrapped",
+			src:           `if (someLongFunctionName(a) == otherValue) print a;`,
+			maxLineLength: 30,
+			want:          "if (\n  someLongFunctionName(a) == otherValue\n)\n  print a;\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			program, err := parser.Parse(strings.NewReader(test.src), "test.lox", parser.WithComments(true))
+			if err != nil {
+				t.Fatalf("parsing program: %s", err)
+			}
+
+			got := format.NodeWithConfig(program, format.Config{MaxLineLength: test.maxLineLength})
+
+			if got != test.want {
+				t.Errorf("NodeWithConfig(%q) =\n%s\nwant:\n%s", test.src, got, test.want)
+			}
+		})
+	}
+}
+
+func TestNodeAnnotations(t *testing.T) {
+	src := `@deprecated("use sum instead")
+@pure
+fun add(a, b) {
+  return a + b;
+}
+
+@test
+class Foo {
+  @deprecated
+  static bar() {}
+}
+`
+	program, err := parser.Parse(strings.NewReader(src), "test.lox", parser.WithComments(true))
+	if err != nil {
+		t.Fatalf("parsing program: %s", err)
+	}
+
+	funDecl, ok := program.Stmts[0].(*ast.FunDecl)
+	if !ok {
+		t.Fatalf("first statement is %T, want *ast.FunDecl", program.Stmts[0])
+	}
+	annotation, ok := funDecl.Annotation("deprecated")
+	if !ok {
+		t.Fatalf("add has no deprecated annotation")
+	}
+	if len(annotation.Args) != 1 {
+		t.Errorf("deprecated annotation has %d arguments, want 1", len(annotation.Args))
+	}
+	if _, ok := funDecl.Annotation("pure"); !ok {
+		t.Errorf("add has no pure annotation")
+	}
+	if got, want := funDecl.Start().Line, 1; got != want {
+		t.Errorf("add starts on line %d, want %d", got, want)
+	}
+
+	classDecl, ok := program.Stmts[1].(*ast.ClassDecl)
+	if !ok {
+		t.Fatalf("second statement is %T, want *ast.ClassDecl", program.Stmts[1])
+	}
+	if _, ok := classDecl.Annotation("test"); !ok {
+		t.Errorf("Foo has no test annotation")
+	}
+	if _, ok := classDecl.Methods()[0].Annotation("deprecated"); !ok {
+		t.Errorf("Foo.bar has no deprecated annotation")
+	}
+
+	if got := format.Node(program); got != src {
+		t.Errorf("Node(%q) =\n%s\nwant:\n%s", src, got, src)
+	}
+}
+
+func TestNodeWithConfigSortClassMembers(t *testing.T) {
+	src, err := os.ReadFile("testdata/sort_class_members.lox")
+	if err != nil {
+		t.Fatal(err)
+	}
+	program, err := parser.Parse(strings.NewReader(string(src)), "test.lox", parser.WithComments(true))
+	if err != nil {
+		t.Fatalf("parsing program: %s", err)
+	}
+
+	got := format.NodeWithConfig(program, format.Config{MaxLineLength: format.DefaultMaxLineLength, SortClassMembers: true})
+
+	goldenPath := "testdata/sort_class_members.golden"
+	if *update {
+		if err := os.WriteFile(goldenPath, []byte(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("NodeWithConfig() =\n%s\nwant (from %s):\n%s\nRun with -update to update the golden file.", got, goldenPath, want)
+	}
+
+	if got := format.Node(program); got != string(src) {
+		t.Errorf("Node() =\n%s\nwant source unchanged when SortClassMembers is false", got)
+	}
+}
+
+func TestNodeWithConfigAlignAssignments(t *testing.T) {
+	src, err := os.ReadFile("testdata/align_assignments.lox")
+	if err != nil {
+		t.Fatal(err)
+	}
+	program, err := parser.Parse(strings.NewReader(string(src)), "test.lox", parser.WithComments(true))
+	if err != nil {
+		t.Fatalf("parsing program: %s", err)
+	}
+
+	got := format.NodeWithConfig(program, format.Config{MaxLineLength: format.DefaultMaxLineLength, AlignAssignments: true})
+
+	goldenPath := "testdata/align_assignments.golden"
+	if *update {
+		if err := os.WriteFile(goldenPath, []byte(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("NodeWithConfig() =\n%s\nwant (from %s):\n%s\nRun with -update to update the golden file.", got, goldenPath, want)
+	}
+
+	if got := format.Node(program); got != string(src) {
+		t.Errorf("Node() =\n%s\nwant source unchanged when AlignAssignments is false", got)
+	}
+}
@@ -3,14 +3,18 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
+	"strings"
 
 	"github.com/marcuscaisey/lox/golox/ast"
 	"github.com/marcuscaisey/lox/golox/parser"
+	"github.com/marcuscaisey/lox/golox/watch"
 	"github.com/marcuscaisey/lox/loxfmt/format"
 )
 
@@ -35,6 +39,10 @@ func cli() int {
 	}
 	write := flag.Bool("write", false, "Write result to (source) file instead of stdout")
 	printAST := flag.Bool("ast", false, "Print the AST")
+	maxLineLength := flag.Int("max-line-length", format.DefaultMaxLineLength, "Maximum line length before long lines are broken")
+	sortClassMembers := flag.Bool("sort-class-members", false, "Sort class members into static members, init, methods, then property accessors")
+	alignAssignments := flag.Bool("align-assignments", false, "Align the = of consecutive variable declarations and assignments")
+	watchFile := flag.Bool("watch", false, "Format the file in-place each time it changes until interrupted")
 	printHelp := flag.Bool("help", false, "Print this message")
 
 	flag.Parse()
@@ -44,7 +52,12 @@ func cli() int {
 		return 0
 	}
 
-	if err := loxfmt(flag.Args(), *write, *printAST); err != nil {
+	cfg := format.Config{
+		MaxLineLength:    *maxLineLength,
+		SortClassMembers: *sortClassMembers,
+		AlignAssignments: *alignAssignments,
+	}
+	if err := loxfmt(flag.Args(), *write, *printAST, cfg, *watchFile); err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		var usageErr usageError
 		if errors.As(err, &usageErr) {
@@ -58,19 +71,28 @@ func cli() int {
 	return 0
 }
 
-func loxfmt(args []string, write bool, printAST bool) error {
+func loxfmt(args []string, write bool, printAST bool, cfg format.Config, watchFile bool) error {
 	if len(args) > 1 {
 		return usageError("at most one path can be provided")
 	}
 	if len(args) == 0 && write {
 		return usageError("cannot use -write with standard input")
 	}
+	if watchFile {
+		if len(args) == 0 {
+			return usageError("cannot use -watch with standard input")
+		}
+		if printAST {
+			return usageError("cannot use -watch with -ast")
+		}
+		return watchAndFormat(args[0], cfg)
+	}
 
 	reader := io.Reader(os.Stdin)
 	filename := "<stdin>"
 	if len(args) > 0 {
-		path := args[0]
-		data, err := os.ReadFile(path)
+		filename = args[0]
+		data, err := os.ReadFile(filename)
 		if err != nil {
 			return err
 		}
@@ -86,7 +108,7 @@ func loxfmt(args []string, write bool, printAST bool) error {
 		return err
 	}
 
-	formatted := format.Node(program)
+	formatted := format.NodeWithConfig(program, cfg)
 	if write {
 		if err := os.WriteFile(filename, []byte(formatted), 0644); err != nil {
 			return fmt.Errorf("failed to write formatted source to file: %w", err)
@@ -97,3 +119,60 @@ func loxfmt(args []string, write bool, printAST bool) error {
 
 	return nil
 }
+
+// watchAndFormat formats a file in-place and then again each time it changes until interrupted.
+func watchAndFormat(filename string, cfg format.Config) error {
+	formatFile := func() {
+		if err := formatInPlace(filename, cfg); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}
+	formatFile()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	return watch.File(ctx, filename, formatFile)
+}
+
+// formatInPlace formats a file and writes the result back to it if it's changed, reporting which lines were changed.
+func formatInPlace(filename string, cfg format.Config) error {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	program, err := parser.Parse(bytes.NewReader(src), filename, parser.WithComments(true))
+	if err != nil {
+		return err
+	}
+	formatted := format.NodeWithConfig(program, cfg)
+	if formatted == string(src) {
+		return nil
+	}
+	if err := os.WriteFile(filename, []byte(formatted), 0644); err != nil {
+		return fmt.Errorf("failed to write formatted source to file: %w", err)
+	}
+	first, last := changedLines(string(src), formatted)
+	if first == last {
+		fmt.Printf("formatted %s: line %d changed\n", filename, first)
+	} else {
+		fmt.Printf("formatted %s: lines %d-%d changed\n", filename, first, last)
+	}
+	return nil
+}
+
+// changedLines returns the first and last (1-based) lines of after which differ from before.
+func changedLines(before string, after string) (int, int) {
+	beforeLines := strings.Split(before, "\n")
+	afterLines := strings.Split(after, "\n")
+	prefix := 0
+	for prefix < min(len(beforeLines), len(afterLines)) && beforeLines[prefix] == afterLines[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < min(len(beforeLines), len(afterLines))-prefix &&
+		beforeLines[len(beforeLines)-1-suffix] == afterLines[len(afterLines)-1-suffix] {
+		suffix++
+	}
+	first := prefix + 1
+	last := max(len(afterLines)-suffix, first)
+	return first, last
+}
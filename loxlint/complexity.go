@@ -0,0 +1,55 @@
+package main
+
+import (
+	"github.com/marcuscaisey/lox/golox/ast"
+	"github.com/marcuscaisey/lox/golox/loxerr"
+	"github.com/marcuscaisey/lox/golox/token"
+)
+
+// checkComplexity reports the functions and methods in the program whose cyclomatic complexity is more than
+// maxComplexity.
+func checkComplexity(program *ast.Program, maxComplexity int) loxerr.Errors {
+	var errs loxerr.Errors
+	ast.Walk(program, func(node ast.Node) bool {
+		var rang token.Range
+		var fun *ast.Function
+		switch node := node.(type) {
+		case *ast.FunDecl:
+			rang, fun = node.Name, node.Function
+		case *ast.MethodDecl:
+			rang, fun = node.Name, node.Function
+		case *ast.FunExpr:
+			rang, fun = node.Fun, node.Function
+		default:
+			return true
+		}
+		if complexity := cyclomaticComplexity(fun); complexity > maxComplexity {
+			errs.Addf(rang, loxerr.Hint, "function has a cyclomatic complexity of %d which is more than the maximum of %d",
+				complexity, maxComplexity)
+		}
+		return true
+	})
+	return errs
+}
+
+// cyclomaticComplexity returns the cyclomatic complexity of a function, which is one more than the number of decision
+// points in its body. The decision points are if, while, and for statements, and, and or expressions, and ternary
+// expressions. The decision points of nested functions and classes aren't counted.
+func cyclomaticComplexity(fun *ast.Function) int {
+	complexity := 1
+	ast.WalkChildren(fun, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.FunDecl, *ast.FunExpr, *ast.ClassDecl:
+			return false
+		case *ast.IfStmt, *ast.WhileStmt, *ast.ForStmt, *ast.TernaryExpr:
+			complexity++
+		case *ast.BinaryExpr:
+			if node.Op.Type == token.And || node.Op.Type == token.Or {
+				complexity++
+			}
+		default:
+		}
+		return true
+	})
+	return complexity
+}
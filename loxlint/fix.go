@@ -0,0 +1,185 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/marcuscaisey/lox/golox/analyse"
+	"github.com/marcuscaisey/lox/golox/ast"
+	"github.com/marcuscaisey/lox/golox/loxerr"
+	"github.com/marcuscaisey/lox/golox/token"
+)
+
+// fix describes a change to the source code which fixes a problem.
+// The bytes in the range [start, end) of the source code are replaced with newText.
+type fix struct {
+	start       int
+	end         int
+	newText     string
+	pos         token.Position
+	description string
+}
+
+// String formats the fix as the location of the problem it fixes in the form filename:line:col, followed by its
+// description. The filename is omitted if the problem's file has no name.
+func (f *fix) String() string {
+	loc := f.pos.String()
+	if name := f.pos.File.Name; name != "" {
+		loc = name + ":" + loc
+	}
+	return fmt.Sprintf("%s: %s", loc, f.description)
+}
+
+// findFixes returns the fixes for the problems which can be fixed automatically, along with the problems which can't.
+// The following problems can be fixed:
+//   - unused variable declarations are removed, unless their initialiser may have side effects
+//   - var is replaced with let in a block or for loop
+//   - unused parameters are renamed to _
+//   - unreachable code after a return statement is removed
+//
+// The fixes are sorted by their position in the source code and don't overlap.
+func findFixes(program *ast.Program, src []byte, loxErrs loxerr.Errors) ([]*fix, loxerr.Errors) {
+	varDecls := map[token.Position]*ast.VarDecl{}
+	for _, decl := range stmtVarDecls(program) {
+		varDecls[decl.Name.Start()] = decl
+	}
+	params := map[token.Position]*ast.ParamDecl{}
+	ast.Walk(program, func(param *ast.ParamDecl) bool {
+		params[param.Name.Start()] = param
+		return true
+	})
+
+	var fixes []*fix
+	var unfixable loxerr.Errors
+	for _, err := range loxErrs {
+		var f *fix
+		switch {
+		case err.Msg == analyse.LetInsteadOfVarMsg:
+			f = &fix{
+				start:       err.Start().Offset(),
+				end:         err.End().Offset(),
+				newText:     token.Let.String(),
+				description: fmt.Sprintf("replace %m with %m", token.Var, token.Let),
+			}
+		case err.Msg == analyse.UnreachableCodeMsg:
+			start, end := expandToLines(src, err.Start().Offset(), err.End().Offset())
+			f = &fix{start: start, end: end, description: "remove unreachable code"}
+		case strings.HasSuffix(err.Msg, analyse.UnusedDeclMsgSuffix):
+			if decl, ok := varDecls[err.Start()]; ok && !hasSideEffects(decl.Initialiser) {
+				start, end := expandToLines(src, decl.Start().Offset(), decl.End().Offset())
+				f = &fix{start: start, end: end, description: fmt.Sprintf("remove unused declaration of %m", decl.Name)}
+			} else if param, ok := params[err.Start()]; ok {
+				f = &fix{
+					start:       param.Name.Start().Offset(),
+					end:         param.Name.End().Offset(),
+					newText:     token.IdentBlank,
+					description: fmt.Sprintf("rename unused parameter %m to '%s'", param.Name, token.IdentBlank),
+				}
+			}
+		}
+		if f == nil {
+			unfixable = append(unfixable, err)
+			continue
+		}
+		f.pos = err.Start()
+		fixes = append(fixes, f)
+	}
+
+	// Sort the fixes by their start, with the largest fix first if more than one starts at the same position, so
+	// that fixes which are contained by another, such as a let replacement in unreachable code, can be dropped.
+	slices.SortFunc(fixes, func(x, y *fix) int {
+		return cmp.Or(cmp.Compare(x.start, y.start), cmp.Compare(y.end, x.end))
+	})
+	var nonOverlapping []*fix
+	for _, f := range fixes {
+		if len(nonOverlapping) > 0 && f.start < nonOverlapping[len(nonOverlapping)-1].end {
+			continue
+		}
+		nonOverlapping = append(nonOverlapping, f)
+	}
+
+	return nonOverlapping, unfixable
+}
+
+// applyFixes returns the result of applying fixes to src. fixes must be sorted by their position in the source code
+// and not overlap.
+func applyFixes(src []byte, fixes []*fix) []byte {
+	fixed := slices.Clone(src)
+	// The fixes are applied in reverse order so that applying one doesn't change the position of those before it.
+	for _, f := range slices.Backward(fixes) {
+		fixed = slices.Concat(fixed[:f.start], []byte(f.newText), fixed[f.end:])
+	}
+	return fixed
+}
+
+// stmtVarDecls returns the variable declarations which are statements of the program or of a block. The declarations
+// in the initialiser of a for loop are excluded since they can't be removed.
+func stmtVarDecls(program *ast.Program) []*ast.VarDecl {
+	var decls []*ast.VarDecl
+	addDecls := func(stmts []ast.Stmt) {
+		for _, stmt := range stmts {
+			if commentedStmt, ok := stmt.(*ast.CommentedStmt); ok {
+				stmt = commentedStmt.Stmt
+			}
+			if decl, ok := stmt.(*ast.VarDecl); ok {
+				decls = append(decls, decl)
+			}
+		}
+	}
+	addDecls(program.Stmts)
+	ast.Walk(program, func(block *ast.Block) bool {
+		addDecls(block.Stmts)
+		return true
+	})
+	return decls
+}
+
+// hasSideEffects reports whether evaluating expr may have side effects.
+func hasSideEffects(expr ast.Expr) bool {
+	if expr == nil {
+		return false
+	}
+	_, found := ast.Find(expr, func(node ast.Node) bool {
+		switch node.(type) {
+		case *ast.CallExpr, *ast.AssignmentExpr, *ast.PropertySetExpr, *ast.IndexSetExpr:
+			return true
+		default:
+			return false
+		}
+	})
+	return found
+}
+
+// expandToLines expands the range [start, end) of src to cover the whole lines that it spans, including the trailing
+// newline, if there's only whitespace before and after it on those lines. Otherwise, the range is expanded to cover the
+// whitespace after it, or before it if there's only whitespace after it on its last line, so that removing it doesn't
+// leave behind any trailing or doubled whitespace.
+func expandToLines(src []byte, start, end int) (int, int) {
+	lineStart := start
+	for lineStart > 0 && isHorizontalSpace(src[lineStart-1]) {
+		lineStart--
+	}
+	lineEnd := end
+	for lineEnd < len(src) && isHorizontalSpace(src[lineEnd]) {
+		lineEnd++
+	}
+	atLineStart := lineStart == 0 || src[lineStart-1] == '\n'
+	atLineEnd := lineEnd == len(src) || src[lineEnd] == '\n'
+	switch {
+	case atLineStart && atLineEnd:
+		if lineEnd < len(src) {
+			lineEnd++
+		}
+		return lineStart, lineEnd
+	case atLineEnd:
+		return lineStart, end
+	default:
+		return start, lineEnd
+	}
+}
+
+func isHorizontalSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\r'
+}
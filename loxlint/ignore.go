@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// disableFileDirective is the comment which disables linting of a file when it appears in the comments at the top of
+// it.
+const disableFileDirective = "// loxlint:disable-file"
+
+// validateIgnorePattern returns an error if pattern is not a valid -ignore-path glob pattern.
+func validateIgnorePattern(pattern string) error {
+	for _, segment := range strings.Split(pattern, "/") {
+		if _, err := path.Match(segment, ""); err != nil {
+			return fmt.Errorf("invalid -ignore-path %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// isIgnored reports whether filename matches any of the -ignore-path glob patterns.
+func isIgnored(filename string, patterns []string) bool {
+	filename = filepath.ToSlash(filepath.Clean(filename))
+	for _, pattern := range patterns {
+		if matchGlob(pattern, filename) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchGlob reports whether name matches the glob pattern. Each /-separated segment of the pattern has the syntax of
+// [path.Match], except for ** which matches any number of segments, including none.
+func matchGlob(pattern string, name string) bool {
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchSegments(patterns []string, names []string) bool {
+	for len(patterns) > 0 {
+		if patterns[0] == "**" {
+			for i := range len(names) + 1 {
+				if matchSegments(patterns[1:], names[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(names) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(patterns[0], names[0]); !ok {
+			return false
+		}
+		patterns, names = patterns[1:], names[1:]
+	}
+	return len(names) == 0
+}
+
+// isDisabled reports whether the source contains the disable file directive in the comments at the top of it.
+func isDisabled(src []byte) bool {
+	for line := range bytes.Lines(src) {
+		line := string(bytes.TrimSpace(line))
+		if line == disableFileDirective {
+			return true
+		}
+		if line != "" && !strings.HasPrefix(line, "//") {
+			return false
+		}
+	}
+	return false
+}
@@ -3,15 +3,22 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
+	"slices"
 
 	"github.com/marcuscaisey/lox/golox/analyse"
+	"github.com/marcuscaisey/lox/golox/ast"
 	"github.com/marcuscaisey/lox/golox/builtins"
+	"github.com/marcuscaisey/lox/golox/loxerr"
 	"github.com/marcuscaisey/lox/golox/parser"
+	"github.com/marcuscaisey/lox/golox/typecheck"
+	"github.com/marcuscaisey/lox/golox/watch"
 )
 
 func main() {
@@ -33,6 +40,18 @@ func cli() int {
 		fmt.Fprintln(os.Stderr, "Options:")
 		flag.PrintDefaults()
 	}
+	minSeverity := flag.String("min-severity", "hint", "Only report problems at least this severe (hint, warning, or error)")
+	maxParams := flag.Int("max-params", 0, "Report functions with more than this many parameters (0 disables the check)")
+	maxComplexity := flag.Int("max-complexity", 0, "Report functions with a cyclomatic complexity more than this (0 disables the check)")
+	check := flag.Bool("check", false, "Print a summary of the number of hints, warnings, and errors and exit with the number of errors")
+	fix := flag.Bool("fix", false, "Fix problems which can be fixed automatically and write the result to (source) file")
+	fixDryRun := flag.Bool("fix-dry-run", false, "Print the fixes which -fix would make without writing them")
+	watchFile := flag.Bool("watch", false, "Lint the file again each time it changes until interrupted")
+	var ignorePaths []string
+	flag.Func("ignore-path", "Don't lint the file if its path matches this glob `pattern` (can be repeated)", func(pattern string) error {
+		ignorePaths = append(ignorePaths, pattern)
+		return nil
+	})
 	printHelp := flag.Bool("help", false, "Print this message")
 
 	flag.Parse()
@@ -42,7 +61,32 @@ func cli() int {
 		return 0
 	}
 
-	if err := loxlint(flag.Args()); err != nil {
+	run := func() error {
+		return loxlint(flag.Args(), *minSeverity, *maxParams, *maxComplexity, *fix, *fixDryRun, ignorePaths)
+	}
+	if !*watchFile {
+		return exitCode(run(), *check)
+	}
+	if flag.NArg() == 0 {
+		return exitCode(usageError("cannot use -watch with standard input"), *check)
+	}
+	// Only problems found in the file are reported on each change. Any other error would be returned every time.
+	err := run()
+	code := exitCode(err, *check)
+	if err != nil && !errors.As(err, new(loxerr.Errors)) {
+		return code
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if err := watch.File(ctx, flag.Arg(0), func() { exitCode(run(), *check) }); err != nil {
+		return exitCode(err, false)
+	}
+	return 0
+}
+
+// exitCode reports the error returned by loxlint, if any, and returns the exit code which it corresponds to.
+func exitCode(err error, check bool) int {
+	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		var usageErr usageError
 		if errors.As(err, &usageErr) {
@@ -50,33 +94,147 @@ func cli() int {
 			flag.Usage()
 			return 2
 		}
+		var loxErrs loxerr.Errors
+		if check && errors.As(err, &loxErrs) {
+			return printSummary(loxErrs)
+		}
 		return 1
 	}
 
+	if check {
+		return printSummary(nil)
+	}
 	return 0
 }
 
-func loxlint(args []string) error {
+// maxCheckExitCode is the maximum exit code when -check is provided. Exit codes above 125 have special meanings to
+// shells.
+const maxCheckExitCode = 125
+
+// printSummary prints a summary of the number of each type of error to stderr and returns the number of fatal errors,
+// capped at maxCheckExitCode, to be used as the exit code.
+func printSummary(loxErrs loxerr.Errors) int {
+	numHints := len(loxErrs.Filter(loxerr.Hint))
+	numWarnings := len(loxErrs.Filter(loxerr.Warning))
+	numErrors := len(loxErrs.Fatal())
+	fmt.Fprintf(os.Stderr, "%d hints, %d warnings, %d errors\n", numHints, numWarnings, numErrors)
+	return min(numErrors, maxCheckExitCode)
+}
+
+// severities maps the values of the -min-severity flag to the corresponding error type.
+var severities = map[string]loxerr.Type{
+	"hint":    loxerr.Hint,
+	"warning": loxerr.Warning,
+	"error":   loxerr.Fatal,
+}
+
+func loxlint(args []string, minSeverity string, maxParams int, maxComplexity int, fix bool, fixDryRun bool, ignorePaths []string) error {
 	if len(args) > 1 {
 		return usageError("at most one path can be provided")
 	}
+	minType, ok := severities[minSeverity]
+	if !ok {
+		return usageError(fmt.Sprintf("invalid -min-severity %q: must be one of hint, warning, or error", minSeverity))
+	}
+	if maxParams < 0 {
+		return usageError(fmt.Sprintf("invalid -max-params %d: must not be negative", maxParams))
+	}
+	if maxComplexity < 0 {
+		return usageError(fmt.Sprintf("invalid -max-complexity %d: must not be negative", maxComplexity))
+	}
+	if len(args) == 0 && fix {
+		return usageError("cannot use -fix with standard input")
+	}
+	if fix && fixDryRun {
+		return usageError("cannot use -fix with -fix-dry-run")
+	}
+	for _, pattern := range ignorePaths {
+		if err := validateIgnorePattern(pattern); err != nil {
+			return usageError(err.Error())
+		}
+	}
+	// Standard input has no path, so it's never ignored.
+	if len(args) > 0 && isIgnored(args[0], ignorePaths) {
+		return nil
+	}
 
 	filename := "<stdin>"
 	reader := io.Reader(os.Stdin)
 	if len(args) > 0 {
-		filename := args[0]
-		data, err := os.ReadFile(filename)
+		filename = args[0]
+		file, err := os.Open(filename)
 		if err != nil {
 			return err
 		}
-		reader = bytes.NewReader(data)
+		defer file.Close()
+		reader = file
+	}
+	src, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	if isDisabled(src) {
+		return nil
+	}
+
+	program, loxErrs, err := lint(src, filename, minType, maxParams, maxComplexity)
+	if err != nil {
+		return err
+	}
+	if !fix && !fixDryRun {
+		return loxErrs.Err()
+	}
+
+	fixes, unfixable := findFixes(program, src, loxErrs)
+	if fixDryRun {
+		for _, f := range fixes {
+			fmt.Println(f)
+		}
+		return unfixable.Err()
 	}
 
-	program, err := parser.Parse(reader, filename)
+	if len(fixes) == 0 {
+		return loxErrs.Err()
+	}
+	fixed := applyFixes(src, fixes)
+	if err := os.WriteFile(filename, fixed, 0644); err != nil {
+		return fmt.Errorf("failed to write fixed source to file: %w", err)
+	}
+	// The source is linted again so that the positions of the remaining problems refer to the fixed source.
+	_, loxErrs, err = lint(fixed, filename, minType, maxParams, maxComplexity)
 	if err != nil {
 		return err
 	}
+	return loxErrs.Err()
+}
+
+// lint parses the source code and returns the program along with the problems found in it which are at least as severe
+// as minType. Functions with more than maxParams parameters are reported if maxParams is positive and functions with a
+// cyclomatic complexity more than maxComplexity are reported if maxComplexity is positive. If the source code can't be
+// parsed, then an error is returned.
+func lint(src []byte, filename string, minType loxerr.Type, maxParams int, maxComplexity int) (*ast.Program, loxerr.Errors, error) {
+	program, err := parser.Parse(bytes.NewReader(src), filename)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	builtins := builtins.MustParseStubs("builtins.lox")
-	return analyse.Program(program, builtins)
+	analyseErr := analyse.Program(program, builtins)
+	_, typecheckErr := typecheck.Infer(program, builtins)
+	var analyseLoxErrs, typecheckLoxErrs loxerr.Errors
+	errors.As(analyseErr, &analyseLoxErrs)
+	errors.As(typecheckErr, &typecheckLoxErrs)
+	loxErrs := slices.Concat(analyseLoxErrs, typecheckLoxErrs)
+	if maxParams > 0 {
+		loxErrs = slices.Concat(loxErrs, checkNumParams(program, maxParams))
+	}
+	if maxComplexity > 0 {
+		loxErrs = slices.Concat(loxErrs, checkComplexity(program, maxComplexity))
+	}
+	// Error types are ordered from most to least severe.
+	var types []loxerr.Type
+	for typ := loxerr.Fatal; typ <= minType; typ++ {
+		types = append(types, typ)
+	}
+	return program, loxErrs.Filter(types...), nil
 }
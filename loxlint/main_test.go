@@ -25,6 +25,321 @@ func TestLoxlint(t *testing.T) {
 	loxtest.Run(t, runner)
 }
 
+func TestCheck(t *testing.T) {
+	loxlintPath := loxtest.MustBuildBinary(t, "loxlint")
+	path := filepath.Join(t.TempDir(), "test.lox")
+	src := `fun f() {
+  let unused = 1;
+}
+f();
+print -"a";
+break;
+break;
+`
+	if err := os.WriteFile(path, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command(loxlintPath, "-check", path)
+	_, err := cmd.Output()
+
+	exitErr := &exec.ExitError{}
+	if !errors.As(err, &exitErr) {
+		t.Fatalf("running loxlint: %v", err)
+	}
+	if got, want := exitErr.ExitCode(), 2; got != want {
+		t.Errorf("exit code = %d, want %d", got, want)
+	}
+	lines := strings.Split(strings.TrimSpace(string(exitErr.Stderr)), "\n")
+	if got, want := lines[len(lines)-1], "1 hints, 1 warnings, 2 errors"; got != want {
+		t.Errorf("summary = %q, want %q\nstderr:\n%s", got, want, exitErr.Stderr)
+	}
+}
+
+func TestMinSeverity(t *testing.T) {
+	loxlintPath := loxtest.MustBuildBinary(t, "loxlint")
+	path := filepath.Join(t.TempDir(), "test.lox")
+	src := `fun f() {
+  let unused = 1;
+}
+f();
+print -"a";
+`
+	if err := os.WriteFile(path, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		minSeverity  string
+		wantExitCode int
+		wantLines    []string
+	}{
+		{
+			minSeverity:  "hint",
+			wantExitCode: 1,
+			wantLines: []string{
+				"test.lox:2:7: hint: 'unused' has been declared but is never used",
+				"test.lox:5:7: warning: '-' operator cannot be used with type 'string'",
+			},
+		},
+		{
+			minSeverity:  "warning",
+			wantExitCode: 1,
+			wantLines: []string{
+				"test.lox:5:7: warning: '-' operator cannot be used with type 'string'",
+			},
+		},
+		{
+			minSeverity:  "error",
+			wantExitCode: 0,
+			wantLines:    nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.minSeverity, func(t *testing.T) {
+			cmd := exec.Command(loxlintPath, "-min-severity", test.minSeverity, "test.lox")
+			cmd.Dir = filepath.Dir(path)
+			var stderr strings.Builder
+			cmd.Stderr = &stderr
+			err := cmd.Run()
+
+			exitErr := &exec.ExitError{}
+			if err != nil && !errors.As(err, &exitErr) {
+				t.Fatalf("running loxlint: %v", err)
+			}
+			if got := cmd.ProcessState.ExitCode(); got != test.wantExitCode {
+				t.Errorf("exit code = %d, want %d", got, test.wantExitCode)
+			}
+			gotLines := regexp.MustCompile(`(?m)^test\.lox:\d+:\d+: .+$`).FindAllString(stderr.String(), -1)
+			if diff := loxtest.LinesDiff(gotLines, test.wantLines); diff != "" {
+				t.Errorf("incorrect problems reported:\n%s\nstderr:\n%s", diff, stderr.String())
+			}
+		})
+	}
+}
+
+func TestMaxParams(t *testing.T) {
+	loxlintPath := loxtest.MustBuildBinary(t, "loxlint")
+	path := filepath.Join(t.TempDir(), "test.lox")
+	src := `fun f(a, b, c, d, e, g) {
+  return a + b + c + d + e + g;
+}
+print f(1, 2, 3, 4, 5, 6);
+`
+	if err := os.WriteFile(path, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		args         []string
+		wantExitCode int
+		wantLines    []string
+	}{
+		{
+			name:         "disabled",
+			args:         nil,
+			wantExitCode: 0,
+			wantLines:    nil,
+		},
+		{
+			name:         "exceeded",
+			args:         []string{"-max-params", "5"},
+			wantExitCode: 1,
+			wantLines: []string{
+				"test.lox:1:7: hint: function has 6 parameters which is more than the maximum of 5",
+			},
+		},
+		{
+			name:         "not exceeded",
+			args:         []string{"-max-params", "6"},
+			wantExitCode: 0,
+			wantLines:    nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cmd := exec.Command(loxlintPath, append(test.args, "test.lox")...)
+			cmd.Dir = filepath.Dir(path)
+			var stderr strings.Builder
+			cmd.Stderr = &stderr
+			err := cmd.Run()
+
+			exitErr := &exec.ExitError{}
+			if err != nil && !errors.As(err, &exitErr) {
+				t.Fatalf("running loxlint: %v", err)
+			}
+			if got := cmd.ProcessState.ExitCode(); got != test.wantExitCode {
+				t.Errorf("exit code = %d, want %d", got, test.wantExitCode)
+			}
+			gotLines := regexp.MustCompile(`(?m)^test\.lox:\d+:\d+: .+$`).FindAllString(stderr.String(), -1)
+			if diff := loxtest.LinesDiff(gotLines, test.wantLines); diff != "" {
+				t.Errorf("incorrect problems reported:\n%s\nstderr:\n%s", diff, stderr.String())
+			}
+		})
+	}
+}
+
+func TestMaxComplexity(t *testing.T) {
+	loxlintPath := loxtest.MustBuildBinary(t, "loxlint")
+	path := filepath.Join(t.TempDir(), "test.lox")
+	src := `fun classify(n) {
+  if (n < 0) {
+    if (n < -100 or n == -1) {
+      return "very negative";
+    }
+    return "negative";
+  }
+  while (n > 10) {
+    n = n / 10;
+  }
+  let digit = fun(x) {
+    return x < 5 ? "low" : "high";
+  };
+  return n == 0 ? "zero" : digit(n);
+}
+print classify(5);
+`
+	if err := os.WriteFile(path, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		args         []string
+		wantExitCode int
+		wantLines    []string
+	}{
+		{
+			name:         "disabled",
+			args:         nil,
+			wantExitCode: 0,
+			wantLines:    nil,
+		},
+		{
+			name:         "exceeded",
+			args:         []string{"-max-complexity", "1"},
+			wantExitCode: 1,
+			wantLines: []string{
+				"test.lox:1:5:   hint: function has a cyclomatic complexity of 6 which is more than the maximum of 1",
+				"test.lox:11:15: hint: function has a cyclomatic complexity of 2 which is more than the maximum of 1",
+			},
+		},
+		{
+			name:         "not exceeded",
+			args:         []string{"-max-complexity", "6"},
+			wantExitCode: 0,
+			wantLines:    nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cmd := exec.Command(loxlintPath, append(test.args, "test.lox")...)
+			cmd.Dir = filepath.Dir(path)
+			var stderr strings.Builder
+			cmd.Stderr = &stderr
+			err := cmd.Run()
+
+			exitErr := &exec.ExitError{}
+			if err != nil && !errors.As(err, &exitErr) {
+				t.Fatalf("running loxlint: %v", err)
+			}
+			if got := cmd.ProcessState.ExitCode(); got != test.wantExitCode {
+				t.Errorf("exit code = %d, want %d", got, test.wantExitCode)
+			}
+			gotLines := regexp.MustCompile(`(?m)^test\.lox:\d+:\d+: .+$`).FindAllString(stderr.String(), -1)
+			if diff := loxtest.LinesDiff(gotLines, test.wantLines); diff != "" {
+				t.Errorf("incorrect problems reported:\n%s\nstderr:\n%s", diff, stderr.String())
+			}
+		})
+	}
+}
+
+func TestFix(t *testing.T) {
+	loxlintPath := loxtest.MustBuildBinary(t, "loxlint")
+	src, err := os.ReadFile("testdata/fix.lox")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "test.lox")
+	if err := os.WriteFile(path, src, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command(loxlintPath, "-fix", "test.lox")
+	cmd.Dir = filepath.Dir(path)
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+	err = cmd.Run()
+
+	exitErr := &exec.ExitError{}
+	if !errors.As(err, &exitErr) {
+		t.Fatalf("running loxlint: %v", err)
+	}
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile("testdata/fix.golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := loxtest.TextDiff(string(got), string(want)); diff != "" {
+		t.Errorf("incorrect fixed source:\n%s", diff)
+	}
+	gotLines := regexp.MustCompile(`(?m)^test\.lox:\d+:\d+: .+$`).FindAllString(stderr.String(), -1)
+	wantLines := []string{"test.lox:6:7: hint: 'unusedCall' has been declared but is never used"}
+	if diff := loxtest.LinesDiff(gotLines, wantLines); diff != "" {
+		t.Errorf("incorrect problems reported:\n%s\nstderr:\n%s", diff, stderr.String())
+	}
+}
+
+func TestFixDryRun(t *testing.T) {
+	loxlintPath := loxtest.MustBuildBinary(t, "loxlint")
+	src, err := os.ReadFile("testdata/fix.lox")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "test.lox")
+	if err := os.WriteFile(path, src, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command(loxlintPath, "-fix-dry-run", "test.lox")
+	cmd.Dir = filepath.Dir(path)
+	stdout, err := cmd.Output()
+
+	exitErr := &exec.ExitError{}
+	if !errors.As(err, &exitErr) {
+		t.Fatalf("running loxlint: %v", err)
+	}
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(src) {
+		t.Errorf("source file was modified:\n%s", got)
+	}
+	gotLines := strings.Split(strings.TrimSpace(string(stdout)), "\n")
+	wantLines := []string{
+		"test.lox:4:10: rename unused parameter 'b' to '_'",
+		"test.lox:5:3: replace 'var' with 'let'",
+		"test.lox:6:7: remove unused declaration of 'unused'",
+		"test.lox:7:3: replace 'var' with 'let'",
+		"test.lox:8:8: replace 'var' with 'let'",
+		"test.lox:12:3: remove unreachable code",
+		"test.lox:18:19: remove unused declaration of 'alsoUnused'",
+	}
+	if diff := loxtest.LinesDiff(gotLines, wantLines); diff != "" {
+		t.Errorf("incorrect fixes printed to stdout:\n%s\nstdout:\n%s", diff, stdout)
+	}
+	gotProblems := regexp.MustCompile(`(?m)^test\.lox:\d+:\d+: .+$`).FindAllString(string(exitErr.Stderr), -1)
+	wantProblems := []string{"test.lox:7:7: hint: 'unusedCall' has been declared but is never used"}
+	if diff := loxtest.LinesDiff(gotProblems, wantProblems); diff != "" {
+		t.Errorf("incorrect problems reported:\n%s\nstderr:\n%s", diff, exitErr.Stderr)
+	}
+}
+
 func newRunner(rootDir string, loxlintPath string) *runner {
 	return &runner{
 		rootDir:     rootDir,
@@ -97,7 +412,7 @@ func (r *runner) mustRunLoxlint(t *testing.T, path string) *loxlintResult {
 	var errors []string
 	var warnings []string
 	var hints []string
-	errorWarningHintRe := regexp.MustCompile(`(?m)^\d+:\d+: (error|warning|hint): (.+)$`)
+	errorWarningHintRe := regexp.MustCompile(`(?m)^(?:.+:)?\d+:\d+: +(error|warning|hint): (.+)$`)
 	for _, match := range errorWarningHintRe.FindAllStringSubmatch(string(exitErr.Stderr), -1) {
 		switch string(match[1]) {
 		case "error":
@@ -184,3 +499,72 @@ func (r *runner) Update(t *testing.T, path string) {
 		t.Fatal(err)
 	}
 }
+
+func TestIgnorePath(t *testing.T) {
+	loxlintPath := loxtest.MustBuildBinary(t, "loxlint")
+	dir := t.TempDir()
+	src := []byte("print -\"a\";\n")
+	filenames := []string{
+		"main.lox",
+		"lib/util.lox",
+		"generated/a.lox",
+		"generated/nested/b.lox",
+		"vendor/c.lox",
+	}
+	for _, filename := range filenames {
+		path := filepath.Join(dir, filename)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, src, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ignoreArgs := []string{"-ignore-path", "generated/**", "-ignore-path", "vendor/*.lox"}
+	wantLinted := map[string]bool{
+		"main.lox":     true,
+		"lib/util.lox": true,
+	}
+	for _, filename := range filenames {
+		t.Run(filename, func(t *testing.T) {
+			cmd := exec.Command(loxlintPath, append(ignoreArgs, filename)...)
+			cmd.Dir = dir
+			var stderr strings.Builder
+			cmd.Stderr = &stderr
+			err := cmd.Run()
+
+			exitErr := &exec.ExitError{}
+			if err != nil && !errors.As(err, &exitErr) {
+				t.Fatalf("running loxlint: %v", err)
+			}
+			linted := strings.Contains(stderr.String(), "warning: '-' operator cannot be used with type 'string'")
+			if linted != wantLinted[filename] {
+				t.Errorf("file linted = %t, want %t\nstderr:\n%s", linted, wantLinted[filename], stderr.String())
+			}
+		})
+	}
+}
+
+func TestDisableFile(t *testing.T) {
+	loxlintPath := loxtest.MustBuildBinary(t, "loxlint")
+	path := filepath.Join(t.TempDir(), "test.lox")
+	src := `// Code generated by a transpiler. DO NOT EDIT.
+// loxlint:disable-file
+
+print -"a";
+break;
+`
+	if err := os.WriteFile(path, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command(loxlintPath, path)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("running loxlint: %v\noutput:\n%s", err, output)
+	}
+	if len(output) > 0 {
+		t.Errorf("loxlint printed output, want none:\n%s", output)
+	}
+}
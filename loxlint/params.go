@@ -0,0 +1,19 @@
+package main
+
+import (
+	"github.com/marcuscaisey/lox/golox/ast"
+	"github.com/marcuscaisey/lox/golox/loxerr"
+)
+
+// checkNumParams reports the functions and methods in the program which declare more than maxParams parameters.
+func checkNumParams(program *ast.Program, maxParams int) loxerr.Errors {
+	var errs loxerr.Errors
+	ast.Walk(program, func(fun *ast.Function) bool {
+		if len(fun.Params) > maxParams {
+			errs.AddSpanningRangesf(fun.Params[0], fun.Params[len(fun.Params)-1], loxerr.Hint,
+				"function has %d parameters which is more than the maximum of %d", len(fun.Params), maxParams)
+		}
+		return true
+	})
+	return errs
+}
@@ -11,6 +11,7 @@ type Client struct {
 	in     io.Reader
 	out    io.Writer
 	server *server
+	nextID int
 }
 
 func newClient(in io.Reader, out io.Writer, server *server) *Client {
@@ -38,6 +39,31 @@ func (c *Client) Notify(method string, params any) error {
 	return nil
 }
 
+// Request sends a request to the server. The response to the request is not waited for.
+func (c *Client) Request(method string, params any) error {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("sending %q request: marshalling parameters to JSON: %s", method, err)
+	}
+	c.nextID++
+	req := &request{
+		JSONRPC: validJSONRPC,
+		ID:      intOrStr{int: c.nextID, isInt: true},
+		Method:  method,
+		Params:  ptrTo(json.RawMessage(data)),
+	}
+	if err := c.server.write(req); err != nil {
+		return fmt.Errorf("sending %q request: %s", method, err)
+	}
+	return nil
+}
+
+// Exit stops the server once the message currently being handled has been handled. The given exit code is reported by
+// [Serve].
+func (c *Client) Exit(code int) {
+	c.server.exitCode = &code
+}
+
 func ptrTo[T any](v T) *T {
 	return &v
 }
@@ -12,6 +12,9 @@ const (
 	MethodNotFound ErrorCode = -32601
 	InvalidParams  ErrorCode = -32602
 	InternalError  ErrorCode = -32603
+	// RequestCancelled is returned when a request has been canceled by the client. It's defined by LSP, rather than
+	// JSON-RPC.
+	RequestCancelled ErrorCode = -32800
 )
 
 // NewError returns an error which can be encoded as a JSON-RPC error response.
@@ -33,6 +36,11 @@ func NewInvalidRequestError(errorMsg string) error {
 	return newErrorWithErrorField(InvalidRequest, "Invalid Request", errorMsg)
 }
 
+// NewRequestCancelledError returns an error indicating that the request was canceled by the client.
+func NewRequestCancelledError() error {
+	return NewError(RequestCancelled, "Request cancelled", nil)
+}
+
 func newParseError(errorMsg string) error {
 	return newErrorWithErrorField(ParseError, "Parse error", errorMsg)
 }
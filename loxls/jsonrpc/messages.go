@@ -57,6 +57,21 @@ type response struct {
 
 func (r *response) isMessage() {}
 
+// batch is a batch of messages which are sent together as an array.
+//
+// https://www.jsonrpc.org/specification#batch
+type batch struct {
+	Entries []*batchEntry
+}
+
+func (b *batch) isMessage() {}
+
+// batchEntry is an entry of a batch. Either Msg or Err is set.
+type batchEntry struct {
+	Msg message        // The message, if the entry is valid.
+	Err *responseError // The error describing why the entry is invalid, if it is.
+}
+
 // responseError is an error object in case a request fails.
 //
 // https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#responseError
@@ -81,6 +96,41 @@ type combinedMessage struct {
 }
 
 func unmarshalMessage(content []byte) (message, error) {
+	if bytes.HasPrefix(bytes.TrimLeft(content, " \t\r\n"), []byte("[")) {
+		return unmarshalBatch(content)
+	}
+	return unmarshalSingleMessage(content)
+}
+
+func unmarshalBatch(content []byte) (message, error) {
+	var rawEntries []json.RawMessage
+	if err := json.Unmarshal(content, &rawEntries); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			return nil, newParseError(err.Error())
+		}
+		return nil, NewInvalidRequestError(err.Error())
+	}
+	if len(rawEntries) == 0 {
+		return nil, NewInvalidRequestError("batch cannot be empty")
+	}
+	b := &batch{Entries: make([]*batchEntry, len(rawEntries))}
+	for i, rawEntry := range rawEntries {
+		msg, err := unmarshalSingleMessage(rawEntry)
+		if err != nil {
+			var respErr *responseError
+			if !errors.As(err, &respErr) {
+				respErr = NewInvalidRequestError(err.Error()).(*responseError)
+			}
+			b.Entries[i] = &batchEntry{Err: respErr}
+			continue
+		}
+		b.Entries[i] = &batchEntry{Msg: msg}
+	}
+	return b, nil
+}
+
+func unmarshalSingleMessage(content []byte) (message, error) {
 	var combinedMsg combinedMessage
 	if err := json.Unmarshal(content, &combinedMsg); err != nil {
 		var syntaxErr *json.SyntaxError
@@ -4,6 +4,7 @@ package jsonrpc
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,22 +13,30 @@ import (
 	"mime"
 	"strconv"
 	"strings"
+	"sync"
 )
 
 // Handler handles JSON-RPC requests and notifications.
 type Handler interface {
-	// HandleRequest responds to a JSON-RPC request.
-	HandleRequest(method string, params *json.RawMessage) (any, error)
+	// HandleRequest responds to a JSON-RPC request. ctx is canceled when a $/cancelRequest notification for the request
+	// is received, after which HandleRequest should return promptly. If the returned error wraps [context.Canceled], then
+	// the request is responded to with a [RequestCancelled] error.
+	HandleRequest(ctx context.Context, method string, params *json.RawMessage) (any, error)
 	// HandleNotification handles a JSON-RPC notification.
 	HandleNotification(method string, params *json.RawMessage)
 	// SetClient sets the client that the handler can use to send requests and notifications to the server's client.
 	SetClient(*Client)
 }
 
-// Serve reads JSON-RPC messages from in, passes them to handler, and writes the responses to out.
-func Serve(in io.Reader, out io.Writer, handler Handler) error {
+// Serve reads JSON-RPC messages from in, passes them to handler, and writes the responses to out. Messages are passed to
+// the handler one at a time in the order that they were read. The context passed to the handler for each request is
+// derived from ctx.
+//
+// Serve returns when in is exhausted, ctx is canceled, or after the handler calls [Client.Exit]. If the handler calls
+// [Client.Exit] with a non-zero exit code, then an [*ExitError] is returned.
+func Serve(ctx context.Context, in io.Reader, out io.Writer, handler Handler) error {
 	server := newServer(in, out, handler)
-	return server.Serve()
+	return server.Serve(ctx)
 }
 
 type server struct {
@@ -35,13 +44,35 @@ type server struct {
 	out     io.Writer
 	handler Handler
 	client  *Client
+	// exitCode is set when the handler requests that the server exits.
+	exitCode *int
+
+	mu sync.Mutex
+	// pendingRequests holds the requests which have been read but not responded to yet.
+	pendingRequests map[intOrStr]*pendingRequest
+}
+
+// pendingRequest is a request which has been read but not responded to yet.
+type pendingRequest struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// ExitError is returned by [Serve] when the handler requests that the server exits with a non-zero exit code.
+type ExitError struct {
+	Code int
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("server exited with code %d", e.Code)
 }
 
 func newServer(in io.Reader, out io.Writer, handler Handler) *server {
 	server := &server{
-		in:      bufio.NewReader(in),
-		out:     out,
-		handler: handler,
+		in:              bufio.NewReader(in),
+		out:             out,
+		handler:         handler,
+		pendingRequests: map[intOrStr]*pendingRequest{},
 	}
 	client := newClient(in, out, server)
 	handler.SetClient(client)
@@ -49,10 +80,28 @@ func newServer(in io.Reader, out io.Writer, handler Handler) *server {
 	return server
 }
 
-func (s *server) Serve() error {
+// readResult is the result of reading a message.
+type readResult struct {
+	msg message
+	err error
+}
+
+func (s *server) Serve(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan readResult)
+	go s.readMessages(ctx, results)
+
 	for {
-		msg, err := s.read()
-		if err != nil {
+		var result readResult
+		select {
+		case result = <-results:
+		case <-ctx.Done():
+			return fmt.Errorf("serving jsonrpc requests: %w", ctx.Err())
+		}
+
+		if err := result.err; err != nil {
 			if errors.Is(err, io.EOF) {
 				slog.Info("EOF reached, stopping server")
 				return nil
@@ -68,9 +117,115 @@ func (s *server) Serve() error {
 			return fmt.Errorf("serving jsonrpc requests: %v", err)
 		}
 
-		if err := s.handle(msg); err != nil {
+		if err := s.handle(result.msg); err != nil {
 			return fmt.Errorf("serving jsonrpc requests: %v", err)
 		}
+
+		if s.exitCode != nil {
+			slog.Info("Exit requested, stopping server", "code", *s.exitCode)
+			if *s.exitCode != 0 {
+				return &ExitError{Code: *s.exitCode}
+			}
+			return nil
+		}
+	}
+}
+
+// readMessages reads messages and sends the results to results until reading fails with an error which isn't a
+// [*responseError], or ctx is canceled.
+//
+// Each request is registered as pending with a context derived from ctx as soon as it's read. $/cancelRequest
+// notifications are also handled as soon as they're read, so that requests can be canceled whilst they're being handled.
+func (s *server) readMessages(ctx context.Context, results chan<- readResult) {
+	for {
+		msg, err := s.read()
+		switch msg := msg.(type) {
+		case *request:
+			s.addPendingRequest(ctx, msg)
+		case *notification:
+			if msg.Method == cancelRequestMethod {
+				s.cancelRequest(msg.Params)
+				continue
+			}
+		case *batch:
+			for _, entry := range msg.Entries {
+				switch entryMsg := entry.Msg.(type) {
+				case *request:
+					s.addPendingRequest(ctx, entryMsg)
+				case *notification:
+					if entryMsg.Method == cancelRequestMethod {
+						s.cancelRequest(entryMsg.Params)
+					}
+				}
+			}
+		}
+
+		select {
+		case results <- readResult{msg: msg, err: err}:
+		case <-ctx.Done():
+			return
+		}
+
+		var respErr *responseError
+		if err != nil && !errors.As(err, &respErr) {
+			return
+		}
+	}
+}
+
+const cancelRequestMethod = "$/cancelRequest"
+
+// cancelParams are the parameters of a $/cancelRequest notification.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#cancelRequest
+type cancelParams struct {
+	ID intOrStr `json:"id"` // The request id to cancel.
+}
+
+// cancelRequest cancels the context of the request identified by the parameters of a $/cancelRequest notification. The
+// notification is ignored if the request has already been responded to.
+func (s *server) cancelRequest(params *json.RawMessage) {
+	var cancelParams cancelParams
+	if params == nil {
+		slog.Warn("Ignoring $/cancelRequest notification without params")
+		return
+	}
+	if err := json.Unmarshal(*params, &cancelParams); err != nil {
+		slog.Warn("Ignoring $/cancelRequest notification with invalid params", "error", err)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if req, ok := s.pendingRequests[cancelParams.ID]; ok {
+		slog.Info("Canceling request", "id", cancelParams.ID.String())
+		req.cancel()
+	}
+}
+
+// addPendingRequest registers a request which has been read as pending until it's responded to.
+func (s *server) addPendingRequest(ctx context.Context, req *request) {
+	ctx, cancel := context.WithCancel(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pendingRequests[req.ID] = &pendingRequest{ctx: ctx, cancel: cancel}
+}
+
+// requestContext returns the context of a pending request and a function which must be called once the request
+// has been responded to.
+func (s *server) requestContext(id intOrStr) (context.Context, func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	req, ok := s.pendingRequests[id]
+	if !ok {
+		return context.Background(), func() {}
+	}
+	return req.ctx, func() {
+		req.cancel()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.pendingRequests[id] == req {
+			delete(s.pendingRequests, id)
+		}
 	}
 }
 
@@ -173,7 +328,7 @@ func (s *server) readHeaderLine() (string, error) {
 	return strings.TrimSuffix(b.String(), "\r\n"), nil
 }
 
-func (s *server) write(msg message) error {
+func (s *server) write(msg any) error {
 	content, err := json.Marshal(msg)
 	if err != nil {
 		return fmt.Errorf("writing message: %w", err)
@@ -187,41 +342,92 @@ func (s *server) write(msg message) error {
 func (s *server) handle(msg message) error {
 	switch msg := msg.(type) {
 	case *request:
-		result, err := s.handler.HandleRequest(msg.Method, msg.Params)
-		resp := &response{JSONRPC: validJSONRPC, ID: &msg.ID}
-		if err != nil {
-			var respErr *responseError
-			if errors.As(err, &respErr) {
-				resp.Error = respErr
-			} else {
-				resp.Error = newInternalError(err.Error())
+		if err := s.write(s.handleRequest(msg)); err != nil {
+			return fmt.Errorf("handling message: %w", err)
+		}
+
+	case *notification:
+		s.handleNotification(msg)
+
+	case *response:
+		ignoreResponse(msg)
+
+	case *batch:
+		// The entries of a batch are handled in order and the responses to its requests are sent back together. No
+		// response is sent if the batch only contains notifications and responses.
+		// https://www.jsonrpc.org/specification#batch
+		var resps []*response
+		for _, entry := range msg.Entries {
+			if entry.Err != nil {
+				resps = append(resps, &response{JSONRPC: validJSONRPC, ID: nil, Error: entry.Err})
+				continue
 			}
-		} else {
-			resultBytes, err := json.Marshal(result)
-			if err != nil {
-				resp.Error = newInternalError(fmt.Sprintf("unable to marshal result: %v", err))
-			} else {
-				rawMsg := json.RawMessage(resultBytes)
-				resp.Result = &rawMsg
+			switch entryMsg := entry.Msg.(type) {
+			case *request:
+				resps = append(resps, s.handleRequest(entryMsg))
+			case *notification:
+				s.handleNotification(entryMsg)
+			case *response:
+				ignoreResponse(entryMsg)
+			case *batch:
+				panic("unexpected batch inside batch")
 			}
 		}
-		if writeErr := s.write(resp); writeErr != nil {
-			return fmt.Errorf("handling message: %w", writeErr)
+		if len(resps) > 0 {
+			if err := s.write(resps); err != nil {
+				return fmt.Errorf("handling message: %w", err)
+			}
 		}
+	}
 
-	case *notification:
-		s.handler.HandleNotification(msg.Method, msg.Params)
+	return nil
+}
 
-	case *response:
-		var msgJSON string
-		bytes, err := json.Marshal(msg)
+func (s *server) handleRequest(req *request) *response {
+	ctx, done := s.requestContext(req.ID)
+	defer done()
+	var result any
+	err := ctx.Err()
+	if err == nil {
+		result, err = s.handler.HandleRequest(ctx, req.Method, req.Params)
+	}
+	resp := &response{JSONRPC: validJSONRPC, ID: &req.ID}
+	if err != nil {
+		var respErr *responseError
+		if errors.As(err, &respErr) {
+			resp.Error = respErr
+		} else if errors.Is(err, context.Canceled) {
+			resp.Error = NewRequestCancelledError().(*responseError)
+		} else {
+			resp.Error = newInternalError(err.Error())
+		}
+	} else {
+		resultBytes, err := json.Marshal(result)
 		if err != nil {
-			msgJSON = "unable to marshal message"
+			resp.Error = newInternalError(fmt.Sprintf("unable to marshal result: %v", err))
 		} else {
-			msgJSON = string(bytes)
+			rawMsg := json.RawMessage(resultBytes)
+			resp.Result = &rawMsg
 		}
-		slog.Info("Ignoring response message", "message", msgJSON)
 	}
+	return resp
+}
 
-	return nil
+func (s *server) handleNotification(notif *notification) {
+	if notif.Method == cancelRequestMethod {
+		// $/cancelRequest notifications are handled as soon as they're read.
+		return
+	}
+	s.handler.HandleNotification(notif.Method, notif.Params)
+}
+
+func ignoreResponse(resp *response) {
+	var msgJSON string
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		msgJSON = "unable to marshal message"
+	} else {
+		msgJSON = string(bytes)
+	}
+	slog.Info("Ignoring response message", "message", msgJSON)
 }
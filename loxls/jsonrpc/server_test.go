@@ -0,0 +1,162 @@
+package jsonrpc_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/marcuscaisey/lox/loxls/jsonrpc"
+)
+
+// testHandler handles slow requests which don't return until they're canceled, and fast requests which return
+// immediately. The methods of the notifications that it handles are recorded.
+type testHandler struct {
+	slowStarted   chan struct{}
+	notifications []string
+}
+
+func (h *testHandler) HandleRequest(ctx context.Context, method string, _ *json.RawMessage) (any, error) {
+	switch method {
+	case "slow":
+		close(h.slowStarted)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(10 * time.Second):
+			return "not cancelled", nil
+		}
+	case "fast":
+		return "done", nil
+	default:
+		return nil, jsonrpc.NewMethodNotFoundError(method)
+	}
+}
+
+func (h *testHandler) HandleNotification(method string, _ *json.RawMessage) {
+	h.notifications = append(h.notifications, method)
+}
+
+func (h *testHandler) SetClient(*jsonrpc.Client) {}
+
+func TestCancelRequest(t *testing.T) {
+	inR, inW := io.Pipe()
+	outR, outW := io.Pipe()
+	out := bufio.NewReader(outR)
+	handler := &testHandler{slowStarted: make(chan struct{})}
+	errs := make(chan error)
+	go func() {
+		errs <- jsonrpc.Serve(context.Background(), inR, outW, handler)
+	}()
+
+	mustWriteMessage(t, inW, `{"jsonrpc":"2.0","id":1,"method":"slow"}`)
+	<-handler.slowStarted
+	mustWriteMessage(t, inW, `{"jsonrpc":"2.0","method":"$/cancelRequest","params":{"id":1}}`)
+	if got, want := mustReadMessage(t, out), `{"jsonrpc":"2.0","id":1,"error":{"code":-32800,"message":"Request cancelled"}}`; got != want {
+		t.Errorf("response to cancelled request = %s, want %s", got, want)
+	}
+
+	mustWriteMessage(t, inW, `{"jsonrpc":"2.0","id":2,"method":"fast"}`)
+	if got, want := mustReadMessage(t, out), `{"jsonrpc":"2.0","id":2,"result":"done"}`; got != want {
+		t.Errorf("response to request after cancelled request = %s, want %s", got, want)
+	}
+
+	if err := inW.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-errs; err != nil {
+		t.Errorf("Serve() returned error: %s", err)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	tests := []struct {
+		name              string
+		batch             string
+		wantResponses     []string
+		wantNotifications []string
+	}{
+		{
+			name: "requests and notification",
+			batch: `[
+  {"jsonrpc":"2.0","id":1,"method":"fast"},
+  {"jsonrpc":"2.0","method":"notify"},
+  {"jsonrpc":"2.0","id":"two","method":"unknown"}
+]`,
+			wantResponses:     []string{`[{"jsonrpc":"2.0","id":1,"result":"done"},{"jsonrpc":"2.0","id":"two","error":{"code":-32601,"message":"Method not found","data":{"method":"unknown"}}}]`},
+			wantNotifications: []string{"notify"},
+		},
+		{
+			name:          "malformed entries",
+			batch:         `[{"jsonrpc":"2.0","id":1,"method":"fast"},1,{"id":2,"method":"fast"}]`,
+			wantResponses: []string{`[{"jsonrpc":"2.0","id":1,"result":"done"},{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"Invalid Request","data":{"error":"json: cannot unmarshal number into Go value of type jsonrpc.combinedMessage"}}},{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"Invalid Request","data":{"error":"jsonrpc is required"}}}]`},
+		},
+		{
+			name:              "only notifications",
+			batch:             `[{"jsonrpc":"2.0","method":"notify"},{"jsonrpc":"2.0","method":"notify"}]`,
+			wantNotifications: []string{"notify", "notify"},
+		},
+		{
+			name:          "empty",
+			batch:         `[]`,
+			wantResponses: []string{`{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"Invalid Request","data":{"error":"batch cannot be empty"}}}`},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var in, out bytes.Buffer
+			mustWriteMessage(t, &in, test.batch)
+			handler := &testHandler{}
+
+			if err := jsonrpc.Serve(context.Background(), &in, &out, handler); err != nil {
+				t.Fatalf("Serve() returned error: %s", err)
+			}
+
+			var gotResponses []string
+			outReader := bufio.NewReader(&out)
+			for outReader.Buffered() > 0 || out.Len() > 0 {
+				gotResponses = append(gotResponses, mustReadMessage(t, outReader))
+			}
+			if !slices.Equal(gotResponses, test.wantResponses) {
+				t.Errorf("responses:\n%s\nwant:\n%s", strings.Join(gotResponses, "\n"), strings.Join(test.wantResponses, "\n"))
+			}
+			if !slices.Equal(handler.notifications, test.wantNotifications) {
+				t.Errorf("handled notifications %q, want %q", handler.notifications, test.wantNotifications)
+			}
+		})
+	}
+}
+
+func mustWriteMessage(t *testing.T, w io.Writer, content string) {
+	t.Helper()
+	if _, err := fmt.Fprintf(w, "Content-Length: %d\r\n\r\n%s", len(content), content); err != nil {
+		t.Fatalf("writing message: %s", err)
+	}
+}
+
+func mustReadMessage(t *testing.T, r *bufio.Reader) string {
+	t.Helper()
+	header, err := r.ReadString('\n')
+	if err != nil {
+		t.Fatalf("reading message header: %s", err)
+	}
+	length, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(header, "Content-Length:")))
+	if err != nil {
+		t.Fatalf("parsing message header %q: %s", header, err)
+	}
+	if _, err := r.ReadString('\n'); err != nil {
+		t.Fatalf("reading message header: %s", err)
+	}
+	content := make([]byte, length)
+	if _, err := io.ReadFull(r, content); err != nil {
+		t.Fatalf("reading message content: %s", err)
+	}
+	return string(content)
+}
@@ -20,7 +20,17 @@ func (c *client) TextDocumentPublishDiagnostics(params *protocol.PublishDiagnost
 	return c.jsonrpcClient.Notify("textDocument/publishDiagnostics", params)
 }
 
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#client_registerCapability
+func (c *client) ClientRegisterCapability(params *protocol.RegistrationParams) error {
+	return c.jsonrpcClient.Request("client/registerCapability", params)
+}
+
 // https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#window_logMessage
 func (c *client) WindowLogMessage(params *protocol.LogMessageParams) error {
 	return c.jsonrpcClient.Notify("window/logMessage", params)
 }
+
+// Exit stops the server with the given exit code once the current message has been handled.
+func (c *client) Exit(code int) {
+	c.jsonrpcClient.Exit(code)
+}
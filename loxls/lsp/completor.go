@@ -3,6 +3,7 @@ package lsp
 import (
 	"cmp"
 	"fmt"
+	"math"
 	"slices"
 	"strings"
 	"unicode"
@@ -11,6 +12,7 @@ import (
 	"github.com/marcuscaisey/lox/golox/ast"
 	"github.com/marcuscaisey/lox/golox/builtins"
 	"github.com/marcuscaisey/lox/golox/token"
+	"github.com/marcuscaisey/lox/golox/typecheck"
 	"github.com/marcuscaisey/lox/loxls/lsp/protocol"
 )
 
@@ -47,19 +49,21 @@ type completor struct {
 }
 
 // newCompletor returns a [completor] which provides completions inside the given program.
+// identTypes are the statically inferred types of the identifiers in the program.
 // builtins is a list of built-in declarations which are available in the global scope.
-func newCompletor(program *ast.Program, identBindings map[*ast.Ident][]ast.Binding, builtins []ast.Decl) *completor {
+func newCompletor(program *ast.Program, identBindings map[*ast.Ident][]ast.Binding, identTypes map[*ast.Ident]typecheck.Type, builtins []ast.Decl) *completor {
 	return &completor{
 		program:            program,
 		classBodyCompletor: newClassBodyCompletor(program),
 		identCompletor:     newIdentCompletor(program),
 		keywordCompletor:   newKeywordCompletor(program),
-		builtinCompls:      declCompletions(builtins),
-		propertyCompletor:  newPropertyCompletor(program, identBindings, builtins),
+		builtinCompls:      builtinCompletions(builtins),
+		propertyCompletor:  newPropertyCompletor(program, identBindings, identTypes, builtins),
 	}
 }
 
-// Complete returns the completions which should be suggested at a position.
+// Complete returns the completions which should be suggested at a position, in the order that they should be suggested.
+// Identifier and keyword completions are ordered by their priority and then their label.
 func (c *completor) Complete(pos *protocol.Position) (compls []*completion, isIncomplete bool) {
 	if compls, isIncomplete, ok := c.classBodyCompletor.Complete(pos); ok {
 		return compls, isIncomplete
@@ -67,11 +71,15 @@ func (c *completor) Complete(pos *protocol.Position) (compls []*completion, isIn
 	if compls, ok := c.propertyCompletor.Complete(pos); ok {
 		return compls, false
 	}
-	return slices.Concat(
+	compls = slices.Concat(
 		c.identCompletor.Complete(pos),
 		c.keywordCompletor.Complete(pos),
 		c.builtinCompls,
-	), false
+	)
+	slices.SortStableFunc(compls, func(x, y *completion) int {
+		return cmp.Or(cmp.Compare(x.Priority, y.Priority), cmp.Compare(x.Label, y.Label))
+	})
+	return compls, false
 }
 
 type classBodyCompletor struct {
@@ -141,6 +149,7 @@ func (s snippet) ToCompletion() *completion {
 		Kind:          protocol.CompletionItemKindSnippet,
 		Snippet:       s.content,
 		Documentation: s.doc,
+		Priority:      keywordCompletionPriority,
 	}
 }
 
@@ -161,8 +170,20 @@ type completion struct {
 	// Documentation is the documentation that will be shown. If the client supports it, this will be displayed as
 	// markdown.
 	Documentation string
+	// Priority is the relevance of the completion compared to others. Completions with a lower priority are suggested
+	// first.
+	// Identifier completions have a priority equal to the number of scopes between the scope that the identifier is
+	// declared in and the one that the completion is suggested in. Built-in and keyword completions have the priorities
+	// builtinCompletionPriority and keywordCompletionPriority.
+	Priority int
 }
 
+// Priorities of completions which don't refer to identifiers declared in the program.
+const (
+	builtinCompletionPriority = math.MaxInt - 1
+	keywordCompletionPriority = math.MaxInt
+)
+
 // keywordCompletor provides completions of keywords.
 type keywordCompletor struct {
 	program *ast.Program
@@ -180,25 +201,32 @@ func (c *keywordCompletor) Complete(pos *protocol.Position) []*completion {
 		for _, snippet := range statementSnippets {
 			compls = append(compls, snippet.ToCompletion())
 		}
+		inLoop, inFun := c.enclosingLoopAndFun(pos)
 		for _, keyword := range statementKeywords {
+			if (keyword == "break" || keyword == "continue") && !inLoop || keyword == "return" && !inFun {
+				continue
+			}
 			compls = append(compls, &completion{
-				Label: keyword,
-				Kind:  protocol.CompletionItemKindKeyword,
+				Label:    keyword,
+				Kind:     protocol.CompletionItemKindKeyword,
+				Priority: keywordCompletionPriority,
 			})
 		}
 	}
 
 	for _, keyword := range expressionKeywords {
 		compls = append(compls, &completion{
-			Label: keyword,
-			Kind:  protocol.CompletionItemKindKeyword,
+			Label:    keyword,
+			Kind:     protocol.CompletionItemKindKeyword,
+			Priority: keywordCompletionPriority,
 		})
 	}
 
 	return compls
 }
 
-// validStatementPosition reports whether it's valid to suggest a statement at the given position. This is when either:
+// validStatementPosition reports whether it's valid to suggest a statement at the given position. This is when it's not
+// inside an expression and either:
 //  1. Only whitespace precedes it.
 //  2. It's immediately preceded by a valid statement.
 //  3. It's immediately preceded by the opening of a block.
@@ -211,6 +239,10 @@ func (c *keywordCompletor) validStatementPosition(pos *protocol.Position) bool {
 		startPos = containingIdentRange.Start
 	}
 
+	if c.inExpression(startPos) {
+		return false
+	}
+
 	prevCharEnd, ok := c.previousCharacterEnd(startPos)
 	if !ok {
 		return true
@@ -232,6 +264,54 @@ func (c *keywordCompletor) validStatementPosition(pos *protocol.Position) bool {
 	return result
 }
 
+// inExpression reports whether the given position is inside an expression. A position is only considered to be inside
+// an expression if it's after the start of the expression and there's no block between them, since a block inside an
+// expression, such as the body of a function expression, can contain statements.
+func (c *keywordCompletor) inExpression(pos *protocol.Position) bool {
+	result := false
+	ast.Walk(c.program, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case ast.Expr:
+			if n.IsValid() && inRange(pos, n) && !equalPositions(pos, n.Start()) {
+				result = true
+			}
+		case *ast.Block:
+			if !n.LeftBrace.IsZero() && inRange(pos, n) && !equalPositions(pos, n.Start()) {
+				result = false
+			}
+		default:
+		}
+		return true
+	})
+	return result
+}
+
+// enclosingLoopAndFun reports whether the given position is inside the body of a loop and whether it's inside the body of
+// a function. A loop is only considered to enclose the position if there's no function between them, since break and
+// continue can't be used to exit a loop from inside a nested function.
+func (c *keywordCompletor) enclosingLoopAndFun(pos *protocol.Position) (inLoop bool, inFun bool) {
+	ast.Walk(c.program, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.Function:
+			if n.Body != nil && inRange(pos, n.Body) {
+				inFun = true
+				inLoop = false
+			}
+		case *ast.WhileStmt:
+			if n.Body != nil && inRange(pos, n.Body) {
+				inLoop = true
+			}
+		case *ast.ForStmt:
+			if n.Body != nil && inRange(pos, n.Body) {
+				inLoop = true
+			}
+		default:
+		}
+		return true
+	})
+	return inLoop, inFun
+}
+
 // previousCharacterEnd returns the end position of the previous non-whitespace character which isn't part of a comment
 // and whether one exists.
 func (c *keywordCompletor) previousCharacterEnd(pos *protocol.Position) (*protocol.Position, bool) {
@@ -305,11 +385,20 @@ type completionLocation struct {
 
 // Complete returns completions for all identifiers in scope at the given position.
 func (s *completionScope) Complete(pos *protocol.Position) []*completion {
+	compls, _ := s.complete(pos)
+	return compls
+}
+
+// complete is like Complete but also returns the number of scopes nested inside this one which contain the position.
+func (s *completionScope) complete(pos *protocol.Position) ([]*completion, int) {
 	var compls []*completion
+	depth := 0
 
 	for _, child := range s.children {
 		if inRangePositions(pos, child.start, child.end) {
-			compls = append(compls, child.Complete(pos)...)
+			childCompls, childDepth := child.complete(pos)
+			compls = append(compls, childCompls...)
+			depth = childDepth + 1
 			break
 		}
 	}
@@ -317,11 +406,16 @@ func (s *completionScope) Complete(pos *protocol.Position) []*completion {
 	for _, loc := range slices.Backward(s.complLocs) {
 		locPos := newPosition(loc.Position)
 		if pos.Line > locPos.Line || (pos.Line == locPos.Line && pos.Character >= locPos.Character) {
-			compls = append(compls, loc.Completions...)
+			for _, compl := range loc.Completions {
+				// Completions can be shared between scopes so the priority is set on a copy.
+				complCopy := *compl
+				complCopy.Priority = depth
+				compls = append(compls, &complCopy)
+			}
 		}
 	}
 
-	return compls
+	return compls, depth
 }
 
 func genIdentCompletions(program *ast.Program) *completionScope {
@@ -371,6 +465,8 @@ func (g *identCompletionGenerator) walk(node ast.Node) bool {
 	switch node := node.(type) {
 	case *ast.VarDecl:
 		g.walkVarDecl(node)
+	case *ast.DestructuringDecl:
+		g.walkDestructuringDecl(node)
 	case *ast.FunDecl:
 		g.walkFunDecl(node)
 	case *ast.FunExpr:
@@ -400,6 +496,23 @@ func (g *identCompletionGenerator) walkVarDecl(decl *ast.VarDecl) {
 	})
 }
 
+func (g *identCompletionGenerator) walkDestructuringDecl(decl *ast.DestructuringDecl) {
+	ast.Walk(decl.Initialiser, g.walk)
+	if decl.Semicolon.IsZero() {
+		return
+	}
+	var compls []*completion
+	for _, varDecl := range decl.Decls {
+		if compl, ok := varCompletion(varDecl.Name); ok {
+			compls = append(compls, compl)
+		}
+	}
+	g.curScope.complLocs = append(g.curScope.complLocs, &completionLocation{
+		Position:    decl.Semicolon.End(),
+		Completions: compls,
+	})
+}
+
 func (g *identCompletionGenerator) walkFunDecl(decl *ast.FunDecl) {
 	funCompl, ok := funCompletion(decl)
 	if !ok {
@@ -528,6 +641,7 @@ const (
 type propertyCompletor struct {
 	program              *ast.Program
 	identBindings        map[*ast.Ident][]ast.Binding
+	identTypes           map[*ast.Ident]typecheck.Type
 	compls               []*completion
 	complsByPropComplKey map[propertyCompletionKey][]*completion
 }
@@ -537,7 +651,7 @@ type propertyCompletionKey struct {
 	PropertyType propertyType
 }
 
-func newPropertyCompletor(program *ast.Program, identBindings map[*ast.Ident][]ast.Binding, builtIns []ast.Decl) *propertyCompletor {
+func newPropertyCompletor(program *ast.Program, identBindings map[*ast.Ident][]ast.Binding, identTypes map[*ast.Ident]typecheck.Type, builtIns []ast.Decl) *propertyCompletor {
 	complsByPropComplKey := genPropertyCompletions(program, identBindings)
 	seenCompls := map[*completion]bool{}
 	var allCompls []*completion
@@ -576,6 +690,7 @@ func newPropertyCompletor(program *ast.Program, identBindings map[*ast.Ident][]a
 	return &propertyCompletor{
 		program:              program,
 		identBindings:        identBindings,
+		identTypes:           identTypes,
 		compls:               allCompls,
 		complsByPropComplKey: complsByPropComplKey,
 	}
@@ -590,6 +705,8 @@ func (c *propertyCompletor) Complete(pos *protocol.Position) ([]*completion, boo
 		object = propertyExpr.Object
 	} else if propertySetExpr, ok := ast.Find(c.program, inRangeOrFollowsName); ok {
 		object = propertySetExpr.Object
+	} else if _, ok := outermostNodeAtOrBefore[*ast.SuperExpr](c.program, pos); ok {
+		return c.superCompletions(pos), true
 	} else {
 		return nil, false
 	}
@@ -610,35 +727,9 @@ func (c *propertyCompletor) Complete(pos *protocol.Position) ([]*completion, boo
 		return c.complsByPropComplKey[propertyCompletionKey{classDecl, propType}], true
 	}
 
-	if _, ok := object.(*ast.SuperExpr); ok {
-		classDecl, ok := innermostNodeAt[*ast.ClassDecl](c.program, pos)
-		if !ok {
-			return nil, true
-		}
-		methodDecl, ok := innermostNodeAt[*ast.MethodDecl](classDecl, pos)
-		if !ok {
-			return nil, true
-		}
-		superclassBindings, ok := c.identBindings[classDecl.Superclass]
-		if !ok {
-			return nil, true
-		}
-		superclassDecl, ok := superclassBindings[0].(*ast.ClassDecl)
-		if !ok {
-			return nil, true
-		}
-		propType := propertyTypeInstance
-		if methodDecl.IsStatic() {
-			propType = propertyTypeStatic
-		}
-		propComplKey := propertyCompletionKey{superclassDecl, propType}
-		compls := make([]*completion, 0, len(c.complsByPropComplKey[propComplKey]))
-		for _, compl := range c.complsByPropComplKey[propComplKey] {
-			if compl.Kind == protocol.CompletionItemKindMethod {
-				compls = append(compls, compl)
-			}
-		}
-		return compls, true
+	if c.isString(object) {
+		// Strings don't have any properties, so none of the other completions would be valid.
+		return nil, true
 	}
 
 	if identExpr, ok := object.(*ast.IdentExpr); ok {
@@ -652,6 +743,55 @@ func (c *propertyCompletor) Complete(pos *protocol.Position) ([]*completion, boo
 	return c.compls, true
 }
 
+// isString reports whether expr is statically known to evaluate to a string.
+func (c *propertyCompletor) isString(expr ast.Expr) bool {
+	switch expr := expr.(type) {
+	case *ast.LiteralExpr:
+		return expr.Value.Type == token.String
+	case *ast.IdentExpr:
+		_, ok := c.identTypes[expr.Ident].(typecheck.TypeString)
+		return ok
+	case *ast.GroupExpr:
+		return c.isString(expr.Expr)
+	default:
+		return false
+	}
+}
+
+// superCompletions returns completions for the methods which can be accessed through super at the given position.
+func (c *propertyCompletor) superCompletions(pos *protocol.Position) []*completion {
+	classDecl, ok := innermostNodeAt[*ast.ClassDecl](c.program, pos)
+	if !ok {
+		return nil
+	}
+	methodDecl, ok := innermostNodeAt[*ast.MethodDecl](classDecl, pos)
+	if !ok {
+		return nil
+	}
+	superclassBindings, ok := c.identBindings[classDecl.Superclass]
+	if !ok {
+		return nil
+	}
+	superclassDecl, ok := superclassBindings[0].(*ast.ClassDecl)
+	if !ok {
+		return nil
+	}
+	propType := propertyTypeInstance
+	if methodDecl.IsStatic() {
+		propType = propertyTypeStatic
+	}
+	propComplKey := propertyCompletionKey{superclassDecl, propType}
+	compls := make([]*completion, 0, len(c.complsByPropComplKey[propComplKey]))
+	for _, compl := range c.complsByPropComplKey[propComplKey] {
+		// Only methods can be accessed through super and private methods are excluded as they're not intended to be
+		// used by subclasses.
+		if compl.Kind == protocol.CompletionItemKindMethod && !strings.HasPrefix(compl.Label, "_") {
+			compls = append(compls, compl)
+		}
+	}
+	return compls
+}
+
 func genPropertyCompletions(program *ast.Program, identBindings map[*ast.Ident][]ast.Binding) map[propertyCompletionKey][]*completion {
 	g := &propertyCompletionGenerator{
 		propComplLabels:      map[propertyCompletionLabel]bool{},
@@ -891,6 +1031,8 @@ func declCompletion(decl ast.Decl) (*completion, bool) {
 	switch decl := decl.(type) {
 	case *ast.VarDecl:
 		return varCompletion(decl.Name)
+	case *ast.DestructuredVarDecl:
+		return varCompletion(decl.Name)
 	case *ast.FunDecl:
 		return funCompletion(decl)
 	case *ast.ClassDecl:
@@ -901,11 +1043,12 @@ func declCompletion(decl ast.Decl) (*completion, bool) {
 	panic("unreachable")
 }
 
-// declCompletions returns completions for all of the provided declarations.
-func declCompletions(decls []ast.Decl) []*completion {
+// builtinCompletions returns completions for all of the provided built-in declarations.
+func builtinCompletions(decls []ast.Decl) []*completion {
 	compls := make([]*completion, 0, len(decls))
 	for _, decl := range decls {
 		if compl, ok := declCompletion(decl); ok {
+			compl.Priority = builtinCompletionPriority
 			compls = append(compls, compl)
 		}
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/marcuscaisey/lox/golox/ast"
 	"github.com/marcuscaisey/lox/golox/loxerr"
 	"github.com/marcuscaisey/lox/golox/parser"
+	"github.com/marcuscaisey/lox/golox/typecheck"
 	"github.com/marcuscaisey/lox/loxls/jsonrpc"
 	"github.com/marcuscaisey/lox/loxls/lsp/protocol"
 )
@@ -24,13 +25,17 @@ type document struct {
 	URI     string
 	Version int
 	Text    string
+	// Open is false if the document isn't open in the client and was read from disk instead.
+	Open bool
 
 	// Server generated
 	Filename       string
 	Program        *ast.Program
 	HasParseErrors bool
 	IdentBindings  map[*ast.Ident][]ast.Binding
+	Types          *typecheck.Types // Inferred types, which are only used to improve completions and hover information
 	Completor      *completor
+	LoxErrs        loxerr.Errors // Errors which are published as diagnostics
 }
 
 // document returns the document with the given URI, or an error if it doesn't exist.
@@ -44,7 +49,7 @@ func (h *Handler) document(uri string) (*document, error) {
 
 // https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocument_didOpen
 func (h *Handler) textDocumentDidOpen(params *protocol.DidOpenTextDocumentParams) error {
-	if err := h.updateDoc(params.TextDocument.Uri, params.TextDocument.Version, string(params.TextDocument.Text)); err != nil {
+	if err := h.updateDoc(params.TextDocument.Uri, params.TextDocument.Version, string(params.TextDocument.Text), true); err != nil {
 		return fmt.Errorf("textDocument/didOpen: %s", err)
 	}
 	return nil
@@ -68,7 +73,7 @@ func (h *Handler) textDocumentDidChange(params *protocol.DidChangeTextDocumentPa
 			src = change.Text
 		}
 	}
-	if err := h.updateDoc(params.TextDocument.Uri, params.TextDocument.Version, src); err != nil {
+	if err := h.updateDoc(params.TextDocument.Uri, params.TextDocument.Version, src, true); err != nil {
 		return fmt.Errorf("textDocument/didChange: %s", err)
 	}
 	return nil
@@ -139,7 +144,9 @@ func applyIncrementalTextChange(text string, change *protocol.IncrementalTextDoc
 	return text[:low] + change.Text + text[high:], nil
 }
 
-func (h *Handler) updateDoc(uri string, version int, src string) error {
+// updateDoc parses and analyses the given source, stores the result as the document with the given URI, and publishes
+// any diagnostics for it. open should be true if the document is open in the client and false if it was read from disk.
+func (h *Handler) updateDoc(uri string, version int, src string, open bool) error {
 	filename, err := uriToFilename(uri)
 	if err != nil {
 		return fmt.Errorf("updating document: %w", err)
@@ -155,53 +162,46 @@ func (h *Handler) updateDoc(uri string, version int, src string) error {
 		builtins = h.builtinStubs
 	}
 	identBindings, resolveErr := analyse.ResolveIdents(program, builtins, analyse.WithExtraFeatures(h.extraFeatures))
+	// Type errors aren't published as diagnostics, the types are only used to improve completions and hover information.
+	types, _ := typecheck.Infer(program, builtins)
+
+	semanticsErr := analyse.CheckSemantics(program, analyse.WithExtraFeatures(h.extraFeatures))
+	var resolveLoxErrs, semanticsLoxErrs loxerr.Errors
+	errors.As(resolveErr, &resolveLoxErrs)
+	errors.As(semanticsErr, &semanticsLoxErrs)
+	var loxErrs loxerr.Errors
+	if filename != h.builtinStubsFilename {
+		loxErrs = slices.Concat(parseLoxErrs, resolveLoxErrs, semanticsLoxErrs)
+		loxErrs.Sort()
+	}
 
 	h.docs[uri] = &document{
 		URI:            uri,
 		Version:        version,
 		Text:           src,
+		Open:           open,
 		Filename:       filename,
 		Program:        program,
 		HasParseErrors: len(parseLoxErrs) > 0,
 		IdentBindings:  identBindings,
-		Completor:      newCompletor(program, identBindings, h.builtinStubs),
+		Types:          types,
+		Completor:      newCompletor(program, identBindings, types.Idents, h.builtinStubs),
+		LoxErrs:        loxErrs,
 	}
 
-	semanticsErr := analyse.CheckSemantics(program, analyse.WithExtraFeatures(h.extraFeatures))
-	var resolveLoxErrs, semanticsLoxErrs loxerr.Errors
-	errors.As(resolveErr, &resolveLoxErrs)
-	errors.As(semanticsErr, &semanticsLoxErrs)
-	loxErrs := slices.Concat(parseLoxErrs, resolveLoxErrs, semanticsLoxErrs)
-	loxErrs.Sort()
-
-	var diagnostics []*protocol.Diagnostic
-	if filename != h.builtinStubsFilename {
-		diagnostics = make([]*protocol.Diagnostic, len(loxErrs))
-		for i, e := range loxErrs {
-			var severity protocol.DiagnosticSeverity
-			var tags []protocol.DiagnosticTag
-			switch e.Type {
-			case loxerr.Fatal:
-				severity = protocol.DiagnosticSeverityError
-			case loxerr.Warning:
-				severity = protocol.DiagnosticSeverityWarning
-			case loxerr.Hint:
-				severity = protocol.DiagnosticSeverityHint
-				if strings.HasSuffix(e.Msg, "has been declared but is never used") {
-					tags = append(tags, protocol.DiagnosticTagUnnecessary)
-				}
-			}
-			diagnostics[i] = &protocol.Diagnostic{Range: newRange(e), Severity: severity, Source: "loxls", Message: e.Msg, Tags: tags}
-		}
-	} else {
-		diagnostics = []*protocol.Diagnostic{}
+	diagnostics := make([]*protocol.Diagnostic, len(loxErrs))
+	for i, e := range loxErrs {
+		diagnostics[i] = newDiagnostic(e)
 	}
 
-	return h.client.TextDocumentPublishDiagnostics(&protocol.PublishDiagnosticsParams{
+	params := &protocol.PublishDiagnosticsParams{
 		Uri:         uri,
-		Version:     protocol.NewOptional(version),
 		Diagnostics: diagnostics,
-	})
+	}
+	if open {
+		params.Version = protocol.NewOptional(version)
+	}
+	return h.client.TextDocumentPublishDiagnostics(params)
 }
 
 func uriToFilename(uri string) (string, error) {
@@ -220,3 +220,23 @@ func (h *Handler) textDocumentDidClose(params *protocol.DidCloseTextDocumentPara
 	delete(h.docs, doc.URI)
 	return nil
 }
+
+func newDiagnostic(e *loxerr.Error) *protocol.Diagnostic {
+	var severity protocol.DiagnosticSeverity
+	var tags []protocol.DiagnosticTag
+	switch e.Type {
+	case loxerr.Fatal:
+		severity = protocol.DiagnosticSeverityError
+	case loxerr.Warning:
+		severity = protocol.DiagnosticSeverityWarning
+		if strings.Contains(e.Msg, " is deprecated") {
+			tags = append(tags, protocol.DiagnosticTagDeprecated)
+		}
+	case loxerr.Hint:
+		severity = protocol.DiagnosticSeverityHint
+		if strings.HasSuffix(e.Msg, analyse.UnusedDeclMsgSuffix) {
+			tags = append(tags, protocol.DiagnosticTagUnnecessary)
+		}
+	}
+	return &protocol.Diagnostic{Range: newRange(e), Severity: severity, Source: "loxls", Message: e.Msg, Tags: tags}
+}
@@ -1,6 +1,7 @@
 package lsp
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -34,7 +35,7 @@ func NewHandler() *Handler {
 }
 
 // HandleRequest responds to a JSON-RPC request.
-func (h *Handler) HandleRequest(method string, jsonParams *json.RawMessage) (any, error) {
+func (h *Handler) HandleRequest(ctx context.Context, method string, jsonParams *json.RawMessage) (any, error) {
 	if !h.initialized && method != "initialize" {
 		return nil, jsonrpc.NewError(jsonrpc.ErrorCode(protocol.ErrorCodesServerNotInitialized), "Server not initialized", nil)
 	}
@@ -55,13 +56,31 @@ func (h *Handler) HandleRequest(method string, jsonParams *json.RawMessage) (any
 	case "textDocument/documentSymbol":
 		return handleRequest(h.textDocumentDocumentSymbol, jsonParams)
 	case "textDocument/completion":
-		return handleRequest(h.textDocumentCompletion, jsonParams)
+		return handleCancellableRequest(ctx, h.textDocumentCompletion, jsonParams)
 	case "textDocument/signatureHelp":
 		return handleRequest(h.textDocumentSignatureHelp, jsonParams)
 	case "textDocument/formatting":
 		return handleRequest(h.textDocumentFormatting, jsonParams)
+	case "textDocument/onTypeFormatting":
+		return handleRequest(h.textDocumentOnTypeFormatting, jsonParams)
 	case "textDocument/rename":
 		return handleRequest(h.textDocumentRename, jsonParams)
+	case "textDocument/prepareRename":
+		return handleRequest(h.textDocumentPrepareRename, jsonParams)
+	case "textDocument/codeAction":
+		return handleRequest(h.textDocumentCodeAction, jsonParams)
+	case "textDocument/codeLens":
+		return handleRequest(h.textDocumentCodeLens, jsonParams)
+	case "codeLens/resolve":
+		return handleRequest(h.codeLensResolve, jsonParams)
+	case "textDocument/prepareTypeHierarchy":
+		return handleRequest(h.textDocumentPrepareTypeHierarchy, jsonParams)
+	case "typeHierarchy/supertypes":
+		return handleRequest(h.typeHierarchySupertypes, jsonParams)
+	case "typeHierarchy/subtypes":
+		return handleRequest(h.typeHierarchySubtypes, jsonParams)
+	case "textDocument/selectionRange":
+		return handleRequest(h.textDocumentSelectionRange, jsonParams)
 	default:
 		return nil, jsonrpc.NewMethodNotFoundError(method)
 	}
@@ -77,6 +96,23 @@ func handleRequest[T any, R any](handler requestHandler[T, R], jsonParams *json.
 	return handler(params)
 }
 
+// cancellableRequestHandler is a requestHandler which should return promptly with an error wrapping [context.Canceled]
+// once its context is canceled.
+type cancellableRequestHandler[T any, R any] func(context.Context, T) (R, error)
+
+func handleCancellableRequest[T any, R any](ctx context.Context, handler cancellableRequestHandler[T, R], jsonParams *json.RawMessage) (any, error) {
+	return handleRequest(func(params T) (R, error) { return handler(ctx, params) }, jsonParams)
+}
+
+// errorCodeRequestFailed is the LSP error code for a request which failed even though it was syntactically correct.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#errorCodes
+const errorCodeRequestFailed jsonrpc.ErrorCode = -32803
+
+func newRequestFailedError(message string) error {
+	return jsonrpc.NewError(errorCodeRequestFailed, message, nil)
+}
+
 // HandleNotification responds to a JSON-RPC notification.
 func (h *Handler) HandleNotification(method string, jsonParams *json.RawMessage) {
 	if err := h.handleNotification(method, jsonParams); err != nil {
@@ -93,7 +129,7 @@ func (h *Handler) handleNotification(method string, jsonParams *json.RawMessage)
 	}
 	switch method {
 	case "initialized":
-		// No further initialisation needed
+		return h.registerFileWatcher()
 	case "exit":
 		return h.exit()
 	case "textDocument/didOpen":
@@ -102,6 +138,8 @@ func (h *Handler) handleNotification(method string, jsonParams *json.RawMessage)
 		return handleNotification(method, h.textDocumentDidChange, jsonParams)
 	case "textDocument/didClose":
 		return handleNotification(method, h.textDocumentDidClose, jsonParams)
+	case "workspace/didChangeWatchedFiles":
+		return handleNotification(method, h.workspaceDidChangeWatchedFiles, jsonParams)
 	default:
 		if !strings.HasPrefix(method, "$/") {
 			// If a server or client receives notifications starting with ‘$/’ it is free to ignore the notification.
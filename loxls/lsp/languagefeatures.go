@@ -4,6 +4,7 @@ package lsp
 // https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#languageFeatures.
 
 import (
+	"context"
 	"fmt"
 	"slices"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"github.com/marcuscaisey/lox/golox/ast"
 	"github.com/marcuscaisey/lox/golox/token"
 	"github.com/marcuscaisey/lox/loxfmt/format"
+	"github.com/marcuscaisey/lox/loxls/jsonrpc"
 	"github.com/marcuscaisey/lox/loxls/lsp/protocol"
 )
 
@@ -60,7 +62,6 @@ func (h *Handler) textDocumentReferences(params *protocol.ReferenceParams) (prot
 		return nil, nil
 	}
 
-	slices.SortFunc(refs, func(a, b ast.Node) int { return a.Start().Compare(b.Start()) })
 	locs := make(protocol.LocationSlice, len(refs))
 	for i, ref := range refs {
 		locs[i] = &protocol.Location{
@@ -72,7 +73,18 @@ func (h *Handler) textDocumentReferences(params *protocol.ReferenceParams) (prot
 	return locs, nil
 }
 
+// references returns the references to the identifier at the given position, sorted by their position in the document.
 func references(doc *document, pos *protocol.Position, includeDecl bool) (references []ast.Node, ok bool) {
+	refs, ok := unsortedReferences(doc, pos, includeDecl)
+	if !ok {
+		return nil, false
+	}
+	// The references are collected by iterating over a map, so they're sorted to make the order deterministic.
+	slices.SortFunc(refs, func(a, b ast.Node) int { return a.Start().Compare(b.Start()) })
+	return refs, true
+}
+
+func unsortedReferences(doc *document, pos *protocol.Position, includeDecl bool) (references []ast.Node, ok bool) {
 	if thisRefs, ok := thisReferences(doc, pos); ok {
 		return thisRefs, true
 	}
@@ -130,20 +142,66 @@ func (h *Handler) textDocumentHover(params *protocol.HoverParams) (*protocol.Hov
 		return nil, err
 	}
 
-	defs, ok := definitions(doc, params.Position)
-	if !ok {
+	headers, body := hoverHeadersAndBody(doc, params.Position)
+	if len(headers) == 0 {
 		return nil, nil
 	}
 
-	var headers []string
-	var body string
+	contentFormat := protocol.MarkupKindPlainText
+	if len(h.capabilities.GetTextDocument().GetHover().GetContentFormat()) > 0 {
+		contentFormat = h.capabilities.GetTextDocument().GetHover().GetContentFormat()[0]
+	}
+
+	header := strings.Join(headers, "\n")
+	if len(headers) > 1 {
+		body = fmt.Sprintf("%d implementations", len(headers))
+	}
+
+	var contents string
+	if contentFormat == protocol.MarkupKindMarkdown {
+		contents = fmt.Sprintf("```lox\n%s\n```", header)
+		if body != "" {
+			contents = fmt.Sprintf("%s\n---\n%s", contents, body)
+		}
+	} else {
+		contents = header
+		if body != "" {
+			contents = fmt.Sprintf("%s\n%s", header, body)
+		}
+	}
+
+	return &protocol.Hover{
+		Contents: &protocol.MarkupContentOrMarkedStringOrMarkedStringSlice{
+			Value: &protocol.MarkupContent{
+				Kind:  contentFormat,
+				Value: contents,
+			},
+		},
+	}, nil
+}
+
+// hoverHeadersAndBody returns the headers and body of the hover information for the identifier at pos.
+// Each header describes a declaration of the identifier. The body is the documentation of the last declaration.
+func hoverHeadersAndBody(doc *document, pos *protocol.Position) (headers []string, body string) {
+	if header, body, isInstanceProp := instancePropertyHeaderAndBody(doc, pos); isInstanceProp {
+		if header == "" {
+			return nil, ""
+		}
+		return []string{header}, body
+	}
+
+	defs, ok := definitions(doc, pos)
+	if !ok {
+		return nil, ""
+	}
+
 	for _, def := range defs {
 		decl, ok := def.(ast.Decl)
 		if !ok {
 			continue
 		}
 		switch decl := decl.(type) {
-		case *ast.VarDecl, *ast.ParamDecl:
+		case *ast.VarDecl, *ast.DestructuredVarDecl, *ast.ParamDecl:
 			header, ok := varDetail(decl.BoundIdent())
 			if !ok {
 				continue
@@ -155,8 +213,8 @@ func (h *Handler) textDocumentHover(params *protocol.HoverParams) (*protocol.Hov
 			if !ok {
 				continue
 			}
-			headers = append(headers, header)
-			body = decl.Documentation()
+			headers = append(headers, withReturnType(header, decl.Function, doc.Types.Functions))
+			body = documentation(decl)
 
 		case *ast.ClassDecl:
 			if !decl.Name.IsValid() {
@@ -218,7 +276,7 @@ func (h *Handler) textDocumentHover(params *protocol.HoverParams) (*protocol.Hov
 			}
 			fmt.Fprint(b, "}")
 			headers = append(headers, b.String())
-			body = decl.Documentation()
+			body = documentation(decl)
 
 		case *ast.MethodDecl:
 			header, ok := methodDetail(decl)
@@ -226,44 +284,76 @@ func (h *Handler) textDocumentHover(params *protocol.HoverParams) (*protocol.Hov
 				continue
 			}
 			headers = append(headers, header)
-			body = decl.Documentation()
+			body = documentation(decl)
 		}
 	}
-	if len(headers) == 0 {
-		return nil, nil
+	return headers, body
+}
+
+// documentation returns the documentation of a declaration to show on hover. If the declaration is deprecated, then
+// this is preceded by the deprecation message.
+func documentation(decl ast.Decl) string {
+	doc := ""
+	if documented, ok := decl.(ast.Documented); ok {
+		doc = documented.Documentation()
+	}
+	msg, deprecated := analyse.Deprecation(decl)
+	if !deprecated {
+		return doc
+	}
+	deprecation := "Deprecated."
+	if msg != "" {
+		deprecation = fmt.Sprintf("Deprecated: %s", msg)
+	}
+	if doc == "" {
+		return deprecation
 	}
+	return fmt.Sprintf("%s\n\n%s", deprecation, doc)
+}
 
-	contentFormat := protocol.MarkupKindPlainText
-	if len(h.capabilities.GetTextDocument().GetHover().GetContentFormat()) > 0 {
-		contentFormat = h.capabilities.GetTextDocument().GetHover().GetContentFormat()[0]
+// instancePropertyHeaderAndBody returns the hover header and body for the name of a property expression at pos whose
+// object is an instance of a class which can be determined statically. The declaration of the property is searched for
+// in the class and then up its inheritance chain. If the property is not declared, then the returned header is empty.
+// isInstanceProp reports whether there is such a property expression at pos.
+func instancePropertyHeaderAndBody(doc *document, pos *protocol.Position) (header string, body string, isInstanceProp bool) {
+	var object ast.Expr
+	var name *ast.Ident
+	if propertyExpr, ok := innermostNodeAt[*ast.PropertyExpr](doc.Program, pos); ok && inRange(pos, propertyExpr.Name) {
+		object, name = propertyExpr.Object, propertyExpr.Name
+	} else if propertySetExpr, ok := innermostNodeAt[*ast.PropertySetExpr](doc.Program, pos); ok && inRange(pos, propertySetExpr.Name) {
+		object, name = propertySetExpr.Object, propertySetExpr.Name
+	} else {
+		return "", "", false
 	}
 
-	header := strings.Join(headers, "\n")
-	if len(headers) > 1 {
-		body = fmt.Sprintf("%d implementations", len(headers))
+	classDecl, ok := instanceClass(object, doc.IdentBindings)
+	if !ok {
+		return "", "", false
 	}
 
-	var contents string
-	if contentFormat == protocol.MarkupKindMarkdown {
-		contents = fmt.Sprintf("```lox\n%s\n```", header)
-		if body != "" {
-			contents = fmt.Sprintf("%s\n---\n%s", contents, body)
-		}
-	} else {
-		contents = header
-		if body != "" {
-			contents = fmt.Sprintf("%s\n%s", header, body)
-		}
+	decl, declClassDecl, ok := instanceProperty(classDecl, name.String(), doc.IdentBindings)
+	if !ok {
+		return "", "", true
 	}
 
-	return &protocol.Hover{
-		Contents: &protocol.MarkupContentOrMarkedStringOrMarkedStringSlice{
-			Value: &protocol.MarkupContent{
-				Kind:  contentFormat,
-				Value: contents,
-			},
-		},
-	}, nil
+	b := new(strings.Builder)
+	if declClassDecl != classDecl {
+		fmt.Fprintf(b, "// Inherited from %s\n", declClassDecl.Name)
+	}
+	switch decl := decl.(type) {
+	case *ast.MethodDecl:
+		detail, ok := methodDetail(decl)
+		if !ok {
+			return "", "", true
+		}
+		fmt.Fprint(b, detail)
+		body = documentation(decl)
+	case *ast.PropertySetExpr:
+		fmt.Fprintf(b, "(field) %s.%s", declClassDecl.Name, decl.Name)
+	default:
+		return "", "", true
+	}
+	return b.String(), body, true
 }
 
 // https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocument_documentSymbol
@@ -287,14 +377,28 @@ func (h *Handler) textDocumentDocumentSymbol(params *protocol.DocumentSymbolPara
 				SelectionRange: newRange(decl.Name),
 			})
 			return false
+		case *ast.DestructuringDecl:
+			for _, varDecl := range decl.Decls {
+				if !varDecl.Name.IsValid() {
+					continue
+				}
+				docSymbols = append(docSymbols, &protocol.DocumentSymbol{
+					Name:           varDecl.Name.String(),
+					Kind:           protocol.SymbolKindVariable,
+					Range:          newRange(decl),
+					SelectionRange: newRange(varDecl.Name),
+				})
+			}
+			return false
 		case *ast.FunDecl:
 			if !decl.Name.IsValid() {
 				return false
 			}
 			docSymbols = append(docSymbols, &protocol.DocumentSymbol{
 				Name:           decl.Name.String(),
-				Detail:         funSignature(decl.GetParams()),
+				Detail:         withReturnType(funSignature(decl.GetParams()), decl.Function, doc.Types.Functions),
 				Kind:           protocol.SymbolKindFunction,
+				Tags:           symbolTags(decl),
 				Range:          newRange(decl),
 				SelectionRange: newRange(decl.Name),
 			})
@@ -306,6 +410,7 @@ func (h *Handler) textDocumentDocumentSymbol(params *protocol.DocumentSymbolPara
 			class := &protocol.DocumentSymbol{
 				Name:           decl.Name.String(),
 				Kind:           protocol.SymbolKindClass,
+				Tags:           symbolTags(decl),
 				Range:          newRange(decl),
 				SelectionRange: newRange(decl.Name),
 			}
@@ -330,6 +435,7 @@ func (h *Handler) textDocumentDocumentSymbol(params *protocol.DocumentSymbolPara
 					Name:           name,
 					Detail:         funSignature(methodDecl.GetParams()),
 					Kind:           kind,
+					Tags:           symbolTags(methodDecl),
 					Range:          newRange(methodDecl),
 					SelectionRange: newRange(methodDecl.Name),
 				})
@@ -347,6 +453,15 @@ func (h *Handler) textDocumentDocumentSymbol(params *protocol.DocumentSymbolPara
 	return &protocol.SymbolInformationSliceOrDocumentSymbolSlice{Value: symbols}, nil
 }
 
+// symbolTags returns the tags of the symbol declared by decl. A declaration with a @deprecated annotation is tagged as
+// deprecated.
+func symbolTags(decl ast.Annotated) []protocol.SymbolTag {
+	if _, ok := decl.Annotation("deprecated"); ok {
+		return []protocol.SymbolTag{protocol.SymbolTagDeprecated}
+	}
+	return nil
+}
+
 func toSymbolInformations(docSymbols protocol.DocumentSymbolSlice, uri string) protocol.SymbolInformationSlice {
 	symbolInfos := make(protocol.SymbolInformationSlice, 0, len(docSymbols))
 	for _, docSymbol := range docSymbols {
@@ -370,7 +485,7 @@ func toSymbolInformations(docSymbols protocol.DocumentSymbolSlice, uri string) p
 }
 
 // https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocument_completion
-func (h *Handler) textDocumentCompletion(params *protocol.CompletionParams) (*protocol.CompletionItemSliceOrCompletionList, error) {
+func (h *Handler) textDocumentCompletion(ctx context.Context, params *protocol.CompletionParams) (*protocol.CompletionItemSliceOrCompletionList, error) {
 	doc, err := h.document(params.TextDocument.Uri)
 	if err != nil {
 		return nil, err
@@ -397,10 +512,16 @@ func (h *Handler) textDocumentCompletion(params *protocol.CompletionParams) (*pr
 	}
 
 	completions, isIncomplete := doc.Completor.Complete(params.Position)
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("textDocument/completion: %w", err)
+	}
 
 	padding := len(fmt.Sprint(len(completions)))
 	items := make([]*protocol.CompletionItem, 0, len(completions))
 	for _, completion := range completions {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("textDocument/completion: %w", err)
+		}
 		var documentation *protocol.StringOrMarkupContent
 		if completion.Documentation != "" {
 			kind := protocol.MarkupKindPlainText
@@ -492,6 +613,8 @@ func (h *Handler) textDocumentSignatureHelp(params *protocol.SignatureHelpParams
 		calleeIdent = callee.Ident
 	case *ast.PropertyExpr:
 		calleeIdent = callee.Name
+	case *ast.SuperExpr:
+		calleeIdent = callee.Name
 	default:
 		return nil, nil
 	}
@@ -587,16 +710,17 @@ func (h *Handler) signature(prefix string, params []*ast.ParamDecl, doc string)
 	fmt.Fprint(labelBuilder, prefix, "(")
 	labelOffsetSupport := h.capabilities.GetTextDocument().GetSignatureHelp().GetSignatureInformation().GetParameterInformation().GetLabelOffsetSupport()
 	for i, paramDecl := range params {
+		paramLabel := format.Node(paramDecl)
 		parameters[i] = &protocol.ParameterInformation{Label: &protocol.StringOrParameterInformationLabelRange{}}
 		if labelOffsetSupport {
 			parameters[i].Label.Value = &protocol.ParameterInformationLabelRange{
 				Start: utf16StringLen(labelBuilder.String()),
-				End:   utf16StringLen(labelBuilder.String() + paramDecl.Name.String()),
+				End:   utf16StringLen(labelBuilder.String() + paramLabel),
 			}
 		} else {
-			parameters[i].Label.Value = protocol.String(paramDecl.Name.String())
+			parameters[i].Label.Value = protocol.String(paramLabel)
 		}
-		fmt.Fprint(labelBuilder, paramDecl.Name)
+		fmt.Fprint(labelBuilder, paramLabel)
 		if i < len(params)-1 {
 			fmt.Fprint(labelBuilder, ", ")
 		}
@@ -652,6 +776,121 @@ func (h *Handler) textDocumentFormatting(params *protocol.DocumentFormattingPara
 	}, nil
 }
 
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocument_onTypeFormatting
+func (h *Handler) textDocumentOnTypeFormatting(params *protocol.DocumentOnTypeFormattingParams) ([]*protocol.TextEdit, error) {
+	doc, err := h.document(params.TextDocument.Uri)
+	if err != nil {
+		return nil, err
+	}
+
+	switch params.Ch {
+	case token.LeftBrace.String():
+		return leftBraceEdits(doc, params.Position), nil
+	case token.RightBrace.String():
+		return rightBraceEdits(doc, params.Position), nil
+	default:
+		return nil, nil
+	}
+}
+
+// leftBraceEdits returns the edits which follow a { typed at the end of a line with an indented empty line. The
+// matching } is inserted on the line after that if the block hasn't been closed, or moved there if the client has
+// inserted it straight after the {.
+func leftBraceEdits(doc *document, pos *protocol.Position) []*protocol.TextEdit {
+	block, depth, ok := findBlock(doc.Program, func(block *ast.Block) bool {
+		return equalPositions(pos, block.LeftBrace.End())
+	})
+	if !ok {
+		return nil
+	}
+	start := block.LeftBrace.End()
+	end := start
+	newText := "\n" + format.Indentation(depth+1)
+	switch rest := strings.TrimSpace(string(start.File.Line(start.Line)[start.Column:])); {
+	case rest == token.RightBrace.String():
+		end = block.RightBrace.Start()
+		newText += "\n" + format.Indentation(depth)
+	case rest != "":
+		return nil
+	case block.RightBrace.IsZero():
+		newText += "\n" + format.Indentation(depth) + token.RightBrace.String()
+	}
+	return []*protocol.TextEdit{{Range: &protocol.Range{Start: newPosition(start), End: newPosition(end)}, NewText: newText}}
+}
+
+// rightBraceEdits returns the edits which indent a } typed at the start of a line to the depth of its block.
+func rightBraceEdits(doc *document, pos *protocol.Position) []*protocol.TextEdit {
+	block, depth, ok := findBlock(doc.Program, func(block *ast.Block) bool {
+		return !block.RightBrace.IsZero() && equalPositions(pos, block.RightBrace.End())
+	})
+	if !ok {
+		return nil
+	}
+	end := block.RightBrace.Start()
+	leading := string(end.File.Line(end.Line)[:end.Column])
+	if strings.TrimSpace(leading) != "" || leading == format.Indentation(depth) {
+		return nil
+	}
+	start := token.Position{File: end.File, Line: end.Line}
+	return []*protocol.TextEdit{
+		{Range: &protocol.Range{Start: newPosition(start), End: newPosition(end)}, NewText: format.Indentation(depth)},
+	}
+}
+
+// findBlock returns the first block in program which satisfies match and the number of blocks which enclose it.
+func findBlock(program *ast.Program, match func(*ast.Block) bool) (block *ast.Block, depth int, ok bool) {
+	var walk func(node ast.Node, curDepth int)
+	walk = func(node ast.Node, curDepth int) {
+		ast.WalkChildren(node, func(child *ast.Block) bool {
+			if ok {
+				return false
+			}
+			if match(child) {
+				block, depth, ok = child, curDepth, true
+				return false
+			}
+			walk(child, curDepth+1)
+			return false
+		})
+	}
+	walk(program, 0)
+	return block, depth, ok
+}
+
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocument_prepareRename
+func (h *Handler) textDocumentPrepareRename(params *protocol.PrepareRenameParams) (protocol.PrepareRenameResult, error) {
+	doc, err := h.document(params.TextDocument.Uri)
+	if err != nil {
+		return nil, err
+	}
+
+	ident, err := h.renameableIdent(doc, params.Position)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protocol.RangeOrPrepareRenameResultOr2OrPrepareRenameResultOr3{Value: newRange(ident)}, nil
+}
+
+// renameableIdent returns the identifier at the given position if it can be renamed, otherwise an error describing why
+// it can't be.
+func (h *Handler) renameableIdent(doc *document, pos *protocol.Position) (*ast.Ident, error) {
+	ident, ok := outermostNodeAt[*ast.Ident](doc.Program, pos)
+	if !ok {
+		return nil, newRequestFailedError("Only identifiers can be renamed")
+	}
+	bindings := doc.IdentBindings[ident]
+	if len(bindings) == 0 {
+		return nil, newRequestFailedError(fmt.Sprintf("%s can't be renamed as its declaration can't be found", ident))
+	}
+	for _, binding := range bindings {
+		if binding.Start().File.Name == h.builtinStubsFilename {
+			return nil, newRequestFailedError(fmt.Sprintf("%s is a built-in and can't be renamed", ident))
+		}
+	}
+	return ident, nil
+}
+
 // https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocument_rename
 func (h *Handler) textDocumentRename(params *protocol.RenameParams) (*protocol.WorkspaceEdit, error) {
 	doc, err := h.document(params.TextDocument.Uri)
@@ -659,6 +898,10 @@ func (h *Handler) textDocumentRename(params *protocol.RenameParams) (*protocol.W
 		return nil, err
 	}
 
+	if _, err := h.renameableIdent(doc, params.Position); err != nil {
+		return nil, err
+	}
+
 	refs, ok := references(doc, params.Position, true)
 	if !ok {
 		return nil, nil
@@ -689,6 +932,253 @@ func (h *Handler) textDocumentRename(params *protocol.RenameParams) (*protocol.W
 	}, nil
 }
 
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocument_codeAction
+func (h *Handler) textDocumentCodeAction(params *protocol.CodeActionParams) (protocol.CommandOrCodeActionSlice, error) {
+	doc, err := h.document(params.TextDocument.Uri)
+	if err != nil {
+		return nil, err
+	}
+
+	file := doc.Program.Start().File
+	start := newTokenPosition(file, params.Range.Start)
+	end := newTokenPosition(file, params.Range.End)
+	codeActions := protocol.CommandOrCodeActionSlice{}
+	for _, loxErr := range doc.LoxErrs {
+		if loxErr.Fix == nil || loxErr.Start().Compare(end) > 0 || loxErr.End().Compare(start) < 0 {
+			continue
+		}
+		codeActions = append(codeActions, &protocol.CommandOrCodeAction{
+			Value: &protocol.CodeAction{
+				Title:       loxErr.Fix.Description,
+				Kind:        protocol.CodeActionKindQuickFix,
+				Diagnostics: []*protocol.Diagnostic{newDiagnostic(loxErr)},
+				IsPreferred: true,
+				Edit: &protocol.WorkspaceEdit{
+					DocumentChanges: []*protocol.TextDocumentEditOrCreateFileOrRenameFileOrDeleteFile{
+						{
+							Value: &protocol.TextDocumentEdit{
+								TextDocument: &protocol.OptionalVersionedTextDocumentIdentifier{
+									TextDocumentIdentifier: &protocol.TextDocumentIdentifier{Uri: doc.URI},
+									Version:                doc.Version,
+								},
+								Edits: []*protocol.TextEditOrAnnotatedTextEdit{
+									{
+										Value: &protocol.TextEdit{
+											Range: &protocol.Range{
+												Start: newPosition(loxErr.Fix.Start),
+												End:   newPosition(loxErr.Fix.End),
+											},
+											NewText: loxErr.Fix.NewText,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+	return codeActions, nil
+}
+
+// showReferencesCommand is the command of the code lenses returned by textDocument/codeLens once they've been resolved.
+// It's passed the URI of the document, the position of the declaration, and the locations of its references so that
+// editors which support it can open them in a references view.
+const showReferencesCommand = "golox.showReferences"
+
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocument_codeLens
+func (h *Handler) textDocumentCodeLens(params *protocol.CodeLensParams) ([]*protocol.CodeLens, error) {
+	doc, err := h.document(params.TextDocument.Uri)
+	if err != nil {
+		return nil, err
+	}
+
+	lenses := []*protocol.CodeLens{}
+	ast.Walk(doc.Program, func(n ast.Node) bool {
+		switch decl := n.(type) {
+		case *ast.FunDecl, *ast.ClassDecl, *ast.VarDecl, *ast.DestructuredVarDecl:
+			ident := decl.(ast.Decl).BoundIdent()
+			if !ident.IsValid() || ident.String() == token.IdentBlank {
+				return true
+			}
+			// The command is filled in by codeLens/resolve so that references are only counted for visible lenses.
+			lenses = append(lenses, &protocol.CodeLens{
+				Range: newRange(ident),
+				Data:  &protocol.LSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBoolean{Value: protocol.String(doc.URI)},
+			})
+		default:
+		}
+		return true
+	})
+
+	return lenses, nil
+}
+
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#codeLens_resolve
+func (h *Handler) codeLensResolve(lens *protocol.CodeLens) (*protocol.CodeLens, error) {
+	ok := false
+	var uri protocol.String
+	if data := lens.GetData(); data != nil {
+		uri, ok = data.Value.(protocol.String)
+	}
+	if !ok {
+		return nil, jsonrpc.NewError(jsonrpc.InvalidParams, "Invalid params", map[string]any{"error": "code lens data is not a document URI"})
+	}
+	doc, err := h.document(string(uri))
+	if err != nil {
+		return nil, err
+	}
+
+	refs, _ := references(doc, lens.Range.Start, false)
+	locs := make([]*protocol.Location, len(refs))
+	for i, ref := range refs {
+		locs[i] = &protocol.Location{
+			Uri:   filenameToURI(ref.Start().File.Name),
+			Range: newRange(ref),
+		}
+	}
+	args := make([]protocol.LSPAny, 3)
+	for i, arg := range []any{doc.URI, lens.Range.Start, locs} {
+		if args[i], err = toLSPAny(arg); err != nil {
+			return nil, fmt.Errorf("codeLens/resolve: %s", err)
+		}
+	}
+
+	title := fmt.Sprintf("%d references", len(refs))
+	if len(refs) == 1 {
+		title = "1 reference"
+	}
+	lens.Command = &protocol.Command{
+		Title:     title,
+		Command:   showReferencesCommand,
+		Arguments: args,
+	}
+	return lens, nil
+}
+
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocument_prepareTypeHierarchy
+func (h *Handler) textDocumentPrepareTypeHierarchy(params *protocol.TypeHierarchyPrepareParams) (protocol.TypeHierarchyItemSlice, error) {
+	doc, err := h.document(params.TextDocument.Uri)
+	if err != nil {
+		return nil, err
+	}
+
+	defs, ok := definitions(doc, params.Position)
+	if !ok {
+		return nil, nil
+	}
+	for _, def := range defs {
+		if classDecl, ok := def.(*ast.ClassDecl); ok {
+			return protocol.TypeHierarchyItemSlice{newTypeHierarchyItem(classDecl)}, nil
+		}
+	}
+	return nil, nil
+}
+
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#typeHierarchy_supertypes
+func (h *Handler) typeHierarchySupertypes(params *protocol.TypeHierarchySupertypesParams) (protocol.TypeHierarchyItemSlice, error) {
+	doc, classDecl, err := h.typeHierarchyItemClassDecl(params.Item)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the direct superclass is returned. Clients request the supertypes of each item to walk up the rest of the
+	// chain.
+	items := protocol.TypeHierarchyItemSlice{}
+	for superclassDecl := range analyse.InheritanceChain(classDecl, doc.IdentBindings) {
+		if superclassDecl != classDecl {
+			items = append(items, newTypeHierarchyItem(superclassDecl))
+			break
+		}
+	}
+	return items, nil
+}
+
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#typeHierarchy_subtypes
+func (h *Handler) typeHierarchySubtypes(params *protocol.TypeHierarchySubtypesParams) (protocol.TypeHierarchyItemSlice, error) {
+	doc, classDecl, err := h.typeHierarchyItemClassDecl(params.Item)
+	if err != nil {
+		return nil, err
+	}
+
+	items := protocol.TypeHierarchyItemSlice{}
+	ast.Walk(doc.Program, func(subclassDecl *ast.ClassDecl) bool {
+		if subclassDecl == classDecl {
+			return true
+		}
+		if superclassBindings, ok := doc.IdentBindings[subclassDecl.Superclass]; ok && superclassBindings[0] == classDecl {
+			items = append(items, newTypeHierarchyItem(subclassDecl))
+		}
+		return true
+	})
+	return items, nil
+}
+
+// typeHierarchyItemClassDecl returns the class declaration that a type hierarchy item returned by
+// textDocument/prepareTypeHierarchy, typeHierarchy/supertypes, or typeHierarchy/subtypes refers to and the document
+// that it's declared in.
+func (h *Handler) typeHierarchyItemClassDecl(item *protocol.TypeHierarchyItem) (*document, *ast.ClassDecl, error) {
+	doc, err := h.document(item.Uri)
+	if err != nil {
+		return nil, nil, err
+	}
+	pos := item.GetSelectionRange().GetStart()
+	if pos == nil {
+		return nil, nil, jsonrpc.NewError(jsonrpc.InvalidParams, "Invalid params", map[string]any{"error": "type hierarchy item has no selection range"})
+	}
+	classDecl, ok := innermostNodeAt[*ast.ClassDecl](doc.Program, pos)
+	if !ok || !equalPositions(pos, classDecl.Name.Start()) {
+		return nil, nil, newRequestFailedError("Type hierarchy item no longer refers to a class")
+	}
+	return doc, classDecl, nil
+}
+
+func newTypeHierarchyItem(classDecl *ast.ClassDecl) *protocol.TypeHierarchyItem {
+	return &protocol.TypeHierarchyItem{
+		Name:           classDecl.Name.String(),
+		Kind:           protocol.SymbolKindClass,
+		Uri:            filenameToURI(classDecl.Start().File.Name),
+		Range:          newRange(classDecl),
+		SelectionRange: newRange(classDecl.Name),
+	}
+}
+
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocument_selectionRange
+func (h *Handler) textDocumentSelectionRange(params *protocol.SelectionRangeParams) (protocol.SelectionRangeSlice, error) {
+	doc, err := h.document(params.TextDocument.Uri)
+	if err != nil {
+		return nil, err
+	}
+
+	file := doc.Program.Start().File
+	selectionRanges := make(protocol.SelectionRangeSlice, len(params.Positions))
+	for i, pos := range params.Positions {
+		selectionRanges[i] = newSelectionRange(doc.Program, newTokenPosition(file, pos))
+	}
+	return selectionRanges, nil
+}
+
+// newSelectionRange returns the chain of ranges of the nodes in program which contain pos, starting with the innermost
+// node. Nodes which have the same range as their parent are skipped. If no nodes contain pos, then an empty range at pos
+// is returned.
+func newSelectionRange(program *ast.Program, pos token.Position) *protocol.SelectionRange {
+	nodes := ast.InnermostContaining(program, pos)
+	if len(nodes) == 0 {
+		return &protocol.SelectionRange{Range: &protocol.Range{Start: newPosition(pos), End: newPosition(pos)}}
+	}
+	var selectionRange *protocol.SelectionRange
+	var parent ast.Node
+	for _, node := range slices.Backward(nodes) {
+		if parent != nil && node.Start() == parent.Start() && node.End() == parent.End() {
+			continue
+		}
+		selectionRange = &protocol.SelectionRange{Range: newRange(node), Parent: selectionRange}
+		parent = node
+	}
+	return selectionRange
+}
+
 func filenameToURI(filename string) string {
 	return fmt.Sprintf("file://%s", filename)
 }
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/marcuscaisey/lox/golox/builtins"
+	"github.com/marcuscaisey/lox/golox/token"
 	"github.com/marcuscaisey/lox/loxls/lsp/protocol"
 )
 
@@ -60,6 +61,11 @@ func (h *Handler) initialize(params *protocol.InitializeParams[*initializationOp
 			TriggerCharacters: []string{"(", ",", ")"},
 		}
 	}
+	// RenameOptions can only be returned if the client supports the textDocument/prepareRename request.
+	renameProvider := &protocol.BooleanOrRenameOptions{Value: protocol.Boolean(true)}
+	if h.capabilities.GetTextDocument().GetRename().GetPrepareSupport() {
+		renameProvider.Value = &protocol.RenameOptions{PrepareProvider: true}
+	}
 	return &protocol.InitializeResult{
 		Capabilities: &protocol.ServerCapabilities{
 			PositionEncoding: protocol.PositionEncodingKindUTF16,
@@ -88,7 +94,21 @@ func (h *Handler) initialize(params *protocol.InitializeParams[*initializationOp
 			DocumentFormattingProvider: &protocol.BooleanOrDocumentFormattingOptions{
 				Value: protocol.Boolean(true),
 			},
-			RenameProvider: &protocol.BooleanOrRenameOptions{
+			DocumentOnTypeFormattingProvider: &protocol.DocumentOnTypeFormattingOptions{
+				FirstTriggerCharacter: token.LeftBrace.String(),
+				MoreTriggerCharacter:  []string{token.RightBrace.String(), token.Semicolon.String()},
+			},
+			RenameProvider: renameProvider,
+			CodeActionProvider: &protocol.BooleanOrCodeActionOptions{
+				Value: &protocol.CodeActionOptions{CodeActionKinds: []protocol.CodeActionKind{protocol.CodeActionKindQuickFix}},
+			},
+			CodeLensProvider: &protocol.CodeLensOptions{
+				ResolveProvider: true,
+			},
+			TypeHierarchyProvider: &protocol.BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptions{
+				Value: protocol.Boolean(true),
+			},
+			SelectionRangeProvider: &protocol.BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptions{
 				Value: protocol.Boolean(true),
 			},
 		},
@@ -149,10 +169,12 @@ func (h *Handler) shutdown() (any, error) {
 
 // https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#exit
 func (h *Handler) exit() error {
+	// The server should exit with success code 0 if the shutdown request has been received before, otherwise with error
+	// code 1.
 	code := 0
 	if !h.shuttingDown {
 		code = 1
 	}
-	os.Exit(code)
+	h.client.Exit(code)
 	return nil
 }
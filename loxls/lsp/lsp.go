@@ -2,12 +2,16 @@
 package lsp
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"unicode/utf16"
 
+	"github.com/marcuscaisey/lox/golox/analyse"
 	"github.com/marcuscaisey/lox/golox/ast"
 	"github.com/marcuscaisey/lox/golox/token"
+	"github.com/marcuscaisey/lox/golox/typecheck"
+	"github.com/marcuscaisey/lox/loxfmt/format"
 	"github.com/marcuscaisey/lox/loxls/lsp/protocol"
 )
 
@@ -39,6 +43,112 @@ func funSignature(params []*ast.ParamDecl) string {
 	return fmt.Sprintf("fun(%s)", formatParams(params))
 }
 
+// inferReturnType returns the type of the values returned by a function and whether it could be inferred.
+// The type can only be inferred if the function contains at least one return statement and every value that it can
+// return has the same type. This includes the nil which is returned if execution reaches the end of the function.
+func inferReturnType(fun *ast.Function, funTypes map[*ast.Function]*typecheck.TypeFunction) (string, bool) {
+	if fun == nil || fun.Body == nil || !containsReturn(fun.Body) {
+		return "", false
+	}
+	funType, ok := funTypes[fun]
+	if !ok {
+		return "", false
+	}
+	switch funType.Return.(type) {
+	case nil, typecheck.TypeUnknown, typecheck.TypeUnion:
+		return "", false
+	default:
+		return funType.Return.String(), true
+	}
+}
+
+// containsReturn reports whether a function body contains a return statement, excluding those in nested functions and
+// classes.
+func containsReturn(body *ast.Block) bool {
+	found := false
+	ast.Walk(body, func(node ast.Node) bool {
+		switch node.(type) {
+		case *ast.FunDecl, *ast.FunExpr, *ast.ClassDecl:
+			return false
+		case *ast.ReturnStmt:
+			found = true
+			return false
+		default:
+			return !found
+		}
+	})
+	return found
+}
+
+// instanceClass returns the class that expr evaluates to an instance of and whether it could be determined.
+// The class can be determined if expr is a call of a class or a variable which was initialised with one.
+func instanceClass(expr ast.Expr, identBindings map[*ast.Ident][]ast.Binding) (*ast.ClassDecl, bool) {
+	switch expr := expr.(type) {
+	case *ast.GroupExpr:
+		return instanceClass(expr.Expr, identBindings)
+	case *ast.CallExpr:
+		callee, ok := expr.Callee.(*ast.IdentExpr)
+		if !ok || !callee.IsValid() {
+			return nil, false
+		}
+		bindings := identBindings[callee.Ident]
+		if len(bindings) != 1 {
+			return nil, false
+		}
+		classDecl, ok := bindings[0].(*ast.ClassDecl)
+		return classDecl, ok
+	case *ast.IdentExpr:
+		if !expr.IsValid() {
+			return nil, false
+		}
+		bindings := identBindings[expr.Ident]
+		if len(bindings) != 1 {
+			return nil, false
+		}
+		varDecl, ok := bindings[0].(*ast.VarDecl)
+		if !ok || varDecl.Initialiser == nil {
+			return nil, false
+		}
+		return instanceClass(varDecl.Initialiser, identBindings)
+	default:
+		return nil, false
+	}
+}
+
+// instanceProperty returns the declaration of the instance property with the given name of a class, along with the
+// class that it's declared in. The class is searched first, followed by its superclasses. Methods take precedence over
+// fields which are assigned to in the same class.
+func instanceProperty(classDecl *ast.ClassDecl, name string, identBindings map[*ast.Ident][]ast.Binding) (ast.Binding, *ast.ClassDecl, bool) {
+	for curClassDecl := range analyse.InheritanceChain(classDecl, identBindings) {
+		for _, methodDecl := range curClassDecl.Methods() {
+			if !methodDecl.IsStatic() && methodDecl.Name.IsValid() && methodDecl.Name.String() == name {
+				return methodDecl, curClassDecl, true
+			}
+		}
+		for _, methodDecl := range curClassDecl.Methods() {
+			if methodDecl.IsStatic() {
+				continue
+			}
+			field, ok := ast.Find(methodDecl, func(expr *ast.PropertySetExpr) bool {
+				_, isThis := expr.Object.(*ast.ThisExpr)
+				return isThis && expr.Name.IsValid() && expr.Name.String() == name
+			})
+			if ok {
+				return field, curClassDecl, true
+			}
+		}
+	}
+	return nil, nil, false
+}
+
+// withReturnType appends the inferred return type of a function to its detail, if one can be inferred.
+func withReturnType(detail string, fun *ast.Function, funTypes map[*ast.Function]*typecheck.TypeFunction) string {
+	if returnType, ok := inferReturnType(fun, funTypes); ok {
+		return fmt.Sprintf("%s -> %s", detail, returnType)
+	}
+	return detail
+}
+
 func classDetail(decl *ast.ClassDecl) (string, bool) {
 	if !decl.Name.IsValid() {
 		return "", false
@@ -51,11 +161,15 @@ func methodDetail(methodDecl *ast.MethodDecl) (string, bool) {
 		return "", false
 	}
 	if methodDecl.IsGetter() {
+		name, ok := ast.QualifiedName(methodDecl)
+		if !ok {
+			return "", false
+		}
 		static := ""
 		if methodDecl.IsStatic() {
 			static = "static "
 		}
-		return fmt.Sprintf("(property) %s%s.%s", static, methodDecl.Class.Name, methodDecl.Name), true
+		return fmt.Sprintf("(property) %s%s", static, name), true
 	}
 	prefix, ok := methodDetailPrefix(methodDecl)
 	if !ok {
@@ -73,10 +187,11 @@ func methodDetailPrefix(methodDecl *ast.MethodDecl) (string, bool) {
 }
 
 func formatMethodName(decl *ast.MethodDecl) (string, bool) {
-	if !decl.Name.IsValid() || decl.Class == nil || !decl.Class.Name.IsValid() {
+	name, ok := ast.QualifiedName(decl)
+	if !ok {
 		return "", false
 	}
-	return fmt.Sprintf("%s%s.%s", formatMethodModifiers(decl.Modifiers), decl.Class.Name, decl.Name), true
+	return formatMethodModifiers(decl.Modifiers) + name, true
 }
 
 func formatMethodModifiers(modifiers []token.Token) string {
@@ -90,7 +205,7 @@ func formatMethodModifiers(modifiers []token.Token) string {
 func formatParams(params []*ast.ParamDecl) string {
 	b := &strings.Builder{}
 	for i, param := range params {
-		fmt.Fprint(b, param.Name.String())
+		fmt.Fprint(b, format.Node(param))
 		if i < len(params)-1 {
 			fmt.Fprint(b, ", ")
 		}
@@ -187,3 +302,16 @@ func utf16StringLen(s string) int {
 func utf16BytesLen(b []byte) int {
 	return utf16StringLen(string(b))
 }
+
+// toLSPAny converts a value to a [protocol.LSPAny] via its JSON representation.
+func toLSPAny(value any) (protocol.LSPAny, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("converting %T to LSPAny: %s", value, err)
+	}
+	var anyValue protocol.LSPAny
+	if err := json.Unmarshal(data, &anyValue); err != nil {
+		return nil, fmt.Errorf("converting %T to LSPAny: %s", value, err)
+	}
+	return anyValue, nil
+}
@@ -0,0 +1,1269 @@
+package lsp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/marcuscaisey/lox/golox/analyse"
+	"github.com/marcuscaisey/lox/golox/ast"
+	"github.com/marcuscaisey/lox/golox/builtins"
+	"github.com/marcuscaisey/lox/golox/loxerr"
+	"github.com/marcuscaisey/lox/golox/parser"
+	"github.com/marcuscaisey/lox/golox/typecheck"
+	"github.com/marcuscaisey/lox/loxls/jsonrpc"
+	"github.com/marcuscaisey/lox/loxls/lsp/protocol"
+)
+
+func TestInferReturnType(t *testing.T) {
+	tests := []struct {
+		name   string
+		src    string
+		want   string
+		wantOk bool
+	}{
+		{
+			name:   "string literal",
+			src:    `fun f() { return "hello"; }`,
+			want:   "string",
+			wantOk: true,
+		},
+		{
+			name:   "same literal type in all returns",
+			src:    `fun f(x) { if (x) { return 1; } return (2); }`,
+			want:   "number",
+			wantOk: true,
+		},
+		{
+			name:   "bare return",
+			src:    `fun f() { return; }`,
+			want:   "nil",
+			wantOk: true,
+		},
+		{
+			name:   "class instance",
+			src:    `class A {} fun f() { return A(); }`,
+			want:   "A",
+			wantOk: true,
+		},
+		{
+			name:   "mixed returns",
+			src:    `fun f(x) { if (x) { return "a"; } return 1; }`,
+			wantOk: false,
+		},
+		{
+			name:   "non-literal return",
+			src:    `fun f(x) { return x; }`,
+			wantOk: false,
+		},
+		{
+			name:   "no returns",
+			src:    `fun f() {}`,
+			wantOk: false,
+		},
+		{
+			name:   "falls off end after returning value",
+			src:    `fun f(x) { if (x) return 1; }`,
+			wantOk: false,
+		},
+		{
+			name:   "falls off end after bare return",
+			src:    `fun f(x) { if (x) return; }`,
+			want:   "nil",
+			wantOk: true,
+		},
+		{
+			name:   "all branches return",
+			src:    `fun f(x) { if (x) return 1; else return 2; }`,
+			want:   "number",
+			wantOk: true,
+		},
+		{
+			name:   "arithmetic",
+			src:    `fun f() { return 1 + 2; }`,
+			want:   "number",
+			wantOk: true,
+		},
+		{
+			name:   "returns in nested function ignored",
+			src:    `fun f() { fun g() { return 1; } return "a"; }`,
+			want:   "string",
+			wantOk: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			program, err := parser.Parse(strings.NewReader(test.src), "test.lox")
+			if err != nil {
+				t.Fatalf("parsing program: %s", err)
+			}
+			types, _ := typecheck.Infer(program, nil)
+			funDecl, ok := ast.FindLast(program, func(decl *ast.FunDecl) bool { return decl.Name.String() == "f" })
+			if !ok {
+				t.Fatal("function f not found")
+			}
+
+			got, gotOk := inferReturnType(funDecl.Function, types.Functions)
+
+			if got != test.want || gotOk != test.wantOk {
+				t.Errorf("inferReturnType() = (%q, %t), want (%q, %t)", got, gotOk, test.want, test.wantOk)
+			}
+		})
+	}
+}
+
+func TestHoverReturnType(t *testing.T) {
+	src := `fun falls(x) {
+  if (x) return 1;
+}
+fun returns(x) {
+  if (x) return 1;
+  return 2;
+}
+falls(true);
+returns(true);
+`
+	tests := []struct {
+		name       string
+		line       int
+		wantHeader []string
+	}{
+		{name: "function falling off end", line: 7, wantHeader: []string{"fun falls(x)"}},
+		{name: "function always returning", line: 8, wantHeader: []string{"fun returns(x) -> number"}},
+	}
+	doc := mustNewDocument(t, src, nil)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gotHeaders, _ := hoverHeadersAndBody(doc, &protocol.Position{Line: test.line, Character: 0})
+
+			if !slices.Equal(gotHeaders, test.wantHeader) {
+				t.Errorf("hoverHeadersAndBody() headers = %q, want %q", gotHeaders, test.wantHeader)
+			}
+		})
+	}
+}
+
+func TestHoverInstanceProperty(t *testing.T) {
+	src := `class A {
+  // greet greets.
+  greet() {}
+
+  init() {
+    this.field = 1;
+  }
+}
+
+class B < A {
+  wave() {}
+}
+
+var a = A();
+a.greet();
+var b = B();
+b.wave();
+b.greet();
+b.field;
+b.unknown;
+`
+	tests := []struct {
+		name       string
+		line       int
+		character  int
+		wantHeader []string
+		wantBody   string
+	}{
+		{
+			name:       "instance method",
+			line:       14,
+			character:  2,
+			wantHeader: []string{"(method) A.greet()"},
+			wantBody:   "greet greets.",
+		},
+		{
+			name:       "method declared in class",
+			line:       16,
+			character:  2,
+			wantHeader: []string{"(method) B.wave()"},
+		},
+		{
+			name:       "inherited method",
+			line:       17,
+			character:  2,
+			wantHeader: []string{"// Inherited from A\n(method) A.greet()"},
+			wantBody:   "greet greets.",
+		},
+		{
+			name:       "inherited field",
+			line:       18,
+			character:  2,
+			wantHeader: []string{"// Inherited from A\n(field) A.field"},
+		},
+		{
+			name:      "unknown property",
+			line:      19,
+			character: 2,
+		},
+	}
+	doc := mustNewDocument(t, src, nil)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gotHeaders, gotBody := hoverHeadersAndBody(doc, &protocol.Position{Line: test.line, Character: test.character})
+
+			if !slices.Equal(gotHeaders, test.wantHeader) || gotBody != test.wantBody {
+				t.Errorf("hoverHeadersAndBody() = (%q, %q), want (%q, %q)", gotHeaders, gotBody, test.wantHeader, test.wantBody)
+			}
+		})
+	}
+}
+
+func TestHoverDeprecated(t *testing.T) {
+	src := `// add adds.
+@deprecated("use sum instead")
+fun add(a, b) {}
+
+@deprecated
+class Old {}
+
+add(1, 2);
+Old();
+`
+	tests := []struct {
+		name       string
+		line       int
+		character  int
+		wantHeader []string
+		wantBody   string
+	}{
+		{
+			name:       "deprecated with message",
+			line:       7,
+			character:  0,
+			wantHeader: []string{"fun add(a, b)"},
+			wantBody:   "Deprecated: use sum instead\n\nadd adds.",
+		},
+		{
+			name:       "deprecated without message",
+			line:       8,
+			character:  0,
+			wantHeader: []string{"class Old {}"},
+			wantBody:   "Deprecated.",
+		},
+	}
+	doc := mustNewDocument(t, src, nil)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gotHeaders, gotBody := hoverHeadersAndBody(doc, &protocol.Position{Line: test.line, Character: test.character})
+
+			if !slices.Equal(gotHeaders, test.wantHeader) || gotBody != test.wantBody {
+				t.Errorf("hoverHeadersAndBody() = (%q, %q), want (%q, %q)", gotHeaders, gotBody, test.wantHeader, test.wantBody)
+			}
+		})
+	}
+}
+
+func TestDefinitionOfBuiltin(t *testing.T) {
+	builtinStubsFilename := "/cache/loxls/builtins.lox"
+	builtinStubs := builtins.MustParseStubs(builtinStubsFilename)
+	doc := mustNewDocument(t, "print clock();\n", builtinStubs)
+	h := &Handler{docs: map[string]*document{doc.URI: doc}}
+
+	got, err := h.textDocumentDefinition(&protocol.DefinitionParams{
+		TextDocumentPositionParams: &protocol.TextDocumentPositionParams{
+			TextDocument: &protocol.TextDocumentIdentifier{Uri: doc.URI},
+			Position:     &protocol.Position{Line: 0, Character: 7},
+		},
+	})
+	if err != nil {
+		t.Fatalf("textDocumentDefinition() returned error: %s", err)
+	}
+
+	want := &protocol.LocationOrLocationSlice{Value: protocol.LocationSlice{
+		{
+			Uri: "file:///cache/loxls/builtins.lox",
+			Range: &protocol.Range{
+				Start: &protocol.Position{Line: 4, Character: 4},
+				End:   &protocol.Position{Line: 4, Character: 9},
+			},
+		},
+	}}
+	gotJSON, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantJSON, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(gotJSON) != string(wantJSON) {
+		t.Errorf("textDocumentDefinition() = %s, want %s", gotJSON, wantJSON)
+	}
+}
+
+func TestPrepareRename(t *testing.T) {
+	builtinStubsFilename := "/cache/loxls/builtins.lox"
+	builtinStubs := builtins.MustParseStubs(builtinStubsFilename)
+	doc := mustNewDocument(t, "var start = clock();\nprint start;\n", builtinStubs)
+	h := &Handler{
+		docs:                 map[string]*document{doc.URI: doc},
+		builtinStubsFilename: builtinStubsFilename,
+		builtinStubs:         builtinStubs,
+	}
+	tests := []struct {
+		name      string
+		line      int
+		character int
+		wantRange *protocol.Range
+		wantErr   string
+	}{
+		{
+			name:      "user-defined variable",
+			line:      1,
+			character: 8,
+			wantRange: &protocol.Range{
+				Start: &protocol.Position{Line: 1, Character: 6},
+				End:   &protocol.Position{Line: 1, Character: 11},
+			},
+		},
+		{
+			name:      "built-in",
+			line:      0,
+			character: 13,
+			wantErr:   "clock is a built-in and can't be renamed",
+		},
+		{
+			name:      "keyword",
+			line:      1,
+			character: 2,
+			wantErr:   "Only identifiers can be renamed",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := h.textDocumentPrepareRename(&protocol.PrepareRenameParams{
+				TextDocumentPositionParams: &protocol.TextDocumentPositionParams{
+					TextDocument: &protocol.TextDocumentIdentifier{Uri: doc.URI},
+					Position:     &protocol.Position{Line: test.line, Character: test.character},
+				},
+			})
+
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("textDocumentPrepareRename() returned error %v, want error containing %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("textDocumentPrepareRename() returned error: %s", err)
+			}
+			gotJSON, err := json.Marshal(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			wantJSON, err := json.Marshal(test.wantRange)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(gotJSON) != string(wantJSON) {
+				t.Errorf("textDocumentPrepareRename() = %s, want %s", gotJSON, wantJSON)
+			}
+		})
+	}
+}
+
+func TestRenameDocumentedFunction(t *testing.T) {
+	src := `// add returns the sum of a and b.
+// It's used below.
+fun add(a, b) {
+  return a + b;
+}
+
+print add(1, 2);
+`
+	doc := mustNewDocument(t, src, nil)
+	h := &Handler{docs: map[string]*document{doc.URI: doc}}
+
+	got, err := h.textDocumentRename(&protocol.RenameParams{
+		TextDocument: &protocol.TextDocumentIdentifier{Uri: doc.URI},
+		Position:     &protocol.Position{Line: 6, Character: 7},
+		NewName:      "sum",
+	})
+	if err != nil {
+		t.Fatalf("textDocumentRename() returned error: %s", err)
+	}
+
+	docEdit, ok := got.DocumentChanges[0].Value.(*protocol.TextDocumentEdit)
+	if !ok {
+		t.Fatalf("textDocumentRename() returned document change of type %T, want *protocol.TextDocumentEdit", got.DocumentChanges[0].Value)
+	}
+	file := doc.Program.Start().File
+	renamed := src
+	for _, edit := range slices.Backward(docEdit.Edits) {
+		textEdit := edit.Value.(*protocol.TextEdit)
+		start := newTokenPosition(file, textEdit.Range.Start).Offset()
+		end := newTokenPosition(file, textEdit.Range.End).Offset()
+		renamed = renamed[:start] + textEdit.NewText + renamed[end:]
+	}
+
+	renamedDoc := mustNewDocument(t, renamed, nil)
+	funDecl, ok := renamedDoc.Program.Stmts[0].(*ast.FunDecl)
+	if !ok {
+		t.Fatalf("first statement of renamed program is %T, want *ast.FunDecl\nrenamed program:\n%s", renamedDoc.Program.Stmts[0], renamed)
+	}
+	if funDecl.Name.String() != "sum" {
+		t.Errorf("renamed function has name %q, want %q\nrenamed program:\n%s", funDecl.Name, "sum", renamed)
+	}
+	wantDoc := "add returns the sum of a and b.\nIt's used below."
+	if gotDoc := funDecl.Documentation(); gotDoc != wantDoc {
+		t.Errorf("renamed function has documentation %q, want %q\nrenamed program:\n%s", gotDoc, wantDoc, renamed)
+	}
+}
+
+func TestCodeActionInsertSemicolon(t *testing.T) {
+	filename := "/test.lox"
+	src := "print 1\n"
+	program, err := parser.Parse(strings.NewReader(src), filename, parser.WithComments(true))
+	var loxErrs loxerr.Errors
+	if !errors.As(err, &loxErrs) {
+		t.Fatalf("parsing program returned error %v, want loxerr.Errors", err)
+	}
+	doc := &document{
+		URI:      filenameToURI(filename),
+		Version:  1,
+		Text:     src,
+		Filename: filename,
+		Program:  program,
+		LoxErrs:  loxErrs,
+	}
+	h := &Handler{docs: map[string]*document{doc.URI: doc}}
+
+	got, err := h.textDocumentCodeAction(&protocol.CodeActionParams{
+		TextDocument: &protocol.TextDocumentIdentifier{Uri: doc.URI},
+		Range: &protocol.Range{
+			Start: &protocol.Position{Line: 1, Character: 0},
+			End:   &protocol.Position{Line: 1, Character: 0},
+		},
+		Context: &protocol.CodeActionContext{},
+	})
+	if err != nil {
+		t.Fatalf("textDocumentCodeAction() returned error: %s", err)
+	}
+
+	gotJSON, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantJSON := `[{"title":"Insert ;","kind":"quickfix",` +
+		`"diagnostics":[{"range":{"start":{"line":1,"character":0},"end":{"line":1,"character":0}},"severity":1,"source":"loxls","message":"expected trailing ';'"}],` +
+		`"isPreferred":true,` +
+		`"edit":{"documentChanges":[{"textDocument":{"uri":"file:///test.lox","version":1},` +
+		`"edits":[{"range":{"start":{"line":0,"character":7},"end":{"line":0,"character":7}},"newText":";"}]}]}}]`
+	if string(gotJSON) != wantJSON {
+		t.Errorf("textDocumentCodeAction() =\n%s\nwant\n%s", gotJSON, wantJSON)
+	}
+}
+
+func TestCodeLens(t *testing.T) {
+	src := `fun greet() {}
+greet();
+greet();
+greet();
+var unused = 1;
+`
+	doc := mustNewDocument(t, src, nil)
+	h := &Handler{docs: map[string]*document{doc.URI: doc}}
+
+	lenses, err := h.textDocumentCodeLens(&protocol.CodeLensParams{
+		TextDocument: &protocol.TextDocumentIdentifier{Uri: doc.URI},
+	})
+	if err != nil {
+		t.Fatalf("textDocumentCodeLens() returned error: %s", err)
+	}
+
+	wantTitles := []string{"3 references", "0 references"}
+	if len(lenses) != len(wantTitles) {
+		t.Fatalf("textDocumentCodeLens() returned %d lenses, want %d", len(lenses), len(wantTitles))
+	}
+	for i, lens := range lenses {
+		if lens.Command != nil {
+			t.Errorf("textDocumentCodeLens() returned lens %d with command %q, want unresolved lens", i, lens.Command.Title)
+		}
+		// Send the lens back to the server as a client would so that its data is decoded from JSON.
+		lensJSON, err := json.Marshal(lens)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var unresolvedLens *protocol.CodeLens
+		if err := json.Unmarshal(lensJSON, &unresolvedLens); err != nil {
+			t.Fatal(err)
+		}
+		resolvedLens, err := h.codeLensResolve(unresolvedLens)
+		if err != nil {
+			t.Fatalf("codeLensResolve() returned error: %s", err)
+		}
+		if got := resolvedLens.GetCommand().GetTitle(); got != wantTitles[i] {
+			t.Errorf("codeLensResolve() returned lens %d with title %q, want %q", i, got, wantTitles[i])
+		}
+		if got := resolvedLens.GetCommand().GetCommand(); got != showReferencesCommand {
+			t.Errorf("codeLensResolve() returned lens %d with command %q, want %q", i, got, showReferencesCommand)
+		}
+	}
+}
+
+func TestTypeHierarchy(t *testing.T) {
+	src := `class A {}
+class B < A {}
+class C < B {}
+class D < A {}
+class E {}
+`
+	doc := mustNewDocument(t, src, nil)
+	h := &Handler{docs: map[string]*document{doc.URI: doc}}
+
+	names := func(items protocol.TypeHierarchyItemSlice) []string {
+		names := []string{}
+		for _, item := range items {
+			names = append(names, item.Name)
+		}
+		return names
+	}
+	prepare := func(t *testing.T, pos *protocol.Position) protocol.TypeHierarchyItemSlice {
+		t.Helper()
+		items, err := h.textDocumentPrepareTypeHierarchy(&protocol.TypeHierarchyPrepareParams{
+			TextDocumentPositionParams: &protocol.TextDocumentPositionParams{
+				TextDocument: &protocol.TextDocumentIdentifier{Uri: doc.URI},
+				Position:     pos,
+			},
+		})
+		if err != nil {
+			t.Fatalf("textDocumentPrepareTypeHierarchy() returned error: %s", err)
+		}
+		return items
+	}
+
+	tests := []struct {
+		name           string
+		pos            *protocol.Position
+		wantSupertypes []string
+		wantSubtypes   []string
+	}{
+		{
+			name:           "root class",
+			pos:            &protocol.Position{Line: 0, Character: 6},
+			wantSupertypes: []string{},
+			wantSubtypes:   []string{"B", "D"},
+		},
+		{
+			name:           "superclass reference",
+			pos:            &protocol.Position{Line: 2, Character: 10},
+			wantSupertypes: []string{"A"},
+			wantSubtypes:   []string{"C"},
+		},
+		{
+			name:           "leaf class",
+			pos:            &protocol.Position{Line: 2, Character: 6},
+			wantSupertypes: []string{"B"},
+			wantSubtypes:   []string{},
+		},
+		{
+			name:           "unrelated class",
+			pos:            &protocol.Position{Line: 4, Character: 6},
+			wantSupertypes: []string{},
+			wantSubtypes:   []string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			items := prepare(t, test.pos)
+			if len(items) != 1 {
+				t.Fatalf("textDocumentPrepareTypeHierarchy() returned %d items, want 1", len(items))
+			}
+			// Send the item back to the server as a client would.
+			itemJSON, err := json.Marshal(items[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			var item *protocol.TypeHierarchyItem
+			if err := json.Unmarshal(itemJSON, &item); err != nil {
+				t.Fatal(err)
+			}
+
+			supertypes, err := h.typeHierarchySupertypes(&protocol.TypeHierarchySupertypesParams{Item: item})
+			if err != nil {
+				t.Fatalf("typeHierarchySupertypes() returned error: %s", err)
+			}
+			if got := names(supertypes); !slices.Equal(got, test.wantSupertypes) {
+				t.Errorf("typeHierarchySupertypes() = %q, want %q", got, test.wantSupertypes)
+			}
+			subtypes, err := h.typeHierarchySubtypes(&protocol.TypeHierarchySubtypesParams{Item: item})
+			if err != nil {
+				t.Fatalf("typeHierarchySubtypes() returned error: %s", err)
+			}
+			if got := names(subtypes); !slices.Equal(got, test.wantSubtypes) {
+				t.Errorf("typeHierarchySubtypes() = %q, want %q", got, test.wantSubtypes)
+			}
+		})
+	}
+
+	t.Run("not a class", func(t *testing.T) {
+		if items := prepare(t, &protocol.Position{Line: 0, Character: 0}); items != nil {
+			t.Errorf("textDocumentPrepareTypeHierarchy() = %q, want nil", names(items))
+		}
+	})
+}
+
+func TestSelectionRange(t *testing.T) {
+	src := `fun f(a, b, c) {
+  return (a + b) * c;
+}
+`
+	doc := mustNewDocument(t, src, nil)
+	h := &Handler{docs: map[string]*document{doc.URI: doc}}
+
+	selectionRanges, err := h.textDocumentSelectionRange(&protocol.SelectionRangeParams{
+		TextDocument: &protocol.TextDocumentIdentifier{Uri: doc.URI},
+		Positions:    []*protocol.Position{{Line: 1, Character: 14}},
+	})
+	if err != nil {
+		t.Fatalf("textDocumentSelectionRange() returned error: %s", err)
+	}
+	if len(selectionRanges) != 1 {
+		t.Fatalf("textDocumentSelectionRange() returned %d selection ranges, want 1", len(selectionRanges))
+	}
+
+	var got []string
+	for selectionRange := selectionRanges[0]; selectionRange != nil; selectionRange = selectionRange.Parent {
+		start, end := selectionRange.Range.Start, selectionRange.Range.End
+		got = append(got, fmt.Sprintf("%d:%d-%d:%d", start.Line, start.Character, end.Line, end.Character))
+	}
+	want := []string{
+		"1:14-1:15", // b
+		"1:10-1:15", // a + b
+		"1:9-1:16",  // (a + b)
+		"1:9-1:20",  // (a + b) * c
+		"1:2-1:21",  // return (a + b) * c;
+		"0:15-2:1",  // function body
+		"0:5-2:1",   // function
+		"0:0-2:1",   // function declaration
+		"0:0-3:0",   // program
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("textDocumentSelectionRange() returned ranges:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestOnTypeFormatting(t *testing.T) {
+	tests := []struct {
+		name      string
+		src       string
+		line      int
+		character int
+		ch        string
+		want      string
+	}{
+		{
+			name:      "left brace opening unclosed block",
+			src:       "fun f() {\n",
+			line:      0,
+			character: 9,
+			ch:        "{",
+			want:      "fun f() {\n  \n}\n",
+		},
+		{
+			name:      "left brace opening unclosed nested block",
+			src:       "fun f() {\n  if (x) {\n",
+			line:      1,
+			character: 10,
+			ch:        "{",
+			want:      "fun f() {\n  if (x) {\n    \n  }\n",
+		},
+		{
+			name:      "left brace opening block closed by client",
+			src:       "class A {\n  m() {}\n}\n",
+			line:      1,
+			character: 7,
+			ch:        "{",
+			want:      "class A {\n  m() {\n    \n  }\n}\n",
+		},
+		{
+			name:      "left brace opening block closed on later line",
+			src:       "fun f() {\n  while (true) {\n  }\n}\n",
+			line:      1,
+			character: 16,
+			ch:        "{",
+			want:      "fun f() {\n  while (true) {\n    \n  }\n}\n",
+		},
+		{
+			name:      "left brace followed by statement",
+			src:       "if (x) { print x;\n",
+			line:      0,
+			character: 8,
+			ch:        "{",
+			want:      "if (x) { print x;\n",
+		},
+		{
+			name:      "right brace closing nested block",
+			src:       "fun f() {\n  if (x) {\n    print x;\n    }\n}\n",
+			line:      3,
+			character: 5,
+			ch:        "}",
+			want:      "fun f() {\n  if (x) {\n    print x;\n  }\n}\n",
+		},
+		{
+			name:      "right brace closing top-level block",
+			src:       "{\n  print 1;\n  }\n",
+			line:      2,
+			character: 3,
+			ch:        "}",
+			want:      "{\n  print 1;\n}\n",
+		},
+		{
+			name:      "right brace after statement",
+			src:       "{\n  print 1; }\n",
+			line:      1,
+			character: 12,
+			ch:        "}",
+			want:      "{\n  print 1; }\n",
+		},
+		{
+			name:      "semicolon",
+			src:       "{\n    print 1;\n}\n",
+			line:      1,
+			character: 12,
+			ch:        ";",
+			want:      "{\n    print 1;\n}\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filename := "/test.lox"
+			program, _ := parser.Parse(strings.NewReader(test.src), filename, parser.WithComments(true))
+			doc := &document{URI: filenameToURI(filename), Text: test.src, Filename: filename, Program: program}
+			h := &Handler{docs: map[string]*document{doc.URI: doc}}
+
+			edits, err := h.textDocumentOnTypeFormatting(&protocol.DocumentOnTypeFormattingParams{
+				TextDocument: &protocol.TextDocumentIdentifier{Uri: doc.URI},
+				Position:     &protocol.Position{Line: test.line, Character: test.character},
+				Ch:           test.ch,
+			})
+			if err != nil {
+				t.Fatalf("textDocumentOnTypeFormatting() returned error: %s", err)
+			}
+
+			got := test.src
+			for _, edit := range slices.Backward(edits) {
+				got, err = applyIncrementalTextChange(got, &protocol.IncrementalTextDocumentContentChangeEvent{
+					Range: edit.Range,
+					Text:  edit.NewText,
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			if got != test.want {
+				t.Errorf("applying textDocumentOnTypeFormatting() edits to\n%q\ngave\n%q\nwant\n%q", test.src, got, test.want)
+			}
+		})
+	}
+}
+
+func TestCompleteSuperProperties(t *testing.T) {
+	src := `class A {
+  a() {}
+  _private() {}
+  get prop() {}
+}
+class B < A {
+  b() {}
+}
+class C < B {
+  c() {
+    super.
+  }
+}
+`
+	// The program is incomplete, so an error is expected.
+	program, _ := parser.Parse(strings.NewReader(src), "test.lox")
+	identBindings, _ := analyse.ResolveIdents(program, nil)
+	c := newCompletor(program, identBindings, nil, nil)
+
+	compls, _ := c.Complete(&protocol.Position{Line: 10, Character: 10})
+
+	var got []string
+	for _, compl := range compls {
+		got = append(got, compl.Label)
+	}
+	want := []string{"a", "b"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Complete() returned completions with labels %q, want %q", got, want)
+	}
+}
+
+func TestCompleteThisPropertiesInStaticMethod(t *testing.T) {
+	src := `class A {
+  instanceMethod() {}
+  static staticMethod() {}
+  static create() {
+    this.
+  }
+}
+`
+	// The program is incomplete, so an error is expected.
+	program, _ := parser.Parse(strings.NewReader(src), "test.lox")
+	identBindings, _ := analyse.ResolveIdents(program, nil)
+	c := newCompletor(program, identBindings, nil, nil)
+
+	compls, _ := c.Complete(&protocol.Position{Line: 4, Character: 9})
+
+	var got []string
+	for _, compl := range compls {
+		got = append(got, compl.Label)
+	}
+	want := []string{"create", "staticMethod"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Complete() returned completions with labels %q, want %q", got, want)
+	}
+}
+
+func TestCompleteStringProperties(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		pos  *protocol.Position
+		want []string
+	}{
+		{
+			name: "string literal",
+			src: `class A {
+  m() {}
+}
+"x".
+`,
+			pos:  &protocol.Position{Line: 3, Character: 4},
+			want: nil,
+		},
+		{
+			name: "variable holding a string",
+			src: `class A {
+  m() {}
+}
+var s = "x";
+s.
+`,
+			pos:  &protocol.Position{Line: 4, Character: 2},
+			want: nil,
+		},
+		{
+			name: "variable of unknown type",
+			src: `class A {
+  m() {}
+}
+fun f(s) {
+  s.
+}
+`,
+			pos:  &protocol.Position{Line: 4, Character: 4},
+			want: []string{"m"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// The program is incomplete, so an error is expected.
+			program, _ := parser.Parse(strings.NewReader(test.src), "test.lox")
+			identBindings, _ := analyse.ResolveIdents(program, nil)
+			types, _ := typecheck.Infer(program, nil)
+			c := newCompletor(program, identBindings, types.Idents, nil)
+
+			compls, _ := c.Complete(test.pos)
+
+			var got []string
+			for _, compl := range compls {
+				got = append(got, compl.Label)
+			}
+			if !slices.Equal(got, test.want) {
+				t.Errorf("Complete() returned completions with labels %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCompletePriority(t *testing.T) {
+	src := `var cat = 1;
+fun f() {
+  var count = 2;
+  {
+    var cup = 3;
+    c
+  }
+}
+`
+	program, _ := parser.Parse(strings.NewReader(src), "test.lox")
+	builtinStubs := builtins.MustParseStubs("builtins.lox")
+	identBindings, _ := analyse.ResolveIdents(program, builtinStubs)
+	c := newCompletor(program, identBindings, nil, builtinStubs)
+
+	compls, _ := c.Complete(&protocol.Position{Line: 5, Character: 5})
+
+	var got []string
+	for _, compl := range compls {
+		if strings.HasPrefix(compl.Label, "c") {
+			got = append(got, compl.Label)
+		}
+	}
+	want := []string{"cup", "count", "cat", "clock", "clone", "compare", "class", "class"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Complete() returned completions with labels %q, want %q", got, want)
+	}
+}
+
+func TestCompleteContextualKeywords(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		pos  *protocol.Position
+		want []string
+	}{
+		{
+			name: "top level",
+			src:  "print 1;\n",
+			pos:  &protocol.Position{Line: 1, Character: 0},
+			want: nil,
+		},
+		{
+			name: "function",
+			src:  "fun f() {\n  \n}\n",
+			pos:  &protocol.Position{Line: 1, Character: 2},
+			want: []string{"return"},
+		},
+		{
+			name: "loop inside function",
+			src:  "fun f() {\n  while (true) {\n    \n  }\n}\n",
+			pos:  &protocol.Position{Line: 2, Character: 4},
+			want: []string{"break", "continue", "return"},
+		},
+		{
+			name: "after loop",
+			src:  "while (true) {}\n",
+			pos:  &protocol.Position{Line: 1, Character: 0},
+			want: nil,
+		},
+		{
+			name: "function inside loop",
+			src:  "while (true) {\n  fun f() {\n    \n  }\n}\n",
+			pos:  &protocol.Position{Line: 2, Character: 4},
+			want: []string{"return"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			program, _ := parser.Parse(strings.NewReader(test.src), "test.lox")
+			builtinStubs := builtins.MustParseStubs("builtins.lox")
+			identBindings, _ := analyse.ResolveIdents(program, builtinStubs)
+			c := newCompletor(program, identBindings, nil, builtinStubs)
+
+			compls, _ := c.Complete(test.pos)
+
+			var got []string
+			for _, compl := range compls {
+				if slices.Contains([]string{"break", "continue", "return"}, compl.Label) {
+					got = append(got, compl.Label)
+				}
+			}
+			if !slices.Equal(got, test.want) {
+				t.Errorf("Complete() returned contextual keywords %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCompleteStatementSnippets(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		pos  *protocol.Position
+		want bool
+	}{
+		{
+			name: "after statement",
+			src:  "print 1;\n",
+			pos:  &protocol.Position{Line: 1, Character: 0},
+			want: true,
+		},
+		{
+			name: "inside call argument",
+			src:  "f(fun() {} );\n",
+			pos:  &protocol.Position{Line: 0, Character: 11},
+			want: false,
+		},
+		{
+			name: "inside function expression body",
+			src:  "f(fun() {\n  \n});\n",
+			pos:  &protocol.Position{Line: 1, Character: 2},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			program, _ := parser.Parse(strings.NewReader(test.src), "test.lox")
+			builtinStubs := builtins.MustParseStubs("builtins.lox")
+			identBindings, _ := analyse.ResolveIdents(program, builtinStubs)
+			c := newCompletor(program, identBindings, nil, builtinStubs)
+
+			compls, _ := c.Complete(test.pos)
+
+			got := slices.ContainsFunc(compls, func(compl *completion) bool {
+				return compl.Snippet != "" && slices.Contains([]string{"if", "while", "for"}, compl.Label)
+			})
+			if got != test.want {
+				t.Errorf("Complete() returned statement snippets: %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDidChangeWatchedFiles(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	dir := t.TempDir()
+	createdFilename := filepath.Join(dir, "created.lox")
+	openFilename := filepath.Join(dir, "open.lox")
+	deletedFilename := filepath.Join(dir, "deleted.lox")
+	if err := os.WriteFile(createdFilename, []byte("print x;\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(openFilename, []byte("print y;\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(deletedFilename, []byte("print z;\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var in bytes.Buffer
+	writeMessage := func(msg map[string]any) { mustWriteMessage(t, &in, msg) }
+	writeMessage(map[string]any{
+		"id":     1,
+		"method": "initialize",
+		"params": map[string]any{
+			"processId": nil,
+			"rootUri":   nil,
+			"capabilities": map[string]any{
+				"workspace": map[string]any{"didChangeWatchedFiles": map[string]any{"dynamicRegistration": true}},
+			},
+		},
+	})
+	writeMessage(map[string]any{"method": "initialized", "params": map[string]any{}})
+	writeMessage(map[string]any{
+		"method": "textDocument/didOpen",
+		"params": map[string]any{
+			"textDocument": map[string]any{"uri": filenameToURI(openFilename), "languageId": "lox", "version": 1, "text": "print 1;\n"},
+		},
+	})
+	writeMessage(map[string]any{
+		"method": "workspace/didChangeWatchedFiles",
+		"params": map[string]any{
+			"changes": []map[string]any{
+				{"uri": filenameToURI(createdFilename), "type": protocol.FileChangeTypeCreated},
+				{"uri": filenameToURI(openFilename), "type": protocol.FileChangeTypeChanged},
+				{"uri": filenameToURI(deletedFilename), "type": protocol.FileChangeTypeCreated},
+			},
+		},
+	})
+	writeMessage(map[string]any{
+		"method": "workspace/didChangeWatchedFiles",
+		"params": map[string]any{
+			"changes": []map[string]any{
+				{"uri": filenameToURI(deletedFilename), "type": protocol.FileChangeTypeDeleted},
+			},
+		},
+	})
+
+	var out bytes.Buffer
+	if err := jsonrpc.Serve(context.Background(), &in, &out, NewHandler()); err != nil {
+		t.Fatalf("Serve() returned error: %s", err)
+	}
+
+	type message struct {
+		Method string          `json:"method"`
+		Params json.RawMessage `json:"params"`
+	}
+	var registrations []string
+	var diagnostics []string
+	for _, content := range splitMessages(out.String()) {
+		var msg message
+		if err := json.Unmarshal([]byte(content), &msg); err != nil {
+			t.Fatalf("unmarshalling message %s: %s", content, err)
+		}
+		switch msg.Method {
+		case "client/registerCapability":
+			registrations = append(registrations, string(msg.Params))
+		case "textDocument/publishDiagnostics":
+			var params protocol.PublishDiagnosticsParams
+			if err := json.Unmarshal(msg.Params, &params); err != nil {
+				t.Fatal(err)
+			}
+			messages := make([]string, len(params.Diagnostics))
+			for i, diagnostic := range params.Diagnostics {
+				messages[i] = diagnostic.Message
+			}
+			diagnostics = append(diagnostics, fmt.Sprintf("%s: [%s]", filepath.Base(params.Uri), strings.Join(messages, ", ")))
+		}
+	}
+
+	wantRegistrations := []string{
+		`{"registrations":[{"id":"workspace/didChangeWatchedFiles","method":"workspace/didChangeWatchedFiles","registerOptions":{"watchers":[{"globPattern":"**/*.lox"}]}}]}`,
+	}
+	if !slices.Equal(registrations, wantRegistrations) {
+		t.Errorf("client/registerCapability params = %q, want %q", registrations, wantRegistrations)
+	}
+	wantDiagnostics := []string{
+		"open.lox: []",
+		"created.lox: ['x' has not been declared]",
+		"deleted.lox: ['z' has not been declared]",
+		"deleted.lox: []",
+	}
+	if !slices.Equal(diagnostics, wantDiagnostics) {
+		t.Errorf("published diagnostics = %q, want %q", diagnostics, wantDiagnostics)
+	}
+}
+
+func TestShutdownExit(t *testing.T) {
+	initializeMsg := map[string]any{
+		"id":     1,
+		"method": "initialize",
+		"params": map[string]any{"processId": nil, "rootUri": nil, "capabilities": map[string]any{}},
+	}
+	shutdownMsg := map[string]any{"id": 2, "method": "shutdown"}
+	strayRequestMsg := map[string]any{
+		"id":     3,
+		"method": "textDocument/documentSymbol",
+		"params": map[string]any{"textDocument": map[string]any{"uri": "file:///test.lox"}},
+	}
+	exitMsg := map[string]any{"method": "exit"}
+	requestAfterExitMsg := map[string]any{"id": 4, "method": "shutdown"}
+
+	tests := []struct {
+		name          string
+		msgs          []map[string]any
+		wantExitCode  int
+		wantResponses []string
+	}{
+		{
+			name:         "exit after shutdown",
+			msgs:         []map[string]any{initializeMsg, shutdownMsg, exitMsg, requestAfterExitMsg},
+			wantExitCode: 0,
+			wantResponses: []string{
+				`1: <result>`,
+				`2: null`,
+			},
+		},
+		{
+			name:         "request after shutdown",
+			msgs:         []map[string]any{initializeMsg, shutdownMsg, strayRequestMsg, exitMsg},
+			wantExitCode: 0,
+			wantResponses: []string{
+				`1: <result>`,
+				`2: null`,
+				`3: error {"code":-32600,"message":"Invalid Request","data":{"error":"Server shutting down"}}`,
+			},
+		},
+		{
+			name:         "exit without shutdown",
+			msgs:         []map[string]any{initializeMsg, exitMsg, requestAfterExitMsg},
+			wantExitCode: 1,
+			wantResponses: []string{
+				`1: <result>`,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv("XDG_CACHE_HOME", t.TempDir())
+			var in bytes.Buffer
+			for _, msg := range test.msgs {
+				mustWriteMessage(t, &in, maps.Clone(msg))
+			}
+
+			var out bytes.Buffer
+			err := jsonrpc.Serve(context.Background(), &in, &out, NewHandler())
+
+			gotExitCode := 0
+			if err != nil {
+				var exitErr *jsonrpc.ExitError
+				if !errors.As(err, &exitErr) {
+					t.Fatalf("Serve() returned error: %s", err)
+				}
+				gotExitCode = exitErr.Code
+			}
+			if gotExitCode != test.wantExitCode {
+				t.Errorf("exit code = %d, want %d", gotExitCode, test.wantExitCode)
+			}
+
+			type response struct {
+				ID     int             `json:"id"`
+				Result json.RawMessage `json:"result"`
+				Error  json.RawMessage `json:"error"`
+			}
+			var gotResponses []string
+			for _, content := range splitMessages(out.String()) {
+				var resp response
+				if err := json.Unmarshal([]byte(content), &resp); err != nil {
+					t.Fatalf("unmarshalling message %s: %s", content, err)
+				}
+				switch {
+				case resp.Error != nil:
+					gotResponses = append(gotResponses, fmt.Sprintf("%d: error %s", resp.ID, resp.Error))
+				case resp.ID == 1:
+					// The initialize result is large and not relevant to this test.
+					gotResponses = append(gotResponses, "1: <result>")
+				default:
+					gotResponses = append(gotResponses, fmt.Sprintf("%d: %s", resp.ID, resp.Result))
+				}
+			}
+			if !slices.Equal(gotResponses, test.wantResponses) {
+				t.Errorf("responses = %q, want %q", gotResponses, test.wantResponses)
+			}
+		})
+	}
+}
+
+func mustWriteMessage(t *testing.T, w io.Writer, msg map[string]any) {
+	t.Helper()
+	msg["jsonrpc"] = "2.0"
+	data, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Fprintf(w, "Content-Length: %d\r\n\r\n%s", len(data), data)
+}
+
+// splitMessages returns the contents of the JSON-RPC messages in s.
+func splitMessages(s string) []string {
+	return regexp.MustCompile(`Content-Length: \d+\r\n\r\n`).Split(s, -1)[1:]
+}
+
+func mustNewDocument(t *testing.T, src string, builtins []ast.Decl) *document {
+	t.Helper()
+	filename := "/test.lox"
+	program, err := parser.Parse(strings.NewReader(src), filename, parser.WithComments(true))
+	if err != nil {
+		t.Fatalf("parsing program: %s", err)
+	}
+	identBindings, _ := analyse.ResolveIdents(program, builtins)
+	types, _ := typecheck.Infer(program, builtins)
+	return &document{
+		URI:           filenameToURI(filename),
+		Text:          src,
+		Filename:      filename,
+		Program:       program,
+		IdentBindings: identBindings,
+		Types:         types,
+	}
+}
@@ -1,6 +1,9 @@
 package lsp
 
 import (
+	"bytes"
+	"unicode/utf16"
+
 	"github.com/marcuscaisey/lox/golox/token"
 	"github.com/marcuscaisey/lox/loxls/lsp/protocol"
 )
@@ -12,6 +15,23 @@ func newPosition(p token.Position) *protocol.Position {
 	}
 }
 
+// newTokenPosition creates a [token.Position] in file from a [protocol.Position]. Positions past the end of a line or
+// file are clamped to the end of it.
+func newTokenPosition(file *token.File, p *protocol.Position) token.Position {
+	line := min(p.Line+1, bytes.Count(file.Contents, []byte("\n"))+1)
+	lineText := file.Line(line)
+	column := len(lineText)
+	character := 0
+	for i, r := range string(lineText) {
+		if character >= p.Character {
+			column = i
+			break
+		}
+		character += utf16.RuneLen(r)
+	}
+	return token.Position{File: file, Line: line, Column: column}
+}
+
 func equalPositions(x *protocol.Position, y token.Position) bool {
 	yProto := newPosition(y)
 	return x.Line == yProto.Line && x.Character == yProto.Character
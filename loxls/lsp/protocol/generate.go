@@ -17,5 +17,16 @@ package protocol
 //typegen:method textDocument/publishDiagnostics
 //typegen:method textDocument/signatureHelp
 //typegen:method textDocument/formatting
+//typegen:method textDocument/onTypeFormatting
 //typegen:method textDocument/rename
+//typegen:method textDocument/prepareRename
+//typegen:method textDocument/codeAction
+//typegen:method textDocument/codeLens
+//typegen:method codeLens/resolve
+//typegen:method textDocument/prepareTypeHierarchy
+//typegen:method typeHierarchy/supertypes
+//typegen:method typeHierarchy/subtypes
+//typegen:method textDocument/selectionRange
 //typegen:method window/logMessage
+//typegen:method workspace/didChangeWatchedFiles
+//typegen:method client/registerCapability
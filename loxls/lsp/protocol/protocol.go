@@ -10,1663 +10,1422 @@ import (
 	"slices"
 )
 
-type Integer int
+// A special text edit with an additional change annotation.
+//
+// @since 3.16.0.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#annotatedTextEdit
+type AnnotatedTextEdit struct {
+	*TextEdit
+	// The actual identifier of the change annotation
+	AnnotationId ChangeAnnotationIdentifier `json:"annotationId"`
+}
 
-type String string
+// The actual identifier of the change annotation
+func (a *AnnotatedTextEdit) GetAnnotationId() ChangeAnnotationIdentifier {
+	if a == nil {
+		var zero ChangeAnnotationIdentifier
+		return zero
+	}
+	return a.AnnotationId
+}
 
-// IntegerOrString contains either of the following types:
-//   - [Integer]
-//   - [String]
-type IntegerOrString struct {
-	Value IntegerOrStringValue
+// A base for all symbol information.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#baseSymbolInformation
+type BaseSymbolInformation struct {
+	// The name of this symbol.
+	Name string `json:"name"`
+	// The kind of this symbol.
+	Kind SymbolKind `json:"kind"`
+	// Tags for this symbol.
+	//
+	// @since 3.16.0
+	Tags []SymbolTag `json:"tags,omitempty"`
+	// The name of the symbol containing this symbol. This information is for
+	// user interface purposes (e.g. to render a qualifier in the user interface
+	// if necessary). It can't be used to re-infer a hierarchy for the document
+	// symbols.
+	ContainerName string `json:"containerName,omitempty"`
 }
 
-// IntegerOrStringValue is either of the following types:
-//   - [Integer]
-//   - [String]
+// The name of this symbol.
+func (b *BaseSymbolInformation) GetName() string {
+	if b == nil {
+		var zero string
+		return zero
+	}
+	return b.Name
+}
+
+// The kind of this symbol.
+func (b *BaseSymbolInformation) GetKind() SymbolKind {
+	if b == nil {
+		var zero SymbolKind
+		return zero
+	}
+	return b.Kind
+}
+
+// Tags for this symbol.
+//
+// @since 3.16.0
+func (b *BaseSymbolInformation) GetTags() []SymbolTag {
+	if b == nil {
+		var zero []SymbolTag
+		return zero
+	}
+	return b.Tags
+}
+
+// The name of the symbol containing this symbol. This information is for
+// user interface purposes (e.g. to render a qualifier in the user interface
+// if necessary). It can't be used to re-infer a hierarchy for the document
+// symbols.
+func (b *BaseSymbolInformation) GetContainerName() string {
+	if b == nil {
+		var zero string
+		return zero
+	}
+	return b.ContainerName
+}
+
+type Boolean bool
+
+// BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptions contains either of the following types:
+//   - [Boolean]
+//   - [*CallHierarchyOptions]
+//   - [*CallHierarchyRegistrationOptions]
+type BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptions struct {
+	Value BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptionsValue
+}
+
+// BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*CallHierarchyOptions]
+//   - [*CallHierarchyRegistrationOptions]
 //
 //sumtype:decl
-type IntegerOrStringValue interface {
-	isIntegerOrStringValue()
+type BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptionsValue interface {
+	isBooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptionsValue()
 }
 
-func (Integer) isIntegerOrStringValue() {}
-func (String) isIntegerOrStringValue()  {}
+func (Boolean) isBooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptionsValue() {}
+func (*CallHierarchyOptions) isBooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptionsValue() {
+}
+func (*CallHierarchyRegistrationOptions) isBooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptionsValue() {
+}
 
-func (i *IntegerOrString) UnmarshalJSON(data []byte) error {
+func (b *BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptions) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var integerValue Integer
-	if err := json.Unmarshal(data, &integerValue); err == nil {
-		i.Value = integerValue
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
 		return nil
 	}
-	var stringValue String
-	if err := json.Unmarshal(data, &stringValue); err == nil {
-		i.Value = stringValue
+	var callHierarchyOptionsValue *CallHierarchyOptions
+	if err := json.Unmarshal(data, &callHierarchyOptionsValue); err == nil {
+		b.Value = callHierarchyOptionsValue
+		return nil
+	}
+	var callHierarchyRegistrationOptionsValue *CallHierarchyRegistrationOptions
+	if err := json.Unmarshal(data, &callHierarchyRegistrationOptionsValue); err == nil {
+		b.Value = callHierarchyRegistrationOptionsValue
 		return nil
 	}
 	return &json.UnmarshalTypeError{
 		Value: string(data),
-		Type:  reflect.TypeFor[*IntegerOrString](),
+		Type:  reflect.TypeFor[*BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptions](),
 	}
 }
 
-func (i *IntegerOrString) MarshalJSON() ([]byte, error) {
-	return json.Marshal(i.Value)
+func (b *BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#progressToken
-type ProgressToken = *IntegerOrString
-
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#workDoneProgressParams
-type WorkDoneProgressParams struct {
-	// An optional token that a server can use to report work done progress.
-	WorkDoneToken ProgressToken `json:"workDoneToken,omitempty"`
+// BooleanOrCodeActionOptions contains either of the following types:
+//   - [Boolean]
+//   - [*CodeActionOptions]
+type BooleanOrCodeActionOptions struct {
+	Value BooleanOrCodeActionOptionsValue
 }
 
-// An optional token that a server can use to report work done progress.
-func (w *WorkDoneProgressParams) GetWorkDoneToken() ProgressToken {
-	if w == nil {
-		var zero ProgressToken
-		return zero
-	}
-	return w.WorkDoneToken
+// BooleanOrCodeActionOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*CodeActionOptions]
+//
+//sumtype:decl
+type BooleanOrCodeActionOptionsValue interface {
+	isBooleanOrCodeActionOptionsValue()
 }
 
-type XInitializeParamsClientInfo struct {
-	// The name of the client as defined by the client.
-	Name string `json:"name"`
-	// The client's version as defined by the client.
-	Version string `json:"version,omitempty"`
-}
+func (Boolean) isBooleanOrCodeActionOptionsValue()            {}
+func (*CodeActionOptions) isBooleanOrCodeActionOptionsValue() {}
 
-// The name of the client as defined by the client.
-func (x *XInitializeParamsClientInfo) GetName() string {
-	if x == nil {
-		return *new(string)
+func (b *BooleanOrCodeActionOptions) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
+	}
+	var codeActionOptionsValue *CodeActionOptions
+	if err := json.Unmarshal(data, &codeActionOptionsValue); err == nil {
+		b.Value = codeActionOptionsValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrCodeActionOptions](),
 	}
-	return x.Name
 }
 
-// The client's version as defined by the client.
-func (x *XInitializeParamsClientInfo) GetVersion() string {
-	if x == nil {
-		return *new(string)
-	}
-	return x.Version
+func (b *BooleanOrCodeActionOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#resourceOperationKind
-type ResourceOperationKind string
+// BooleanOrDeclarationOptionsOrDeclarationRegistrationOptions contains either of the following types:
+//   - [Boolean]
+//   - [*DeclarationOptions]
+//   - [*DeclarationRegistrationOptions]
+type BooleanOrDeclarationOptionsOrDeclarationRegistrationOptions struct {
+	Value BooleanOrDeclarationOptionsOrDeclarationRegistrationOptionsValue
+}
 
-const (
-	// Supports creating new files and folders.
-	ResourceOperationKindCreate ResourceOperationKind = "create"
-	// Supports renaming existing files and folders.
-	ResourceOperationKindRename ResourceOperationKind = "rename"
-	// Supports deleting existing files and folders.
-	ResourceOperationKindDelete ResourceOperationKind = "delete"
-)
+// BooleanOrDeclarationOptionsOrDeclarationRegistrationOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*DeclarationOptions]
+//   - [*DeclarationRegistrationOptions]
+//
+//sumtype:decl
+type BooleanOrDeclarationOptionsOrDeclarationRegistrationOptionsValue interface {
+	isBooleanOrDeclarationOptionsOrDeclarationRegistrationOptionsValue()
+}
 
-var validResourceOperationKindValues = map[string]bool{
-	"create": true,
-	"rename": true,
-	"delete": true,
+func (Boolean) isBooleanOrDeclarationOptionsOrDeclarationRegistrationOptionsValue()             {}
+func (*DeclarationOptions) isBooleanOrDeclarationOptionsOrDeclarationRegistrationOptionsValue() {}
+func (*DeclarationRegistrationOptions) isBooleanOrDeclarationOptionsOrDeclarationRegistrationOptionsValue() {
 }
 
-func (r *ResourceOperationKind) UnmarshalJSON(data []byte) error {
+func (b *BooleanOrDeclarationOptionsOrDeclarationRegistrationOptions) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var stringValue string
-	if err := json.Unmarshal(data, &stringValue); err != nil {
-		return err
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
 	}
-	if !validResourceOperationKindValues[stringValue] {
-		return fmt.Errorf("cannot unmarshal %v into ResourceOperationKind: custom values are not supported", stringValue)
+	var declarationOptionsValue *DeclarationOptions
+	if err := json.Unmarshal(data, &declarationOptionsValue); err == nil {
+		b.Value = declarationOptionsValue
+		return nil
 	}
-	*r = ResourceOperationKind(stringValue)
-
-	return nil
-}
-
-func (r ResourceOperationKind) MarshalJSON() ([]byte, error) {
-	var stringValue = string(r)
-	if !validResourceOperationKindValues[stringValue] {
-		return nil, fmt.Errorf("cannot marshal %v into ResourceOperationKind: custom values are not supported", stringValue)
+	var declarationRegistrationOptionsValue *DeclarationRegistrationOptions
+	if err := json.Unmarshal(data, &declarationRegistrationOptionsValue); err == nil {
+		b.Value = declarationRegistrationOptionsValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrDeclarationOptionsOrDeclarationRegistrationOptions](),
 	}
-	return json.Marshal(stringValue)
-
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#failureHandlingKind
-type FailureHandlingKind string
+func (b *BooleanOrDeclarationOptionsOrDeclarationRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
+}
 
-const (
-	// Applying the workspace change is simply aborted if one of the changes provided
-	// fails. All operations executed before the failing operation stay executed.
-	FailureHandlingKindAbort FailureHandlingKind = "abort"
-	// All operations are executed transactional. That means they either all
-	// succeed or no changes at all are applied to the workspace.
-	FailureHandlingKindTransactional FailureHandlingKind = "transactional"
-	// If the workspace edit contains only textual file changes they are executed transactional.
-	// If resource changes (create, rename or delete file) are part of the change the failure
-	// handling strategy is abort.
-	FailureHandlingKindTextOnlyTransactional FailureHandlingKind = "textOnlyTransactional"
-	// The client tries to undo the operations already executed. But there is no
-	// guarantee that this is succeeding.
-	FailureHandlingKindUndo FailureHandlingKind = "undo"
-)
+// BooleanOrDefinitionOptions contains either of the following types:
+//   - [Boolean]
+//   - [*DefinitionOptions]
+type BooleanOrDefinitionOptions struct {
+	Value BooleanOrDefinitionOptionsValue
+}
 
-var validFailureHandlingKindValues = map[string]bool{
-	"abort":                 true,
-	"transactional":         true,
-	"textOnlyTransactional": true,
-	"undo":                  true,
+// BooleanOrDefinitionOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*DefinitionOptions]
+//
+//sumtype:decl
+type BooleanOrDefinitionOptionsValue interface {
+	isBooleanOrDefinitionOptionsValue()
 }
 
-func (f *FailureHandlingKind) UnmarshalJSON(data []byte) error {
+func (Boolean) isBooleanOrDefinitionOptionsValue()            {}
+func (*DefinitionOptions) isBooleanOrDefinitionOptionsValue() {}
+
+func (b *BooleanOrDefinitionOptions) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var stringValue string
-	if err := json.Unmarshal(data, &stringValue); err != nil {
-		return err
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
 	}
-	if !validFailureHandlingKindValues[stringValue] {
-		return fmt.Errorf("cannot unmarshal %v into FailureHandlingKind: custom values are not supported", stringValue)
+	var definitionOptionsValue *DefinitionOptions
+	if err := json.Unmarshal(data, &definitionOptionsValue); err == nil {
+		b.Value = definitionOptionsValue
+		return nil
 	}
-	*f = FailureHandlingKind(stringValue)
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrDefinitionOptions](),
+	}
+}
 
-	return nil
+func (b *BooleanOrDefinitionOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-func (f FailureHandlingKind) MarshalJSON() ([]byte, error) {
-	var stringValue = string(f)
-	if !validFailureHandlingKindValues[stringValue] {
-		return nil, fmt.Errorf("cannot marshal %v into FailureHandlingKind: custom values are not supported", stringValue)
-	}
-	return json.Marshal(stringValue)
+// BooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptions contains either of the following types:
+//   - [Boolean]
+//   - [*DocumentColorOptions]
+//   - [*DocumentColorRegistrationOptions]
+type BooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptions struct {
+	Value BooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptionsValue
+}
 
+// BooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*DocumentColorOptions]
+//   - [*DocumentColorRegistrationOptions]
+//
+//sumtype:decl
+type BooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptionsValue interface {
+	isBooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptionsValue()
 }
 
-type WorkspaceEditClientCapabilitiesChangeAnnotationSupport struct {
-	// Whether the client groups edits with equal labels into tree nodes,
-	// for instance all edits labelled with "Changes in Strings" would
-	// be a tree node.
-	GroupsOnLabel bool `json:"groupsOnLabel,omitempty"`
+func (Boolean) isBooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptionsValue() {}
+func (*DocumentColorOptions) isBooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptionsValue() {
+}
+func (*DocumentColorRegistrationOptions) isBooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptionsValue() {
 }
 
-// Whether the client groups edits with equal labels into tree nodes,
-// for instance all edits labelled with "Changes in Strings" would
-// be a tree node.
-func (w *WorkspaceEditClientCapabilitiesChangeAnnotationSupport) GetGroupsOnLabel() bool {
-	if w == nil {
-		return *new(bool)
+func (b *BooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptions) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
+	}
+	var documentColorOptionsValue *DocumentColorOptions
+	if err := json.Unmarshal(data, &documentColorOptionsValue); err == nil {
+		b.Value = documentColorOptionsValue
+		return nil
+	}
+	var documentColorRegistrationOptionsValue *DocumentColorRegistrationOptions
+	if err := json.Unmarshal(data, &documentColorRegistrationOptionsValue); err == nil {
+		b.Value = documentColorRegistrationOptionsValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptions](),
 	}
-	return w.GroupsOnLabel
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#workspaceEditClientCapabilities
-type WorkspaceEditClientCapabilities struct {
-	// The client supports versioned document changes in `WorkspaceEdit`s
-	DocumentChanges bool `json:"documentChanges,omitempty"`
-	// The resource operations the client supports. Clients should at least
-	// support 'create', 'rename' and 'delete' files and folders.
-	//
-	// @since 3.13.0
-	ResourceOperations []ResourceOperationKind `json:"resourceOperations,omitempty"`
-	// The failure handling strategy of a client if applying the workspace edit
-	// fails.
-	//
-	// @since 3.13.0
-	FailureHandling FailureHandlingKind `json:"failureHandling,omitempty"`
-	// Whether the client normalizes line endings to the client specific
-	// setting.
-	// If set to `true` the client will normalize line ending characters
-	// in a workspace edit to the client-specified new line
-	// character.
-	//
-	// @since 3.16.0
-	NormalizesLineEndings bool `json:"normalizesLineEndings,omitempty"`
-	// Whether the client in general supports change annotations on text edits,
-	// create file, rename file and delete file changes.
-	//
-	// @since 3.16.0
-	ChangeAnnotationSupport *WorkspaceEditClientCapabilitiesChangeAnnotationSupport `json:"changeAnnotationSupport,omitempty"`
+func (b *BooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-// The client supports versioned document changes in `WorkspaceEdit`s
-func (w *WorkspaceEditClientCapabilities) GetDocumentChanges() bool {
-	if w == nil {
-		var zero bool
-		return zero
-	}
-	return w.DocumentChanges
+// BooleanOrDocumentFormattingOptions contains either of the following types:
+//   - [Boolean]
+//   - [*DocumentFormattingOptions]
+type BooleanOrDocumentFormattingOptions struct {
+	Value BooleanOrDocumentFormattingOptionsValue
 }
 
-// The resource operations the client supports. Clients should at least
-// support 'create', 'rename' and 'delete' files and folders.
+// BooleanOrDocumentFormattingOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*DocumentFormattingOptions]
 //
-// @since 3.13.0
-func (w *WorkspaceEditClientCapabilities) GetResourceOperations() []ResourceOperationKind {
-	if w == nil {
-		var zero []ResourceOperationKind
-		return zero
-	}
-	return w.ResourceOperations
+//sumtype:decl
+type BooleanOrDocumentFormattingOptionsValue interface {
+	isBooleanOrDocumentFormattingOptionsValue()
 }
 
-// The failure handling strategy of a client if applying the workspace edit
-// fails.
-//
-// @since 3.13.0
-func (w *WorkspaceEditClientCapabilities) GetFailureHandling() FailureHandlingKind {
-	if w == nil {
-		var zero FailureHandlingKind
-		return zero
-	}
-	return w.FailureHandling
-}
+func (Boolean) isBooleanOrDocumentFormattingOptionsValue()                    {}
+func (*DocumentFormattingOptions) isBooleanOrDocumentFormattingOptionsValue() {}
 
-// Whether the client normalizes line endings to the client specific
-// setting.
-// If set to `true` the client will normalize line ending characters
-// in a workspace edit to the client-specified new line
-// character.
-//
-// @since 3.16.0
-func (w *WorkspaceEditClientCapabilities) GetNormalizesLineEndings() bool {
-	if w == nil {
-		var zero bool
-		return zero
+func (b *BooleanOrDocumentFormattingOptions) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
+	}
+	var documentFormattingOptionsValue *DocumentFormattingOptions
+	if err := json.Unmarshal(data, &documentFormattingOptionsValue); err == nil {
+		b.Value = documentFormattingOptionsValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrDocumentFormattingOptions](),
 	}
-	return w.NormalizesLineEndings
 }
 
-// Whether the client in general supports change annotations on text edits,
-// create file, rename file and delete file changes.
-//
-// @since 3.16.0
-func (w *WorkspaceEditClientCapabilities) GetChangeAnnotationSupport() *WorkspaceEditClientCapabilitiesChangeAnnotationSupport {
-	if w == nil {
-		var zero *WorkspaceEditClientCapabilitiesChangeAnnotationSupport
-		return zero
-	}
-	return w.ChangeAnnotationSupport
+func (b *BooleanOrDocumentFormattingOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#didChangeConfigurationClientCapabilities
-type DidChangeConfigurationClientCapabilities struct {
-	// Did change configuration notification supports dynamic registration.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+// BooleanOrDocumentHighlightOptions contains either of the following types:
+//   - [Boolean]
+//   - [*DocumentHighlightOptions]
+type BooleanOrDocumentHighlightOptions struct {
+	Value BooleanOrDocumentHighlightOptionsValue
 }
 
-// Did change configuration notification supports dynamic registration.
-func (d *DidChangeConfigurationClientCapabilities) GetDynamicRegistration() bool {
-	if d == nil {
-		var zero bool
-		return zero
-	}
-	return d.DynamicRegistration
+// BooleanOrDocumentHighlightOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*DocumentHighlightOptions]
+//
+//sumtype:decl
+type BooleanOrDocumentHighlightOptionsValue interface {
+	isBooleanOrDocumentHighlightOptionsValue()
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#didChangeWatchedFilesClientCapabilities
-type DidChangeWatchedFilesClientCapabilities struct {
-	// Did change watched files notification supports dynamic registration. Please note
-	// that the current protocol doesn't support static configuration for file changes
-	// from the server side.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
-	// Whether the client has support for {@link  RelativePattern relative pattern}
-	// or not.
-	//
-	// @since 3.17.0
-	RelativePatternSupport bool `json:"relativePatternSupport,omitempty"`
-}
+func (Boolean) isBooleanOrDocumentHighlightOptionsValue()                   {}
+func (*DocumentHighlightOptions) isBooleanOrDocumentHighlightOptionsValue() {}
 
-// Did change watched files notification supports dynamic registration. Please note
-// that the current protocol doesn't support static configuration for file changes
-// from the server side.
-func (d *DidChangeWatchedFilesClientCapabilities) GetDynamicRegistration() bool {
-	if d == nil {
-		var zero bool
-		return zero
+func (b *BooleanOrDocumentHighlightOptions) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
 	}
-	return d.DynamicRegistration
-}
-
-// Whether the client has support for {@link  RelativePattern relative pattern}
-// or not.
-//
-// @since 3.17.0
-func (d *DidChangeWatchedFilesClientCapabilities) GetRelativePatternSupport() bool {
-	if d == nil {
-		var zero bool
-		return zero
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
+	}
+	var documentHighlightOptionsValue *DocumentHighlightOptions
+	if err := json.Unmarshal(data, &documentHighlightOptionsValue); err == nil {
+		b.Value = documentHighlightOptionsValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrDocumentHighlightOptions](),
 	}
-	return d.RelativePatternSupport
 }
 
-// A symbol kind.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#symbolKind
-type SymbolKind uint32
+func (b *BooleanOrDocumentHighlightOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
+}
 
-const (
-	SymbolKindFile          SymbolKind = 1
-	SymbolKindModule        SymbolKind = 2
-	SymbolKindNamespace     SymbolKind = 3
-	SymbolKindPackage       SymbolKind = 4
-	SymbolKindClass         SymbolKind = 5
-	SymbolKindMethod        SymbolKind = 6
-	SymbolKindProperty      SymbolKind = 7
-	SymbolKindField         SymbolKind = 8
-	SymbolKindConstructor   SymbolKind = 9
-	SymbolKindEnum          SymbolKind = 10
-	SymbolKindInterface     SymbolKind = 11
-	SymbolKindFunction      SymbolKind = 12
-	SymbolKindVariable      SymbolKind = 13
-	SymbolKindConstant      SymbolKind = 14
-	SymbolKindString        SymbolKind = 15
-	SymbolKindNumber        SymbolKind = 16
-	SymbolKindBoolean       SymbolKind = 17
-	SymbolKindArray         SymbolKind = 18
-	SymbolKindObject        SymbolKind = 19
-	SymbolKindKey           SymbolKind = 20
-	SymbolKindNull          SymbolKind = 21
-	SymbolKindEnumMember    SymbolKind = 22
-	SymbolKindStruct        SymbolKind = 23
-	SymbolKindEvent         SymbolKind = 24
-	SymbolKindOperator      SymbolKind = 25
-	SymbolKindTypeParameter SymbolKind = 26
-)
+// BooleanOrDocumentRangeFormattingOptions contains either of the following types:
+//   - [Boolean]
+//   - [*DocumentRangeFormattingOptions]
+type BooleanOrDocumentRangeFormattingOptions struct {
+	Value BooleanOrDocumentRangeFormattingOptionsValue
+}
 
-var validSymbolKindValues = map[uint32]bool{
-	1:  true,
-	2:  true,
-	3:  true,
-	4:  true,
-	5:  true,
-	6:  true,
-	7:  true,
-	8:  true,
-	9:  true,
-	10: true,
-	11: true,
-	12: true,
-	13: true,
-	14: true,
-	15: true,
-	16: true,
-	17: true,
-	18: true,
-	19: true,
-	20: true,
-	21: true,
-	22: true,
-	23: true,
-	24: true,
-	25: true,
-	26: true,
+// BooleanOrDocumentRangeFormattingOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*DocumentRangeFormattingOptions]
+//
+//sumtype:decl
+type BooleanOrDocumentRangeFormattingOptionsValue interface {
+	isBooleanOrDocumentRangeFormattingOptionsValue()
 }
 
-func (s *SymbolKind) UnmarshalJSON(data []byte) error {
+func (Boolean) isBooleanOrDocumentRangeFormattingOptionsValue()                         {}
+func (*DocumentRangeFormattingOptions) isBooleanOrDocumentRangeFormattingOptionsValue() {}
+
+func (b *BooleanOrDocumentRangeFormattingOptions) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var uint32Value uint32
-	if err := json.Unmarshal(data, &uint32Value); err != nil {
-		return err
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
 	}
-	if !validSymbolKindValues[uint32Value] {
-		return fmt.Errorf("cannot unmarshal %v into SymbolKind: custom values are not supported", uint32Value)
+	var documentRangeFormattingOptionsValue *DocumentRangeFormattingOptions
+	if err := json.Unmarshal(data, &documentRangeFormattingOptionsValue); err == nil {
+		b.Value = documentRangeFormattingOptionsValue
+		return nil
 	}
-	*s = SymbolKind(uint32Value)
-
-	return nil
-}
-
-func (s SymbolKind) MarshalJSON() ([]byte, error) {
-	var uint32Value = uint32(s)
-	if !validSymbolKindValues[uint32Value] {
-		return nil, fmt.Errorf("cannot marshal %v into SymbolKind: custom values are not supported", uint32Value)
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrDocumentRangeFormattingOptions](),
 	}
-	return json.Marshal(uint32Value)
-
 }
 
-type WorkspaceSymbolClientCapabilitiesSymbolKind struct {
-	// The symbol kind values the client supports. When this
-	// property exists the client also guarantees that it will
-	// handle values outside its set gracefully and falls back
-	// to a default value when unknown.
-	//
-	// If this property is not present the client only supports
-	// the symbol kinds from `File` to `Array` as defined in
-	// the initial version of the protocol.
-	ValueSet []SymbolKind `json:"valueSet,omitempty"`
+func (b *BooleanOrDocumentRangeFormattingOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-// The symbol kind values the client supports. When this
-// property exists the client also guarantees that it will
-// handle values outside its set gracefully and falls back
-// to a default value when unknown.
-//
-// If this property is not present the client only supports
-// the symbol kinds from `File` to `Array` as defined in
-// the initial version of the protocol.
-func (w *WorkspaceSymbolClientCapabilitiesSymbolKind) GetValueSet() []SymbolKind {
-	if w == nil {
-		return *new([]SymbolKind)
-	}
-	return w.ValueSet
+// BooleanOrDocumentSymbolOptions contains either of the following types:
+//   - [Boolean]
+//   - [*DocumentSymbolOptions]
+type BooleanOrDocumentSymbolOptions struct {
+	Value BooleanOrDocumentSymbolOptionsValue
 }
 
-// Symbol tags are extra annotations that tweak the rendering of a symbol.
-//
-// @since 3.16
+// BooleanOrDocumentSymbolOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*DocumentSymbolOptions]
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#symbolTag
-type SymbolTag uint32
-
-const (
-	// Render a symbol as obsolete, usually using a strike-out.
-	SymbolTagDeprecated SymbolTag = 1
-)
-
-var validSymbolTagValues = map[uint32]bool{
-	1: true,
+//sumtype:decl
+type BooleanOrDocumentSymbolOptionsValue interface {
+	isBooleanOrDocumentSymbolOptionsValue()
 }
 
-func (s *SymbolTag) UnmarshalJSON(data []byte) error {
+func (Boolean) isBooleanOrDocumentSymbolOptionsValue()                {}
+func (*DocumentSymbolOptions) isBooleanOrDocumentSymbolOptionsValue() {}
+
+func (b *BooleanOrDocumentSymbolOptions) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var uint32Value uint32
-	if err := json.Unmarshal(data, &uint32Value); err != nil {
-		return err
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
 	}
-	if !validSymbolTagValues[uint32Value] {
-		return fmt.Errorf("cannot unmarshal %v into SymbolTag: custom values are not supported", uint32Value)
+	var documentSymbolOptionsValue *DocumentSymbolOptions
+	if err := json.Unmarshal(data, &documentSymbolOptionsValue); err == nil {
+		b.Value = documentSymbolOptionsValue
+		return nil
 	}
-	*s = SymbolTag(uint32Value)
-
-	return nil
-}
-
-func (s SymbolTag) MarshalJSON() ([]byte, error) {
-	var uint32Value = uint32(s)
-	if !validSymbolTagValues[uint32Value] {
-		return nil, fmt.Errorf("cannot marshal %v into SymbolTag: custom values are not supported", uint32Value)
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrDocumentSymbolOptions](),
 	}
-	return json.Marshal(uint32Value)
-
 }
 
-type WorkspaceSymbolClientCapabilitiesTagSupport struct {
-	// The tags supported by the client.
-	ValueSet []SymbolTag `json:"valueSet"`
+func (b *BooleanOrDocumentSymbolOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-// The tags supported by the client.
-func (w *WorkspaceSymbolClientCapabilitiesTagSupport) GetValueSet() []SymbolTag {
-	if w == nil {
-		return *new([]SymbolTag)
-	}
-	return w.ValueSet
+// BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptions contains either of the following types:
+//   - [Boolean]
+//   - [*FoldingRangeOptions]
+//   - [*FoldingRangeRegistrationOptions]
+type BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptions struct {
+	Value BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptionsValue
 }
 
-type WorkspaceSymbolClientCapabilitiesResolveSupport struct {
-	// The properties that a client can resolve lazily. Usually
-	// `location.range`
-	Properties []string `json:"properties"`
+// BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*FoldingRangeOptions]
+//   - [*FoldingRangeRegistrationOptions]
+//
+//sumtype:decl
+type BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptionsValue interface {
+	isBooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptionsValue()
 }
 
-// The properties that a client can resolve lazily. Usually
-// `location.range`
-func (w *WorkspaceSymbolClientCapabilitiesResolveSupport) GetProperties() []string {
-	if w == nil {
-		return *new([]string)
-	}
-	return w.Properties
+func (Boolean) isBooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptionsValue()              {}
+func (*FoldingRangeOptions) isBooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptionsValue() {}
+func (*FoldingRangeRegistrationOptions) isBooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptionsValue() {
 }
 
-// Client capabilities for a {@link WorkspaceSymbolRequest}.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#workspaceSymbolClientCapabilities
-type WorkspaceSymbolClientCapabilities struct {
-	// Symbol request supports dynamic registration.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
-	// Specific capabilities for the `SymbolKind` in the `workspace/symbol` request.
-	SymbolKind *WorkspaceSymbolClientCapabilitiesSymbolKind `json:"symbolKind,omitempty"`
-	// The client supports tags on `SymbolInformation`.
-	// Clients supporting tags have to handle unknown tags gracefully.
-	//
-	// @since 3.16.0
-	TagSupport *WorkspaceSymbolClientCapabilitiesTagSupport `json:"tagSupport,omitempty"`
-	// The client support partial workspace symbols. The client will send the
-	// request `workspaceSymbol/resolve` to the server to resolve additional
-	// properties.
-	//
-	// @since 3.17.0
-	ResolveSupport *WorkspaceSymbolClientCapabilitiesResolveSupport `json:"resolveSupport,omitempty"`
+func (b *BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptions) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
+	}
+	var foldingRangeOptionsValue *FoldingRangeOptions
+	if err := json.Unmarshal(data, &foldingRangeOptionsValue); err == nil {
+		b.Value = foldingRangeOptionsValue
+		return nil
+	}
+	var foldingRangeRegistrationOptionsValue *FoldingRangeRegistrationOptions
+	if err := json.Unmarshal(data, &foldingRangeRegistrationOptionsValue); err == nil {
+		b.Value = foldingRangeRegistrationOptionsValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptions](),
+	}
 }
 
-// Symbol request supports dynamic registration.
-func (w *WorkspaceSymbolClientCapabilities) GetDynamicRegistration() bool {
-	if w == nil {
-		var zero bool
-		return zero
-	}
-	return w.DynamicRegistration
+func (b *BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-// Specific capabilities for the `SymbolKind` in the `workspace/symbol` request.
-func (w *WorkspaceSymbolClientCapabilities) GetSymbolKind() *WorkspaceSymbolClientCapabilitiesSymbolKind {
-	if w == nil {
-		var zero *WorkspaceSymbolClientCapabilitiesSymbolKind
-		return zero
-	}
-	return w.SymbolKind
+// BooleanOrHoverOptions contains either of the following types:
+//   - [Boolean]
+//   - [*HoverOptions]
+type BooleanOrHoverOptions struct {
+	Value BooleanOrHoverOptionsValue
 }
 
-// The client supports tags on `SymbolInformation`.
-// Clients supporting tags have to handle unknown tags gracefully.
+// BooleanOrHoverOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*HoverOptions]
 //
-// @since 3.16.0
-func (w *WorkspaceSymbolClientCapabilities) GetTagSupport() *WorkspaceSymbolClientCapabilitiesTagSupport {
-	if w == nil {
-		var zero *WorkspaceSymbolClientCapabilitiesTagSupport
-		return zero
-	}
-	return w.TagSupport
+//sumtype:decl
+type BooleanOrHoverOptionsValue interface {
+	isBooleanOrHoverOptionsValue()
 }
 
-// The client support partial workspace symbols. The client will send the
-// request `workspaceSymbol/resolve` to the server to resolve additional
-// properties.
-//
-// @since 3.17.0
-func (w *WorkspaceSymbolClientCapabilities) GetResolveSupport() *WorkspaceSymbolClientCapabilitiesResolveSupport {
-	if w == nil {
-		var zero *WorkspaceSymbolClientCapabilitiesResolveSupport
-		return zero
+func (Boolean) isBooleanOrHoverOptionsValue()       {}
+func (*HoverOptions) isBooleanOrHoverOptionsValue() {}
+
+func (b *BooleanOrHoverOptions) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
+	}
+	var hoverOptionsValue *HoverOptions
+	if err := json.Unmarshal(data, &hoverOptionsValue); err == nil {
+		b.Value = hoverOptionsValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrHoverOptions](),
 	}
-	return w.ResolveSupport
 }
 
-// The client capabilities of a {@link ExecuteCommandRequest}.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#executeCommandClientCapabilities
-type ExecuteCommandClientCapabilities struct {
-	// Execute command supports dynamic registration.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+func (b *BooleanOrHoverOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-// Execute command supports dynamic registration.
-func (e *ExecuteCommandClientCapabilities) GetDynamicRegistration() bool {
-	if e == nil {
-		var zero bool
-		return zero
-	}
-	return e.DynamicRegistration
+// BooleanOrImplementationOptionsOrImplementationRegistrationOptions contains either of the following types:
+//   - [Boolean]
+//   - [*ImplementationOptions]
+//   - [*ImplementationRegistrationOptions]
+type BooleanOrImplementationOptionsOrImplementationRegistrationOptions struct {
+	Value BooleanOrImplementationOptionsOrImplementationRegistrationOptionsValue
 }
 
-// @since 3.16.0
+// BooleanOrImplementationOptionsOrImplementationRegistrationOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*ImplementationOptions]
+//   - [*ImplementationRegistrationOptions]
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#semanticTokensWorkspaceClientCapabilities
-type SemanticTokensWorkspaceClientCapabilities struct {
-	// Whether the client implementation supports a refresh request sent from
-	// the server to the client.
-	//
-	// Note that this event is global and will force the client to refresh all
-	// semantic tokens currently shown. It should be used with absolute care
-	// and is useful for situation where a server for example detects a project
-	// wide change that requires such a calculation.
-	RefreshSupport bool `json:"refreshSupport,omitempty"`
+//sumtype:decl
+type BooleanOrImplementationOptionsOrImplementationRegistrationOptionsValue interface {
+	isBooleanOrImplementationOptionsOrImplementationRegistrationOptionsValue()
 }
 
-// Whether the client implementation supports a refresh request sent from
-// the server to the client.
-//
-// Note that this event is global and will force the client to refresh all
-// semantic tokens currently shown. It should be used with absolute care
-// and is useful for situation where a server for example detects a project
-// wide change that requires such a calculation.
-func (s *SemanticTokensWorkspaceClientCapabilities) GetRefreshSupport() bool {
-	if s == nil {
-		var zero bool
-		return zero
+func (Boolean) isBooleanOrImplementationOptionsOrImplementationRegistrationOptionsValue() {}
+func (*ImplementationOptions) isBooleanOrImplementationOptionsOrImplementationRegistrationOptionsValue() {
+}
+func (*ImplementationRegistrationOptions) isBooleanOrImplementationOptionsOrImplementationRegistrationOptionsValue() {
+}
+
+func (b *BooleanOrImplementationOptionsOrImplementationRegistrationOptions) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
+	}
+	var implementationOptionsValue *ImplementationOptions
+	if err := json.Unmarshal(data, &implementationOptionsValue); err == nil {
+		b.Value = implementationOptionsValue
+		return nil
+	}
+	var implementationRegistrationOptionsValue *ImplementationRegistrationOptions
+	if err := json.Unmarshal(data, &implementationRegistrationOptionsValue); err == nil {
+		b.Value = implementationRegistrationOptionsValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrImplementationOptionsOrImplementationRegistrationOptions](),
 	}
-	return s.RefreshSupport
 }
 
-// @since 3.16.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#codeLensWorkspaceClientCapabilities
-type CodeLensWorkspaceClientCapabilities struct {
-	// Whether the client implementation supports a refresh request sent from the
-	// server to the client.
-	//
-	// Note that this event is global and will force the client to refresh all
-	// code lenses currently shown. It should be used with absolute care and is
-	// useful for situation where a server for example detect a project wide
-	// change that requires such a calculation.
-	RefreshSupport bool `json:"refreshSupport,omitempty"`
+func (b *BooleanOrImplementationOptionsOrImplementationRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-// Whether the client implementation supports a refresh request sent from the
-// server to the client.
-//
-// Note that this event is global and will force the client to refresh all
-// code lenses currently shown. It should be used with absolute care and is
-// useful for situation where a server for example detect a project wide
-// change that requires such a calculation.
-func (c *CodeLensWorkspaceClientCapabilities) GetRefreshSupport() bool {
-	if c == nil {
-		var zero bool
-		return zero
-	}
-	return c.RefreshSupport
+// BooleanOrInlayHintOptionsOrInlayHintRegistrationOptions contains either of the following types:
+//   - [Boolean]
+//   - [*InlayHintOptions]
+//   - [*InlayHintRegistrationOptions]
+type BooleanOrInlayHintOptionsOrInlayHintRegistrationOptions struct {
+	Value BooleanOrInlayHintOptionsOrInlayHintRegistrationOptionsValue
 }
 
-// Capabilities relating to events from file operations by the user in the client.
-//
-// These events do not come from the file system, they come from user operations
-// like renaming a file in the UI.
-//
-// @since 3.16.0
+// BooleanOrInlayHintOptionsOrInlayHintRegistrationOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*InlayHintOptions]
+//   - [*InlayHintRegistrationOptions]
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#fileOperationClientCapabilities
-type FileOperationClientCapabilities struct {
-	// Whether the client supports dynamic registration for file requests/notifications.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
-	// The client has support for sending didCreateFiles notifications.
-	DidCreate bool `json:"didCreate,omitempty"`
-	// The client has support for sending willCreateFiles requests.
-	WillCreate bool `json:"willCreate,omitempty"`
-	// The client has support for sending didRenameFiles notifications.
-	DidRename bool `json:"didRename,omitempty"`
-	// The client has support for sending willRenameFiles requests.
-	WillRename bool `json:"willRename,omitempty"`
-	// The client has support for sending didDeleteFiles notifications.
-	DidDelete bool `json:"didDelete,omitempty"`
-	// The client has support for sending willDeleteFiles requests.
-	WillDelete bool `json:"willDelete,omitempty"`
+//sumtype:decl
+type BooleanOrInlayHintOptionsOrInlayHintRegistrationOptionsValue interface {
+	isBooleanOrInlayHintOptionsOrInlayHintRegistrationOptionsValue()
 }
 
-// Whether the client supports dynamic registration for file requests/notifications.
-func (f *FileOperationClientCapabilities) GetDynamicRegistration() bool {
-	if f == nil {
-		var zero bool
-		return zero
-	}
-	return f.DynamicRegistration
+func (Boolean) isBooleanOrInlayHintOptionsOrInlayHintRegistrationOptionsValue()           {}
+func (*InlayHintOptions) isBooleanOrInlayHintOptionsOrInlayHintRegistrationOptionsValue() {}
+func (*InlayHintRegistrationOptions) isBooleanOrInlayHintOptionsOrInlayHintRegistrationOptionsValue() {
 }
 
-// The client has support for sending didCreateFiles notifications.
-func (f *FileOperationClientCapabilities) GetDidCreate() bool {
-	if f == nil {
-		var zero bool
-		return zero
+func (b *BooleanOrInlayHintOptionsOrInlayHintRegistrationOptions) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
+	}
+	var inlayHintOptionsValue *InlayHintOptions
+	if err := json.Unmarshal(data, &inlayHintOptionsValue); err == nil {
+		b.Value = inlayHintOptionsValue
+		return nil
+	}
+	var inlayHintRegistrationOptionsValue *InlayHintRegistrationOptions
+	if err := json.Unmarshal(data, &inlayHintRegistrationOptionsValue); err == nil {
+		b.Value = inlayHintRegistrationOptionsValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrInlayHintOptionsOrInlayHintRegistrationOptions](),
 	}
-	return f.DidCreate
 }
 
-// The client has support for sending willCreateFiles requests.
-func (f *FileOperationClientCapabilities) GetWillCreate() bool {
-	if f == nil {
-		var zero bool
-		return zero
-	}
-	return f.WillCreate
+func (b *BooleanOrInlayHintOptionsOrInlayHintRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-// The client has support for sending didRenameFiles notifications.
-func (f *FileOperationClientCapabilities) GetDidRename() bool {
-	if f == nil {
-		var zero bool
-		return zero
-	}
-	return f.DidRename
+// BooleanOrInlineCompletionOptions contains either of the following types:
+//   - [Boolean]
+//   - [*InlineCompletionOptions]
+type BooleanOrInlineCompletionOptions struct {
+	Value BooleanOrInlineCompletionOptionsValue
 }
 
-// The client has support for sending willRenameFiles requests.
-func (f *FileOperationClientCapabilities) GetWillRename() bool {
-	if f == nil {
-		var zero bool
-		return zero
-	}
-	return f.WillRename
+// BooleanOrInlineCompletionOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*InlineCompletionOptions]
+//
+//sumtype:decl
+type BooleanOrInlineCompletionOptionsValue interface {
+	isBooleanOrInlineCompletionOptionsValue()
 }
 
-// The client has support for sending didDeleteFiles notifications.
-func (f *FileOperationClientCapabilities) GetDidDelete() bool {
-	if f == nil {
-		var zero bool
-		return zero
+func (Boolean) isBooleanOrInlineCompletionOptionsValue()                  {}
+func (*InlineCompletionOptions) isBooleanOrInlineCompletionOptionsValue() {}
+
+func (b *BooleanOrInlineCompletionOptions) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
+	}
+	var inlineCompletionOptionsValue *InlineCompletionOptions
+	if err := json.Unmarshal(data, &inlineCompletionOptionsValue); err == nil {
+		b.Value = inlineCompletionOptionsValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrInlineCompletionOptions](),
 	}
-	return f.DidDelete
 }
 
-// The client has support for sending willDeleteFiles requests.
-func (f *FileOperationClientCapabilities) GetWillDelete() bool {
-	if f == nil {
-		var zero bool
-		return zero
-	}
-	return f.WillDelete
+func (b *BooleanOrInlineCompletionOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-// Client workspace capabilities specific to inline values.
-//
-// @since 3.17.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#inlineValueWorkspaceClientCapabilities
-type InlineValueWorkspaceClientCapabilities struct {
-	// Whether the client implementation supports a refresh request sent from the
-	// server to the client.
-	//
-	// Note that this event is global and will force the client to refresh all
-	// inline values currently shown. It should be used with absolute care and is
-	// useful for situation where a server for example detects a project wide
-	// change that requires such a calculation.
-	RefreshSupport bool `json:"refreshSupport,omitempty"`
+// BooleanOrInlineValueOptionsOrInlineValueRegistrationOptions contains either of the following types:
+//   - [Boolean]
+//   - [*InlineValueOptions]
+//   - [*InlineValueRegistrationOptions]
+type BooleanOrInlineValueOptionsOrInlineValueRegistrationOptions struct {
+	Value BooleanOrInlineValueOptionsOrInlineValueRegistrationOptionsValue
 }
 
-// Whether the client implementation supports a refresh request sent from the
-// server to the client.
+// BooleanOrInlineValueOptionsOrInlineValueRegistrationOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*InlineValueOptions]
+//   - [*InlineValueRegistrationOptions]
 //
-// Note that this event is global and will force the client to refresh all
-// inline values currently shown. It should be used with absolute care and is
-// useful for situation where a server for example detects a project wide
-// change that requires such a calculation.
-func (i *InlineValueWorkspaceClientCapabilities) GetRefreshSupport() bool {
-	if i == nil {
-		var zero bool
-		return zero
-	}
-	return i.RefreshSupport
+//sumtype:decl
+type BooleanOrInlineValueOptionsOrInlineValueRegistrationOptionsValue interface {
+	isBooleanOrInlineValueOptionsOrInlineValueRegistrationOptionsValue()
 }
 
-// Client workspace capabilities specific to inlay hints.
-//
-// @since 3.17.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#inlayHintWorkspaceClientCapabilities
-type InlayHintWorkspaceClientCapabilities struct {
-	// Whether the client implementation supports a refresh request sent from
-	// the server to the client.
-	//
-	// Note that this event is global and will force the client to refresh all
-	// inlay hints currently shown. It should be used with absolute care and
-	// is useful for situation where a server for example detects a project wide
-	// change that requires such a calculation.
-	RefreshSupport bool `json:"refreshSupport,omitempty"`
+func (Boolean) isBooleanOrInlineValueOptionsOrInlineValueRegistrationOptionsValue()             {}
+func (*InlineValueOptions) isBooleanOrInlineValueOptionsOrInlineValueRegistrationOptionsValue() {}
+func (*InlineValueRegistrationOptions) isBooleanOrInlineValueOptionsOrInlineValueRegistrationOptionsValue() {
 }
 
-// Whether the client implementation supports a refresh request sent from
-// the server to the client.
-//
-// Note that this event is global and will force the client to refresh all
-// inlay hints currently shown. It should be used with absolute care and
-// is useful for situation where a server for example detects a project wide
-// change that requires such a calculation.
-func (i *InlayHintWorkspaceClientCapabilities) GetRefreshSupport() bool {
-	if i == nil {
-		var zero bool
-		return zero
+func (b *BooleanOrInlineValueOptionsOrInlineValueRegistrationOptions) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
+	}
+	var inlineValueOptionsValue *InlineValueOptions
+	if err := json.Unmarshal(data, &inlineValueOptionsValue); err == nil {
+		b.Value = inlineValueOptionsValue
+		return nil
+	}
+	var inlineValueRegistrationOptionsValue *InlineValueRegistrationOptions
+	if err := json.Unmarshal(data, &inlineValueRegistrationOptionsValue); err == nil {
+		b.Value = inlineValueRegistrationOptionsValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrInlineValueOptionsOrInlineValueRegistrationOptions](),
 	}
-	return i.RefreshSupport
 }
 
-// Workspace client capabilities specific to diagnostic pull requests.
-//
-// @since 3.17.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#diagnosticWorkspaceClientCapabilities
-type DiagnosticWorkspaceClientCapabilities struct {
-	// Whether the client implementation supports a refresh request sent from
-	// the server to the client.
-	//
-	// Note that this event is global and will force the client to refresh all
-	// pulled diagnostics currently shown. It should be used with absolute care and
-	// is useful for situation where a server for example detects a project wide
-	// change that requires such a calculation.
-	RefreshSupport bool `json:"refreshSupport,omitempty"`
+func (b *BooleanOrInlineValueOptionsOrInlineValueRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-// Whether the client implementation supports a refresh request sent from
-// the server to the client.
+// BooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptions contains either of the following types:
+//   - [Boolean]
+//   - [*LinkedEditingRangeOptions]
+//   - [*LinkedEditingRangeRegistrationOptions]
+type BooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptions struct {
+	Value BooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptionsValue
+}
+
+// BooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*LinkedEditingRangeOptions]
+//   - [*LinkedEditingRangeRegistrationOptions]
 //
-// Note that this event is global and will force the client to refresh all
-// pulled diagnostics currently shown. It should be used with absolute care and
-// is useful for situation where a server for example detects a project wide
-// change that requires such a calculation.
-func (d *DiagnosticWorkspaceClientCapabilities) GetRefreshSupport() bool {
-	if d == nil {
-		var zero bool
-		return zero
-	}
-	return d.RefreshSupport
+//sumtype:decl
+type BooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptionsValue interface {
+	isBooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptionsValue()
 }
 
-// Client workspace capabilities specific to folding ranges
-//
-// @since 3.18.0
-// @proposed
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#foldingRangeWorkspaceClientCapabilities
-type FoldingRangeWorkspaceClientCapabilities struct {
-	// Whether the client implementation supports a refresh request sent from the
-	// server to the client.
-	//
-	// Note that this event is global and will force the client to refresh all
-	// folding ranges currently shown. It should be used with absolute care and is
-	// useful for situation where a server for example detects a project wide
-	// change that requires such a calculation.
-	//
-	// @since 3.18.0
-	// @proposed
-	RefreshSupport bool `json:"refreshSupport,omitempty"`
+func (Boolean) isBooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptionsValue() {}
+func (*LinkedEditingRangeOptions) isBooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptionsValue() {
+}
+func (*LinkedEditingRangeRegistrationOptions) isBooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptionsValue() {
 }
 
-// Whether the client implementation supports a refresh request sent from the
-// server to the client.
-//
-// Note that this event is global and will force the client to refresh all
-// folding ranges currently shown. It should be used with absolute care and is
-// useful for situation where a server for example detects a project wide
-// change that requires such a calculation.
-//
-// @since 3.18.0
-// @proposed
-func (f *FoldingRangeWorkspaceClientCapabilities) GetRefreshSupport() bool {
-	if f == nil {
-		var zero bool
-		return zero
+func (b *BooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptions) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
+	}
+	var linkedEditingRangeOptionsValue *LinkedEditingRangeOptions
+	if err := json.Unmarshal(data, &linkedEditingRangeOptionsValue); err == nil {
+		b.Value = linkedEditingRangeOptionsValue
+		return nil
+	}
+	var linkedEditingRangeRegistrationOptionsValue *LinkedEditingRangeRegistrationOptions
+	if err := json.Unmarshal(data, &linkedEditingRangeRegistrationOptionsValue); err == nil {
+		b.Value = linkedEditingRangeRegistrationOptionsValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptions](),
 	}
-	return f.RefreshSupport
 }
 
-// Workspace specific client capabilities.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#workspaceClientCapabilities
-type WorkspaceClientCapabilities struct {
-	// The client supports applying batch edits
-	// to the workspace by supporting the request
-	// 'workspace/applyEdit'
-	ApplyEdit bool `json:"applyEdit,omitempty"`
-	// Capabilities specific to `WorkspaceEdit`s.
-	WorkspaceEdit *WorkspaceEditClientCapabilities `json:"workspaceEdit,omitempty"`
-	// Capabilities specific to the `workspace/didChangeConfiguration` notification.
-	DidChangeConfiguration *DidChangeConfigurationClientCapabilities `json:"didChangeConfiguration,omitempty"`
-	// Capabilities specific to the `workspace/didChangeWatchedFiles` notification.
-	DidChangeWatchedFiles *DidChangeWatchedFilesClientCapabilities `json:"didChangeWatchedFiles,omitempty"`
-	// Capabilities specific to the `workspace/symbol` request.
-	Symbol *WorkspaceSymbolClientCapabilities `json:"symbol,omitempty"`
-	// Capabilities specific to the `workspace/executeCommand` request.
-	ExecuteCommand *ExecuteCommandClientCapabilities `json:"executeCommand,omitempty"`
-	// The client has support for workspace folders.
-	//
-	// @since 3.6.0
-	WorkspaceFolders bool `json:"workspaceFolders,omitempty"`
-	// The client supports `workspace/configuration` requests.
-	//
-	// @since 3.6.0
-	Configuration bool `json:"configuration,omitempty"`
-	// Capabilities specific to the semantic token requests scoped to the
-	// workspace.
-	//
-	// @since 3.16.0.
-	SemanticTokens *SemanticTokensWorkspaceClientCapabilities `json:"semanticTokens,omitempty"`
-	// Capabilities specific to the code lens requests scoped to the
-	// workspace.
-	//
-	// @since 3.16.0.
-	CodeLens *CodeLensWorkspaceClientCapabilities `json:"codeLens,omitempty"`
-	// The client has support for file notifications/requests for user operations on files.
-	//
-	// Since 3.16.0
-	FileOperations *FileOperationClientCapabilities `json:"fileOperations,omitempty"`
-	// Capabilities specific to the inline values requests scoped to the
-	// workspace.
-	//
-	// @since 3.17.0.
-	InlineValue *InlineValueWorkspaceClientCapabilities `json:"inlineValue,omitempty"`
-	// Capabilities specific to the inlay hint requests scoped to the
-	// workspace.
-	//
-	// @since 3.17.0.
-	InlayHint *InlayHintWorkspaceClientCapabilities `json:"inlayHint,omitempty"`
-	// Capabilities specific to the diagnostic requests scoped to the
-	// workspace.
-	//
-	// @since 3.17.0.
-	Diagnostics *DiagnosticWorkspaceClientCapabilities `json:"diagnostics,omitempty"`
-	// Capabilities specific to the folding range requests scoped to the workspace.
-	//
-	// @since 3.18.0
-	// @proposed
-	FoldingRange *FoldingRangeWorkspaceClientCapabilities `json:"foldingRange,omitempty"`
+func (b *BooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-// The client supports applying batch edits
-// to the workspace by supporting the request
-// 'workspace/applyEdit'
-func (w *WorkspaceClientCapabilities) GetApplyEdit() bool {
-	if w == nil {
-		var zero bool
-		return zero
-	}
-	return w.ApplyEdit
+// BooleanOrMonikerOptionsOrMonikerRegistrationOptions contains either of the following types:
+//   - [Boolean]
+//   - [*MonikerOptions]
+//   - [*MonikerRegistrationOptions]
+type BooleanOrMonikerOptionsOrMonikerRegistrationOptions struct {
+	Value BooleanOrMonikerOptionsOrMonikerRegistrationOptionsValue
 }
 
-// Capabilities specific to `WorkspaceEdit`s.
-func (w *WorkspaceClientCapabilities) GetWorkspaceEdit() *WorkspaceEditClientCapabilities {
-	if w == nil {
-		var zero *WorkspaceEditClientCapabilities
-		return zero
-	}
-	return w.WorkspaceEdit
+// BooleanOrMonikerOptionsOrMonikerRegistrationOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*MonikerOptions]
+//   - [*MonikerRegistrationOptions]
+//
+//sumtype:decl
+type BooleanOrMonikerOptionsOrMonikerRegistrationOptionsValue interface {
+	isBooleanOrMonikerOptionsOrMonikerRegistrationOptionsValue()
 }
 
-// Capabilities specific to the `workspace/didChangeConfiguration` notification.
-func (w *WorkspaceClientCapabilities) GetDidChangeConfiguration() *DidChangeConfigurationClientCapabilities {
-	if w == nil {
-		var zero *DidChangeConfigurationClientCapabilities
-		return zero
-	}
-	return w.DidChangeConfiguration
-}
+func (Boolean) isBooleanOrMonikerOptionsOrMonikerRegistrationOptionsValue()                     {}
+func (*MonikerOptions) isBooleanOrMonikerOptionsOrMonikerRegistrationOptionsValue()             {}
+func (*MonikerRegistrationOptions) isBooleanOrMonikerOptionsOrMonikerRegistrationOptionsValue() {}
 
-// Capabilities specific to the `workspace/didChangeWatchedFiles` notification.
-func (w *WorkspaceClientCapabilities) GetDidChangeWatchedFiles() *DidChangeWatchedFilesClientCapabilities {
-	if w == nil {
-		var zero *DidChangeWatchedFilesClientCapabilities
-		return zero
+func (b *BooleanOrMonikerOptionsOrMonikerRegistrationOptions) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
 	}
-	return w.DidChangeWatchedFiles
-}
-
-// Capabilities specific to the `workspace/symbol` request.
-func (w *WorkspaceClientCapabilities) GetSymbol() *WorkspaceSymbolClientCapabilities {
-	if w == nil {
-		var zero *WorkspaceSymbolClientCapabilities
-		return zero
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
 	}
-	return w.Symbol
-}
-
-// Capabilities specific to the `workspace/executeCommand` request.
-func (w *WorkspaceClientCapabilities) GetExecuteCommand() *ExecuteCommandClientCapabilities {
-	if w == nil {
-		var zero *ExecuteCommandClientCapabilities
-		return zero
+	var monikerOptionsValue *MonikerOptions
+	if err := json.Unmarshal(data, &monikerOptionsValue); err == nil {
+		b.Value = monikerOptionsValue
+		return nil
+	}
+	var monikerRegistrationOptionsValue *MonikerRegistrationOptions
+	if err := json.Unmarshal(data, &monikerRegistrationOptionsValue); err == nil {
+		b.Value = monikerRegistrationOptionsValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrMonikerOptionsOrMonikerRegistrationOptions](),
 	}
-	return w.ExecuteCommand
 }
 
-// The client has support for workspace folders.
-//
-// @since 3.6.0
-func (w *WorkspaceClientCapabilities) GetWorkspaceFolders() bool {
-	if w == nil {
-		var zero bool
-		return zero
-	}
-	return w.WorkspaceFolders
+func (b *BooleanOrMonikerOptionsOrMonikerRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-// The client supports `workspace/configuration` requests.
-//
-// @since 3.6.0
-func (w *WorkspaceClientCapabilities) GetConfiguration() bool {
-	if w == nil {
-		var zero bool
-		return zero
-	}
-	return w.Configuration
+// BooleanOrReferenceOptions contains either of the following types:
+//   - [Boolean]
+//   - [*ReferenceOptions]
+type BooleanOrReferenceOptions struct {
+	Value BooleanOrReferenceOptionsValue
 }
 
-// Capabilities specific to the semantic token requests scoped to the
-// workspace.
+// BooleanOrReferenceOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*ReferenceOptions]
 //
-// @since 3.16.0.
-func (w *WorkspaceClientCapabilities) GetSemanticTokens() *SemanticTokensWorkspaceClientCapabilities {
-	if w == nil {
-		var zero *SemanticTokensWorkspaceClientCapabilities
-		return zero
-	}
-	return w.SemanticTokens
+//sumtype:decl
+type BooleanOrReferenceOptionsValue interface {
+	isBooleanOrReferenceOptionsValue()
 }
 
-// Capabilities specific to the code lens requests scoped to the
-// workspace.
-//
-// @since 3.16.0.
-func (w *WorkspaceClientCapabilities) GetCodeLens() *CodeLensWorkspaceClientCapabilities {
-	if w == nil {
-		var zero *CodeLensWorkspaceClientCapabilities
-		return zero
-	}
-	return w.CodeLens
-}
+func (Boolean) isBooleanOrReferenceOptionsValue()           {}
+func (*ReferenceOptions) isBooleanOrReferenceOptionsValue() {}
 
-// The client has support for file notifications/requests for user operations on files.
-//
-// Since 3.16.0
-func (w *WorkspaceClientCapabilities) GetFileOperations() *FileOperationClientCapabilities {
-	if w == nil {
-		var zero *FileOperationClientCapabilities
-		return zero
+func (b *BooleanOrReferenceOptions) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
 	}
-	return w.FileOperations
-}
-
-// Capabilities specific to the inline values requests scoped to the
-// workspace.
-//
-// @since 3.17.0.
-func (w *WorkspaceClientCapabilities) GetInlineValue() *InlineValueWorkspaceClientCapabilities {
-	if w == nil {
-		var zero *InlineValueWorkspaceClientCapabilities
-		return zero
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
+	}
+	var referenceOptionsValue *ReferenceOptions
+	if err := json.Unmarshal(data, &referenceOptionsValue); err == nil {
+		b.Value = referenceOptionsValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrReferenceOptions](),
 	}
-	return w.InlineValue
 }
 
-// Capabilities specific to the inlay hint requests scoped to the
-// workspace.
-//
-// @since 3.17.0.
-func (w *WorkspaceClientCapabilities) GetInlayHint() *InlayHintWorkspaceClientCapabilities {
-	if w == nil {
-		var zero *InlayHintWorkspaceClientCapabilities
-		return zero
-	}
-	return w.InlayHint
+func (b *BooleanOrReferenceOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-// Capabilities specific to the diagnostic requests scoped to the
-// workspace.
-//
-// @since 3.17.0.
-func (w *WorkspaceClientCapabilities) GetDiagnostics() *DiagnosticWorkspaceClientCapabilities {
-	if w == nil {
-		var zero *DiagnosticWorkspaceClientCapabilities
-		return zero
-	}
-	return w.Diagnostics
+// BooleanOrRenameOptions contains either of the following types:
+//   - [Boolean]
+//   - [*RenameOptions]
+type BooleanOrRenameOptions struct {
+	Value BooleanOrRenameOptionsValue
 }
 
-// Capabilities specific to the folding range requests scoped to the workspace.
+// BooleanOrRenameOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*RenameOptions]
 //
-// @since 3.18.0
-// @proposed
-func (w *WorkspaceClientCapabilities) GetFoldingRange() *FoldingRangeWorkspaceClientCapabilities {
-	if w == nil {
-		var zero *FoldingRangeWorkspaceClientCapabilities
-		return zero
-	}
-	return w.FoldingRange
+//sumtype:decl
+type BooleanOrRenameOptionsValue interface {
+	isBooleanOrRenameOptionsValue()
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocumentSyncClientCapabilities
-type TextDocumentSyncClientCapabilities struct {
-	// Whether text document synchronization supports dynamic registration.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
-	// The client supports sending will save notifications.
-	WillSave bool `json:"willSave,omitempty"`
-	// The client supports sending a will save request and
-	// waits for a response providing text edits which will
-	// be applied to the document before it is saved.
-	WillSaveWaitUntil bool `json:"willSaveWaitUntil,omitempty"`
-	// The client supports did save notifications.
-	DidSave bool `json:"didSave,omitempty"`
-}
+func (Boolean) isBooleanOrRenameOptionsValue()        {}
+func (*RenameOptions) isBooleanOrRenameOptionsValue() {}
 
-// Whether text document synchronization supports dynamic registration.
-func (t *TextDocumentSyncClientCapabilities) GetDynamicRegistration() bool {
-	if t == nil {
-		var zero bool
-		return zero
+func (b *BooleanOrRenameOptions) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
 	}
-	return t.DynamicRegistration
-}
-
-// The client supports sending will save notifications.
-func (t *TextDocumentSyncClientCapabilities) GetWillSave() bool {
-	if t == nil {
-		var zero bool
-		return zero
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
+	}
+	var renameOptionsValue *RenameOptions
+	if err := json.Unmarshal(data, &renameOptionsValue); err == nil {
+		b.Value = renameOptionsValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrRenameOptions](),
 	}
-	return t.WillSave
 }
 
-// The client supports sending a will save request and
-// waits for a response providing text edits which will
-// be applied to the document before it is saved.
-func (t *TextDocumentSyncClientCapabilities) GetWillSaveWaitUntil() bool {
-	if t == nil {
-		var zero bool
-		return zero
-	}
-	return t.WillSaveWaitUntil
+func (b *BooleanOrRenameOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-// The client supports did save notifications.
-func (t *TextDocumentSyncClientCapabilities) GetDidSave() bool {
-	if t == nil {
-		var zero bool
-		return zero
-	}
-	return t.DidSave
+// BooleanOrSaveOptions contains either of the following types:
+//   - [Boolean]
+//   - [*SaveOptions]
+type BooleanOrSaveOptions struct {
+	Value BooleanOrSaveOptionsValue
 }
 
-// Describes the content type that a client supports in various
-// result literals like `Hover`, `ParameterInfo` or `CompletionItem`.
-//
-// Please note that `MarkupKinds` must not start with a `$`. This kinds
-// are reserved for internal usage.
+// BooleanOrSaveOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*SaveOptions]
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#markupKind
-type MarkupKind string
-
-const (
-	// Plain text is supported as a content format
-	MarkupKindPlainText MarkupKind = "plaintext"
-	// Markdown is supported as a content format
-	MarkupKindMarkdown MarkupKind = "markdown"
-)
-
-var validMarkupKindValues = map[string]bool{
-	"plaintext": true,
-	"markdown":  true,
+//sumtype:decl
+type BooleanOrSaveOptionsValue interface {
+	isBooleanOrSaveOptionsValue()
 }
 
-func (m *MarkupKind) UnmarshalJSON(data []byte) error {
+func (Boolean) isBooleanOrSaveOptionsValue()      {}
+func (*SaveOptions) isBooleanOrSaveOptionsValue() {}
+
+func (b *BooleanOrSaveOptions) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var stringValue string
-	if err := json.Unmarshal(data, &stringValue); err != nil {
-		return err
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
 	}
-	if !validMarkupKindValues[stringValue] {
-		return fmt.Errorf("cannot unmarshal %v into MarkupKind: custom values are not supported", stringValue)
+	var saveOptionsValue *SaveOptions
+	if err := json.Unmarshal(data, &saveOptionsValue); err == nil {
+		b.Value = saveOptionsValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrSaveOptions](),
 	}
-	*m = MarkupKind(stringValue)
-
-	return nil
 }
 
-func (m MarkupKind) MarshalJSON() ([]byte, error) {
-	var stringValue = string(m)
-	if !validMarkupKindValues[stringValue] {
-		return nil, fmt.Errorf("cannot marshal %v into MarkupKind: custom values are not supported", stringValue)
-	}
-	return json.Marshal(stringValue)
+func (b *BooleanOrSaveOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
+}
 
+// BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptions contains either of the following types:
+//   - [Boolean]
+//   - [*SelectionRangeOptions]
+//   - [*SelectionRangeRegistrationOptions]
+type BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptions struct {
+	Value BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptionsValue
 }
 
-// Completion item tags are extra annotations that tweak the rendering of a completion
-// item.
-//
-// @since 3.15.0
+// BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*SelectionRangeOptions]
+//   - [*SelectionRangeRegistrationOptions]
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#completionItemTag
-type CompletionItemTag uint32
-
-const (
-	// Render a completion as obsolete, usually using a strike-out.
-	CompletionItemTagDeprecated CompletionItemTag = 1
-)
+//sumtype:decl
+type BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptionsValue interface {
+	isBooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptionsValue()
+}
 
-var validCompletionItemTagValues = map[uint32]bool{
-	1: true,
+func (Boolean) isBooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptionsValue() {}
+func (*SelectionRangeOptions) isBooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptionsValue() {
+}
+func (*SelectionRangeRegistrationOptions) isBooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptionsValue() {
 }
 
-func (c *CompletionItemTag) UnmarshalJSON(data []byte) error {
+func (b *BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptions) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var uint32Value uint32
-	if err := json.Unmarshal(data, &uint32Value); err != nil {
-		return err
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
 	}
-	if !validCompletionItemTagValues[uint32Value] {
-		return fmt.Errorf("cannot unmarshal %v into CompletionItemTag: custom values are not supported", uint32Value)
+	var selectionRangeOptionsValue *SelectionRangeOptions
+	if err := json.Unmarshal(data, &selectionRangeOptionsValue); err == nil {
+		b.Value = selectionRangeOptionsValue
+		return nil
 	}
-	*c = CompletionItemTag(uint32Value)
+	var selectionRangeRegistrationOptionsValue *SelectionRangeRegistrationOptions
+	if err := json.Unmarshal(data, &selectionRangeRegistrationOptionsValue); err == nil {
+		b.Value = selectionRangeRegistrationOptionsValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptions](),
+	}
+}
 
-	return nil
+func (b *BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-func (c CompletionItemTag) MarshalJSON() ([]byte, error) {
-	var uint32Value = uint32(c)
-	if !validCompletionItemTagValues[uint32Value] {
-		return nil, fmt.Errorf("cannot marshal %v into CompletionItemTag: custom values are not supported", uint32Value)
-	}
-	return json.Marshal(uint32Value)
+// BooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2 contains either of the following types:
+//   - [Boolean]
+//   - [*SemanticTokensClientCapabilitiesRequestsFullOr2]
+type BooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2 struct {
+	Value BooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2Value
+}
 
+// BooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2Value is either of the following types:
+//   - [Boolean]
+//   - [*SemanticTokensClientCapabilitiesRequestsFullOr2]
+//
+//sumtype:decl
+type BooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2Value interface {
+	isBooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2Value()
 }
 
-type CompletionClientCapabilitiesCompletionItemTagSupport struct {
-	// The tags supported by the client.
-	ValueSet []CompletionItemTag `json:"valueSet"`
+func (Boolean) isBooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2Value() {}
+func (*SemanticTokensClientCapabilitiesRequestsFullOr2) isBooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2Value() {
 }
 
-// The tags supported by the client.
-func (c *CompletionClientCapabilitiesCompletionItemTagSupport) GetValueSet() []CompletionItemTag {
-	if c == nil {
-		return *new([]CompletionItemTag)
+func (b *BooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
+	}
+	var semanticTokensClientCapabilitiesRequestsFullOr2Value *SemanticTokensClientCapabilitiesRequestsFullOr2
+	if err := json.Unmarshal(data, &semanticTokensClientCapabilitiesRequestsFullOr2Value); err == nil {
+		b.Value = semanticTokensClientCapabilitiesRequestsFullOr2Value
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2](),
 	}
-	return c.ValueSet
 }
 
-type CompletionClientCapabilitiesCompletionItemResolveSupport struct {
-	// The properties that a client can resolve lazily.
-	Properties []string `json:"properties"`
+func (b *BooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-// The properties that a client can resolve lazily.
-func (c *CompletionClientCapabilitiesCompletionItemResolveSupport) GetProperties() []string {
-	if c == nil {
-		return *new([]string)
-	}
-	return c.Properties
+// BooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2 contains either of the following types:
+//   - [Boolean]
+//   - [*SemanticTokensClientCapabilitiesRequestsRangeOr2]
+type BooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2 struct {
+	Value BooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2Value
 }
 
-// How whitespace and indentation is handled during completion
-// item insertion.
-//
-// @since 3.16.0
+// BooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2Value is either of the following types:
+//   - [Boolean]
+//   - [*SemanticTokensClientCapabilitiesRequestsRangeOr2]
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#insertTextMode
-type InsertTextMode uint32
-
-const (
-	// The insertion or replace strings is taken as it is. If the
-	// value is multi line the lines below the cursor will be
-	// inserted using the indentation defined in the string value.
-	// The client will not apply any kind of adjustments to the
-	// string.
-	InsertTextModeasIs InsertTextMode = 1
-	// The editor adjusts leading whitespace of new lines so that
-	// they match the indentation up to the cursor of the line for
-	// which the item is accepted.
-	//
-	// Consider a line like this: <2tabs><cursor><3tabs>foo. Accepting a
-	// multi line completion item is indented using 2 tabs and all
-	// following lines inserted will be indented using 2 tabs as well.
-	InsertTextModeadjustIndentation InsertTextMode = 2
-)
+//sumtype:decl
+type BooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2Value interface {
+	isBooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2Value()
+}
 
-var validInsertTextModeValues = map[uint32]bool{
-	1: true,
-	2: true,
+func (Boolean) isBooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2Value() {}
+func (*SemanticTokensClientCapabilitiesRequestsRangeOr2) isBooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2Value() {
 }
 
-func (i *InsertTextMode) UnmarshalJSON(data []byte) error {
+func (b *BooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var uint32Value uint32
-	if err := json.Unmarshal(data, &uint32Value); err != nil {
-		return err
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
 	}
-	if !validInsertTextModeValues[uint32Value] {
-		return fmt.Errorf("cannot unmarshal %v into InsertTextMode: custom values are not supported", uint32Value)
+	var semanticTokensClientCapabilitiesRequestsRangeOr2Value *SemanticTokensClientCapabilitiesRequestsRangeOr2
+	if err := json.Unmarshal(data, &semanticTokensClientCapabilitiesRequestsRangeOr2Value); err == nil {
+		b.Value = semanticTokensClientCapabilitiesRequestsRangeOr2Value
+		return nil
 	}
-	*i = InsertTextMode(uint32Value)
-
-	return nil
-}
-
-func (i InsertTextMode) MarshalJSON() ([]byte, error) {
-	var uint32Value = uint32(i)
-	if !validInsertTextModeValues[uint32Value] {
-		return nil, fmt.Errorf("cannot marshal %v into InsertTextMode: custom values are not supported", uint32Value)
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2](),
 	}
-	return json.Marshal(uint32Value)
-
 }
 
-type CompletionClientCapabilitiesCompletionItemInsertTextModeSupport struct {
-	ValueSet []InsertTextMode `json:"valueSet"`
-}
-
-func (c *CompletionClientCapabilitiesCompletionItemInsertTextModeSupport) GetValueSet() []InsertTextMode {
-	if c == nil {
-		return *new([]InsertTextMode)
-	}
-	return c.ValueSet
+func (b *BooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-type CompletionClientCapabilitiesCompletionItem struct {
-	// Client supports snippets as insert text.
-	//
-	// A snippet can define tab stops and placeholders with `$1`, `$2`
-	// and `${3:foo}`. `$0` defines the final tab stop, it defaults to
-	// the end of the snippet. Placeholders with equal identifiers are linked,
-	// that is typing in one will update others too.
-	SnippetSupport bool `json:"snippetSupport,omitempty"`
-	// Client supports commit characters on a completion item.
-	CommitCharactersSupport bool `json:"commitCharactersSupport,omitempty"`
-	// Client supports the following content formats for the documentation
-	// property. The order describes the preferred format of the client.
-	DocumentationFormat []MarkupKind `json:"documentationFormat,omitempty"`
-	// Client supports the deprecated property on a completion item.
-	DeprecatedSupport bool `json:"deprecatedSupport,omitempty"`
-	// Client supports the preselect property on a completion item.
-	PreselectSupport bool `json:"preselectSupport,omitempty"`
-	// Client supports the tag property on a completion item. Clients supporting
-	// tags have to handle unknown tags gracefully. Clients especially need to
-	// preserve unknown tags when sending a completion item back to the server in
-	// a resolve call.
-	//
-	// @since 3.15.0
-	TagSupport *CompletionClientCapabilitiesCompletionItemTagSupport `json:"tagSupport,omitempty"`
-	// Client support insert replace edit to control different behavior if a
-	// completion item is inserted in the text or should replace text.
-	//
-	// @since 3.16.0
-	InsertReplaceSupport bool `json:"insertReplaceSupport,omitempty"`
-	// Indicates which properties a client can resolve lazily on a completion
-	// item. Before version 3.16.0 only the predefined properties `documentation`
-	// and `details` could be resolved lazily.
-	//
-	// @since 3.16.0
-	ResolveSupport *CompletionClientCapabilitiesCompletionItemResolveSupport `json:"resolveSupport,omitempty"`
-	// The client supports the `insertTextMode` property on
-	// a completion item to override the whitespace handling mode
-	// as defined by the client (see `insertTextMode`).
-	//
-	// @since 3.16.0
-	InsertTextModeSupport *CompletionClientCapabilitiesCompletionItemInsertTextModeSupport `json:"insertTextModeSupport,omitempty"`
-	// The client has support for completion item label
-	// details (see also `CompletionItemLabelDetails`).
-	//
-	// @since 3.17.0
-	LabelDetailsSupport bool `json:"labelDetailsSupport,omitempty"`
+// BooleanOrSemanticTokensOptionsFullOr2 contains either of the following types:
+//   - [Boolean]
+//   - [*SemanticTokensOptionsFullOr2]
+type BooleanOrSemanticTokensOptionsFullOr2 struct {
+	Value BooleanOrSemanticTokensOptionsFullOr2Value
 }
 
-// Client supports snippets as insert text.
+// BooleanOrSemanticTokensOptionsFullOr2Value is either of the following types:
+//   - [Boolean]
+//   - [*SemanticTokensOptionsFullOr2]
 //
-// A snippet can define tab stops and placeholders with `$1`, `$2`
-// and `${3:foo}`. `$0` defines the final tab stop, it defaults to
-// the end of the snippet. Placeholders with equal identifiers are linked,
-// that is typing in one will update others too.
-func (c *CompletionClientCapabilitiesCompletionItem) GetSnippetSupport() bool {
-	if c == nil {
-		return *new(bool)
-	}
-	return c.SnippetSupport
+//sumtype:decl
+type BooleanOrSemanticTokensOptionsFullOr2Value interface {
+	isBooleanOrSemanticTokensOptionsFullOr2Value()
 }
 
-// Client supports commit characters on a completion item.
-func (c *CompletionClientCapabilitiesCompletionItem) GetCommitCharactersSupport() bool {
-	if c == nil {
-		return *new(bool)
+func (Boolean) isBooleanOrSemanticTokensOptionsFullOr2Value()                       {}
+func (*SemanticTokensOptionsFullOr2) isBooleanOrSemanticTokensOptionsFullOr2Value() {}
+
+func (b *BooleanOrSemanticTokensOptionsFullOr2) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
+	}
+	var semanticTokensOptionsFullOr2Value *SemanticTokensOptionsFullOr2
+	if err := json.Unmarshal(data, &semanticTokensOptionsFullOr2Value); err == nil {
+		b.Value = semanticTokensOptionsFullOr2Value
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrSemanticTokensOptionsFullOr2](),
 	}
-	return c.CommitCharactersSupport
 }
 
-// Client supports the following content formats for the documentation
-// property. The order describes the preferred format of the client.
-func (c *CompletionClientCapabilitiesCompletionItem) GetDocumentationFormat() []MarkupKind {
-	if c == nil {
-		return *new([]MarkupKind)
-	}
-	return c.DocumentationFormat
+func (b *BooleanOrSemanticTokensOptionsFullOr2) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-// Client supports the deprecated property on a completion item.
-func (c *CompletionClientCapabilitiesCompletionItem) GetDeprecatedSupport() bool {
-	if c == nil {
-		return *new(bool)
-	}
-	return c.DeprecatedSupport
-}
-
-// Client supports the preselect property on a completion item.
-func (c *CompletionClientCapabilitiesCompletionItem) GetPreselectSupport() bool {
-	if c == nil {
-		return *new(bool)
-	}
-	return c.PreselectSupport
+// BooleanOrSemanticTokensOptionsRangeOr2 contains either of the following types:
+//   - [Boolean]
+//   - [*SemanticTokensOptionsRangeOr2]
+type BooleanOrSemanticTokensOptionsRangeOr2 struct {
+	Value BooleanOrSemanticTokensOptionsRangeOr2Value
 }
 
-// Client supports the tag property on a completion item. Clients supporting
-// tags have to handle unknown tags gracefully. Clients especially need to
-// preserve unknown tags when sending a completion item back to the server in
-// a resolve call.
+// BooleanOrSemanticTokensOptionsRangeOr2Value is either of the following types:
+//   - [Boolean]
+//   - [*SemanticTokensOptionsRangeOr2]
 //
-// @since 3.15.0
-func (c *CompletionClientCapabilitiesCompletionItem) GetTagSupport() *CompletionClientCapabilitiesCompletionItemTagSupport {
-	if c == nil {
-		return *new(*CompletionClientCapabilitiesCompletionItemTagSupport)
-	}
-	return c.TagSupport
+//sumtype:decl
+type BooleanOrSemanticTokensOptionsRangeOr2Value interface {
+	isBooleanOrSemanticTokensOptionsRangeOr2Value()
 }
 
-// Client support insert replace edit to control different behavior if a
-// completion item is inserted in the text or should replace text.
-//
-// @since 3.16.0
-func (c *CompletionClientCapabilitiesCompletionItem) GetInsertReplaceSupport() bool {
-	if c == nil {
-		return *new(bool)
-	}
-	return c.InsertReplaceSupport
-}
+func (Boolean) isBooleanOrSemanticTokensOptionsRangeOr2Value()                        {}
+func (*SemanticTokensOptionsRangeOr2) isBooleanOrSemanticTokensOptionsRangeOr2Value() {}
 
-// Indicates which properties a client can resolve lazily on a completion
-// item. Before version 3.16.0 only the predefined properties `documentation`
-// and `details` could be resolved lazily.
-//
-// @since 3.16.0
-func (c *CompletionClientCapabilitiesCompletionItem) GetResolveSupport() *CompletionClientCapabilitiesCompletionItemResolveSupport {
-	if c == nil {
-		return *new(*CompletionClientCapabilitiesCompletionItemResolveSupport)
+func (b *BooleanOrSemanticTokensOptionsRangeOr2) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
+	}
+	var semanticTokensOptionsRangeOr2Value *SemanticTokensOptionsRangeOr2
+	if err := json.Unmarshal(data, &semanticTokensOptionsRangeOr2Value); err == nil {
+		b.Value = semanticTokensOptionsRangeOr2Value
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrSemanticTokensOptionsRangeOr2](),
 	}
-	return c.ResolveSupport
 }
 
-// The client supports the `insertTextMode` property on
-// a completion item to override the whitespace handling mode
-// as defined by the client (see `insertTextMode`).
-//
-// @since 3.16.0
-func (c *CompletionClientCapabilitiesCompletionItem) GetInsertTextModeSupport() *CompletionClientCapabilitiesCompletionItemInsertTextModeSupport {
-	if c == nil {
-		return *new(*CompletionClientCapabilitiesCompletionItemInsertTextModeSupport)
-	}
-	return c.InsertTextModeSupport
+func (b *BooleanOrSemanticTokensOptionsRangeOr2) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-// The client has support for completion item label
-// details (see also `CompletionItemLabelDetails`).
-//
-// @since 3.17.0
-func (c *CompletionClientCapabilitiesCompletionItem) GetLabelDetailsSupport() bool {
-	if c == nil {
-		return *new(bool)
-	}
-	return c.LabelDetailsSupport
+// BooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptions contains either of the following types:
+//   - [Boolean]
+//   - [*TypeDefinitionOptions]
+//   - [*TypeDefinitionRegistrationOptions]
+type BooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptions struct {
+	Value BooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptionsValue
 }
 
-// The kind of a completion entry.
+// BooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*TypeDefinitionOptions]
+//   - [*TypeDefinitionRegistrationOptions]
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#completionItemKind
-type CompletionItemKind uint32
-
-const (
-	CompletionItemKindText          CompletionItemKind = 1
-	CompletionItemKindMethod        CompletionItemKind = 2
-	CompletionItemKindFunction      CompletionItemKind = 3
-	CompletionItemKindConstructor   CompletionItemKind = 4
-	CompletionItemKindField         CompletionItemKind = 5
-	CompletionItemKindVariable      CompletionItemKind = 6
-	CompletionItemKindClass         CompletionItemKind = 7
-	CompletionItemKindInterface     CompletionItemKind = 8
-	CompletionItemKindModule        CompletionItemKind = 9
-	CompletionItemKindProperty      CompletionItemKind = 10
-	CompletionItemKindUnit          CompletionItemKind = 11
-	CompletionItemKindValue         CompletionItemKind = 12
-	CompletionItemKindEnum          CompletionItemKind = 13
-	CompletionItemKindKeyword       CompletionItemKind = 14
-	CompletionItemKindSnippet       CompletionItemKind = 15
-	CompletionItemKindColor         CompletionItemKind = 16
-	CompletionItemKindFile          CompletionItemKind = 17
-	CompletionItemKindReference     CompletionItemKind = 18
-	CompletionItemKindFolder        CompletionItemKind = 19
-	CompletionItemKindEnumMember    CompletionItemKind = 20
-	CompletionItemKindConstant      CompletionItemKind = 21
-	CompletionItemKindStruct        CompletionItemKind = 22
-	CompletionItemKindEvent         CompletionItemKind = 23
-	CompletionItemKindOperator      CompletionItemKind = 24
-	CompletionItemKindTypeParameter CompletionItemKind = 25
-)
+//sumtype:decl
+type BooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptionsValue interface {
+	isBooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptionsValue()
+}
 
-var validCompletionItemKindValues = map[uint32]bool{
-	1:  true,
-	2:  true,
-	3:  true,
-	4:  true,
-	5:  true,
-	6:  true,
-	7:  true,
-	8:  true,
-	9:  true,
-	10: true,
-	11: true,
-	12: true,
-	13: true,
-	14: true,
-	15: true,
-	16: true,
-	17: true,
-	18: true,
-	19: true,
-	20: true,
-	21: true,
-	22: true,
-	23: true,
-	24: true,
-	25: true,
+func (Boolean) isBooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptionsValue() {}
+func (*TypeDefinitionOptions) isBooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptionsValue() {
+}
+func (*TypeDefinitionRegistrationOptions) isBooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptionsValue() {
 }
 
-func (c *CompletionItemKind) UnmarshalJSON(data []byte) error {
+func (b *BooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptions) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var uint32Value uint32
-	if err := json.Unmarshal(data, &uint32Value); err != nil {
-		return err
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
 	}
-	if !validCompletionItemKindValues[uint32Value] {
-		return fmt.Errorf("cannot unmarshal %v into CompletionItemKind: custom values are not supported", uint32Value)
+	var typeDefinitionOptionsValue *TypeDefinitionOptions
+	if err := json.Unmarshal(data, &typeDefinitionOptionsValue); err == nil {
+		b.Value = typeDefinitionOptionsValue
+		return nil
 	}
-	*c = CompletionItemKind(uint32Value)
-
-	return nil
-}
-
-func (c CompletionItemKind) MarshalJSON() ([]byte, error) {
-	var uint32Value = uint32(c)
-	if !validCompletionItemKindValues[uint32Value] {
-		return nil, fmt.Errorf("cannot marshal %v into CompletionItemKind: custom values are not supported", uint32Value)
+	var typeDefinitionRegistrationOptionsValue *TypeDefinitionRegistrationOptions
+	if err := json.Unmarshal(data, &typeDefinitionRegistrationOptionsValue); err == nil {
+		b.Value = typeDefinitionRegistrationOptionsValue
+		return nil
 	}
-	return json.Marshal(uint32Value)
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptions](),
+	}
+}
 
+func (b *BooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
 }
 
-type CompletionClientCapabilitiesCompletionItemKind struct {
-	// The completion item kind values the client supports. When this
-	// property exists the client also guarantees that it will
-	// handle values outside its set gracefully and falls back
-	// to a default value when unknown.
-	//
-	// If this property is not present the client only supports
-	// the completion items kinds from `Text` to `Reference` as defined in
-	// the initial version of the protocol.
-	ValueSet []CompletionItemKind `json:"valueSet,omitempty"`
+// BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptions contains either of the following types:
+//   - [Boolean]
+//   - [*TypeHierarchyOptions]
+//   - [*TypeHierarchyRegistrationOptions]
+type BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptions struct {
+	Value BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptionsValue
 }
 
-// The completion item kind values the client supports. When this
-// property exists the client also guarantees that it will
-// handle values outside its set gracefully and falls back
-// to a default value when unknown.
+// BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*TypeHierarchyOptions]
+//   - [*TypeHierarchyRegistrationOptions]
 //
-// If this property is not present the client only supports
-// the completion items kinds from `Text` to `Reference` as defined in
-// the initial version of the protocol.
-func (c *CompletionClientCapabilitiesCompletionItemKind) GetValueSet() []CompletionItemKind {
-	if c == nil {
-		return *new([]CompletionItemKind)
-	}
-	return c.ValueSet
+//sumtype:decl
+type BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptionsValue interface {
+	isBooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptionsValue()
 }
 
-type CompletionClientCapabilitiesCompletionList struct {
-	// The client supports the following itemDefaults on
-	// a completion list.
-	//
-	// The value lists the supported property names of the
-	// `CompletionList.itemDefaults` object. If omitted
-	// no properties are supported.
-	//
-	// @since 3.17.0
-	ItemDefaults []string `json:"itemDefaults,omitempty"`
+func (Boolean) isBooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptionsValue() {}
+func (*TypeHierarchyOptions) isBooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptionsValue() {
+}
+func (*TypeHierarchyRegistrationOptions) isBooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptionsValue() {
 }
 
-// The client supports the following itemDefaults on
-// a completion list.
-//
-// The value lists the supported property names of the
-// `CompletionList.itemDefaults` object. If omitted
-// no properties are supported.
+func (b *BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptions) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
+	}
+	var typeHierarchyOptionsValue *TypeHierarchyOptions
+	if err := json.Unmarshal(data, &typeHierarchyOptionsValue); err == nil {
+		b.Value = typeHierarchyOptionsValue
+		return nil
+	}
+	var typeHierarchyRegistrationOptionsValue *TypeHierarchyRegistrationOptions
+	if err := json.Unmarshal(data, &typeHierarchyRegistrationOptionsValue); err == nil {
+		b.Value = typeHierarchyRegistrationOptionsValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptions](),
+	}
+}
+
+func (b *BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
+}
+
+// BooleanOrWorkspaceSymbolOptions contains either of the following types:
+//   - [Boolean]
+//   - [*WorkspaceSymbolOptions]
+type BooleanOrWorkspaceSymbolOptions struct {
+	Value BooleanOrWorkspaceSymbolOptionsValue
+}
+
+// BooleanOrWorkspaceSymbolOptionsValue is either of the following types:
+//   - [Boolean]
+//   - [*WorkspaceSymbolOptions]
 //
-// @since 3.17.0
-func (c *CompletionClientCapabilitiesCompletionList) GetItemDefaults() []string {
-	if c == nil {
-		return *new([]string)
+//sumtype:decl
+type BooleanOrWorkspaceSymbolOptionsValue interface {
+	isBooleanOrWorkspaceSymbolOptionsValue()
+}
+
+func (Boolean) isBooleanOrWorkspaceSymbolOptionsValue()                 {}
+func (*WorkspaceSymbolOptions) isBooleanOrWorkspaceSymbolOptionsValue() {}
+
+func (b *BooleanOrWorkspaceSymbolOptions) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		b.Value = booleanValue
+		return nil
+	}
+	var workspaceSymbolOptionsValue *WorkspaceSymbolOptions
+	if err := json.Unmarshal(data, &workspaceSymbolOptionsValue); err == nil {
+		b.Value = workspaceSymbolOptionsValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*BooleanOrWorkspaceSymbolOptions](),
 	}
-	return c.ItemDefaults
 }
 
-// Completion client capabilities
+func (b *BooleanOrWorkspaceSymbolOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Value)
+}
+
+// @since 3.16.0
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#completionClientCapabilities
-type CompletionClientCapabilities struct {
-	// Whether completion supports dynamic registration.
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#callHierarchyClientCapabilities
+type CallHierarchyClientCapabilities struct {
+	// Whether implementation supports dynamic registration. If this is set to `true`
+	// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
+	// return value for the corresponding server capability as well.
 	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
-	// The client supports the following `CompletionItem` specific
-	// capabilities.
-	CompletionItem     *CompletionClientCapabilitiesCompletionItem     `json:"completionItem,omitempty"`
-	CompletionItemKind *CompletionClientCapabilitiesCompletionItemKind `json:"completionItemKind,omitempty"`
-	// Defines how the client handles whitespace and indentation
-	// when accepting a completion item that uses multi line
-	// text in either `insertText` or `textEdit`.
-	//
-	// @since 3.17.0
-	InsertTextMode InsertTextMode `json:"insertTextMode,omitempty"`
-	// The client supports to send additional context information for a
-	// `textDocument/completion` request.
-	ContextSupport bool `json:"contextSupport,omitempty"`
-	// The client supports the following `CompletionList` specific
-	// capabilities.
-	//
-	// @since 3.17.0
-	CompletionList *CompletionClientCapabilitiesCompletionList `json:"completionList,omitempty"`
 }
 
-// Whether completion supports dynamic registration.
-func (c *CompletionClientCapabilities) GetDynamicRegistration() bool {
+// Whether implementation supports dynamic registration. If this is set to `true`
+// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
+// return value for the corresponding server capability as well.
+func (c *CallHierarchyClientCapabilities) GetDynamicRegistration() bool {
 	if c == nil {
 		var zero bool
 		return zero
@@ -1674,483 +1433,555 @@ func (c *CompletionClientCapabilities) GetDynamicRegistration() bool {
 	return c.DynamicRegistration
 }
 
-// The client supports the following `CompletionItem` specific
-// capabilities.
-func (c *CompletionClientCapabilities) GetCompletionItem() *CompletionClientCapabilitiesCompletionItem {
-	if c == nil {
-		var zero *CompletionClientCapabilitiesCompletionItem
-		return zero
-	}
-	return c.CompletionItem
+// Call hierarchy options used during static registration.
+//
+// @since 3.16.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#callHierarchyOptions
+type CallHierarchyOptions struct {
+	*WorkDoneProgressOptions
 }
 
-func (c *CompletionClientCapabilities) GetCompletionItemKind() *CompletionClientCapabilitiesCompletionItemKind {
-	if c == nil {
-		var zero *CompletionClientCapabilitiesCompletionItemKind
-		return zero
-	}
-	return c.CompletionItemKind
+// Call hierarchy options used during static or dynamic registration.
+//
+// @since 3.16.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#callHierarchyRegistrationOptions
+type CallHierarchyRegistrationOptions struct {
+	*TextDocumentRegistrationOptions
+	*CallHierarchyOptions
+	*StaticRegistrationOptions
 }
 
-// Defines how the client handles whitespace and indentation
-// when accepting a completion item that uses multi line
-// text in either `insertText` or `textEdit`.
+// Additional information that describes document changes.
 //
-// @since 3.17.0
-func (c *CompletionClientCapabilities) GetInsertTextMode() InsertTextMode {
+// @since 3.16.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#changeAnnotation
+type ChangeAnnotation struct {
+	// A human-readable string describing the actual change. The string
+	// is rendered prominent in the user interface.
+	Label string `json:"label"`
+	// A flag which indicates that user confirmation is needed
+	// before applying the change.
+	NeedsConfirmation bool `json:"needsConfirmation,omitempty"`
+	// A human-readable string which is rendered less prominent in
+	// the user interface.
+	Description string `json:"description,omitempty"`
+}
+
+// A human-readable string describing the actual change. The string
+// is rendered prominent in the user interface.
+func (c *ChangeAnnotation) GetLabel() string {
 	if c == nil {
-		var zero InsertTextMode
+		var zero string
 		return zero
 	}
-	return c.InsertTextMode
+	return c.Label
 }
 
-// The client supports to send additional context information for a
-// `textDocument/completion` request.
-func (c *CompletionClientCapabilities) GetContextSupport() bool {
+// A flag which indicates that user confirmation is needed
+// before applying the change.
+func (c *ChangeAnnotation) GetNeedsConfirmation() bool {
 	if c == nil {
 		var zero bool
 		return zero
 	}
-	return c.ContextSupport
+	return c.NeedsConfirmation
 }
 
-// The client supports the following `CompletionList` specific
-// capabilities.
-//
-// @since 3.17.0
-func (c *CompletionClientCapabilities) GetCompletionList() *CompletionClientCapabilitiesCompletionList {
+// A human-readable string which is rendered less prominent in
+// the user interface.
+func (c *ChangeAnnotation) GetDescription() string {
 	if c == nil {
-		var zero *CompletionClientCapabilitiesCompletionList
+		var zero string
 		return zero
 	}
-	return c.CompletionList
+	return c.Description
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#hoverClientCapabilities
-type HoverClientCapabilities struct {
-	// Whether hover supports dynamic registration.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
-	// Client supports the following content formats for the content
-	// property. The order describes the preferred format of the client.
-	ContentFormat []MarkupKind `json:"contentFormat,omitempty"`
+// An identifier to refer to a change annotation stored with a workspace edit.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#changeAnnotationIdentifier
+type ChangeAnnotationIdentifier = string
+
+// Defines the capabilities provided by the client.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#clientCapabilities
+type ClientCapabilities struct {
+	// Workspace specific client capabilities.
+	Workspace *WorkspaceClientCapabilities `json:"workspace,omitempty"`
+	// Text document specific client capabilities.
+	TextDocument *TextDocumentClientCapabilities `json:"textDocument,omitempty"`
+	// Capabilities specific to the notebook document support.
+	//
+	// @since 3.17.0
+	NotebookDocument *NotebookDocumentClientCapabilities `json:"notebookDocument,omitempty"`
+	// Window specific client capabilities.
+	Window *WindowClientCapabilities `json:"window,omitempty"`
+	// General client capabilities.
+	//
+	// @since 3.16.0
+	General *GeneralClientCapabilities `json:"general,omitempty"`
+	// Experimental client capabilities.
+	Experimental LSPAny `json:"experimental,omitempty"`
 }
 
-// Whether hover supports dynamic registration.
-func (h *HoverClientCapabilities) GetDynamicRegistration() bool {
-	if h == nil {
-		var zero bool
+// Workspace specific client capabilities.
+func (c *ClientCapabilities) GetWorkspace() *WorkspaceClientCapabilities {
+	if c == nil {
+		var zero *WorkspaceClientCapabilities
 		return zero
 	}
-	return h.DynamicRegistration
+	return c.Workspace
 }
 
-// Client supports the following content formats for the content
-// property. The order describes the preferred format of the client.
-func (h *HoverClientCapabilities) GetContentFormat() []MarkupKind {
-	if h == nil {
-		var zero []MarkupKind
+// Text document specific client capabilities.
+func (c *ClientCapabilities) GetTextDocument() *TextDocumentClientCapabilities {
+	if c == nil {
+		var zero *TextDocumentClientCapabilities
 		return zero
 	}
-	return h.ContentFormat
-}
-
-type SignatureHelpClientCapabilitiesSignatureInformationParameterInformation struct {
-	// The client supports processing label offsets instead of a
-	// simple label string.
-	//
-	// @since 3.14.0
-	LabelOffsetSupport bool `json:"labelOffsetSupport,omitempty"`
+	return c.TextDocument
 }
 
-// The client supports processing label offsets instead of a
-// simple label string.
+// Capabilities specific to the notebook document support.
 //
-// @since 3.14.0
-func (s *SignatureHelpClientCapabilitiesSignatureInformationParameterInformation) GetLabelOffsetSupport() bool {
-	if s == nil {
-		return *new(bool)
+// @since 3.17.0
+func (c *ClientCapabilities) GetNotebookDocument() *NotebookDocumentClientCapabilities {
+	if c == nil {
+		var zero *NotebookDocumentClientCapabilities
+		return zero
 	}
-	return s.LabelOffsetSupport
-}
-
-type SignatureHelpClientCapabilitiesSignatureInformation struct {
-	// Client supports the following content formats for the documentation
-	// property. The order describes the preferred format of the client.
-	DocumentationFormat []MarkupKind `json:"documentationFormat,omitempty"`
-	// Client capabilities specific to parameter information.
-	ParameterInformation *SignatureHelpClientCapabilitiesSignatureInformationParameterInformation `json:"parameterInformation,omitempty"`
-	// The client supports the `activeParameter` property on `SignatureInformation`
-	// literal.
-	//
-	// @since 3.16.0
-	ActiveParameterSupport bool `json:"activeParameterSupport,omitempty"`
+	return c.NotebookDocument
 }
 
-// Client supports the following content formats for the documentation
-// property. The order describes the preferred format of the client.
-func (s *SignatureHelpClientCapabilitiesSignatureInformation) GetDocumentationFormat() []MarkupKind {
-	if s == nil {
-		return *new([]MarkupKind)
+// Window specific client capabilities.
+func (c *ClientCapabilities) GetWindow() *WindowClientCapabilities {
+	if c == nil {
+		var zero *WindowClientCapabilities
+		return zero
 	}
-	return s.DocumentationFormat
+	return c.Window
 }
 
-// Client capabilities specific to parameter information.
-func (s *SignatureHelpClientCapabilitiesSignatureInformation) GetParameterInformation() *SignatureHelpClientCapabilitiesSignatureInformationParameterInformation {
-	if s == nil {
-		return *new(*SignatureHelpClientCapabilitiesSignatureInformationParameterInformation)
+// General client capabilities.
+//
+// @since 3.16.0
+func (c *ClientCapabilities) GetGeneral() *GeneralClientCapabilities {
+	if c == nil {
+		var zero *GeneralClientCapabilities
+		return zero
 	}
-	return s.ParameterInformation
+	return c.General
 }
 
-// The client supports the `activeParameter` property on `SignatureInformation`
-// literal.
-//
-// @since 3.16.0
-func (s *SignatureHelpClientCapabilitiesSignatureInformation) GetActiveParameterSupport() bool {
-	if s == nil {
-		return *new(bool)
+// Experimental client capabilities.
+func (c *ClientCapabilities) GetExperimental() LSPAny {
+	if c == nil {
+		var zero LSPAny
+		return zero
 	}
-	return s.ActiveParameterSupport
+	return c.Experimental
 }
 
-// Client Capabilities for a {@link SignatureHelpRequest}.
+// A code action represents a change that can be performed in code, e.g. to fix a problem or
+// to refactor code.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#signatureHelpClientCapabilities
-type SignatureHelpClientCapabilities struct {
-	// Whether signature help supports dynamic registration.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
-	// The client supports the following `SignatureInformation`
-	// specific properties.
-	SignatureInformation *SignatureHelpClientCapabilitiesSignatureInformation `json:"signatureInformation,omitempty"`
-	// The client supports to send additional context information for a
-	// `textDocument/signatureHelp` request. A client that opts into
-	// contextSupport will also support the `retriggerCharacters` on
-	// `SignatureHelpOptions`.
+// A CodeAction must set either `edit` and/or a `command`. If both are supplied, the `edit` is applied first, then the `command` is executed.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#codeAction
+type CodeAction struct {
+	// A short, human-readable, title for this code action.
+	Title string `json:"title"`
+	// The kind of the code action.
+	//
+	// Used to filter code actions.
+	Kind CodeActionKind `json:"kind,omitempty"`
+	// The diagnostics that this code action resolves.
+	Diagnostics []*Diagnostic `json:"diagnostics,omitempty"`
+	// Marks this as a preferred action. Preferred actions are used by the `auto fix` command and can be targeted
+	// by keybindings.
+	//
+	// A quick fix should be marked preferred if it properly addresses the underlying error.
+	// A refactoring should be marked preferred if it is the most reasonable choice of actions to take.
 	//
 	// @since 3.15.0
-	ContextSupport bool `json:"contextSupport,omitempty"`
+	IsPreferred bool `json:"isPreferred,omitempty"`
+	// Marks that the code action cannot currently be applied.
+	//
+	// Clients should follow the following guidelines regarding disabled code actions:
+	//
+	//   - Disabled code actions are not shown in automatic [lightbulbs](https://code.visualstudio.com/docs/editor/editingevolved#_code-action)
+	//     code action menus.
+	//
+	//   - Disabled actions are shown as faded out in the code action menu when the user requests a more specific type
+	//     of code action, such as refactorings.
+	//
+	//   - If the user has a [keybinding](https://code.visualstudio.com/docs/editor/refactoring#_keybindings-for-code-actions)
+	//     that auto applies a code action and only disabled code actions are returned, the client should show the user an
+	//     error message with `reason` in the editor.
+	//
+	// @since 3.16.0
+	Disabled *CodeActionDisabled `json:"disabled,omitempty"`
+	// The workspace edit this code action performs.
+	Edit *WorkspaceEdit `json:"edit,omitempty"`
+	// A command this code action executes. If a code action
+	// provides an edit and a command, first the edit is
+	// executed and then the command.
+	Command *Command `json:"command,omitempty"`
+	// A data entry field that is preserved on a code action between
+	// a `textDocument/codeAction` and a `codeAction/resolve` request.
+	//
+	// @since 3.16.0
+	Data LSPAny `json:"data,omitempty"`
 }
 
-// Whether signature help supports dynamic registration.
-func (s *SignatureHelpClientCapabilities) GetDynamicRegistration() bool {
-	if s == nil {
-		var zero bool
+// A short, human-readable, title for this code action.
+func (c *CodeAction) GetTitle() string {
+	if c == nil {
+		var zero string
 		return zero
 	}
-	return s.DynamicRegistration
+	return c.Title
 }
 
-// The client supports the following `SignatureInformation`
-// specific properties.
-func (s *SignatureHelpClientCapabilities) GetSignatureInformation() *SignatureHelpClientCapabilitiesSignatureInformation {
-	if s == nil {
-		var zero *SignatureHelpClientCapabilitiesSignatureInformation
+// The kind of the code action.
+//
+// Used to filter code actions.
+func (c *CodeAction) GetKind() CodeActionKind {
+	if c == nil {
+		var zero CodeActionKind
 		return zero
 	}
-	return s.SignatureInformation
+	return c.Kind
 }
 
-// The client supports to send additional context information for a
-// `textDocument/signatureHelp` request. A client that opts into
-// contextSupport will also support the `retriggerCharacters` on
-// `SignatureHelpOptions`.
-//
-// @since 3.15.0
-func (s *SignatureHelpClientCapabilities) GetContextSupport() bool {
-	if s == nil {
-		var zero bool
+// The diagnostics that this code action resolves.
+func (c *CodeAction) GetDiagnostics() []*Diagnostic {
+	if c == nil {
+		var zero []*Diagnostic
 		return zero
 	}
-	return s.ContextSupport
+	return c.Diagnostics
 }
 
-// @since 3.14.0
+// Marks this as a preferred action. Preferred actions are used by the `auto fix` command and can be targeted
+// by keybindings.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#declarationClientCapabilities
-type DeclarationClientCapabilities struct {
-	// Whether declaration supports dynamic registration. If this is set to `true`
-	// the client supports the new `DeclarationRegistrationOptions` return value
-	// for the corresponding server capability as well.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
-	// The client supports additional metadata in the form of declaration links.
-	LinkSupport bool `json:"linkSupport,omitempty"`
-}
-
-// Whether declaration supports dynamic registration. If this is set to `true`
-// the client supports the new `DeclarationRegistrationOptions` return value
-// for the corresponding server capability as well.
-func (d *DeclarationClientCapabilities) GetDynamicRegistration() bool {
-	if d == nil {
+// A quick fix should be marked preferred if it properly addresses the underlying error.
+// A refactoring should be marked preferred if it is the most reasonable choice of actions to take.
+//
+// @since 3.15.0
+func (c *CodeAction) GetIsPreferred() bool {
+	if c == nil {
 		var zero bool
 		return zero
 	}
-	return d.DynamicRegistration
+	return c.IsPreferred
 }
 
-// The client supports additional metadata in the form of declaration links.
-func (d *DeclarationClientCapabilities) GetLinkSupport() bool {
-	if d == nil {
-		var zero bool
+// Marks that the code action cannot currently be applied.
+//
+// Clients should follow the following guidelines regarding disabled code actions:
+//
+//   - Disabled code actions are not shown in automatic [lightbulbs](https://code.visualstudio.com/docs/editor/editingevolved#_code-action)
+//     code action menus.
+//
+//   - Disabled actions are shown as faded out in the code action menu when the user requests a more specific type
+//     of code action, such as refactorings.
+//
+//   - If the user has a [keybinding](https://code.visualstudio.com/docs/editor/refactoring#_keybindings-for-code-actions)
+//     that auto applies a code action and only disabled code actions are returned, the client should show the user an
+//     error message with `reason` in the editor.
+//
+// @since 3.16.0
+func (c *CodeAction) GetDisabled() *CodeActionDisabled {
+	if c == nil {
+		var zero *CodeActionDisabled
 		return zero
 	}
-	return d.LinkSupport
+	return c.Disabled
 }
 
-// Client Capabilities for a {@link DefinitionRequest}.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#definitionClientCapabilities
-type DefinitionClientCapabilities struct {
-	// Whether definition supports dynamic registration.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
-	// The client supports additional metadata in the form of definition links.
-	//
-	// @since 3.14.0
-	LinkSupport bool `json:"linkSupport,omitempty"`
+// The workspace edit this code action performs.
+func (c *CodeAction) GetEdit() *WorkspaceEdit {
+	if c == nil {
+		var zero *WorkspaceEdit
+		return zero
+	}
+	return c.Edit
 }
 
-// Whether definition supports dynamic registration.
-func (d *DefinitionClientCapabilities) GetDynamicRegistration() bool {
-	if d == nil {
-		var zero bool
+// A command this code action executes. If a code action
+// provides an edit and a command, first the edit is
+// executed and then the command.
+func (c *CodeAction) GetCommand() *Command {
+	if c == nil {
+		var zero *Command
 		return zero
 	}
-	return d.DynamicRegistration
+	return c.Command
 }
 
-// The client supports additional metadata in the form of definition links.
+// A data entry field that is preserved on a code action between
+// a `textDocument/codeAction` and a `codeAction/resolve` request.
 //
-// @since 3.14.0
-func (d *DefinitionClientCapabilities) GetLinkSupport() bool {
-	if d == nil {
-		var zero bool
+// @since 3.16.0
+func (c *CodeAction) GetData() LSPAny {
+	if c == nil {
+		var zero LSPAny
 		return zero
 	}
-	return d.LinkSupport
+	return c.Data
 }
 
-// Since 3.6.0
+// The Client Capabilities of a {@link CodeActionRequest}.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#typeDefinitionClientCapabilities
-type TypeDefinitionClientCapabilities struct {
-	// Whether implementation supports dynamic registration. If this is set to `true`
-	// the client supports the new `TypeDefinitionRegistrationOptions` return value
-	// for the corresponding server capability as well.
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#codeActionClientCapabilities
+type CodeActionClientCapabilities struct {
+	// Whether code action supports dynamic registration.
 	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
-	// The client supports additional metadata in the form of definition links.
+	// The client support code action literals of type `CodeAction` as a valid
+	// response of the `textDocument/codeAction` request. If the property is not
+	// set the request can only return `Command` literals.
 	//
-	// Since 3.14.0
-	LinkSupport bool `json:"linkSupport,omitempty"`
+	// @since 3.8.0
+	CodeActionLiteralSupport *CodeActionClientCapabilitiesCodeActionLiteralSupport `json:"codeActionLiteralSupport,omitempty"`
+	// Whether code action supports the `isPreferred` property.
+	//
+	// @since 3.15.0
+	IsPreferredSupport bool `json:"isPreferredSupport,omitempty"`
+	// Whether code action supports the `disabled` property.
+	//
+	// @since 3.16.0
+	DisabledSupport bool `json:"disabledSupport,omitempty"`
+	// Whether code action supports the `data` property which is
+	// preserved between a `textDocument/codeAction` and a
+	// `codeAction/resolve` request.
+	//
+	// @since 3.16.0
+	DataSupport bool `json:"dataSupport,omitempty"`
+	// Whether the client supports resolving additional code action
+	// properties via a separate `codeAction/resolve` request.
+	//
+	// @since 3.16.0
+	ResolveSupport *CodeActionClientCapabilitiesResolveSupport `json:"resolveSupport,omitempty"`
+	// Whether the client honors the change annotations in
+	// text edits and resource operations returned via the
+	// `CodeAction#edit` property by for example presenting
+	// the workspace edit in the user interface and asking
+	// for confirmation.
+	//
+	// @since 3.16.0
+	HonorsChangeAnnotations bool `json:"honorsChangeAnnotations,omitempty"`
 }
 
-// Whether implementation supports dynamic registration. If this is set to `true`
-// the client supports the new `TypeDefinitionRegistrationOptions` return value
-// for the corresponding server capability as well.
-func (t *TypeDefinitionClientCapabilities) GetDynamicRegistration() bool {
-	if t == nil {
+// Whether code action supports dynamic registration.
+func (c *CodeActionClientCapabilities) GetDynamicRegistration() bool {
+	if c == nil {
 		var zero bool
 		return zero
 	}
-	return t.DynamicRegistration
+	return c.DynamicRegistration
 }
 
-// The client supports additional metadata in the form of definition links.
+// The client support code action literals of type `CodeAction` as a valid
+// response of the `textDocument/codeAction` request. If the property is not
+// set the request can only return `Command` literals.
 //
-// Since 3.14.0
-func (t *TypeDefinitionClientCapabilities) GetLinkSupport() bool {
-	if t == nil {
-		var zero bool
+// @since 3.8.0
+func (c *CodeActionClientCapabilities) GetCodeActionLiteralSupport() *CodeActionClientCapabilitiesCodeActionLiteralSupport {
+	if c == nil {
+		var zero *CodeActionClientCapabilitiesCodeActionLiteralSupport
 		return zero
 	}
-	return t.LinkSupport
+	return c.CodeActionLiteralSupport
 }
 
-// @since 3.6.0
+// Whether code action supports the `isPreferred` property.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#implementationClientCapabilities
-type ImplementationClientCapabilities struct {
-	// Whether implementation supports dynamic registration. If this is set to `true`
-	// the client supports the new `ImplementationRegistrationOptions` return value
-	// for the corresponding server capability as well.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
-	// The client supports additional metadata in the form of definition links.
-	//
-	// @since 3.14.0
-	LinkSupport bool `json:"linkSupport,omitempty"`
+// @since 3.15.0
+func (c *CodeActionClientCapabilities) GetIsPreferredSupport() bool {
+	if c == nil {
+		var zero bool
+		return zero
+	}
+	return c.IsPreferredSupport
 }
 
-// Whether implementation supports dynamic registration. If this is set to `true`
-// the client supports the new `ImplementationRegistrationOptions` return value
-// for the corresponding server capability as well.
-func (i *ImplementationClientCapabilities) GetDynamicRegistration() bool {
-	if i == nil {
+// Whether code action supports the `disabled` property.
+//
+// @since 3.16.0
+func (c *CodeActionClientCapabilities) GetDisabledSupport() bool {
+	if c == nil {
 		var zero bool
 		return zero
 	}
-	return i.DynamicRegistration
+	return c.DisabledSupport
 }
 
-// The client supports additional metadata in the form of definition links.
+// Whether code action supports the `data` property which is
+// preserved between a `textDocument/codeAction` and a
+// `codeAction/resolve` request.
 //
-// @since 3.14.0
-func (i *ImplementationClientCapabilities) GetLinkSupport() bool {
-	if i == nil {
+// @since 3.16.0
+func (c *CodeActionClientCapabilities) GetDataSupport() bool {
+	if c == nil {
 		var zero bool
 		return zero
 	}
-	return i.LinkSupport
+	return c.DataSupport
 }
 
-// Client Capabilities for a {@link ReferencesRequest}.
+// Whether the client supports resolving additional code action
+// properties via a separate `codeAction/resolve` request.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#referenceClientCapabilities
-type ReferenceClientCapabilities struct {
-	// Whether references supports dynamic registration.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+// @since 3.16.0
+func (c *CodeActionClientCapabilities) GetResolveSupport() *CodeActionClientCapabilitiesResolveSupport {
+	if c == nil {
+		var zero *CodeActionClientCapabilitiesResolveSupport
+		return zero
+	}
+	return c.ResolveSupport
 }
 
-// Whether references supports dynamic registration.
-func (r *ReferenceClientCapabilities) GetDynamicRegistration() bool {
-	if r == nil {
+// Whether the client honors the change annotations in
+// text edits and resource operations returned via the
+// `CodeAction#edit` property by for example presenting
+// the workspace edit in the user interface and asking
+// for confirmation.
+//
+// @since 3.16.0
+func (c *CodeActionClientCapabilities) GetHonorsChangeAnnotations() bool {
+	if c == nil {
 		var zero bool
 		return zero
 	}
-	return r.DynamicRegistration
+	return c.HonorsChangeAnnotations
 }
 
-// Client Capabilities for a {@link DocumentHighlightRequest}.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentHighlightClientCapabilities
-type DocumentHighlightClientCapabilities struct {
-	// Whether document highlight supports dynamic registration.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+type CodeActionClientCapabilitiesCodeActionLiteralSupport struct {
+	// The code action kind is support with the following value
+	// set.
+	CodeActionKind *CodeActionClientCapabilitiesCodeActionLiteralSupportCodeActionKind `json:"codeActionKind"`
 }
 
-// Whether document highlight supports dynamic registration.
-func (d *DocumentHighlightClientCapabilities) GetDynamicRegistration() bool {
-	if d == nil {
-		var zero bool
-		return zero
+// The code action kind is support with the following value
+// set.
+func (c *CodeActionClientCapabilitiesCodeActionLiteralSupport) GetCodeActionKind() *CodeActionClientCapabilitiesCodeActionLiteralSupportCodeActionKind {
+	if c == nil {
+		return *new(*CodeActionClientCapabilitiesCodeActionLiteralSupportCodeActionKind)
 	}
-	return d.DynamicRegistration
+	return c.CodeActionKind
 }
 
-type DocumentSymbolClientCapabilitiesSymbolKind struct {
-	// The symbol kind values the client supports. When this
+type CodeActionClientCapabilitiesCodeActionLiteralSupportCodeActionKind struct {
+	// The code action kind values the client supports. When this
 	// property exists the client also guarantees that it will
 	// handle values outside its set gracefully and falls back
 	// to a default value when unknown.
-	//
-	// If this property is not present the client only supports
-	// the symbol kinds from `File` to `Array` as defined in
-	// the initial version of the protocol.
-	ValueSet []SymbolKind `json:"valueSet,omitempty"`
+	ValueSet []CodeActionKind `json:"valueSet"`
 }
 
-// The symbol kind values the client supports. When this
+// The code action kind values the client supports. When this
 // property exists the client also guarantees that it will
 // handle values outside its set gracefully and falls back
 // to a default value when unknown.
-//
-// If this property is not present the client only supports
-// the symbol kinds from `File` to `Array` as defined in
-// the initial version of the protocol.
-func (d *DocumentSymbolClientCapabilitiesSymbolKind) GetValueSet() []SymbolKind {
-	if d == nil {
-		return *new([]SymbolKind)
+func (c *CodeActionClientCapabilitiesCodeActionLiteralSupportCodeActionKind) GetValueSet() []CodeActionKind {
+	if c == nil {
+		return *new([]CodeActionKind)
 	}
-	return d.ValueSet
+	return c.ValueSet
 }
 
-type DocumentSymbolClientCapabilitiesTagSupport struct {
-	// The tags supported by the client.
-	ValueSet []SymbolTag `json:"valueSet"`
+type CodeActionClientCapabilitiesResolveSupport struct {
+	// The properties that a client can resolve lazily.
+	Properties []string `json:"properties"`
 }
 
-// The tags supported by the client.
-func (d *DocumentSymbolClientCapabilitiesTagSupport) GetValueSet() []SymbolTag {
-	if d == nil {
-		return *new([]SymbolTag)
+// The properties that a client can resolve lazily.
+func (c *CodeActionClientCapabilitiesResolveSupport) GetProperties() []string {
+	if c == nil {
+		return *new([]string)
 	}
-	return d.ValueSet
+	return c.Properties
 }
 
-// Client Capabilities for a {@link DocumentSymbolRequest}.
+// Contains additional diagnostic information about the context in which
+// a {@link CodeActionProvider.provideCodeActions code action} is run.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentSymbolClientCapabilities
-type DocumentSymbolClientCapabilities struct {
-	// Whether document symbol supports dynamic registration.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
-	// Specific capabilities for the `SymbolKind` in the
-	// `textDocument/documentSymbol` request.
-	SymbolKind *DocumentSymbolClientCapabilitiesSymbolKind `json:"symbolKind,omitempty"`
-	// The client supports hierarchical document symbols.
-	HierarchicalDocumentSymbolSupport bool `json:"hierarchicalDocumentSymbolSupport,omitempty"`
-	// The client supports tags on `SymbolInformation`. Tags are supported on
-	// `DocumentSymbol` if `hierarchicalDocumentSymbolSupport` is set to true.
-	// Clients supporting tags have to handle unknown tags gracefully.
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#codeActionContext
+type CodeActionContext struct {
+	// An array of diagnostics known on the client side overlapping the range provided to the
+	// `textDocument/codeAction` request. They are provided so that the server knows which
+	// errors are currently presented to the user for the given range. There is no guarantee
+	// that these accurately reflect the error state of the resource. The primary parameter
+	// to compute code actions is the provided range.
+	Diagnostics []*Diagnostic `json:"diagnostics"`
+	// Requested kind of actions to return.
 	//
-	// @since 3.16.0
-	TagSupport *DocumentSymbolClientCapabilitiesTagSupport `json:"tagSupport,omitempty"`
-	// The client supports an additional label presented in the UI when
-	// registering a document symbol provider.
+	// Actions not of this kind are filtered out by the client before being shown. So servers
+	// can omit computing them.
+	Only []CodeActionKind `json:"only,omitempty"`
+	// The reason why code actions were requested.
 	//
-	// @since 3.16.0
-	LabelSupport bool `json:"labelSupport,omitempty"`
+	// @since 3.17.0
+	TriggerKind CodeActionTriggerKind `json:"triggerKind,omitempty"`
 }
 
-// Whether document symbol supports dynamic registration.
-func (d *DocumentSymbolClientCapabilities) GetDynamicRegistration() bool {
-	if d == nil {
-		var zero bool
+// An array of diagnostics known on the client side overlapping the range provided to the
+// `textDocument/codeAction` request. They are provided so that the server knows which
+// errors are currently presented to the user for the given range. There is no guarantee
+// that these accurately reflect the error state of the resource. The primary parameter
+// to compute code actions is the provided range.
+func (c *CodeActionContext) GetDiagnostics() []*Diagnostic {
+	if c == nil {
+		var zero []*Diagnostic
 		return zero
 	}
-	return d.DynamicRegistration
+	return c.Diagnostics
 }
 
-// Specific capabilities for the `SymbolKind` in the
-// `textDocument/documentSymbol` request.
-func (d *DocumentSymbolClientCapabilities) GetSymbolKind() *DocumentSymbolClientCapabilitiesSymbolKind {
-	if d == nil {
-		var zero *DocumentSymbolClientCapabilitiesSymbolKind
+// Requested kind of actions to return.
+//
+// Actions not of this kind are filtered out by the client before being shown. So servers
+// can omit computing them.
+func (c *CodeActionContext) GetOnly() []CodeActionKind {
+	if c == nil {
+		var zero []CodeActionKind
 		return zero
 	}
-	return d.SymbolKind
+	return c.Only
 }
 
-// The client supports hierarchical document symbols.
-func (d *DocumentSymbolClientCapabilities) GetHierarchicalDocumentSymbolSupport() bool {
-	if d == nil {
-		var zero bool
+// The reason why code actions were requested.
+//
+// @since 3.17.0
+func (c *CodeActionContext) GetTriggerKind() CodeActionTriggerKind {
+	if c == nil {
+		var zero CodeActionTriggerKind
 		return zero
 	}
-	return d.HierarchicalDocumentSymbolSupport
+	return c.TriggerKind
 }
 
-// The client supports tags on `SymbolInformation`. Tags are supported on
-// `DocumentSymbol` if `hierarchicalDocumentSymbolSupport` is set to true.
-// Clients supporting tags have to handle unknown tags gracefully.
-//
-// @since 3.16.0
-func (d *DocumentSymbolClientCapabilities) GetTagSupport() *DocumentSymbolClientCapabilitiesTagSupport {
-	if d == nil {
-		var zero *DocumentSymbolClientCapabilitiesTagSupport
-		return zero
-	}
-	return d.TagSupport
+type CodeActionDisabled struct {
+	// Human readable description of why the code action is currently disabled.
+	//
+	// This is displayed in the code actions UI.
+	Reason string `json:"reason"`
 }
 
-// The client supports an additional label presented in the UI when
-// registering a document symbol provider.
+// Human readable description of why the code action is currently disabled.
 //
-// @since 3.16.0
-func (d *DocumentSymbolClientCapabilities) GetLabelSupport() bool {
-	if d == nil {
-		var zero bool
+// This is displayed in the code actions UI.
+func (c *CodeActionDisabled) GetReason() string {
+	if c == nil {
+		var zero string
 		return zero
 	}
-	return d.LabelSupport
+	return c.Reason
 }
 
 // A set of predefined code action kinds
@@ -2210,176 +2041,196 @@ const (
 	CodeActionKindSourceFixAll CodeActionKind = "source.fixAll"
 )
 
-type CodeActionClientCapabilitiesCodeActionLiteralSupportCodeActionKind struct {
-	// The code action kind values the client supports. When this
-	// property exists the client also guarantees that it will
-	// handle values outside its set gracefully and falls back
-	// to a default value when unknown.
-	ValueSet []CodeActionKind `json:"valueSet"`
+// Provider options for a {@link CodeActionRequest}.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#codeActionOptions
+type CodeActionOptions struct {
+	*WorkDoneProgressOptions
+	// CodeActionKinds that this server may return.
+	//
+	// The list of kinds may be generic, such as `CodeActionKind.Refactor`, or the server
+	// may list out every specific kind they provide.
+	CodeActionKinds []CodeActionKind `json:"codeActionKinds,omitempty"`
+	// The server provides support to resolve additional
+	// information for a code action.
+	//
+	// @since 3.16.0
+	ResolveProvider bool `json:"resolveProvider,omitempty"`
 }
 
-// The code action kind values the client supports. When this
-// property exists the client also guarantees that it will
-// handle values outside its set gracefully and falls back
-// to a default value when unknown.
-func (c *CodeActionClientCapabilitiesCodeActionLiteralSupportCodeActionKind) GetValueSet() []CodeActionKind {
+// CodeActionKinds that this server may return.
+//
+// The list of kinds may be generic, such as `CodeActionKind.Refactor`, or the server
+// may list out every specific kind they provide.
+func (c *CodeActionOptions) GetCodeActionKinds() []CodeActionKind {
 	if c == nil {
-		return *new([]CodeActionKind)
+		var zero []CodeActionKind
+		return zero
 	}
-	return c.ValueSet
-}
-
-type CodeActionClientCapabilitiesCodeActionLiteralSupport struct {
-	// The code action kind is support with the following value
-	// set.
-	CodeActionKind *CodeActionClientCapabilitiesCodeActionLiteralSupportCodeActionKind `json:"codeActionKind"`
+	return c.CodeActionKinds
 }
 
-// The code action kind is support with the following value
-// set.
-func (c *CodeActionClientCapabilitiesCodeActionLiteralSupport) GetCodeActionKind() *CodeActionClientCapabilitiesCodeActionLiteralSupportCodeActionKind {
+// The server provides support to resolve additional
+// information for a code action.
+//
+// @since 3.16.0
+func (c *CodeActionOptions) GetResolveProvider() bool {
 	if c == nil {
-		return *new(*CodeActionClientCapabilitiesCodeActionLiteralSupportCodeActionKind)
+		var zero bool
+		return zero
 	}
-	return c.CodeActionKind
+	return c.ResolveProvider
 }
 
-type CodeActionClientCapabilitiesResolveSupport struct {
-	// The properties that a client can resolve lazily.
-	Properties []string `json:"properties"`
+// The parameters of a {@link CodeActionRequest}.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#codeActionParams
+type CodeActionParams struct {
+	*WorkDoneProgressParams
+	*PartialResultParams
+	// The document in which the command was invoked.
+	TextDocument *TextDocumentIdentifier `json:"textDocument"`
+	// The range for which the command was invoked.
+	Range *Range `json:"range"`
+	// Context carrying additional information.
+	Context *CodeActionContext `json:"context"`
 }
 
-// The properties that a client can resolve lazily.
-func (c *CodeActionClientCapabilitiesResolveSupport) GetProperties() []string {
+// The document in which the command was invoked.
+func (c *CodeActionParams) GetTextDocument() *TextDocumentIdentifier {
 	if c == nil {
-		return *new([]string)
+		var zero *TextDocumentIdentifier
+		return zero
 	}
-	return c.Properties
-}
-
-// The Client Capabilities of a {@link CodeActionRequest}.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#codeActionClientCapabilities
-type CodeActionClientCapabilities struct {
-	// Whether code action supports dynamic registration.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
-	// The client support code action literals of type `CodeAction` as a valid
-	// response of the `textDocument/codeAction` request. If the property is not
-	// set the request can only return `Command` literals.
-	//
-	// @since 3.8.0
-	CodeActionLiteralSupport *CodeActionClientCapabilitiesCodeActionLiteralSupport `json:"codeActionLiteralSupport,omitempty"`
-	// Whether code action supports the `isPreferred` property.
-	//
-	// @since 3.15.0
-	IsPreferredSupport bool `json:"isPreferredSupport,omitempty"`
-	// Whether code action supports the `disabled` property.
-	//
-	// @since 3.16.0
-	DisabledSupport bool `json:"disabledSupport,omitempty"`
-	// Whether code action supports the `data` property which is
-	// preserved between a `textDocument/codeAction` and a
-	// `codeAction/resolve` request.
-	//
-	// @since 3.16.0
-	DataSupport bool `json:"dataSupport,omitempty"`
-	// Whether the client supports resolving additional code action
-	// properties via a separate `codeAction/resolve` request.
-	//
-	// @since 3.16.0
-	ResolveSupport *CodeActionClientCapabilitiesResolveSupport `json:"resolveSupport,omitempty"`
-	// Whether the client honors the change annotations in
-	// text edits and resource operations returned via the
-	// `CodeAction#edit` property by for example presenting
-	// the workspace edit in the user interface and asking
-	// for confirmation.
-	//
-	// @since 3.16.0
-	HonorsChangeAnnotations bool `json:"honorsChangeAnnotations,omitempty"`
+	return c.TextDocument
 }
 
-// Whether code action supports dynamic registration.
-func (c *CodeActionClientCapabilities) GetDynamicRegistration() bool {
+// The range for which the command was invoked.
+func (c *CodeActionParams) GetRange() *Range {
 	if c == nil {
-		var zero bool
+		var zero *Range
 		return zero
 	}
-	return c.DynamicRegistration
+	return c.Range
 }
 
-// The client support code action literals of type `CodeAction` as a valid
-// response of the `textDocument/codeAction` request. If the property is not
-// set the request can only return `Command` literals.
-//
-// @since 3.8.0
-func (c *CodeActionClientCapabilities) GetCodeActionLiteralSupport() *CodeActionClientCapabilitiesCodeActionLiteralSupport {
+// Context carrying additional information.
+func (c *CodeActionParams) GetContext() *CodeActionContext {
 	if c == nil {
-		var zero *CodeActionClientCapabilitiesCodeActionLiteralSupport
+		var zero *CodeActionContext
 		return zero
 	}
-	return c.CodeActionLiteralSupport
+	return c.Context
 }
 
-// Whether code action supports the `isPreferred` property.
+// The reason why code actions were requested.
 //
-// @since 3.15.0
-func (c *CodeActionClientCapabilities) GetIsPreferredSupport() bool {
-	if c == nil {
-		var zero bool
-		return zero
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#codeActionTriggerKind
+type CodeActionTriggerKind uint32
+
+const (
+	// Code actions were explicitly requested by the user or by an extension.
+	CodeActionTriggerKindInvoked CodeActionTriggerKind = 1
+	// Code actions were requested automatically.
+	//
+	// This typically happens when current selection in a file changes, but can
+	// also be triggered when file content changes.
+	CodeActionTriggerKindAutomatic CodeActionTriggerKind = 2
+)
+
+var validCodeActionTriggerKindValues = map[uint32]bool{
+	1: true,
+	2: true,
+}
+
+func (c *CodeActionTriggerKind) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
 	}
-	return c.IsPreferredSupport
+	var uint32Value uint32
+	if err := json.Unmarshal(data, &uint32Value); err != nil {
+		return err
+	}
+	if !validCodeActionTriggerKindValues[uint32Value] {
+		return fmt.Errorf("cannot unmarshal %v into CodeActionTriggerKind: custom values are not supported", uint32Value)
+	}
+	*c = CodeActionTriggerKind(uint32Value)
+
+	return nil
 }
 
-// Whether code action supports the `disabled` property.
+func (c CodeActionTriggerKind) MarshalJSON() ([]byte, error) {
+	var uint32Value = uint32(c)
+	if !validCodeActionTriggerKindValues[uint32Value] {
+		return nil, fmt.Errorf("cannot marshal %v into CodeActionTriggerKind: custom values are not supported", uint32Value)
+	}
+	return json.Marshal(uint32Value)
+}
+
+// Structure to capture a description for an error code.
 //
 // @since 3.16.0
-func (c *CodeActionClientCapabilities) GetDisabledSupport() bool {
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#codeDescription
+type CodeDescription struct {
+	// An URI to open with more information about the diagnostic error.
+	Href string `json:"href"`
+}
+
+// An URI to open with more information about the diagnostic error.
+func (c *CodeDescription) GetHref() string {
 	if c == nil {
-		var zero bool
+		var zero string
 		return zero
 	}
-	return c.DisabledSupport
+	return c.Href
 }
 
-// Whether code action supports the `data` property which is
-// preserved between a `textDocument/codeAction` and a
-// `codeAction/resolve` request.
+// A code lens represents a {@link Command command} that should be shown along with
+// source text, like the number of references, a way to run tests, etc.
 //
-// @since 3.16.0
-func (c *CodeActionClientCapabilities) GetDataSupport() bool {
+// A code lens is _unresolved_ when no command is associated to it. For performance
+// reasons the creation of a code lens and resolving should be done in two stages.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#codeLens
+type CodeLens struct {
+	// The range in which this code lens is valid. Should only span a single line.
+	Range *Range `json:"range"`
+	// The command this code lens represents.
+	Command *Command `json:"command,omitempty"`
+	// A data entry field that is preserved on a code lens item between
+	// a {@link CodeLensRequest} and a {@link CodeLensResolveRequest}
+	Data LSPAny `json:"data,omitempty"`
+}
+
+// The range in which this code lens is valid. Should only span a single line.
+func (c *CodeLens) GetRange() *Range {
 	if c == nil {
-		var zero bool
+		var zero *Range
 		return zero
 	}
-	return c.DataSupport
+	return c.Range
 }
 
-// Whether the client supports resolving additional code action
-// properties via a separate `codeAction/resolve` request.
-//
-// @since 3.16.0
-func (c *CodeActionClientCapabilities) GetResolveSupport() *CodeActionClientCapabilitiesResolveSupport {
+// The command this code lens represents.
+func (c *CodeLens) GetCommand() *Command {
 	if c == nil {
-		var zero *CodeActionClientCapabilitiesResolveSupport
+		var zero *Command
 		return zero
 	}
-	return c.ResolveSupport
+	return c.Command
 }
 
-// Whether the client honors the change annotations in
-// text edits and resource operations returned via the
-// `CodeAction#edit` property by for example presenting
-// the workspace edit in the user interface and asking
-// for confirmation.
-//
-// @since 3.16.0
-func (c *CodeActionClientCapabilities) GetHonorsChangeAnnotations() bool {
+// A data entry field that is preserved on a code lens item between
+// a {@link CodeLensRequest} and a {@link CodeLensResolveRequest}
+func (c *CodeLens) GetData() LSPAny {
 	if c == nil {
-		var zero bool
+		var zero LSPAny
 		return zero
 	}
-	return c.HonorsChangeAnnotations
+	return c.Data
 }
 
 // The client capabilities  of a {@link CodeLensRequest}.
@@ -2399,1068 +2250,1585 @@ func (c *CodeLensClientCapabilities) GetDynamicRegistration() bool {
 	return c.DynamicRegistration
 }
 
-// The client capabilities of a {@link DocumentLinkRequest}.
+// Code Lens provider options of a {@link CodeLensRequest}.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentLinkClientCapabilities
-type DocumentLinkClientCapabilities struct {
-	// Whether document link supports dynamic registration.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
-	// Whether the client supports the `tooltip` property on `DocumentLink`.
-	//
-	// @since 3.15.0
-	TooltipSupport bool `json:"tooltipSupport,omitempty"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#codeLensOptions
+type CodeLensOptions struct {
+	*WorkDoneProgressOptions
+	// Code lens has a resolve provider as well.
+	ResolveProvider bool `json:"resolveProvider,omitempty"`
 }
 
-// Whether document link supports dynamic registration.
-func (d *DocumentLinkClientCapabilities) GetDynamicRegistration() bool {
-	if d == nil {
+// Code lens has a resolve provider as well.
+func (c *CodeLensOptions) GetResolveProvider() bool {
+	if c == nil {
 		var zero bool
 		return zero
 	}
-	return d.DynamicRegistration
+	return c.ResolveProvider
 }
 
-// Whether the client supports the `tooltip` property on `DocumentLink`.
+// The parameters of a {@link CodeLensRequest}.
 //
-// @since 3.15.0
-func (d *DocumentLinkClientCapabilities) GetTooltipSupport() bool {
-	if d == nil {
-		var zero bool
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#codeLensParams
+type CodeLensParams struct {
+	*WorkDoneProgressParams
+	*PartialResultParams
+	// The document to request code lens for.
+	TextDocument *TextDocumentIdentifier `json:"textDocument"`
+}
+
+// The document to request code lens for.
+func (c *CodeLensParams) GetTextDocument() *TextDocumentIdentifier {
+	if c == nil {
+		var zero *TextDocumentIdentifier
 		return zero
 	}
-	return d.TooltipSupport
+	return c.TextDocument
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentColorClientCapabilities
-type DocumentColorClientCapabilities struct {
-	// Whether implementation supports dynamic registration. If this is set to `true`
-	// the client supports the new `DocumentColorRegistrationOptions` return value
-	// for the corresponding server capability as well.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+// @since 3.16.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#codeLensWorkspaceClientCapabilities
+type CodeLensWorkspaceClientCapabilities struct {
+	// Whether the client implementation supports a refresh request sent from the
+	// server to the client.
+	//
+	// Note that this event is global and will force the client to refresh all
+	// code lenses currently shown. It should be used with absolute care and is
+	// useful for situation where a server for example detect a project wide
+	// change that requires such a calculation.
+	RefreshSupport bool `json:"refreshSupport,omitempty"`
 }
 
-// Whether implementation supports dynamic registration. If this is set to `true`
-// the client supports the new `DocumentColorRegistrationOptions` return value
-// for the corresponding server capability as well.
-func (d *DocumentColorClientCapabilities) GetDynamicRegistration() bool {
-	if d == nil {
+// Whether the client implementation supports a refresh request sent from the
+// server to the client.
+//
+// Note that this event is global and will force the client to refresh all
+// code lenses currently shown. It should be used with absolute care and is
+// useful for situation where a server for example detect a project wide
+// change that requires such a calculation.
+func (c *CodeLensWorkspaceClientCapabilities) GetRefreshSupport() bool {
+	if c == nil {
 		var zero bool
 		return zero
 	}
-	return d.DynamicRegistration
+	return c.RefreshSupport
 }
 
-// Client capabilities of a {@link DocumentFormattingRequest}.
+// Represents a reference to a command. Provides a title which
+// will be used to represent a command in the UI and, optionally,
+// an array of arguments which will be passed to the command handler
+// function when invoked.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentFormattingClientCapabilities
-type DocumentFormattingClientCapabilities struct {
-	// Whether formatting supports dynamic registration.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#command
+type Command struct {
+	// Title of the command, like `save`.
+	Title string `json:"title"`
+	// The identifier of the actual command handler.
+	Command string `json:"command"`
+	// Arguments that the command handler should be
+	// invoked with.
+	Arguments []LSPAny `json:"arguments,omitempty"`
 }
 
-// Whether formatting supports dynamic registration.
-func (d *DocumentFormattingClientCapabilities) GetDynamicRegistration() bool {
-	if d == nil {
-		var zero bool
+// Title of the command, like `save`.
+func (c *Command) GetTitle() string {
+	if c == nil {
+		var zero string
 		return zero
 	}
-	return d.DynamicRegistration
-}
-
-// Client capabilities of a {@link DocumentRangeFormattingRequest}.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentRangeFormattingClientCapabilities
-type DocumentRangeFormattingClientCapabilities struct {
-	// Whether range formatting supports dynamic registration.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
-	// Whether the client supports formatting multiple ranges at once.
-	//
-	// @since 3.18.0
-	// @proposed
-	RangesSupport bool `json:"rangesSupport,omitempty"`
+	return c.Title
 }
 
-// Whether range formatting supports dynamic registration.
-func (d *DocumentRangeFormattingClientCapabilities) GetDynamicRegistration() bool {
-	if d == nil {
-		var zero bool
+// The identifier of the actual command handler.
+func (c *Command) GetCommand() string {
+	if c == nil {
+		var zero string
 		return zero
 	}
-	return d.DynamicRegistration
+	return c.Command
 }
 
-// Whether the client supports formatting multiple ranges at once.
-//
-// @since 3.18.0
-// @proposed
-func (d *DocumentRangeFormattingClientCapabilities) GetRangesSupport() bool {
-	if d == nil {
-		var zero bool
+// Arguments that the command handler should be
+// invoked with.
+func (c *Command) GetArguments() []LSPAny {
+	if c == nil {
+		var zero []LSPAny
 		return zero
 	}
-	return d.RangesSupport
+	return c.Arguments
 }
 
-// Client capabilities of a {@link DocumentOnTypeFormattingRequest}.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentOnTypeFormattingClientCapabilities
-type DocumentOnTypeFormattingClientCapabilities struct {
-	// Whether on type formatting supports dynamic registration.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+// CommandOrCodeAction contains either of the following types:
+//   - [*Command]
+//   - [*CodeAction]
+type CommandOrCodeAction struct {
+	Value CommandOrCodeActionValue
 }
 
-// Whether on type formatting supports dynamic registration.
-func (d *DocumentOnTypeFormattingClientCapabilities) GetDynamicRegistration() bool {
-	if d == nil {
-		var zero bool
-		return zero
-	}
-	return d.DynamicRegistration
+// CommandOrCodeActionValue is either of the following types:
+//   - [*Command]
+//   - [*CodeAction]
+//
+//sumtype:decl
+type CommandOrCodeActionValue interface {
+	isCommandOrCodeActionValue()
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#prepareSupportDefaultBehavior
-type PrepareSupportDefaultBehavior uint32
-
-const (
-	// The client's default behavior is to select the identifier
-	// according the to language's syntax rule.
-	PrepareSupportDefaultBehaviorIdentifier PrepareSupportDefaultBehavior = 1
-)
-
-var validPrepareSupportDefaultBehaviorValues = map[uint32]bool{
-	1: true,
-}
+func (*Command) isCommandOrCodeActionValue()    {}
+func (*CodeAction) isCommandOrCodeActionValue() {}
 
-func (p *PrepareSupportDefaultBehavior) UnmarshalJSON(data []byte) error {
+func (c *CommandOrCodeAction) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var uint32Value uint32
-	if err := json.Unmarshal(data, &uint32Value); err != nil {
-		return err
+	var commandValue *Command
+	if err := json.Unmarshal(data, &commandValue); err == nil {
+		c.Value = commandValue
+		return nil
 	}
-	if !validPrepareSupportDefaultBehaviorValues[uint32Value] {
-		return fmt.Errorf("cannot unmarshal %v into PrepareSupportDefaultBehavior: custom values are not supported", uint32Value)
+	var codeActionValue *CodeAction
+	if err := json.Unmarshal(data, &codeActionValue); err == nil {
+		c.Value = codeActionValue
+		return nil
 	}
-	*p = PrepareSupportDefaultBehavior(uint32Value)
-
-	return nil
-}
-
-func (p PrepareSupportDefaultBehavior) MarshalJSON() ([]byte, error) {
-	var uint32Value = uint32(p)
-	if !validPrepareSupportDefaultBehaviorValues[uint32Value] {
-		return nil, fmt.Errorf("cannot marshal %v into PrepareSupportDefaultBehavior: custom values are not supported", uint32Value)
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*CommandOrCodeAction](),
 	}
-	return json.Marshal(uint32Value)
+}
 
+func (c *CommandOrCodeAction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Value)
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#renameClientCapabilities
-type RenameClientCapabilities struct {
-	// Whether rename supports dynamic registration.
+type CommandOrCodeActionSlice []*CommandOrCodeAction
+
+// Completion client capabilities
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#completionClientCapabilities
+type CompletionClientCapabilities struct {
+	// Whether completion supports dynamic registration.
 	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
-	// Client supports testing for validity of rename operations
-	// before execution.
-	//
-	// @since 3.12.0
-	PrepareSupport bool `json:"prepareSupport,omitempty"`
-	// Client supports the default behavior result.
-	//
-	// The value indicates the default behavior used by the
-	// client.
+	// The client supports the following `CompletionItem` specific
+	// capabilities.
+	CompletionItem     *CompletionClientCapabilitiesCompletionItem     `json:"completionItem,omitempty"`
+	CompletionItemKind *CompletionClientCapabilitiesCompletionItemKind `json:"completionItemKind,omitempty"`
+	// Defines how the client handles whitespace and indentation
+	// when accepting a completion item that uses multi line
+	// text in either `insertText` or `textEdit`.
 	//
-	// @since 3.16.0
-	PrepareSupportDefaultBehavior PrepareSupportDefaultBehavior `json:"prepareSupportDefaultBehavior,omitempty"`
-	// Whether the client honors the change annotations in
-	// text edits and resource operations returned via the
-	// rename request's workspace edit by for example presenting
-	// the workspace edit in the user interface and asking
-	// for confirmation.
+	// @since 3.17.0
+	InsertTextMode InsertTextMode `json:"insertTextMode,omitempty"`
+	// The client supports to send additional context information for a
+	// `textDocument/completion` request.
+	ContextSupport bool `json:"contextSupport,omitempty"`
+	// The client supports the following `CompletionList` specific
+	// capabilities.
 	//
-	// @since 3.16.0
-	HonorsChangeAnnotations bool `json:"honorsChangeAnnotations,omitempty"`
+	// @since 3.17.0
+	CompletionList *CompletionClientCapabilitiesCompletionList `json:"completionList,omitempty"`
 }
 
-// Whether rename supports dynamic registration.
-func (r *RenameClientCapabilities) GetDynamicRegistration() bool {
-	if r == nil {
+// Whether completion supports dynamic registration.
+func (c *CompletionClientCapabilities) GetDynamicRegistration() bool {
+	if c == nil {
 		var zero bool
 		return zero
 	}
-	return r.DynamicRegistration
+	return c.DynamicRegistration
 }
 
-// Client supports testing for validity of rename operations
-// before execution.
-//
-// @since 3.12.0
-func (r *RenameClientCapabilities) GetPrepareSupport() bool {
-	if r == nil {
-		var zero bool
+// The client supports the following `CompletionItem` specific
+// capabilities.
+func (c *CompletionClientCapabilities) GetCompletionItem() *CompletionClientCapabilitiesCompletionItem {
+	if c == nil {
+		var zero *CompletionClientCapabilitiesCompletionItem
 		return zero
 	}
-	return r.PrepareSupport
+	return c.CompletionItem
 }
 
-// Client supports the default behavior result.
-//
-// The value indicates the default behavior used by the
-// client.
-//
-// @since 3.16.0
-func (r *RenameClientCapabilities) GetPrepareSupportDefaultBehavior() PrepareSupportDefaultBehavior {
-	if r == nil {
-		var zero PrepareSupportDefaultBehavior
+func (c *CompletionClientCapabilities) GetCompletionItemKind() *CompletionClientCapabilitiesCompletionItemKind {
+	if c == nil {
+		var zero *CompletionClientCapabilitiesCompletionItemKind
 		return zero
 	}
-	return r.PrepareSupportDefaultBehavior
+	return c.CompletionItemKind
 }
 
-// Whether the client honors the change annotations in
-// text edits and resource operations returned via the
-// rename request's workspace edit by for example presenting
-// the workspace edit in the user interface and asking
-// for confirmation.
+// Defines how the client handles whitespace and indentation
+// when accepting a completion item that uses multi line
+// text in either `insertText` or `textEdit`.
 //
-// @since 3.16.0
-func (r *RenameClientCapabilities) GetHonorsChangeAnnotations() bool {
-	if r == nil {
-		var zero bool
+// @since 3.17.0
+func (c *CompletionClientCapabilities) GetInsertTextMode() InsertTextMode {
+	if c == nil {
+		var zero InsertTextMode
 		return zero
 	}
-	return r.HonorsChangeAnnotations
-}
-
-// Optional is a JSON value which is either present or not.
-type Optional[T any] []T
-
-// NewOptional returns an [Optional] which is present.
-func NewOptional[T any](value T) Optional[T] {
-	return Optional[T]([]T{value})
-}
-
-func (o Optional[T]) IsPresent() bool {
-	return o != nil
-}
-
-func (o Optional[T]) Get() T {
-	if !o.IsPresent() {
-		panic("get of an absent value")
-	}
-	return o[0]
-}
-
-func (o *Optional[T]) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var v T
-	if err := json.Unmarshal(data, &v); err != nil {
-		return err
-	}
-	*o = Optional[T]{v}
-	return nil
+	return c.InsertTextMode
 }
 
-func (o Optional[T]) MarshalJSON() ([]byte, error) {
-	if o.IsPresent() {
-		return json.Marshal(o[0])
+// The client supports to send additional context information for a
+// `textDocument/completion` request.
+func (c *CompletionClientCapabilities) GetContextSupport() bool {
+	if c == nil {
+		var zero bool
+		return zero
 	}
-	return []byte("null"), nil
+	return c.ContextSupport
 }
 
-// A set of predefined range kinds.
+// The client supports the following `CompletionList` specific
+// capabilities.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#foldingRangeKind
-type FoldingRangeKind string
-
-const (
-	// Folding range for a comment
-	FoldingRangeKindComment FoldingRangeKind = "comment"
-	// Folding range for an import or include
-	FoldingRangeKindImports FoldingRangeKind = "imports"
-	// Folding range for a region (e.g. `#region`)
-	FoldingRangeKindRegion FoldingRangeKind = "region"
-)
-
-type FoldingRangeClientCapabilitiesFoldingRangeKind struct {
-	// The folding range kind values the client supports. When this
-	// property exists the client also guarantees that it will
-	// handle values outside its set gracefully and falls back
-	// to a default value when unknown.
-	ValueSet []FoldingRangeKind `json:"valueSet,omitempty"`
-}
-
-// The folding range kind values the client supports. When this
-// property exists the client also guarantees that it will
-// handle values outside its set gracefully and falls back
-// to a default value when unknown.
-func (f *FoldingRangeClientCapabilitiesFoldingRangeKind) GetValueSet() []FoldingRangeKind {
-	if f == nil {
-		return *new([]FoldingRangeKind)
+// @since 3.17.0
+func (c *CompletionClientCapabilities) GetCompletionList() *CompletionClientCapabilitiesCompletionList {
+	if c == nil {
+		var zero *CompletionClientCapabilitiesCompletionList
+		return zero
 	}
-	return f.ValueSet
+	return c.CompletionList
 }
 
-type FoldingRangeClientCapabilitiesFoldingRange struct {
-	// If set, the client signals that it supports setting collapsedText on
-	// folding ranges to display custom labels instead of the default text.
+type CompletionClientCapabilitiesCompletionItem struct {
+	// Client supports snippets as insert text.
 	//
-	// @since 3.17.0
-	CollapsedText bool `json:"collapsedText,omitempty"`
+	// A snippet can define tab stops and placeholders with `$1`, `$2`
+	// and `${3:foo}`. `$0` defines the final tab stop, it defaults to
+	// the end of the snippet. Placeholders with equal identifiers are linked,
+	// that is typing in one will update others too.
+	SnippetSupport bool `json:"snippetSupport,omitempty"`
+	// Client supports commit characters on a completion item.
+	CommitCharactersSupport bool `json:"commitCharactersSupport,omitempty"`
+	// Client supports the following content formats for the documentation
+	// property. The order describes the preferred format of the client.
+	DocumentationFormat []MarkupKind `json:"documentationFormat,omitempty"`
+	// Client supports the deprecated property on a completion item.
+	DeprecatedSupport bool `json:"deprecatedSupport,omitempty"`
+	// Client supports the preselect property on a completion item.
+	PreselectSupport bool `json:"preselectSupport,omitempty"`
+	// Client supports the tag property on a completion item. Clients supporting
+	// tags have to handle unknown tags gracefully. Clients especially need to
+	// preserve unknown tags when sending a completion item back to the server in
+	// a resolve call.
+	//
+	// @since 3.15.0
+	TagSupport *CompletionClientCapabilitiesCompletionItemTagSupport `json:"tagSupport,omitempty"`
+	// Client support insert replace edit to control different behavior if a
+	// completion item is inserted in the text or should replace text.
+	//
+	// @since 3.16.0
+	InsertReplaceSupport bool `json:"insertReplaceSupport,omitempty"`
+	// Indicates which properties a client can resolve lazily on a completion
+	// item. Before version 3.16.0 only the predefined properties `documentation`
+	// and `details` could be resolved lazily.
+	//
+	// @since 3.16.0
+	ResolveSupport *CompletionClientCapabilitiesCompletionItemResolveSupport `json:"resolveSupport,omitempty"`
+	// The client supports the `insertTextMode` property on
+	// a completion item to override the whitespace handling mode
+	// as defined by the client (see `insertTextMode`).
+	//
+	// @since 3.16.0
+	InsertTextModeSupport *CompletionClientCapabilitiesCompletionItemInsertTextModeSupport `json:"insertTextModeSupport,omitempty"`
+	// The client has support for completion item label
+	// details (see also `CompletionItemLabelDetails`).
+	//
+	// @since 3.17.0
+	LabelDetailsSupport bool `json:"labelDetailsSupport,omitempty"`
 }
 
-// If set, the client signals that it supports setting collapsedText on
-// folding ranges to display custom labels instead of the default text.
+// Client supports snippets as insert text.
 //
-// @since 3.17.0
-func (f *FoldingRangeClientCapabilitiesFoldingRange) GetCollapsedText() bool {
-	if f == nil {
+// A snippet can define tab stops and placeholders with `$1`, `$2`
+// and `${3:foo}`. `$0` defines the final tab stop, it defaults to
+// the end of the snippet. Placeholders with equal identifiers are linked,
+// that is typing in one will update others too.
+func (c *CompletionClientCapabilitiesCompletionItem) GetSnippetSupport() bool {
+	if c == nil {
 		return *new(bool)
 	}
-	return f.CollapsedText
+	return c.SnippetSupport
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#foldingRangeClientCapabilities
-type FoldingRangeClientCapabilities struct {
-	// Whether implementation supports dynamic registration for folding range
-	// providers. If this is set to `true` the client supports the new
-	// `FoldingRangeRegistrationOptions` return value for the corresponding
-	// server capability as well.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
-	// The maximum number of folding ranges that the client prefers to receive
-	// per document. The value serves as a hint, servers are free to follow the
-	// limit.
-	RangeLimit Optional[int] `json:"rangeLimit,omitempty"`
-	// If set, the client signals that it only supports folding complete lines.
-	// If set, client will ignore specified `startCharacter` and `endCharacter`
-	// properties in a FoldingRange.
-	LineFoldingOnly bool `json:"lineFoldingOnly,omitempty"`
-	// Specific options for the folding range kind.
-	//
-	// @since 3.17.0
-	FoldingRangeKind *FoldingRangeClientCapabilitiesFoldingRangeKind `json:"foldingRangeKind,omitempty"`
-	// Specific options for the folding range.
-	//
-	// @since 3.17.0
-	FoldingRange *FoldingRangeClientCapabilitiesFoldingRange `json:"foldingRange,omitempty"`
+// Client supports commit characters on a completion item.
+func (c *CompletionClientCapabilitiesCompletionItem) GetCommitCharactersSupport() bool {
+	if c == nil {
+		return *new(bool)
+	}
+	return c.CommitCharactersSupport
 }
 
-// Whether implementation supports dynamic registration for folding range
-// providers. If this is set to `true` the client supports the new
-// `FoldingRangeRegistrationOptions` return value for the corresponding
-// server capability as well.
-func (f *FoldingRangeClientCapabilities) GetDynamicRegistration() bool {
-	if f == nil {
-		var zero bool
-		return zero
+// Client supports the following content formats for the documentation
+// property. The order describes the preferred format of the client.
+func (c *CompletionClientCapabilitiesCompletionItem) GetDocumentationFormat() []MarkupKind {
+	if c == nil {
+		return *new([]MarkupKind)
 	}
-	return f.DynamicRegistration
+	return c.DocumentationFormat
 }
 
-// The maximum number of folding ranges that the client prefers to receive
-// per document. The value serves as a hint, servers are free to follow the
-// limit.
-func (f *FoldingRangeClientCapabilities) GetRangeLimit() Optional[int] {
-	if f == nil {
-		var zero Optional[int]
-		return zero
+// Client supports the deprecated property on a completion item.
+func (c *CompletionClientCapabilitiesCompletionItem) GetDeprecatedSupport() bool {
+	if c == nil {
+		return *new(bool)
 	}
-	return f.RangeLimit
+	return c.DeprecatedSupport
 }
 
-// If set, the client signals that it only supports folding complete lines.
-// If set, client will ignore specified `startCharacter` and `endCharacter`
-// properties in a FoldingRange.
-func (f *FoldingRangeClientCapabilities) GetLineFoldingOnly() bool {
-	if f == nil {
-		var zero bool
-		return zero
+// Client supports the preselect property on a completion item.
+func (c *CompletionClientCapabilitiesCompletionItem) GetPreselectSupport() bool {
+	if c == nil {
+		return *new(bool)
 	}
-	return f.LineFoldingOnly
+	return c.PreselectSupport
 }
 
-// Specific options for the folding range kind.
+// Client supports the tag property on a completion item. Clients supporting
+// tags have to handle unknown tags gracefully. Clients especially need to
+// preserve unknown tags when sending a completion item back to the server in
+// a resolve call.
 //
-// @since 3.17.0
-func (f *FoldingRangeClientCapabilities) GetFoldingRangeKind() *FoldingRangeClientCapabilitiesFoldingRangeKind {
-	if f == nil {
-		var zero *FoldingRangeClientCapabilitiesFoldingRangeKind
-		return zero
+// @since 3.15.0
+func (c *CompletionClientCapabilitiesCompletionItem) GetTagSupport() *CompletionClientCapabilitiesCompletionItemTagSupport {
+	if c == nil {
+		return *new(*CompletionClientCapabilitiesCompletionItemTagSupport)
 	}
-	return f.FoldingRangeKind
+	return c.TagSupport
 }
 
-// Specific options for the folding range.
+// Client support insert replace edit to control different behavior if a
+// completion item is inserted in the text or should replace text.
 //
-// @since 3.17.0
-func (f *FoldingRangeClientCapabilities) GetFoldingRange() *FoldingRangeClientCapabilitiesFoldingRange {
-	if f == nil {
-		var zero *FoldingRangeClientCapabilitiesFoldingRange
-		return zero
+// @since 3.16.0
+func (c *CompletionClientCapabilitiesCompletionItem) GetInsertReplaceSupport() bool {
+	if c == nil {
+		return *new(bool)
 	}
-	return f.FoldingRange
-}
-
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#selectionRangeClientCapabilities
-type SelectionRangeClientCapabilities struct {
-	// Whether implementation supports dynamic registration for selection range providers. If this is set to `true`
-	// the client supports the new `SelectionRangeRegistrationOptions` return value for the corresponding server
-	// capability as well.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	return c.InsertReplaceSupport
 }
 
-// Whether implementation supports dynamic registration for selection range providers. If this is set to `true`
-// the client supports the new `SelectionRangeRegistrationOptions` return value for the corresponding server
-// capability as well.
-func (s *SelectionRangeClientCapabilities) GetDynamicRegistration() bool {
-	if s == nil {
-		var zero bool
-		return zero
+// Indicates which properties a client can resolve lazily on a completion
+// item. Before version 3.16.0 only the predefined properties `documentation`
+// and `details` could be resolved lazily.
+//
+// @since 3.16.0
+func (c *CompletionClientCapabilitiesCompletionItem) GetResolveSupport() *CompletionClientCapabilitiesCompletionItemResolveSupport {
+	if c == nil {
+		return *new(*CompletionClientCapabilitiesCompletionItemResolveSupport)
 	}
-	return s.DynamicRegistration
+	return c.ResolveSupport
 }
 
-// The diagnostic tags.
-//
-// @since 3.15.0
+// The client supports the `insertTextMode` property on
+// a completion item to override the whitespace handling mode
+// as defined by the client (see `insertTextMode`).
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#diagnosticTag
-type DiagnosticTag uint32
-
-const (
-	// Unused or unnecessary code.
-	//
-	// Clients are allowed to render diagnostics with this tag faded out instead of having
-	// an error squiggle.
-	DiagnosticTagUnnecessary DiagnosticTag = 1
-	// Deprecated or obsolete code.
-	//
-	// Clients are allowed to rendered diagnostics with this tag strike through.
-	DiagnosticTagDeprecated DiagnosticTag = 2
-)
-
-var validDiagnosticTagValues = map[uint32]bool{
-	1: true,
-	2: true,
-}
-
-func (d *DiagnosticTag) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var uint32Value uint32
-	if err := json.Unmarshal(data, &uint32Value); err != nil {
-		return err
-	}
-	if !validDiagnosticTagValues[uint32Value] {
-		return fmt.Errorf("cannot unmarshal %v into DiagnosticTag: custom values are not supported", uint32Value)
+// @since 3.16.0
+func (c *CompletionClientCapabilitiesCompletionItem) GetInsertTextModeSupport() *CompletionClientCapabilitiesCompletionItemInsertTextModeSupport {
+	if c == nil {
+		return *new(*CompletionClientCapabilitiesCompletionItemInsertTextModeSupport)
 	}
-	*d = DiagnosticTag(uint32Value)
-
-	return nil
+	return c.InsertTextModeSupport
 }
 
-func (d DiagnosticTag) MarshalJSON() ([]byte, error) {
-	var uint32Value = uint32(d)
-	if !validDiagnosticTagValues[uint32Value] {
-		return nil, fmt.Errorf("cannot marshal %v into DiagnosticTag: custom values are not supported", uint32Value)
+// The client has support for completion item label
+// details (see also `CompletionItemLabelDetails`).
+//
+// @since 3.17.0
+func (c *CompletionClientCapabilitiesCompletionItem) GetLabelDetailsSupport() bool {
+	if c == nil {
+		return *new(bool)
 	}
-	return json.Marshal(uint32Value)
-
+	return c.LabelDetailsSupport
 }
 
-type PublishDiagnosticsClientCapabilitiesTagSupport struct {
-	// The tags supported by the client.
-	ValueSet []DiagnosticTag `json:"valueSet"`
+type CompletionClientCapabilitiesCompletionItemInsertTextModeSupport struct {
+	ValueSet []InsertTextMode `json:"valueSet"`
 }
 
-// The tags supported by the client.
-func (p *PublishDiagnosticsClientCapabilitiesTagSupport) GetValueSet() []DiagnosticTag {
-	if p == nil {
-		return *new([]DiagnosticTag)
+func (c *CompletionClientCapabilitiesCompletionItemInsertTextModeSupport) GetValueSet() []InsertTextMode {
+	if c == nil {
+		return *new([]InsertTextMode)
 	}
-	return p.ValueSet
+	return c.ValueSet
 }
 
-// The publish diagnostic client capabilities.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#publishDiagnosticsClientCapabilities
-type PublishDiagnosticsClientCapabilities struct {
-	// Whether the clients accepts diagnostics with related information.
-	RelatedInformation bool `json:"relatedInformation,omitempty"`
-	// Client supports the tag property to provide meta data about a diagnostic.
-	// Clients supporting tags have to handle unknown tags gracefully.
-	//
-	// @since 3.15.0
-	TagSupport *PublishDiagnosticsClientCapabilitiesTagSupport `json:"tagSupport,omitempty"`
-	// Whether the client interprets the version property of the
-	// `textDocument/publishDiagnostics` notification's parameter.
-	//
-	// @since 3.15.0
-	VersionSupport bool `json:"versionSupport,omitempty"`
-	// Client supports a codeDescription property
-	//
-	// @since 3.16.0
-	CodeDescriptionSupport bool `json:"codeDescriptionSupport,omitempty"`
-	// Whether code action supports the `data` property which is
-	// preserved between a `textDocument/publishDiagnostics` and
-	// `textDocument/codeAction` request.
+type CompletionClientCapabilitiesCompletionItemKind struct {
+	// The completion item kind values the client supports. When this
+	// property exists the client also guarantees that it will
+	// handle values outside its set gracefully and falls back
+	// to a default value when unknown.
 	//
-	// @since 3.16.0
-	DataSupport bool `json:"dataSupport,omitempty"`
-}
-
-// Whether the clients accepts diagnostics with related information.
-func (p *PublishDiagnosticsClientCapabilities) GetRelatedInformation() bool {
-	if p == nil {
-		var zero bool
-		return zero
-	}
-	return p.RelatedInformation
-}
-
-// Client supports the tag property to provide meta data about a diagnostic.
-// Clients supporting tags have to handle unknown tags gracefully.
-//
-// @since 3.15.0
-func (p *PublishDiagnosticsClientCapabilities) GetTagSupport() *PublishDiagnosticsClientCapabilitiesTagSupport {
-	if p == nil {
-		var zero *PublishDiagnosticsClientCapabilitiesTagSupport
-		return zero
-	}
-	return p.TagSupport
+	// If this property is not present the client only supports
+	// the completion items kinds from `Text` to `Reference` as defined in
+	// the initial version of the protocol.
+	ValueSet []CompletionItemKind `json:"valueSet,omitempty"`
 }
 
-// Whether the client interprets the version property of the
-// `textDocument/publishDiagnostics` notification's parameter.
+// The completion item kind values the client supports. When this
+// property exists the client also guarantees that it will
+// handle values outside its set gracefully and falls back
+// to a default value when unknown.
 //
-// @since 3.15.0
-func (p *PublishDiagnosticsClientCapabilities) GetVersionSupport() bool {
-	if p == nil {
-		var zero bool
-		return zero
+// If this property is not present the client only supports
+// the completion items kinds from `Text` to `Reference` as defined in
+// the initial version of the protocol.
+func (c *CompletionClientCapabilitiesCompletionItemKind) GetValueSet() []CompletionItemKind {
+	if c == nil {
+		return *new([]CompletionItemKind)
 	}
-	return p.VersionSupport
+	return c.ValueSet
 }
 
-// Client supports a codeDescription property
-//
-// @since 3.16.0
-func (p *PublishDiagnosticsClientCapabilities) GetCodeDescriptionSupport() bool {
-	if p == nil {
-		var zero bool
-		return zero
-	}
-	return p.CodeDescriptionSupport
+type CompletionClientCapabilitiesCompletionItemResolveSupport struct {
+	// The properties that a client can resolve lazily.
+	Properties []string `json:"properties"`
 }
 
-// Whether code action supports the `data` property which is
-// preserved between a `textDocument/publishDiagnostics` and
-// `textDocument/codeAction` request.
-//
-// @since 3.16.0
-func (p *PublishDiagnosticsClientCapabilities) GetDataSupport() bool {
-	if p == nil {
-		var zero bool
-		return zero
+// The properties that a client can resolve lazily.
+func (c *CompletionClientCapabilitiesCompletionItemResolveSupport) GetProperties() []string {
+	if c == nil {
+		return *new([]string)
 	}
-	return p.DataSupport
+	return c.Properties
 }
 
-// @since 3.16.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#callHierarchyClientCapabilities
-type CallHierarchyClientCapabilities struct {
-	// Whether implementation supports dynamic registration. If this is set to `true`
-	// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
-	// return value for the corresponding server capability as well.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+type CompletionClientCapabilitiesCompletionItemTagSupport struct {
+	// The tags supported by the client.
+	ValueSet []CompletionItemTag `json:"valueSet"`
 }
 
-// Whether implementation supports dynamic registration. If this is set to `true`
-// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
-// return value for the corresponding server capability as well.
-func (c *CallHierarchyClientCapabilities) GetDynamicRegistration() bool {
+// The tags supported by the client.
+func (c *CompletionClientCapabilitiesCompletionItemTagSupport) GetValueSet() []CompletionItemTag {
 	if c == nil {
-		var zero bool
-		return zero
+		return *new([]CompletionItemTag)
 	}
-	return c.DynamicRegistration
-}
-
-type Boolean bool
-
-type SemanticTokensClientCapabilitiesRequestsRangeOr2 struct {
+	return c.ValueSet
 }
 
-// BooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2 contains either of the following types:
-//   - [Boolean]
-//   - [*SemanticTokensClientCapabilitiesRequestsRangeOr2]
-type BooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2 struct {
-	Value BooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2Value
+type CompletionClientCapabilitiesCompletionList struct {
+	// The client supports the following itemDefaults on
+	// a completion list.
+	//
+	// The value lists the supported property names of the
+	// `CompletionList.itemDefaults` object. If omitted
+	// no properties are supported.
+	//
+	// @since 3.17.0
+	ItemDefaults []string `json:"itemDefaults,omitempty"`
 }
 
-// BooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2Value is either of the following types:
-//   - [Boolean]
-//   - [*SemanticTokensClientCapabilitiesRequestsRangeOr2]
+// The client supports the following itemDefaults on
+// a completion list.
 //
-//sumtype:decl
-type BooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2Value interface {
-	isBooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2Value()
-}
-
-func (Boolean) isBooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2Value() {}
-func (*SemanticTokensClientCapabilitiesRequestsRangeOr2) isBooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2Value() {
-}
-
-func (b *BooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
-		return nil
-	}
-	var semanticTokensClientCapabilitiesRequestsRangeOr2Value *SemanticTokensClientCapabilitiesRequestsRangeOr2
-	if err := json.Unmarshal(data, &semanticTokensClientCapabilitiesRequestsRangeOr2Value); err == nil {
-		b.Value = semanticTokensClientCapabilitiesRequestsRangeOr2Value
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2](),
-	}
-}
-
-func (b *BooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
-}
-
-type SemanticTokensClientCapabilitiesRequestsFullOr2 struct {
-	// The client will send the `textDocument/semanticTokens/full/delta` request if
-	// the server provides a corresponding handler.
-	Delta bool `json:"delta,omitempty"`
-}
-
-// The client will send the `textDocument/semanticTokens/full/delta` request if
-// the server provides a corresponding handler.
-func (s *SemanticTokensClientCapabilitiesRequestsFullOr2) GetDelta() bool {
-	if s == nil {
-		return *new(bool)
+// The value lists the supported property names of the
+// `CompletionList.itemDefaults` object. If omitted
+// no properties are supported.
+//
+// @since 3.17.0
+func (c *CompletionClientCapabilitiesCompletionList) GetItemDefaults() []string {
+	if c == nil {
+		return *new([]string)
 	}
-	return s.Delta
-}
-
-// BooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2 contains either of the following types:
-//   - [Boolean]
-//   - [*SemanticTokensClientCapabilitiesRequestsFullOr2]
-type BooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2 struct {
-	Value BooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2Value
+	return c.ItemDefaults
 }
 
-// BooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2Value is either of the following types:
-//   - [Boolean]
-//   - [*SemanticTokensClientCapabilitiesRequestsFullOr2]
+// Contains additional information about the context in which a completion request is triggered.
 //
-//sumtype:decl
-type BooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2Value interface {
-	isBooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2Value()
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#completionContext
+type CompletionContext struct {
+	// How the completion was triggered.
+	TriggerKind CompletionTriggerKind `json:"triggerKind"`
+	// The trigger character (a single character) that has trigger code complete.
+	// Is undefined if `triggerKind !== CompletionTriggerKind.TriggerCharacter`
+	TriggerCharacter string `json:"triggerCharacter,omitempty"`
 }
 
-func (Boolean) isBooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2Value() {}
-func (*SemanticTokensClientCapabilitiesRequestsFullOr2) isBooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2Value() {
+// How the completion was triggered.
+func (c *CompletionContext) GetTriggerKind() CompletionTriggerKind {
+	if c == nil {
+		var zero CompletionTriggerKind
+		return zero
+	}
+	return c.TriggerKind
 }
 
-func (b *BooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
-		return nil
-	}
-	var semanticTokensClientCapabilitiesRequestsFullOr2Value *SemanticTokensClientCapabilitiesRequestsFullOr2
-	if err := json.Unmarshal(data, &semanticTokensClientCapabilitiesRequestsFullOr2Value); err == nil {
-		b.Value = semanticTokensClientCapabilitiesRequestsFullOr2Value
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2](),
+// The trigger character (a single character) that has trigger code complete.
+// Is undefined if `triggerKind !== CompletionTriggerKind.TriggerCharacter`
+func (c *CompletionContext) GetTriggerCharacter() string {
+	if c == nil {
+		var zero string
+		return zero
 	}
+	return c.TriggerCharacter
 }
 
-func (b *BooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
+// A completion item represents a text snippet that is
+// proposed to complete text that is being typed.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#completionItem
+type CompletionItem struct {
+	// The label of this completion item.
+	//
+	// The label property is also by default the text that
+	// is inserted when selecting this completion.
+	//
+	// If label details are provided the label itself should
+	// be an unqualified name of the completion item.
+	Label string `json:"label"`
+	// Additional details for the label
+	//
+	// @since 3.17.0
+	LabelDetails *CompletionItemLabelDetails `json:"labelDetails,omitempty"`
+	// The kind of this completion item. Based of the kind
+	// an icon is chosen by the editor.
+	Kind CompletionItemKind `json:"kind,omitempty"`
+	// Tags for this completion item.
+	//
+	// @since 3.15.0
+	Tags []CompletionItemTag `json:"tags,omitempty"`
+	// A human-readable string with additional information
+	// about this item, like type or symbol information.
+	Detail string `json:"detail,omitempty"`
+	// A human-readable string that represents a doc-comment.
+	Documentation *StringOrMarkupContent `json:"documentation,omitempty"`
+	// Indicates if this item is deprecated.
+	//
+	// Deprecated: Use `tags` instead.
+	Deprecated bool `json:"deprecated,omitempty"`
+	// Select this item when showing.
+	//
+	// *Note* that only one completion item can be selected and that the
+	// tool / client decides which item that is. The rule is that the *first*
+	// item of those that match best is selected.
+	Preselect bool `json:"preselect,omitempty"`
+	// A string that should be used when comparing this item
+	// with other items. When `falsy` the {@link CompletionItem.label label}
+	// is used.
+	SortText string `json:"sortText,omitempty"`
+	// A string that should be used when filtering a set of
+	// completion items. When `falsy` the {@link CompletionItem.label label}
+	// is used.
+	FilterText string `json:"filterText,omitempty"`
+	// A string that should be inserted into a document when selecting
+	// this completion. When `falsy` the {@link CompletionItem.label label}
+	// is used.
+	//
+	// The `insertText` is subject to interpretation by the client side.
+	// Some tools might not take the string literally. For example
+	// VS Code when code complete is requested in this example
+	// `con<cursor position>` and a completion item with an `insertText` of
+	// `console` is provided it will only insert `sole`. Therefore it is
+	// recommended to use `textEdit` instead since it avoids additional client
+	// side interpretation.
+	InsertText string `json:"insertText,omitempty"`
+	// The format of the insert text. The format applies to both the
+	// `insertText` property and the `newText` property of a provided
+	// `textEdit`. If omitted defaults to `InsertTextFormat.PlainText`.
+	//
+	// Please note that the insertTextFormat doesn't apply to
+	// `additionalTextEdits`.
+	InsertTextFormat InsertTextFormat `json:"insertTextFormat,omitempty"`
+	// How whitespace and indentation is handled during completion
+	// item insertion. If not provided the clients default value depends on
+	// the `textDocument.completion.insertTextMode` client capability.
+	//
+	// @since 3.16.0
+	InsertTextMode InsertTextMode `json:"insertTextMode,omitempty"`
+	// An {@link TextEdit edit} which is applied to a document when selecting
+	// this completion. When an edit is provided the value of
+	// {@link CompletionItem.insertText insertText} is ignored.
+	//
+	// Most editors support two different operations when accepting a completion
+	// item. One is to insert a completion text and the other is to replace an
+	// existing text with a completion text. Since this can usually not be
+	// predetermined by a server it can report both ranges. Clients need to
+	// signal support for `InsertReplaceEdits` via the
+	// `textDocument.completion.insertReplaceSupport` client capability
+	// property.
+	//
+	// *Note 1:* The text edit's range as well as both ranges from an insert
+	// replace edit must be a [single line] and they must contain the position
+	// at which completion has been requested.
+	// *Note 2:* If an `InsertReplaceEdit` is returned the edit's insert range
+	// must be a prefix of the edit's replace range, that means it must be
+	// contained and starting at the same position.
+	//
+	// @since 3.16.0 additional type `InsertReplaceEdit`
+	TextEdit *TextEditOrInsertReplaceEdit `json:"textEdit,omitempty"`
+	// The edit text used if the completion item is part of a CompletionList and
+	// CompletionList defines an item default for the text edit range.
+	//
+	// Clients will only honor this property if they opt into completion list
+	// item defaults using the capability `completionList.itemDefaults`.
+	//
+	// If not provided and a list's default range is provided the label
+	// property is used as a text.
+	//
+	// @since 3.17.0
+	TextEditText string `json:"textEditText,omitempty"`
+	// An optional array of additional {@link TextEdit text edits} that are applied when
+	// selecting this completion. Edits must not overlap (including the same insert position)
+	// with the main {@link CompletionItem.textEdit edit} nor with themselves.
+	//
+	// Additional text edits should be used to change text unrelated to the current cursor position
+	// (for example adding an import statement at the top of the file if the completion item will
+	// insert an unqualified type).
+	AdditionalTextEdits []*TextEdit `json:"additionalTextEdits,omitempty"`
+	// An optional set of characters that when pressed while this completion is active will accept it first and
+	// then type that character. *Note* that all commit characters should have `length=1` and that superfluous
+	// characters will be ignored.
+	CommitCharacters []string `json:"commitCharacters,omitempty"`
+	// An optional {@link Command command} that is executed *after* inserting this completion. *Note* that
+	// additional modifications to the current document should be described with the
+	// {@link CompletionItem.additionalTextEdits additionalTextEdits}-property.
+	Command *Command `json:"command,omitempty"`
+	// A data entry field that is preserved on a completion item between a
+	// {@link CompletionRequest} and a {@link CompletionResolveRequest}.
+	Data LSPAny `json:"data,omitempty"`
+}
+
+// The label of this completion item.
+//
+// The label property is also by default the text that
+// is inserted when selecting this completion.
+//
+// If label details are provided the label itself should
+// be an unqualified name of the completion item.
+func (c *CompletionItem) GetLabel() string {
+	if c == nil {
+		var zero string
+		return zero
+	}
+	return c.Label
+}
+
+// Additional details for the label
+//
+// @since 3.17.0
+func (c *CompletionItem) GetLabelDetails() *CompletionItemLabelDetails {
+	if c == nil {
+		var zero *CompletionItemLabelDetails
+		return zero
+	}
+	return c.LabelDetails
+}
+
+// The kind of this completion item. Based of the kind
+// an icon is chosen by the editor.
+func (c *CompletionItem) GetKind() CompletionItemKind {
+	if c == nil {
+		var zero CompletionItemKind
+		return zero
+	}
+	return c.Kind
+}
+
+// Tags for this completion item.
+//
+// @since 3.15.0
+func (c *CompletionItem) GetTags() []CompletionItemTag {
+	if c == nil {
+		var zero []CompletionItemTag
+		return zero
+	}
+	return c.Tags
+}
+
+// A human-readable string with additional information
+// about this item, like type or symbol information.
+func (c *CompletionItem) GetDetail() string {
+	if c == nil {
+		var zero string
+		return zero
+	}
+	return c.Detail
+}
+
+// A human-readable string that represents a doc-comment.
+func (c *CompletionItem) GetDocumentation() *StringOrMarkupContent {
+	if c == nil {
+		var zero *StringOrMarkupContent
+		return zero
+	}
+	return c.Documentation
+}
+
+// Indicates if this item is deprecated.
+//
+// Deprecated: Use `tags` instead.
+func (c *CompletionItem) GetDeprecated() bool {
+	if c == nil {
+		var zero bool
+		return zero
+	}
+	return c.Deprecated
+}
+
+// Select this item when showing.
+//
+// *Note* that only one completion item can be selected and that the
+// tool / client decides which item that is. The rule is that the *first*
+// item of those that match best is selected.
+func (c *CompletionItem) GetPreselect() bool {
+	if c == nil {
+		var zero bool
+		return zero
+	}
+	return c.Preselect
+}
+
+// A string that should be used when comparing this item
+// with other items. When `falsy` the {@link CompletionItem.label label}
+// is used.
+func (c *CompletionItem) GetSortText() string {
+	if c == nil {
+		var zero string
+		return zero
+	}
+	return c.SortText
+}
+
+// A string that should be used when filtering a set of
+// completion items. When `falsy` the {@link CompletionItem.label label}
+// is used.
+func (c *CompletionItem) GetFilterText() string {
+	if c == nil {
+		var zero string
+		return zero
+	}
+	return c.FilterText
+}
+
+// A string that should be inserted into a document when selecting
+// this completion. When `falsy` the {@link CompletionItem.label label}
+// is used.
+//
+// The `insertText` is subject to interpretation by the client side.
+// Some tools might not take the string literally. For example
+// VS Code when code complete is requested in this example
+// `con<cursor position>` and a completion item with an `insertText` of
+// `console` is provided it will only insert `sole`. Therefore it is
+// recommended to use `textEdit` instead since it avoids additional client
+// side interpretation.
+func (c *CompletionItem) GetInsertText() string {
+	if c == nil {
+		var zero string
+		return zero
+	}
+	return c.InsertText
+}
+
+// The format of the insert text. The format applies to both the
+// `insertText` property and the `newText` property of a provided
+// `textEdit`. If omitted defaults to `InsertTextFormat.PlainText`.
+//
+// Please note that the insertTextFormat doesn't apply to
+// `additionalTextEdits`.
+func (c *CompletionItem) GetInsertTextFormat() InsertTextFormat {
+	if c == nil {
+		var zero InsertTextFormat
+		return zero
+	}
+	return c.InsertTextFormat
+}
+
+// How whitespace and indentation is handled during completion
+// item insertion. If not provided the clients default value depends on
+// the `textDocument.completion.insertTextMode` client capability.
+//
+// @since 3.16.0
+func (c *CompletionItem) GetInsertTextMode() InsertTextMode {
+	if c == nil {
+		var zero InsertTextMode
+		return zero
+	}
+	return c.InsertTextMode
+}
+
+// An {@link TextEdit edit} which is applied to a document when selecting
+// this completion. When an edit is provided the value of
+// {@link CompletionItem.insertText insertText} is ignored.
+//
+// Most editors support two different operations when accepting a completion
+// item. One is to insert a completion text and the other is to replace an
+// existing text with a completion text. Since this can usually not be
+// predetermined by a server it can report both ranges. Clients need to
+// signal support for `InsertReplaceEdits` via the
+// `textDocument.completion.insertReplaceSupport` client capability
+// property.
+//
+// *Note 1:* The text edit's range as well as both ranges from an insert
+// replace edit must be a [single line] and they must contain the position
+// at which completion has been requested.
+// *Note 2:* If an `InsertReplaceEdit` is returned the edit's insert range
+// must be a prefix of the edit's replace range, that means it must be
+// contained and starting at the same position.
+//
+// @since 3.16.0 additional type `InsertReplaceEdit`
+func (c *CompletionItem) GetTextEdit() *TextEditOrInsertReplaceEdit {
+	if c == nil {
+		var zero *TextEditOrInsertReplaceEdit
+		return zero
+	}
+	return c.TextEdit
 }
 
-type SemanticTokensClientCapabilitiesRequests struct {
-	// The client will send the `textDocument/semanticTokens/range` request if
-	// the server provides a corresponding handler.
-	Range *BooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2 `json:"range,omitempty"`
-	// The client will send the `textDocument/semanticTokens/full` request if
-	// the server provides a corresponding handler.
-	Full *BooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2 `json:"full,omitempty"`
+// The edit text used if the completion item is part of a CompletionList and
+// CompletionList defines an item default for the text edit range.
+//
+// Clients will only honor this property if they opt into completion list
+// item defaults using the capability `completionList.itemDefaults`.
+//
+// If not provided and a list's default range is provided the label
+// property is used as a text.
+//
+// @since 3.17.0
+func (c *CompletionItem) GetTextEditText() string {
+	if c == nil {
+		var zero string
+		return zero
+	}
+	return c.TextEditText
 }
 
-// The client will send the `textDocument/semanticTokens/range` request if
-// the server provides a corresponding handler.
-func (s *SemanticTokensClientCapabilitiesRequests) GetRange() *BooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2 {
-	if s == nil {
-		return *new(*BooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2)
+// An optional array of additional {@link TextEdit text edits} that are applied when
+// selecting this completion. Edits must not overlap (including the same insert position)
+// with the main {@link CompletionItem.textEdit edit} nor with themselves.
+//
+// Additional text edits should be used to change text unrelated to the current cursor position
+// (for example adding an import statement at the top of the file if the completion item will
+// insert an unqualified type).
+func (c *CompletionItem) GetAdditionalTextEdits() []*TextEdit {
+	if c == nil {
+		var zero []*TextEdit
+		return zero
+	}
+	return c.AdditionalTextEdits
+}
+
+// An optional set of characters that when pressed while this completion is active will accept it first and
+// then type that character. *Note* that all commit characters should have `length=1` and that superfluous
+// characters will be ignored.
+func (c *CompletionItem) GetCommitCharacters() []string {
+	if c == nil {
+		var zero []string
+		return zero
+	}
+	return c.CommitCharacters
+}
+
+// An optional {@link Command command} that is executed *after* inserting this completion. *Note* that
+// additional modifications to the current document should be described with the
+// {@link CompletionItem.additionalTextEdits additionalTextEdits}-property.
+func (c *CompletionItem) GetCommand() *Command {
+	if c == nil {
+		var zero *Command
+		return zero
+	}
+	return c.Command
+}
+
+// A data entry field that is preserved on a completion item between a
+// {@link CompletionRequest} and a {@link CompletionResolveRequest}.
+func (c *CompletionItem) GetData() LSPAny {
+	if c == nil {
+		var zero LSPAny
+		return zero
+	}
+	return c.Data
+}
+
+// The kind of a completion entry.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#completionItemKind
+type CompletionItemKind uint32
+
+const (
+	CompletionItemKindText          CompletionItemKind = 1
+	CompletionItemKindMethod        CompletionItemKind = 2
+	CompletionItemKindFunction      CompletionItemKind = 3
+	CompletionItemKindConstructor   CompletionItemKind = 4
+	CompletionItemKindField         CompletionItemKind = 5
+	CompletionItemKindVariable      CompletionItemKind = 6
+	CompletionItemKindClass         CompletionItemKind = 7
+	CompletionItemKindInterface     CompletionItemKind = 8
+	CompletionItemKindModule        CompletionItemKind = 9
+	CompletionItemKindProperty      CompletionItemKind = 10
+	CompletionItemKindUnit          CompletionItemKind = 11
+	CompletionItemKindValue         CompletionItemKind = 12
+	CompletionItemKindEnum          CompletionItemKind = 13
+	CompletionItemKindKeyword       CompletionItemKind = 14
+	CompletionItemKindSnippet       CompletionItemKind = 15
+	CompletionItemKindColor         CompletionItemKind = 16
+	CompletionItemKindFile          CompletionItemKind = 17
+	CompletionItemKindReference     CompletionItemKind = 18
+	CompletionItemKindFolder        CompletionItemKind = 19
+	CompletionItemKindEnumMember    CompletionItemKind = 20
+	CompletionItemKindConstant      CompletionItemKind = 21
+	CompletionItemKindStruct        CompletionItemKind = 22
+	CompletionItemKindEvent         CompletionItemKind = 23
+	CompletionItemKindOperator      CompletionItemKind = 24
+	CompletionItemKindTypeParameter CompletionItemKind = 25
+)
+
+var validCompletionItemKindValues = map[uint32]bool{
+	1:  true,
+	2:  true,
+	3:  true,
+	4:  true,
+	5:  true,
+	6:  true,
+	7:  true,
+	8:  true,
+	9:  true,
+	10: true,
+	11: true,
+	12: true,
+	13: true,
+	14: true,
+	15: true,
+	16: true,
+	17: true,
+	18: true,
+	19: true,
+	20: true,
+	21: true,
+	22: true,
+	23: true,
+	24: true,
+	25: true,
+}
+
+func (c *CompletionItemKind) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var uint32Value uint32
+	if err := json.Unmarshal(data, &uint32Value); err != nil {
+		return err
+	}
+	if !validCompletionItemKindValues[uint32Value] {
+		return fmt.Errorf("cannot unmarshal %v into CompletionItemKind: custom values are not supported", uint32Value)
+	}
+	*c = CompletionItemKind(uint32Value)
+
+	return nil
+}
+
+func (c CompletionItemKind) MarshalJSON() ([]byte, error) {
+	var uint32Value = uint32(c)
+	if !validCompletionItemKindValues[uint32Value] {
+		return nil, fmt.Errorf("cannot marshal %v into CompletionItemKind: custom values are not supported", uint32Value)
+	}
+	return json.Marshal(uint32Value)
+
+}
+
+// Additional details for a completion item label.
+//
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#completionItemLabelDetails
+type CompletionItemLabelDetails struct {
+	// An optional string which is rendered less prominently directly after {@link CompletionItem.label label},
+	// without any spacing. Should be used for function signatures and type annotations.
+	Detail string `json:"detail,omitempty"`
+	// An optional string which is rendered less prominently after {@link CompletionItem.detail}. Should be used
+	// for fully qualified names and file paths.
+	Description string `json:"description,omitempty"`
+}
+
+// An optional string which is rendered less prominently directly after {@link CompletionItem.label label},
+// without any spacing. Should be used for function signatures and type annotations.
+func (c *CompletionItemLabelDetails) GetDetail() string {
+	if c == nil {
+		var zero string
+		return zero
+	}
+	return c.Detail
+}
+
+// An optional string which is rendered less prominently after {@link CompletionItem.detail}. Should be used
+// for fully qualified names and file paths.
+func (c *CompletionItemLabelDetails) GetDescription() string {
+	if c == nil {
+		var zero string
+		return zero
+	}
+	return c.Description
+}
+
+type CompletionItemSlice []*CompletionItem
+
+// CompletionItemSliceOrCompletionList contains either of the following types:
+//   - [CompletionItemSlice]
+//   - [*CompletionList]
+type CompletionItemSliceOrCompletionList struct {
+	Value CompletionItemSliceOrCompletionListValue
+}
+
+// CompletionItemSliceOrCompletionListValue is either of the following types:
+//   - [CompletionItemSlice]
+//   - [*CompletionList]
+//
+//sumtype:decl
+type CompletionItemSliceOrCompletionListValue interface {
+	isCompletionItemSliceOrCompletionListValue()
+}
+
+func (CompletionItemSlice) isCompletionItemSliceOrCompletionListValue() {}
+func (*CompletionList) isCompletionItemSliceOrCompletionListValue()     {}
+
+func (c *CompletionItemSliceOrCompletionList) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var completionItemSliceValue CompletionItemSlice
+	if err := json.Unmarshal(data, &completionItemSliceValue); err == nil {
+		c.Value = completionItemSliceValue
+		return nil
+	}
+	var completionListValue *CompletionList
+	if err := json.Unmarshal(data, &completionListValue); err == nil {
+		c.Value = completionListValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*CompletionItemSliceOrCompletionList](),
 	}
-	return s.Range
 }
 
-// The client will send the `textDocument/semanticTokens/full` request if
-// the server provides a corresponding handler.
-func (s *SemanticTokensClientCapabilitiesRequests) GetFull() *BooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2 {
-	if s == nil {
-		return *new(*BooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2)
-	}
-	return s.Full
+func (c *CompletionItemSliceOrCompletionList) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Value)
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#tokenFormat
-type TokenFormat string
+// Completion item tags are extra annotations that tweak the rendering of a completion
+// item.
+//
+// @since 3.15.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#completionItemTag
+type CompletionItemTag uint32
 
 const (
-	TokenFormatRelative TokenFormat = "relative"
+	// Render a completion as obsolete, usually using a strike-out.
+	CompletionItemTagDeprecated CompletionItemTag = 1
 )
 
-var validTokenFormatValues = map[string]bool{
-	"relative": true,
+var validCompletionItemTagValues = map[uint32]bool{
+	1: true,
 }
 
-func (t *TokenFormat) UnmarshalJSON(data []byte) error {
+func (c *CompletionItemTag) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var stringValue string
-	if err := json.Unmarshal(data, &stringValue); err != nil {
+	var uint32Value uint32
+	if err := json.Unmarshal(data, &uint32Value); err != nil {
 		return err
 	}
-	if !validTokenFormatValues[stringValue] {
-		return fmt.Errorf("cannot unmarshal %v into TokenFormat: custom values are not supported", stringValue)
+	if !validCompletionItemTagValues[uint32Value] {
+		return fmt.Errorf("cannot unmarshal %v into CompletionItemTag: custom values are not supported", uint32Value)
 	}
-	*t = TokenFormat(stringValue)
+	*c = CompletionItemTag(uint32Value)
 
 	return nil
 }
 
-func (t TokenFormat) MarshalJSON() ([]byte, error) {
-	var stringValue = string(t)
-	if !validTokenFormatValues[stringValue] {
-		return nil, fmt.Errorf("cannot marshal %v into TokenFormat: custom values are not supported", stringValue)
+func (c CompletionItemTag) MarshalJSON() ([]byte, error) {
+	var uint32Value = uint32(c)
+	if !validCompletionItemTagValues[uint32Value] {
+		return nil, fmt.Errorf("cannot marshal %v into CompletionItemTag: custom values are not supported", uint32Value)
 	}
-	return json.Marshal(stringValue)
+	return json.Marshal(uint32Value)
 
 }
 
-// @since 3.16.0
+// Represents a collection of {@link CompletionItem completion items} to be presented
+// in the editor.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#semanticTokensClientCapabilities
-type SemanticTokensClientCapabilities struct {
-	// Whether implementation supports dynamic registration. If this is set to `true`
-	// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
-	// return value for the corresponding server capability as well.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
-	// Which requests the client supports and might send to the server
-	// depending on the server's capability. Please note that clients might not
-	// show semantic tokens or degrade some of the user experience if a range
-	// or full request is advertised by the client but not provided by the
-	// server. If for example the client capability `requests.full` and
-	// `request.range` are both set to true but the server only provides a
-	// range provider the client might not render a minimap correctly or might
-	// even decide to not show any semantic tokens at all.
-	Requests *SemanticTokensClientCapabilitiesRequests `json:"requests"`
-	// The token types that the client supports.
-	TokenTypes []string `json:"tokenTypes"`
-	// The token modifiers that the client supports.
-	TokenModifiers []string `json:"tokenModifiers"`
-	// The token formats the clients supports.
-	Formats []TokenFormat `json:"formats"`
-	// Whether the client supports tokens that can overlap each other.
-	OverlappingTokenSupport bool `json:"overlappingTokenSupport,omitempty"`
-	// Whether the client supports tokens that can span multiple lines.
-	MultilineTokenSupport bool `json:"multilineTokenSupport,omitempty"`
-	// Whether the client allows the server to actively cancel a
-	// semantic token request, e.g. supports returning
-	// LSPErrorCodes.ServerCancelled. If a server does the client
-	// needs to retrigger the request.
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#completionList
+type CompletionList struct {
+	// This list it not complete. Further typing results in recomputing this list.
 	//
-	// @since 3.17.0
-	ServerCancelSupport bool `json:"serverCancelSupport,omitempty"`
-	// Whether the client uses semantic tokens to augment existing
-	// syntax tokens. If set to `true` client side created syntax
-	// tokens and semantic tokens are both used for colorization. If
-	// set to `false` the client only uses the returned semantic tokens
-	// for colorization.
+	// Recomputed lists have all their items replaced (not appended) in the
+	// incomplete completion sessions.
+	IsIncomplete bool `json:"isIncomplete"`
+	// In many cases the items of an actual completion result share the same
+	// value for properties like `commitCharacters` or the range of a text
+	// edit. A completion list can therefore define item defaults which will
+	// be used if a completion item itself doesn't specify the value.
 	//
-	// If the value is `undefined` then the client behavior is not
-	// specified.
+	// If a completion list specifies a default value and a completion item
+	// also specifies a corresponding value the one from the item is used.
+	//
+	// Servers are only allowed to return default values if the client
+	// signals support for this via the `completionList.itemDefaults`
+	// capability.
 	//
 	// @since 3.17.0
-	AugmentsSyntaxTokens bool `json:"augmentsSyntaxTokens,omitempty"`
+	ItemDefaults *CompletionListItemDefaults `json:"itemDefaults,omitempty"`
+	// The completion items.
+	Items []*CompletionItem `json:"items"`
 }
 
-// Whether implementation supports dynamic registration. If this is set to `true`
-// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
-// return value for the corresponding server capability as well.
-func (s *SemanticTokensClientCapabilities) GetDynamicRegistration() bool {
-	if s == nil {
+// This list it not complete. Further typing results in recomputing this list.
+//
+// Recomputed lists have all their items replaced (not appended) in the
+// incomplete completion sessions.
+func (c *CompletionList) GetIsIncomplete() bool {
+	if c == nil {
 		var zero bool
 		return zero
 	}
-	return s.DynamicRegistration
+	return c.IsIncomplete
 }
 
-// Which requests the client supports and might send to the server
-// depending on the server's capability. Please note that clients might not
-// show semantic tokens or degrade some of the user experience if a range
-// or full request is advertised by the client but not provided by the
-// server. If for example the client capability `requests.full` and
-// `request.range` are both set to true but the server only provides a
-// range provider the client might not render a minimap correctly or might
-// even decide to not show any semantic tokens at all.
-func (s *SemanticTokensClientCapabilities) GetRequests() *SemanticTokensClientCapabilitiesRequests {
-	if s == nil {
-		var zero *SemanticTokensClientCapabilitiesRequests
+// In many cases the items of an actual completion result share the same
+// value for properties like `commitCharacters` or the range of a text
+// edit. A completion list can therefore define item defaults which will
+// be used if a completion item itself doesn't specify the value.
+//
+// If a completion list specifies a default value and a completion item
+// also specifies a corresponding value the one from the item is used.
+//
+// Servers are only allowed to return default values if the client
+// signals support for this via the `completionList.itemDefaults`
+// capability.
+//
+// @since 3.17.0
+func (c *CompletionList) GetItemDefaults() *CompletionListItemDefaults {
+	if c == nil {
+		var zero *CompletionListItemDefaults
 		return zero
 	}
-	return s.Requests
+	return c.ItemDefaults
+}
+
+// The completion items.
+func (c *CompletionList) GetItems() []*CompletionItem {
+	if c == nil {
+		var zero []*CompletionItem
+		return zero
+	}
+	return c.Items
+}
+
+type CompletionListItemDefaults struct {
+	// A default commit character set.
+	//
+	// @since 3.17.0
+	CommitCharacters []string `json:"commitCharacters,omitempty"`
+	// A default edit range.
+	//
+	// @since 3.17.0
+	EditRange *RangeOrInsertReplaceRange `json:"editRange,omitempty"`
+	// A default insert text format.
+	//
+	// @since 3.17.0
+	InsertTextFormat InsertTextFormat `json:"insertTextFormat,omitempty"`
+	// A default insert text mode.
+	//
+	// @since 3.17.0
+	InsertTextMode InsertTextMode `json:"insertTextMode,omitempty"`
+	// A default data value.
+	//
+	// @since 3.17.0
+	Data LSPAny `json:"data,omitempty"`
+}
+
+// A default commit character set.
+//
+// @since 3.17.0
+func (c *CompletionListItemDefaults) GetCommitCharacters() []string {
+	if c == nil {
+		return *new([]string)
+	}
+	return c.CommitCharacters
+}
+
+// A default edit range.
+//
+// @since 3.17.0
+func (c *CompletionListItemDefaults) GetEditRange() *RangeOrInsertReplaceRange {
+	if c == nil {
+		return *new(*RangeOrInsertReplaceRange)
+	}
+	return c.EditRange
+}
+
+// A default insert text format.
+//
+// @since 3.17.0
+func (c *CompletionListItemDefaults) GetInsertTextFormat() InsertTextFormat {
+	if c == nil {
+		return *new(InsertTextFormat)
+	}
+	return c.InsertTextFormat
+}
+
+// A default insert text mode.
+//
+// @since 3.17.0
+func (c *CompletionListItemDefaults) GetInsertTextMode() InsertTextMode {
+	if c == nil {
+		return *new(InsertTextMode)
+	}
+	return c.InsertTextMode
+}
+
+// A default data value.
+//
+// @since 3.17.0
+func (c *CompletionListItemDefaults) GetData() LSPAny {
+	if c == nil {
+		return *new(LSPAny)
+	}
+	return c.Data
+}
+
+// Completion options.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#completionOptions
+type CompletionOptions struct {
+	*WorkDoneProgressOptions
+	// Most tools trigger completion request automatically without explicitly requesting
+	// it using a keyboard shortcut (e.g. Ctrl+Space). Typically they do so when the user
+	// starts to type an identifier. For example if the user types `c` in a JavaScript file
+	// code complete will automatically pop up present `console` besides others as a
+	// completion item. Characters that make up identifiers don't need to be listed here.
+	//
+	// If code complete should automatically be trigger on characters not being valid inside
+	// an identifier (for example `.` in JavaScript) list them in `triggerCharacters`.
+	TriggerCharacters []string `json:"triggerCharacters,omitempty"`
+	// The list of all possible characters that commit a completion. This field can be used
+	// if clients don't support individual commit characters per completion item. See
+	// `ClientCapabilities.textDocument.completion.completionItem.commitCharactersSupport`
+	//
+	// If a server provides both `allCommitCharacters` and commit characters on an individual
+	// completion item the ones on the completion item win.
+	//
+	// @since 3.2.0
+	AllCommitCharacters []string `json:"allCommitCharacters,omitempty"`
+	// The server provides support to resolve additional
+	// information for a completion item.
+	ResolveProvider bool `json:"resolveProvider,omitempty"`
+	// The server supports the following `CompletionItem` specific
+	// capabilities.
+	//
+	// @since 3.17.0
+	CompletionItem *CompletionOptionsCompletionItem `json:"completionItem,omitempty"`
 }
 
-// The token types that the client supports.
-func (s *SemanticTokensClientCapabilities) GetTokenTypes() []string {
-	if s == nil {
+// Most tools trigger completion request automatically without explicitly requesting
+// it using a keyboard shortcut (e.g. Ctrl+Space). Typically they do so when the user
+// starts to type an identifier. For example if the user types `c` in a JavaScript file
+// code complete will automatically pop up present `console` besides others as a
+// completion item. Characters that make up identifiers don't need to be listed here.
+//
+// If code complete should automatically be trigger on characters not being valid inside
+// an identifier (for example `.` in JavaScript) list them in `triggerCharacters`.
+func (c *CompletionOptions) GetTriggerCharacters() []string {
+	if c == nil {
 		var zero []string
 		return zero
 	}
-	return s.TokenTypes
+	return c.TriggerCharacters
 }
 
-// The token modifiers that the client supports.
-func (s *SemanticTokensClientCapabilities) GetTokenModifiers() []string {
-	if s == nil {
+// The list of all possible characters that commit a completion. This field can be used
+// if clients don't support individual commit characters per completion item. See
+// `ClientCapabilities.textDocument.completion.completionItem.commitCharactersSupport`
+//
+// If a server provides both `allCommitCharacters` and commit characters on an individual
+// completion item the ones on the completion item win.
+//
+// @since 3.2.0
+func (c *CompletionOptions) GetAllCommitCharacters() []string {
+	if c == nil {
 		var zero []string
 		return zero
 	}
-	return s.TokenModifiers
+	return c.AllCommitCharacters
 }
 
-// The token formats the clients supports.
-func (s *SemanticTokensClientCapabilities) GetFormats() []TokenFormat {
-	if s == nil {
-		var zero []TokenFormat
+// The server provides support to resolve additional
+// information for a completion item.
+func (c *CompletionOptions) GetResolveProvider() bool {
+	if c == nil {
+		var zero bool
 		return zero
 	}
-	return s.Formats
+	return c.ResolveProvider
 }
 
-// Whether the client supports tokens that can overlap each other.
-func (s *SemanticTokensClientCapabilities) GetOverlappingTokenSupport() bool {
-	if s == nil {
-		var zero bool
+// The server supports the following `CompletionItem` specific
+// capabilities.
+//
+// @since 3.17.0
+func (c *CompletionOptions) GetCompletionItem() *CompletionOptionsCompletionItem {
+	if c == nil {
+		var zero *CompletionOptionsCompletionItem
 		return zero
 	}
-	return s.OverlappingTokenSupport
+	return c.CompletionItem
 }
 
-// Whether the client supports tokens that can span multiple lines.
-func (s *SemanticTokensClientCapabilities) GetMultilineTokenSupport() bool {
-	if s == nil {
-		var zero bool
-		return zero
-	}
-	return s.MultilineTokenSupport
+type CompletionOptionsCompletionItem struct {
+	// The server has support for completion item label
+	// details (see also `CompletionItemLabelDetails`) when
+	// receiving a completion item in a resolve call.
+	//
+	// @since 3.17.0
+	LabelDetailsSupport bool `json:"labelDetailsSupport,omitempty"`
 }
 
-// Whether the client allows the server to actively cancel a
-// semantic token request, e.g. supports returning
-// LSPErrorCodes.ServerCancelled. If a server does the client
-// needs to retrigger the request.
+// The server has support for completion item label
+// details (see also `CompletionItemLabelDetails`) when
+// receiving a completion item in a resolve call.
 //
 // @since 3.17.0
-func (s *SemanticTokensClientCapabilities) GetServerCancelSupport() bool {
-	if s == nil {
-		var zero bool
-		return zero
+func (c *CompletionOptionsCompletionItem) GetLabelDetailsSupport() bool {
+	if c == nil {
+		return *new(bool)
 	}
-	return s.ServerCancelSupport
+	return c.LabelDetailsSupport
 }
 
-// Whether the client uses semantic tokens to augment existing
-// syntax tokens. If set to `true` client side created syntax
-// tokens and semantic tokens are both used for colorization. If
-// set to `false` the client only uses the returned semantic tokens
-// for colorization.
-//
-// If the value is `undefined` then the client behavior is not
-// specified.
+// Completion parameters
 //
-// @since 3.17.0
-func (s *SemanticTokensClientCapabilities) GetAugmentsSyntaxTokens() bool {
-	if s == nil {
-		var zero bool
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#completionParams
+type CompletionParams struct {
+	*TextDocumentPositionParams
+	*WorkDoneProgressParams
+	*PartialResultParams
+	// The completion context. This is only available if the client specifies
+	// to send this using the client capability `textDocument.completion.contextSupport === true`
+	Context *CompletionContext `json:"context,omitempty"`
+}
+
+// The completion context. This is only available if the client specifies
+// to send this using the client capability `textDocument.completion.contextSupport === true`
+func (c *CompletionParams) GetContext() *CompletionContext {
+	if c == nil {
+		var zero *CompletionContext
 		return zero
 	}
-	return s.AugmentsSyntaxTokens
+	return c.Context
 }
 
-// Client capabilities for the linked editing range request.
-//
-// @since 3.16.0
+// How a completion was triggered
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#linkedEditingRangeClientCapabilities
-type LinkedEditingRangeClientCapabilities struct {
-	// Whether implementation supports dynamic registration. If this is set to `true`
-	// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
-	// return value for the corresponding server capability as well.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#completionTriggerKind
+type CompletionTriggerKind uint32
+
+const (
+	// Completion was triggered by typing an identifier (24x7 code
+	// complete), manual invocation (e.g Ctrl+Space) or via API.
+	CompletionTriggerKindInvoked CompletionTriggerKind = 1
+	// Completion was triggered by a trigger character specified by
+	// the `triggerCharacters` properties of the `CompletionRegistrationOptions`.
+	CompletionTriggerKindTriggerCharacter CompletionTriggerKind = 2
+	// Completion was re-triggered as current completion list is incomplete
+	CompletionTriggerKindTriggerForIncompleteCompletions CompletionTriggerKind = 3
+)
+
+var validCompletionTriggerKindValues = map[uint32]bool{
+	1: true,
+	2: true,
+	3: true,
 }
 
-// Whether implementation supports dynamic registration. If this is set to `true`
-// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
-// return value for the corresponding server capability as well.
-func (l *LinkedEditingRangeClientCapabilities) GetDynamicRegistration() bool {
-	if l == nil {
-		var zero bool
-		return zero
+func (c *CompletionTriggerKind) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
 	}
-	return l.DynamicRegistration
+	var uint32Value uint32
+	if err := json.Unmarshal(data, &uint32Value); err != nil {
+		return err
+	}
+	if !validCompletionTriggerKindValues[uint32Value] {
+		return fmt.Errorf("cannot unmarshal %v into CompletionTriggerKind: custom values are not supported", uint32Value)
+	}
+	*c = CompletionTriggerKind(uint32Value)
+
+	return nil
 }
 
-// Client capabilities specific to the moniker request.
-//
-// @since 3.16.0
+func (c CompletionTriggerKind) MarshalJSON() ([]byte, error) {
+	var uint32Value = uint32(c)
+	if !validCompletionTriggerKindValues[uint32Value] {
+		return nil, fmt.Errorf("cannot marshal %v into CompletionTriggerKind: custom values are not supported", uint32Value)
+	}
+	return json.Marshal(uint32Value)
+
+}
+
+// Create file operation.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#monikerClientCapabilities
-type MonikerClientCapabilities struct {
-	// Whether moniker supports dynamic registration. If this is set to `true`
-	// the client supports the new `MonikerRegistrationOptions` return value
-	// for the corresponding server capability as well.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#createFile
+type CreateFile struct {
+	*ResourceOperation
+	// A create
+	Kind CreateFileKind `json:"kind"`
+	// The resource to create.
+	Uri string `json:"uri"`
+	// Additional options
+	Options *CreateFileOptions `json:"options,omitempty"`
 }
 
-// Whether moniker supports dynamic registration. If this is set to `true`
-// the client supports the new `MonikerRegistrationOptions` return value
-// for the corresponding server capability as well.
-func (m *MonikerClientCapabilities) GetDynamicRegistration() bool {
-	if m == nil {
-		var zero bool
+// A create
+func (c *CreateFile) GetKind() CreateFileKind {
+	if c == nil {
+		var zero CreateFileKind
 		return zero
 	}
-	return m.DynamicRegistration
+	return c.Kind
 }
 
-// @since 3.17.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#typeHierarchyClientCapabilities
-type TypeHierarchyClientCapabilities struct {
-	// Whether implementation supports dynamic registration. If this is set to `true`
-	// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
-	// return value for the corresponding server capability as well.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+// The resource to create.
+func (c *CreateFile) GetUri() string {
+	if c == nil {
+		var zero string
+		return zero
+	}
+	return c.Uri
 }
 
-// Whether implementation supports dynamic registration. If this is set to `true`
-// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
-// return value for the corresponding server capability as well.
-func (t *TypeHierarchyClientCapabilities) GetDynamicRegistration() bool {
-	if t == nil {
-		var zero bool
+// Additional options
+func (c *CreateFile) GetOptions() *CreateFileOptions {
+	if c == nil {
+		var zero *CreateFileOptions
 		return zero
 	}
-	return t.DynamicRegistration
+	return c.Options
+}
+
+type CreateFileKind struct{}
+
+func (CreateFileKind) String() string {
+	return "create"
+}
+
+func (*CreateFileKind) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	if value != "create" {
+		return fmt.Errorf("cannot unmarshal %v into CreateFileKind: value must be create", value)
+	}
+	return nil
 }
 
-// Client capabilities specific to inline values.
-//
-// @since 3.17.0
+func (CreateFileKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal("create")
+}
+
+// Options to create a file.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#inlineValueClientCapabilities
-type InlineValueClientCapabilities struct {
-	// Whether implementation supports dynamic registration for inline value providers.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#createFileOptions
+type CreateFileOptions struct {
+	// Overwrite existing file. Overwrite wins over `ignoreIfExists`
+	Overwrite bool `json:"overwrite,omitempty"`
+	// Ignore if exists.
+	IgnoreIfExists bool `json:"ignoreIfExists,omitempty"`
 }
 
-// Whether implementation supports dynamic registration for inline value providers.
-func (i *InlineValueClientCapabilities) GetDynamicRegistration() bool {
-	if i == nil {
+// Overwrite existing file. Overwrite wins over `ignoreIfExists`
+func (c *CreateFileOptions) GetOverwrite() bool {
+	if c == nil {
 		var zero bool
 		return zero
 	}
-	return i.DynamicRegistration
-}
-
-type InlayHintClientCapabilitiesResolveSupport struct {
-	// The properties that a client can resolve lazily.
-	Properties []string `json:"properties"`
+	return c.Overwrite
 }
 
-// The properties that a client can resolve lazily.
-func (i *InlayHintClientCapabilitiesResolveSupport) GetProperties() []string {
-	if i == nil {
-		return *new([]string)
+// Ignore if exists.
+func (c *CreateFileOptions) GetIgnoreIfExists() bool {
+	if c == nil {
+		var zero bool
+		return zero
 	}
-	return i.Properties
+	return c.IgnoreIfExists
 }
 
-// Inlay hint client capabilities.
-//
-// @since 3.17.0
+type Decimal float64
+
+// @since 3.14.0
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#inlayHintClientCapabilities
-type InlayHintClientCapabilities struct {
-	// Whether inlay hints support dynamic registration.
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#declarationClientCapabilities
+type DeclarationClientCapabilities struct {
+	// Whether declaration supports dynamic registration. If this is set to `true`
+	// the client supports the new `DeclarationRegistrationOptions` return value
+	// for the corresponding server capability as well.
 	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
-	// Indicates which properties a client can resolve lazily on an inlay
-	// hint.
-	ResolveSupport *InlayHintClientCapabilitiesResolveSupport `json:"resolveSupport,omitempty"`
+	// The client supports additional metadata in the form of declaration links.
+	LinkSupport bool `json:"linkSupport,omitempty"`
 }
 
-// Whether inlay hints support dynamic registration.
-func (i *InlayHintClientCapabilities) GetDynamicRegistration() bool {
-	if i == nil {
+// Whether declaration supports dynamic registration. If this is set to `true`
+// the client supports the new `DeclarationRegistrationOptions` return value
+// for the corresponding server capability as well.
+func (d *DeclarationClientCapabilities) GetDynamicRegistration() bool {
+	if d == nil {
 		var zero bool
 		return zero
 	}
-	return i.DynamicRegistration
+	return d.DynamicRegistration
 }
 
-// Indicates which properties a client can resolve lazily on an inlay
-// hint.
-func (i *InlayHintClientCapabilities) GetResolveSupport() *InlayHintClientCapabilitiesResolveSupport {
-	if i == nil {
-		var zero *InlayHintClientCapabilitiesResolveSupport
+// The client supports additional metadata in the form of declaration links.
+func (d *DeclarationClientCapabilities) GetLinkSupport() bool {
+	if d == nil {
+		var zero bool
 		return zero
 	}
-	return i.ResolveSupport
+	return d.LinkSupport
 }
 
-// Client capabilities specific to diagnostic pull requests.
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#declarationOptions
+type DeclarationOptions struct {
+	*WorkDoneProgressOptions
+}
+
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#declarationRegistrationOptions
+type DeclarationRegistrationOptions struct {
+	*DeclarationOptions
+	*TextDocumentRegistrationOptions
+	*StaticRegistrationOptions
+}
+
+// The definition of a symbol represented as one or many {@link Location locations}.
+// For most programming languages there is only one location at which a symbol is
+// defined.
 //
-// @since 3.17.0
+// Servers should prefer returning `DefinitionLink` over `Definition` if supported
+// by the client.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#diagnosticClientCapabilities
-type DiagnosticClientCapabilities struct {
-	// Whether implementation supports dynamic registration. If this is set to `true`
-	// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
-	// return value for the corresponding server capability as well.
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#definition
+type Definition = *LocationOrLocationSlice
+
+// Client Capabilities for a {@link DefinitionRequest}.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#definitionClientCapabilities
+type DefinitionClientCapabilities struct {
+	// Whether definition supports dynamic registration.
 	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
-	// Whether the clients supports related documents for document diagnostic pulls.
-	RelatedDocumentSupport bool `json:"relatedDocumentSupport,omitempty"`
+	// The client supports additional metadata in the form of definition links.
+	//
+	// @since 3.14.0
+	LinkSupport bool `json:"linkSupport,omitempty"`
 }
 
-// Whether implementation supports dynamic registration. If this is set to `true`
-// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
-// return value for the corresponding server capability as well.
-func (d *DiagnosticClientCapabilities) GetDynamicRegistration() bool {
+// Whether definition supports dynamic registration.
+func (d *DefinitionClientCapabilities) GetDynamicRegistration() bool {
 	if d == nil {
 		var zero bool
 		return zero
@@ -3468,6480 +3836,6897 @@ func (d *DiagnosticClientCapabilities) GetDynamicRegistration() bool {
 	return d.DynamicRegistration
 }
 
-// Whether the clients supports related documents for document diagnostic pulls.
-func (d *DiagnosticClientCapabilities) GetRelatedDocumentSupport() bool {
+// The client supports additional metadata in the form of definition links.
+//
+// @since 3.14.0
+func (d *DefinitionClientCapabilities) GetLinkSupport() bool {
 	if d == nil {
 		var zero bool
 		return zero
 	}
-	return d.RelatedDocumentSupport
+	return d.LinkSupport
 }
 
-// Client capabilities specific to inline completions.
+// Information about where a symbol is defined.
 //
-// @since 3.18.0
-// @proposed
+// Provides additional metadata over normal {@link Location location} definitions, including the range of
+// the defining symbol
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#inlineCompletionClientCapabilities
-type InlineCompletionClientCapabilities struct {
-	// Whether implementation supports dynamic registration for inline completion providers.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
-}
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#definitionLink
+type DefinitionLink = *LocationLink
 
-// Whether implementation supports dynamic registration for inline completion providers.
-func (i *InlineCompletionClientCapabilities) GetDynamicRegistration() bool {
-	if i == nil {
-		var zero bool
-		return zero
-	}
-	return i.DynamicRegistration
-}
+type DefinitionLinkSlice []DefinitionLink
 
-// Text document specific client capabilities.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocumentClientCapabilities
-type TextDocumentClientCapabilities struct {
-	// Defines which synchronization capabilities the client supports.
-	Synchronization *TextDocumentSyncClientCapabilities `json:"synchronization,omitempty"`
-	// Capabilities specific to the `textDocument/completion` request.
-	Completion *CompletionClientCapabilities `json:"completion,omitempty"`
-	// Capabilities specific to the `textDocument/hover` request.
-	Hover *HoverClientCapabilities `json:"hover,omitempty"`
-	// Capabilities specific to the `textDocument/signatureHelp` request.
-	SignatureHelp *SignatureHelpClientCapabilities `json:"signatureHelp,omitempty"`
-	// Capabilities specific to the `textDocument/declaration` request.
-	//
-	// @since 3.14.0
-	Declaration *DeclarationClientCapabilities `json:"declaration,omitempty"`
-	// Capabilities specific to the `textDocument/definition` request.
-	Definition *DefinitionClientCapabilities `json:"definition,omitempty"`
-	// Capabilities specific to the `textDocument/typeDefinition` request.
-	//
-	// @since 3.6.0
-	TypeDefinition *TypeDefinitionClientCapabilities `json:"typeDefinition,omitempty"`
-	// Capabilities specific to the `textDocument/implementation` request.
-	//
-	// @since 3.6.0
-	Implementation *ImplementationClientCapabilities `json:"implementation,omitempty"`
-	// Capabilities specific to the `textDocument/references` request.
-	References *ReferenceClientCapabilities `json:"references,omitempty"`
-	// Capabilities specific to the `textDocument/documentHighlight` request.
-	DocumentHighlight *DocumentHighlightClientCapabilities `json:"documentHighlight,omitempty"`
-	// Capabilities specific to the `textDocument/documentSymbol` request.
-	DocumentSymbol *DocumentSymbolClientCapabilities `json:"documentSymbol,omitempty"`
-	// Capabilities specific to the `textDocument/codeAction` request.
-	CodeAction *CodeActionClientCapabilities `json:"codeAction,omitempty"`
-	// Capabilities specific to the `textDocument/codeLens` request.
-	CodeLens *CodeLensClientCapabilities `json:"codeLens,omitempty"`
-	// Capabilities specific to the `textDocument/documentLink` request.
-	DocumentLink *DocumentLinkClientCapabilities `json:"documentLink,omitempty"`
-	// Capabilities specific to the `textDocument/documentColor` and the
-	// `textDocument/colorPresentation` request.
-	//
-	// @since 3.6.0
-	ColorProvider *DocumentColorClientCapabilities `json:"colorProvider,omitempty"`
-	// Capabilities specific to the `textDocument/formatting` request.
-	Formatting *DocumentFormattingClientCapabilities `json:"formatting,omitempty"`
-	// Capabilities specific to the `textDocument/rangeFormatting` request.
-	RangeFormatting *DocumentRangeFormattingClientCapabilities `json:"rangeFormatting,omitempty"`
-	// Capabilities specific to the `textDocument/onTypeFormatting` request.
-	OnTypeFormatting *DocumentOnTypeFormattingClientCapabilities `json:"onTypeFormatting,omitempty"`
-	// Capabilities specific to the `textDocument/rename` request.
-	Rename *RenameClientCapabilities `json:"rename,omitempty"`
-	// Capabilities specific to the `textDocument/foldingRange` request.
-	//
-	// @since 3.10.0
-	FoldingRange *FoldingRangeClientCapabilities `json:"foldingRange,omitempty"`
-	// Capabilities specific to the `textDocument/selectionRange` request.
-	//
-	// @since 3.15.0
-	SelectionRange *SelectionRangeClientCapabilities `json:"selectionRange,omitempty"`
-	// Capabilities specific to the `textDocument/publishDiagnostics` notification.
-	PublishDiagnostics *PublishDiagnosticsClientCapabilities `json:"publishDiagnostics,omitempty"`
-	// Capabilities specific to the various call hierarchy requests.
-	//
-	// @since 3.16.0
-	CallHierarchy *CallHierarchyClientCapabilities `json:"callHierarchy,omitempty"`
-	// Capabilities specific to the various semantic token request.
-	//
-	// @since 3.16.0
-	SemanticTokens *SemanticTokensClientCapabilities `json:"semanticTokens,omitempty"`
-	// Capabilities specific to the `textDocument/linkedEditingRange` request.
-	//
-	// @since 3.16.0
-	LinkedEditingRange *LinkedEditingRangeClientCapabilities `json:"linkedEditingRange,omitempty"`
-	// Client capabilities specific to the `textDocument/moniker` request.
-	//
-	// @since 3.16.0
-	Moniker *MonikerClientCapabilities `json:"moniker,omitempty"`
-	// Capabilities specific to the various type hierarchy requests.
-	//
-	// @since 3.17.0
-	TypeHierarchy *TypeHierarchyClientCapabilities `json:"typeHierarchy,omitempty"`
-	// Capabilities specific to the `textDocument/inlineValue` request.
-	//
-	// @since 3.17.0
-	InlineValue *InlineValueClientCapabilities `json:"inlineValue,omitempty"`
-	// Capabilities specific to the `textDocument/inlayHint` request.
-	//
-	// @since 3.17.0
-	InlayHint *InlayHintClientCapabilities `json:"inlayHint,omitempty"`
-	// Capabilities specific to the diagnostic pull model.
-	//
-	// @since 3.17.0
-	Diagnostic *DiagnosticClientCapabilities `json:"diagnostic,omitempty"`
-	// Client capabilities specific to inline completions.
-	//
-	// @since 3.18.0
-	// @proposed
-	InlineCompletion *InlineCompletionClientCapabilities `json:"inlineCompletion,omitempty"`
+// Server Capabilities for a {@link DefinitionRequest}.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#definitionOptions
+type DefinitionOptions struct {
+	*WorkDoneProgressOptions
 }
 
-// Defines which synchronization capabilities the client supports.
-func (t *TextDocumentClientCapabilities) GetSynchronization() *TextDocumentSyncClientCapabilities {
-	if t == nil {
-		var zero *TextDocumentSyncClientCapabilities
-		return zero
-	}
-	return t.Synchronization
+// DefinitionOrDefinitionLinkSlice contains either of the following types:
+//   - [Definition]
+//   - [DefinitionLinkSlice]
+type DefinitionOrDefinitionLinkSlice struct {
+	Value DefinitionOrDefinitionLinkSliceValue
 }
 
-// Capabilities specific to the `textDocument/completion` request.
-func (t *TextDocumentClientCapabilities) GetCompletion() *CompletionClientCapabilities {
-	if t == nil {
-		var zero *CompletionClientCapabilities
-		return zero
-	}
-	return t.Completion
+// DefinitionOrDefinitionLinkSliceValue is either of the following types:
+//   - [Definition]
+//   - [DefinitionLinkSlice]
+//
+//sumtype:decl
+type DefinitionOrDefinitionLinkSliceValue interface {
+	isDefinitionOrDefinitionLinkSliceValue()
 }
 
-// Capabilities specific to the `textDocument/hover` request.
-func (t *TextDocumentClientCapabilities) GetHover() *HoverClientCapabilities {
-	if t == nil {
-		var zero *HoverClientCapabilities
-		return zero
+func (Definition) isDefinitionOrDefinitionLinkSliceValue()          {}
+func (DefinitionLinkSlice) isDefinitionOrDefinitionLinkSliceValue() {}
+
+func (d *DefinitionOrDefinitionLinkSlice) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var definitionValue Definition
+	if err := json.Unmarshal(data, &definitionValue); err == nil {
+		d.Value = definitionValue
+		return nil
+	}
+	var definitionLinkSliceValue DefinitionLinkSlice
+	if err := json.Unmarshal(data, &definitionLinkSliceValue); err == nil {
+		d.Value = definitionLinkSliceValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*DefinitionOrDefinitionLinkSlice](),
 	}
-	return t.Hover
 }
 
-// Capabilities specific to the `textDocument/signatureHelp` request.
-func (t *TextDocumentClientCapabilities) GetSignatureHelp() *SignatureHelpClientCapabilities {
-	if t == nil {
-		var zero *SignatureHelpClientCapabilities
-		return zero
-	}
-	return t.SignatureHelp
+func (d *DefinitionOrDefinitionLinkSlice) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Value)
 }
 
-// Capabilities specific to the `textDocument/declaration` request.
+// Parameters for a {@link DefinitionRequest}.
 //
-// @since 3.14.0
-func (t *TextDocumentClientCapabilities) GetDeclaration() *DeclarationClientCapabilities {
-	if t == nil {
-		var zero *DeclarationClientCapabilities
-		return zero
-	}
-	return t.Declaration
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#definitionParams
+type DefinitionParams struct {
+	*TextDocumentPositionParams
+	*WorkDoneProgressParams
+	*PartialResultParams
 }
 
-// Capabilities specific to the `textDocument/definition` request.
-func (t *TextDocumentClientCapabilities) GetDefinition() *DefinitionClientCapabilities {
-	if t == nil {
-		var zero *DefinitionClientCapabilities
+// Delete file operation
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#deleteFile
+type DeleteFile struct {
+	*ResourceOperation
+	// A delete
+	Kind DeleteFileKind `json:"kind"`
+	// The file to delete.
+	Uri string `json:"uri"`
+	// Delete options.
+	Options *DeleteFileOptions `json:"options,omitempty"`
+}
+
+// A delete
+func (d *DeleteFile) GetKind() DeleteFileKind {
+	if d == nil {
+		var zero DeleteFileKind
 		return zero
 	}
-	return t.Definition
+	return d.Kind
 }
 
-// Capabilities specific to the `textDocument/typeDefinition` request.
-//
-// @since 3.6.0
-func (t *TextDocumentClientCapabilities) GetTypeDefinition() *TypeDefinitionClientCapabilities {
-	if t == nil {
-		var zero *TypeDefinitionClientCapabilities
+// The file to delete.
+func (d *DeleteFile) GetUri() string {
+	if d == nil {
+		var zero string
 		return zero
 	}
-	return t.TypeDefinition
+	return d.Uri
 }
 
-// Capabilities specific to the `textDocument/implementation` request.
-//
-// @since 3.6.0
-func (t *TextDocumentClientCapabilities) GetImplementation() *ImplementationClientCapabilities {
-	if t == nil {
-		var zero *ImplementationClientCapabilities
+// Delete options.
+func (d *DeleteFile) GetOptions() *DeleteFileOptions {
+	if d == nil {
+		var zero *DeleteFileOptions
 		return zero
 	}
-	return t.Implementation
+	return d.Options
 }
 
-// Capabilities specific to the `textDocument/references` request.
-func (t *TextDocumentClientCapabilities) GetReferences() *ReferenceClientCapabilities {
-	if t == nil {
-		var zero *ReferenceClientCapabilities
-		return zero
+type DeleteFileKind struct{}
+
+func (DeleteFileKind) String() string {
+	return "delete"
+}
+
+func (*DeleteFileKind) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
-	return t.References
+	if value != "delete" {
+		return fmt.Errorf("cannot unmarshal %v into DeleteFileKind: value must be delete", value)
+	}
+	return nil
 }
 
-// Capabilities specific to the `textDocument/documentHighlight` request.
-func (t *TextDocumentClientCapabilities) GetDocumentHighlight() *DocumentHighlightClientCapabilities {
-	if t == nil {
-		var zero *DocumentHighlightClientCapabilities
+func (DeleteFileKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal("delete")
+}
+
+// Delete file options
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#deleteFileOptions
+type DeleteFileOptions struct {
+	// Delete the content recursively if a folder is denoted.
+	Recursive bool `json:"recursive,omitempty"`
+	// Ignore the operation if the file doesn't exist.
+	IgnoreIfNotExists bool `json:"ignoreIfNotExists,omitempty"`
+}
+
+// Delete the content recursively if a folder is denoted.
+func (d *DeleteFileOptions) GetRecursive() bool {
+	if d == nil {
+		var zero bool
 		return zero
 	}
-	return t.DocumentHighlight
+	return d.Recursive
 }
 
-// Capabilities specific to the `textDocument/documentSymbol` request.
-func (t *TextDocumentClientCapabilities) GetDocumentSymbol() *DocumentSymbolClientCapabilities {
-	if t == nil {
-		var zero *DocumentSymbolClientCapabilities
+// Ignore the operation if the file doesn't exist.
+func (d *DeleteFileOptions) GetIgnoreIfNotExists() bool {
+	if d == nil {
+		var zero bool
 		return zero
 	}
-	return t.DocumentSymbol
+	return d.IgnoreIfNotExists
 }
 
-// Capabilities specific to the `textDocument/codeAction` request.
-func (t *TextDocumentClientCapabilities) GetCodeAction() *CodeActionClientCapabilities {
-	if t == nil {
-		var zero *CodeActionClientCapabilities
+// Represents a diagnostic, such as a compiler error or warning. Diagnostic objects
+// are only valid in the scope of a resource.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#diagnostic
+type Diagnostic struct {
+	// The range at which the message applies
+	Range *Range `json:"range"`
+	// The diagnostic's severity. Can be omitted. If omitted it is up to the
+	// client to interpret diagnostics as error, warning, info or hint.
+	Severity DiagnosticSeverity `json:"severity,omitempty"`
+	// The diagnostic's code, which usually appear in the user interface.
+	Code *IntegerOrString `json:"code,omitempty"`
+	// An optional property to describe the error code.
+	// Requires the code field (above) to be present/not null.
+	//
+	// @since 3.16.0
+	CodeDescription *CodeDescription `json:"codeDescription,omitempty"`
+	// A human-readable string describing the source of this
+	// diagnostic, e.g. 'typescript' or 'super lint'. It usually
+	// appears in the user interface.
+	Source string `json:"source,omitempty"`
+	// The diagnostic's message. It usually appears in the user interface
+	Message string `json:"message"`
+	// Additional metadata about the diagnostic.
+	//
+	// @since 3.15.0
+	Tags []DiagnosticTag `json:"tags,omitempty"`
+	// An array of related diagnostic information, e.g. when symbol-names within
+	// a scope collide all definitions can be marked via this property.
+	RelatedInformation []*DiagnosticRelatedInformation `json:"relatedInformation,omitempty"`
+	// A data entry field that is preserved between a `textDocument/publishDiagnostics`
+	// notification and `textDocument/codeAction` request.
+	//
+	// @since 3.16.0
+	Data LSPAny `json:"data,omitempty"`
+}
+
+// The range at which the message applies
+func (d *Diagnostic) GetRange() *Range {
+	if d == nil {
+		var zero *Range
 		return zero
 	}
-	return t.CodeAction
+	return d.Range
 }
 
-// Capabilities specific to the `textDocument/codeLens` request.
-func (t *TextDocumentClientCapabilities) GetCodeLens() *CodeLensClientCapabilities {
-	if t == nil {
-		var zero *CodeLensClientCapabilities
+// The diagnostic's severity. Can be omitted. If omitted it is up to the
+// client to interpret diagnostics as error, warning, info or hint.
+func (d *Diagnostic) GetSeverity() DiagnosticSeverity {
+	if d == nil {
+		var zero DiagnosticSeverity
 		return zero
 	}
-	return t.CodeLens
+	return d.Severity
 }
 
-// Capabilities specific to the `textDocument/documentLink` request.
-func (t *TextDocumentClientCapabilities) GetDocumentLink() *DocumentLinkClientCapabilities {
-	if t == nil {
-		var zero *DocumentLinkClientCapabilities
+// The diagnostic's code, which usually appear in the user interface.
+func (d *Diagnostic) GetCode() *IntegerOrString {
+	if d == nil {
+		var zero *IntegerOrString
 		return zero
 	}
-	return t.DocumentLink
+	return d.Code
 }
 
-// Capabilities specific to the `textDocument/documentColor` and the
-// `textDocument/colorPresentation` request.
+// An optional property to describe the error code.
+// Requires the code field (above) to be present/not null.
 //
-// @since 3.6.0
-func (t *TextDocumentClientCapabilities) GetColorProvider() *DocumentColorClientCapabilities {
-	if t == nil {
-		var zero *DocumentColorClientCapabilities
+// @since 3.16.0
+func (d *Diagnostic) GetCodeDescription() *CodeDescription {
+	if d == nil {
+		var zero *CodeDescription
 		return zero
 	}
-	return t.ColorProvider
+	return d.CodeDescription
 }
 
-// Capabilities specific to the `textDocument/formatting` request.
-func (t *TextDocumentClientCapabilities) GetFormatting() *DocumentFormattingClientCapabilities {
-	if t == nil {
-		var zero *DocumentFormattingClientCapabilities
+// A human-readable string describing the source of this
+// diagnostic, e.g. 'typescript' or 'super lint'. It usually
+// appears in the user interface.
+func (d *Diagnostic) GetSource() string {
+	if d == nil {
+		var zero string
 		return zero
 	}
-	return t.Formatting
+	return d.Source
 }
 
-// Capabilities specific to the `textDocument/rangeFormatting` request.
-func (t *TextDocumentClientCapabilities) GetRangeFormatting() *DocumentRangeFormattingClientCapabilities {
-	if t == nil {
-		var zero *DocumentRangeFormattingClientCapabilities
+// The diagnostic's message. It usually appears in the user interface
+func (d *Diagnostic) GetMessage() string {
+	if d == nil {
+		var zero string
 		return zero
 	}
-	return t.RangeFormatting
+	return d.Message
 }
 
-// Capabilities specific to the `textDocument/onTypeFormatting` request.
-func (t *TextDocumentClientCapabilities) GetOnTypeFormatting() *DocumentOnTypeFormattingClientCapabilities {
-	if t == nil {
-		var zero *DocumentOnTypeFormattingClientCapabilities
+// Additional metadata about the diagnostic.
+//
+// @since 3.15.0
+func (d *Diagnostic) GetTags() []DiagnosticTag {
+	if d == nil {
+		var zero []DiagnosticTag
 		return zero
 	}
-	return t.OnTypeFormatting
+	return d.Tags
 }
 
-// Capabilities specific to the `textDocument/rename` request.
-func (t *TextDocumentClientCapabilities) GetRename() *RenameClientCapabilities {
-	if t == nil {
-		var zero *RenameClientCapabilities
+// An array of related diagnostic information, e.g. when symbol-names within
+// a scope collide all definitions can be marked via this property.
+func (d *Diagnostic) GetRelatedInformation() []*DiagnosticRelatedInformation {
+	if d == nil {
+		var zero []*DiagnosticRelatedInformation
 		return zero
 	}
-	return t.Rename
+	return d.RelatedInformation
 }
 
-// Capabilities specific to the `textDocument/foldingRange` request.
+// A data entry field that is preserved between a `textDocument/publishDiagnostics`
+// notification and `textDocument/codeAction` request.
 //
-// @since 3.10.0
-func (t *TextDocumentClientCapabilities) GetFoldingRange() *FoldingRangeClientCapabilities {
-	if t == nil {
-		var zero *FoldingRangeClientCapabilities
+// @since 3.16.0
+func (d *Diagnostic) GetData() LSPAny {
+	if d == nil {
+		var zero LSPAny
 		return zero
 	}
-	return t.FoldingRange
+	return d.Data
 }
 
-// Capabilities specific to the `textDocument/selectionRange` request.
+// Client capabilities specific to diagnostic pull requests.
 //
-// @since 3.15.0
-func (t *TextDocumentClientCapabilities) GetSelectionRange() *SelectionRangeClientCapabilities {
-	if t == nil {
-		var zero *SelectionRangeClientCapabilities
-		return zero
-	}
-	return t.SelectionRange
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#diagnosticClientCapabilities
+type DiagnosticClientCapabilities struct {
+	// Whether implementation supports dynamic registration. If this is set to `true`
+	// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
+	// return value for the corresponding server capability as well.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	// Whether the clients supports related documents for document diagnostic pulls.
+	RelatedDocumentSupport bool `json:"relatedDocumentSupport,omitempty"`
 }
 
-// Capabilities specific to the `textDocument/publishDiagnostics` notification.
-func (t *TextDocumentClientCapabilities) GetPublishDiagnostics() *PublishDiagnosticsClientCapabilities {
-	if t == nil {
-		var zero *PublishDiagnosticsClientCapabilities
+// Whether implementation supports dynamic registration. If this is set to `true`
+// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
+// return value for the corresponding server capability as well.
+func (d *DiagnosticClientCapabilities) GetDynamicRegistration() bool {
+	if d == nil {
+		var zero bool
 		return zero
 	}
-	return t.PublishDiagnostics
+	return d.DynamicRegistration
 }
 
-// Capabilities specific to the various call hierarchy requests.
-//
-// @since 3.16.0
-func (t *TextDocumentClientCapabilities) GetCallHierarchy() *CallHierarchyClientCapabilities {
-	if t == nil {
-		var zero *CallHierarchyClientCapabilities
+// Whether the clients supports related documents for document diagnostic pulls.
+func (d *DiagnosticClientCapabilities) GetRelatedDocumentSupport() bool {
+	if d == nil {
+		var zero bool
 		return zero
 	}
-	return t.CallHierarchy
+	return d.RelatedDocumentSupport
 }
 
-// Capabilities specific to the various semantic token request.
+// Diagnostic options.
 //
-// @since 3.16.0
-func (t *TextDocumentClientCapabilities) GetSemanticTokens() *SemanticTokensClientCapabilities {
-	if t == nil {
-		var zero *SemanticTokensClientCapabilities
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#diagnosticOptions
+type DiagnosticOptions struct {
+	*WorkDoneProgressOptions
+	// An optional identifier under which the diagnostics are
+	// managed by the client.
+	Identifier string `json:"identifier,omitempty"`
+	// Whether the language has inter file dependencies meaning that
+	// editing code in one file can result in a different diagnostic
+	// set in another file. Inter file dependencies are common for
+	// most programming languages and typically uncommon for linters.
+	InterFileDependencies bool `json:"interFileDependencies"`
+	// The server provides support for workspace diagnostics as well.
+	WorkspaceDiagnostics bool `json:"workspaceDiagnostics"`
+}
+
+// An optional identifier under which the diagnostics are
+// managed by the client.
+func (d *DiagnosticOptions) GetIdentifier() string {
+	if d == nil {
+		var zero string
 		return zero
 	}
-	return t.SemanticTokens
+	return d.Identifier
 }
 
-// Capabilities specific to the `textDocument/linkedEditingRange` request.
-//
-// @since 3.16.0
-func (t *TextDocumentClientCapabilities) GetLinkedEditingRange() *LinkedEditingRangeClientCapabilities {
-	if t == nil {
-		var zero *LinkedEditingRangeClientCapabilities
+// Whether the language has inter file dependencies meaning that
+// editing code in one file can result in a different diagnostic
+// set in another file. Inter file dependencies are common for
+// most programming languages and typically uncommon for linters.
+func (d *DiagnosticOptions) GetInterFileDependencies() bool {
+	if d == nil {
+		var zero bool
 		return zero
 	}
-	return t.LinkedEditingRange
+	return d.InterFileDependencies
 }
 
-// Client capabilities specific to the `textDocument/moniker` request.
-//
-// @since 3.16.0
-func (t *TextDocumentClientCapabilities) GetMoniker() *MonikerClientCapabilities {
-	if t == nil {
-		var zero *MonikerClientCapabilities
+// The server provides support for workspace diagnostics as well.
+func (d *DiagnosticOptions) GetWorkspaceDiagnostics() bool {
+	if d == nil {
+		var zero bool
 		return zero
 	}
-	return t.Moniker
+	return d.WorkspaceDiagnostics
 }
 
-// Capabilities specific to the various type hierarchy requests.
+// DiagnosticOptionsOrDiagnosticRegistrationOptions contains either of the following types:
+//   - [*DiagnosticOptions]
+//   - [*DiagnosticRegistrationOptions]
+type DiagnosticOptionsOrDiagnosticRegistrationOptions struct {
+	Value DiagnosticOptionsOrDiagnosticRegistrationOptionsValue
+}
+
+// DiagnosticOptionsOrDiagnosticRegistrationOptionsValue is either of the following types:
+//   - [*DiagnosticOptions]
+//   - [*DiagnosticRegistrationOptions]
 //
-// @since 3.17.0
-func (t *TextDocumentClientCapabilities) GetTypeHierarchy() *TypeHierarchyClientCapabilities {
-	if t == nil {
-		var zero *TypeHierarchyClientCapabilities
-		return zero
+//sumtype:decl
+type DiagnosticOptionsOrDiagnosticRegistrationOptionsValue interface {
+	isDiagnosticOptionsOrDiagnosticRegistrationOptionsValue()
+}
+
+func (*DiagnosticOptions) isDiagnosticOptionsOrDiagnosticRegistrationOptionsValue()             {}
+func (*DiagnosticRegistrationOptions) isDiagnosticOptionsOrDiagnosticRegistrationOptionsValue() {}
+
+func (d *DiagnosticOptionsOrDiagnosticRegistrationOptions) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var diagnosticOptionsValue *DiagnosticOptions
+	if err := json.Unmarshal(data, &diagnosticOptionsValue); err == nil {
+		d.Value = diagnosticOptionsValue
+		return nil
+	}
+	var diagnosticRegistrationOptionsValue *DiagnosticRegistrationOptions
+	if err := json.Unmarshal(data, &diagnosticRegistrationOptionsValue); err == nil {
+		d.Value = diagnosticRegistrationOptionsValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*DiagnosticOptionsOrDiagnosticRegistrationOptions](),
 	}
-	return t.TypeHierarchy
 }
 
-// Capabilities specific to the `textDocument/inlineValue` request.
+func (d *DiagnosticOptionsOrDiagnosticRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Value)
+}
+
+// Diagnostic registration options.
 //
 // @since 3.17.0
-func (t *TextDocumentClientCapabilities) GetInlineValue() *InlineValueClientCapabilities {
-	if t == nil {
-		var zero *InlineValueClientCapabilities
-		return zero
-	}
-	return t.InlineValue
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#diagnosticRegistrationOptions
+type DiagnosticRegistrationOptions struct {
+	*TextDocumentRegistrationOptions
+	*DiagnosticOptions
+	*StaticRegistrationOptions
 }
 
-// Capabilities specific to the `textDocument/inlayHint` request.
+// Represents a related message and source code location for a diagnostic. This should be
+// used to point to code locations that cause or related to a diagnostics, e.g when duplicating
+// a symbol in a scope.
 //
-// @since 3.17.0
-func (t *TextDocumentClientCapabilities) GetInlayHint() *InlayHintClientCapabilities {
-	if t == nil {
-		var zero *InlayHintClientCapabilities
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#diagnosticRelatedInformation
+type DiagnosticRelatedInformation struct {
+	// The location of this related diagnostic information.
+	Location *Location `json:"location"`
+	// The message of this related diagnostic information.
+	Message string `json:"message"`
+}
+
+// The location of this related diagnostic information.
+func (d *DiagnosticRelatedInformation) GetLocation() *Location {
+	if d == nil {
+		var zero *Location
 		return zero
 	}
-	return t.InlayHint
+	return d.Location
 }
 
-// Capabilities specific to the diagnostic pull model.
-//
-// @since 3.17.0
-func (t *TextDocumentClientCapabilities) GetDiagnostic() *DiagnosticClientCapabilities {
-	if t == nil {
-		var zero *DiagnosticClientCapabilities
+// The message of this related diagnostic information.
+func (d *DiagnosticRelatedInformation) GetMessage() string {
+	if d == nil {
+		var zero string
 		return zero
 	}
-	return t.Diagnostic
+	return d.Message
 }
 
-// Client capabilities specific to inline completions.
+// The diagnostic's severity.
 //
-// @since 3.18.0
-// @proposed
-func (t *TextDocumentClientCapabilities) GetInlineCompletion() *InlineCompletionClientCapabilities {
-	if t == nil {
-		var zero *InlineCompletionClientCapabilities
-		return zero
-	}
-	return t.InlineCompletion
-}
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#diagnosticSeverity
+type DiagnosticSeverity uint32
 
-// Notebook specific client capabilities.
-//
-// @since 3.17.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#notebookDocumentSyncClientCapabilities
-type NotebookDocumentSyncClientCapabilities struct {
-	// Whether implementation supports dynamic registration. If this is
-	// set to `true` the client supports the new
-	// `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
-	// return value for the corresponding server capability as well.
-	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
-	// The client supports sending execution summary data per cell.
-	ExecutionSummarySupport bool `json:"executionSummarySupport,omitempty"`
+const (
+	// Reports an error.
+	DiagnosticSeverityError DiagnosticSeverity = 1
+	// Reports a warning.
+	DiagnosticSeverityWarning DiagnosticSeverity = 2
+	// Reports an information.
+	DiagnosticSeverityInformation DiagnosticSeverity = 3
+	// Reports a hint.
+	DiagnosticSeverityHint DiagnosticSeverity = 4
+)
+
+var validDiagnosticSeverityValues = map[uint32]bool{
+	1: true,
+	2: true,
+	3: true,
+	4: true,
 }
 
-// Whether implementation supports dynamic registration. If this is
-// set to `true` the client supports the new
-// `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
-// return value for the corresponding server capability as well.
-func (n *NotebookDocumentSyncClientCapabilities) GetDynamicRegistration() bool {
-	if n == nil {
-		var zero bool
-		return zero
+func (d *DiagnosticSeverity) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
 	}
-	return n.DynamicRegistration
+	var uint32Value uint32
+	if err := json.Unmarshal(data, &uint32Value); err != nil {
+		return err
+	}
+	if !validDiagnosticSeverityValues[uint32Value] {
+		return fmt.Errorf("cannot unmarshal %v into DiagnosticSeverity: custom values are not supported", uint32Value)
+	}
+	*d = DiagnosticSeverity(uint32Value)
+
+	return nil
 }
 
-// The client supports sending execution summary data per cell.
-func (n *NotebookDocumentSyncClientCapabilities) GetExecutionSummarySupport() bool {
-	if n == nil {
-		var zero bool
-		return zero
+func (d DiagnosticSeverity) MarshalJSON() ([]byte, error) {
+	var uint32Value = uint32(d)
+	if !validDiagnosticSeverityValues[uint32Value] {
+		return nil, fmt.Errorf("cannot marshal %v into DiagnosticSeverity: custom values are not supported", uint32Value)
 	}
-	return n.ExecutionSummarySupport
+	return json.Marshal(uint32Value)
+
 }
 
-// Capabilities specific to the notebook document support.
+// The diagnostic tags.
 //
-// @since 3.17.0
+// @since 3.15.0
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#notebookDocumentClientCapabilities
-type NotebookDocumentClientCapabilities struct {
-	// Capabilities specific to notebook document synchronization
-	//
-	// @since 3.17.0
-	Synchronization *NotebookDocumentSyncClientCapabilities `json:"synchronization"`
-}
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#diagnosticTag
+type DiagnosticTag uint32
 
-// Capabilities specific to notebook document synchronization
-//
-// @since 3.17.0
-func (n *NotebookDocumentClientCapabilities) GetSynchronization() *NotebookDocumentSyncClientCapabilities {
-	if n == nil {
-		var zero *NotebookDocumentSyncClientCapabilities
-		return zero
-	}
-	return n.Synchronization
-}
+const (
+	// Unused or unnecessary code.
+	//
+	// Clients are allowed to render diagnostics with this tag faded out instead of having
+	// an error squiggle.
+	DiagnosticTagUnnecessary DiagnosticTag = 1
+	// Deprecated or obsolete code.
+	//
+	// Clients are allowed to rendered diagnostics with this tag strike through.
+	DiagnosticTagDeprecated DiagnosticTag = 2
+)
 
-type ShowMessageRequestClientCapabilitiesMessageActionItem struct {
-	// Whether the client supports additional attributes which
-	// are preserved and send back to the server in the
-	// request's response.
-	AdditionalPropertiesSupport bool `json:"additionalPropertiesSupport,omitempty"`
+var validDiagnosticTagValues = map[uint32]bool{
+	1: true,
+	2: true,
 }
 
-// Whether the client supports additional attributes which
-// are preserved and send back to the server in the
-// request's response.
-func (s *ShowMessageRequestClientCapabilitiesMessageActionItem) GetAdditionalPropertiesSupport() bool {
-	if s == nil {
-		return *new(bool)
+func (d *DiagnosticTag) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
 	}
-	return s.AdditionalPropertiesSupport
-}
+	var uint32Value uint32
+	if err := json.Unmarshal(data, &uint32Value); err != nil {
+		return err
+	}
+	if !validDiagnosticTagValues[uint32Value] {
+		return fmt.Errorf("cannot unmarshal %v into DiagnosticTag: custom values are not supported", uint32Value)
+	}
+	*d = DiagnosticTag(uint32Value)
 
-// Show message request client capabilities
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#showMessageRequestClientCapabilities
-type ShowMessageRequestClientCapabilities struct {
-	// Capabilities specific to the `MessageActionItem` type.
-	MessageActionItem *ShowMessageRequestClientCapabilitiesMessageActionItem `json:"messageActionItem,omitempty"`
+	return nil
 }
 
-// Capabilities specific to the `MessageActionItem` type.
-func (s *ShowMessageRequestClientCapabilities) GetMessageActionItem() *ShowMessageRequestClientCapabilitiesMessageActionItem {
-	if s == nil {
-		var zero *ShowMessageRequestClientCapabilitiesMessageActionItem
-		return zero
+func (d DiagnosticTag) MarshalJSON() ([]byte, error) {
+	var uint32Value = uint32(d)
+	if !validDiagnosticTagValues[uint32Value] {
+		return nil, fmt.Errorf("cannot marshal %v into DiagnosticTag: custom values are not supported", uint32Value)
 	}
-	return s.MessageActionItem
+	return json.Marshal(uint32Value)
+
 }
 
-// Client capabilities for the showDocument request.
+// Workspace client capabilities specific to diagnostic pull requests.
 //
-// @since 3.16.0
+// @since 3.17.0
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#showDocumentClientCapabilities
-type ShowDocumentClientCapabilities struct {
-	// The client has support for the showDocument
-	// request.
-	Support bool `json:"support"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#diagnosticWorkspaceClientCapabilities
+type DiagnosticWorkspaceClientCapabilities struct {
+	// Whether the client implementation supports a refresh request sent from
+	// the server to the client.
+	//
+	// Note that this event is global and will force the client to refresh all
+	// pulled diagnostics currently shown. It should be used with absolute care and
+	// is useful for situation where a server for example detects a project wide
+	// change that requires such a calculation.
+	RefreshSupport bool `json:"refreshSupport,omitempty"`
 }
 
-// The client has support for the showDocument
-// request.
-func (s *ShowDocumentClientCapabilities) GetSupport() bool {
-	if s == nil {
+// Whether the client implementation supports a refresh request sent from
+// the server to the client.
+//
+// Note that this event is global and will force the client to refresh all
+// pulled diagnostics currently shown. It should be used with absolute care and
+// is useful for situation where a server for example detects a project wide
+// change that requires such a calculation.
+func (d *DiagnosticWorkspaceClientCapabilities) GetRefreshSupport() bool {
+	if d == nil {
 		var zero bool
 		return zero
 	}
-	return s.Support
+	return d.RefreshSupport
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#windowClientCapabilities
-type WindowClientCapabilities struct {
-	// It indicates whether the client supports server initiated
-	// progress using the `window/workDoneProgress/create` request.
-	//
-	// The capability also controls Whether client supports handling
-	// of progress notifications. If set servers are allowed to report a
-	// `workDoneProgress` property in the request specific server
-	// capabilities.
-	//
-	// @since 3.15.0
-	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
-	// Capabilities specific to the showMessage request.
-	//
-	// @since 3.16.0
-	ShowMessage *ShowMessageRequestClientCapabilities `json:"showMessage,omitempty"`
-	// Capabilities specific to the showDocument request.
-	//
-	// @since 3.16.0
-	ShowDocument *ShowDocumentClientCapabilities `json:"showDocument,omitempty"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#didChangeConfigurationClientCapabilities
+type DidChangeConfigurationClientCapabilities struct {
+	// Did change configuration notification supports dynamic registration.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
 }
 
-// It indicates whether the client supports server initiated
-// progress using the `window/workDoneProgress/create` request.
-//
-// The capability also controls Whether client supports handling
-// of progress notifications. If set servers are allowed to report a
-// `workDoneProgress` property in the request specific server
-// capabilities.
-//
-// @since 3.15.0
-func (w *WindowClientCapabilities) GetWorkDoneProgress() bool {
-	if w == nil {
+// Did change configuration notification supports dynamic registration.
+func (d *DidChangeConfigurationClientCapabilities) GetDynamicRegistration() bool {
+	if d == nil {
 		var zero bool
 		return zero
 	}
-	return w.WorkDoneProgress
+	return d.DynamicRegistration
 }
 
-// Capabilities specific to the showMessage request.
+// The change text document notification's parameters.
 //
-// @since 3.16.0
-func (w *WindowClientCapabilities) GetShowMessage() *ShowMessageRequestClientCapabilities {
-	if w == nil {
-		var zero *ShowMessageRequestClientCapabilities
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#didChangeTextDocumentParams
+type DidChangeTextDocumentParams struct {
+	// The document that did change. The version number points
+	// to the version after all provided content changes have
+	// been applied.
+	TextDocument *VersionedTextDocumentIdentifier `json:"textDocument"`
+	// The actual content changes. The content changes describe single state changes
+	// to the document. So if there are two content changes c1 (at array index 0) and
+	// c2 (at array index 1) for a document in state S then c1 moves the document from
+	// S to S' and c2 from S' to S''. So c1 is computed on the state S and c2 is computed
+	// on the state S'.
+	//
+	// To mirror the content of a document using change events use the following approach:
+	// - start with the same initial content
+	// - apply the 'textDocument/didChange' notifications in the order you receive them.
+	// - apply the `TextDocumentContentChangeEvent`s in a single notification in the order
+	//   you receive them.
+	ContentChanges []TextDocumentContentChangeEvent `json:"contentChanges"`
+}
+
+// The document that did change. The version number points
+// to the version after all provided content changes have
+// been applied.
+func (d *DidChangeTextDocumentParams) GetTextDocument() *VersionedTextDocumentIdentifier {
+	if d == nil {
+		var zero *VersionedTextDocumentIdentifier
 		return zero
 	}
-	return w.ShowMessage
+	return d.TextDocument
 }
 
-// Capabilities specific to the showDocument request.
+// The actual content changes. The content changes describe single state changes
+// to the document. So if there are two content changes c1 (at array index 0) and
+// c2 (at array index 1) for a document in state S then c1 moves the document from
+// S to S' and c2 from S' to S”. So c1 is computed on the state S and c2 is computed
+// on the state S'.
 //
-// @since 3.16.0
-func (w *WindowClientCapabilities) GetShowDocument() *ShowDocumentClientCapabilities {
-	if w == nil {
-		var zero *ShowDocumentClientCapabilities
+// To mirror the content of a document using change events use the following approach:
+//   - start with the same initial content
+//   - apply the 'textDocument/didChange' notifications in the order you receive them.
+//   - apply the `TextDocumentContentChangeEvent`s in a single notification in the order
+//     you receive them.
+func (d *DidChangeTextDocumentParams) GetContentChanges() []TextDocumentContentChangeEvent {
+	if d == nil {
+		var zero []TextDocumentContentChangeEvent
 		return zero
 	}
-	return w.ShowDocument
+	return d.ContentChanges
 }
 
-type GeneralClientCapabilitiesStaleRequestSupport struct {
-	// The client will actively cancel the request.
-	Cancel bool `json:"cancel"`
-	// The list of requests for which the client
-	// will retry the request if it receives a
-	// response with error code `ContentModified`
-	RetryOnContentModified []string `json:"retryOnContentModified"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#didChangeWatchedFilesClientCapabilities
+type DidChangeWatchedFilesClientCapabilities struct {
+	// Did change watched files notification supports dynamic registration. Please note
+	// that the current protocol doesn't support static configuration for file changes
+	// from the server side.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	// Whether the client has support for {@link  RelativePattern relative pattern}
+	// or not.
+	//
+	// @since 3.17.0
+	RelativePatternSupport bool `json:"relativePatternSupport,omitempty"`
 }
 
-// The client will actively cancel the request.
-func (g *GeneralClientCapabilitiesStaleRequestSupport) GetCancel() bool {
-	if g == nil {
-		return *new(bool)
+// Did change watched files notification supports dynamic registration. Please note
+// that the current protocol doesn't support static configuration for file changes
+// from the server side.
+func (d *DidChangeWatchedFilesClientCapabilities) GetDynamicRegistration() bool {
+	if d == nil {
+		var zero bool
+		return zero
 	}
-	return g.Cancel
+	return d.DynamicRegistration
 }
 
-// The list of requests for which the client
-// will retry the request if it receives a
-// response with error code `ContentModified`
-func (g *GeneralClientCapabilitiesStaleRequestSupport) GetRetryOnContentModified() []string {
-	if g == nil {
-		return *new([]string)
+// Whether the client has support for {@link  RelativePattern relative pattern}
+// or not.
+//
+// @since 3.17.0
+func (d *DidChangeWatchedFilesClientCapabilities) GetRelativePatternSupport() bool {
+	if d == nil {
+		var zero bool
+		return zero
 	}
-	return g.RetryOnContentModified
+	return d.RelativePatternSupport
 }
 
-// Client capabilities specific to regular expressions.
-//
-// @since 3.16.0
+// The watched files change notification's parameters.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#regularExpressionsClientCapabilities
-type RegularExpressionsClientCapabilities struct {
-	// The engine's name.
-	Engine string `json:"engine"`
-	// The engine's version.
-	Version string `json:"version,omitempty"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#didChangeWatchedFilesParams
+type DidChangeWatchedFilesParams struct {
+	// The actual file events.
+	Changes []*FileEvent `json:"changes"`
 }
 
-// The engine's name.
-func (r *RegularExpressionsClientCapabilities) GetEngine() string {
-	if r == nil {
-		var zero string
+// The actual file events.
+func (d *DidChangeWatchedFilesParams) GetChanges() []*FileEvent {
+	if d == nil {
+		var zero []*FileEvent
 		return zero
 	}
-	return r.Engine
+	return d.Changes
 }
 
-// The engine's version.
-func (r *RegularExpressionsClientCapabilities) GetVersion() string {
-	if r == nil {
-		var zero string
+// The parameters sent in a close text document notification
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#didCloseTextDocumentParams
+type DidCloseTextDocumentParams struct {
+	// The document that was closed.
+	TextDocument *TextDocumentIdentifier `json:"textDocument"`
+}
+
+// The document that was closed.
+func (d *DidCloseTextDocumentParams) GetTextDocument() *TextDocumentIdentifier {
+	if d == nil {
+		var zero *TextDocumentIdentifier
 		return zero
 	}
-	return r.Version
+	return d.TextDocument
 }
 
-// Client capabilities specific to the used markdown parser.
-//
-// @since 3.16.0
+// The parameters sent in an open text document notification
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#markdownClientCapabilities
-type MarkdownClientCapabilities struct {
-	// The name of the parser.
-	Parser string `json:"parser"`
-	// The version of the parser.
-	Version string `json:"version,omitempty"`
-	// A list of HTML tags that the client allows / supports in
-	// Markdown.
-	//
-	// @since 3.17.0
-	AllowedTags []string `json:"allowedTags,omitempty"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#didOpenTextDocumentParams
+type DidOpenTextDocumentParams struct {
+	// The document that was opened.
+	TextDocument *TextDocumentItem `json:"textDocument"`
 }
 
-// The name of the parser.
-func (m *MarkdownClientCapabilities) GetParser() string {
-	if m == nil {
-		var zero string
+// The document that was opened.
+func (d *DidOpenTextDocumentParams) GetTextDocument() *TextDocumentItem {
+	if d == nil {
+		var zero *TextDocumentItem
 		return zero
 	}
-	return m.Parser
+	return d.TextDocument
 }
 
-// The version of the parser.
-func (m *MarkdownClientCapabilities) GetVersion() string {
-	if m == nil {
-		var zero string
-		return zero
-	}
-	return m.Version
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentColorClientCapabilities
+type DocumentColorClientCapabilities struct {
+	// Whether implementation supports dynamic registration. If this is set to `true`
+	// the client supports the new `DocumentColorRegistrationOptions` return value
+	// for the corresponding server capability as well.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
 }
 
-// A list of HTML tags that the client allows / supports in
-// Markdown.
-//
-// @since 3.17.0
-func (m *MarkdownClientCapabilities) GetAllowedTags() []string {
-	if m == nil {
-		var zero []string
+// Whether implementation supports dynamic registration. If this is set to `true`
+// the client supports the new `DocumentColorRegistrationOptions` return value
+// for the corresponding server capability as well.
+func (d *DocumentColorClientCapabilities) GetDynamicRegistration() bool {
+	if d == nil {
+		var zero bool
 		return zero
 	}
-	return m.AllowedTags
+	return d.DynamicRegistration
 }
 
-// A set of predefined position encoding kinds.
-//
-// @since 3.17.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#positionEncodingKind
-type PositionEncodingKind string
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentColorOptions
+type DocumentColorOptions struct {
+	*WorkDoneProgressOptions
+}
 
-const (
-	// Character offsets count UTF-8 code units (e.g. bytes).
-	PositionEncodingKindUTF8 PositionEncodingKind = "utf-8"
-	// Character offsets count UTF-16 code units.
-	//
-	// This is the default and must always be supported
-	// by servers
-	PositionEncodingKindUTF16 PositionEncodingKind = "utf-16"
-	// Character offsets count UTF-32 code units.
-	//
-	// Implementation note: these are the same as Unicode codepoints,
-	// so this `PositionEncodingKind` may also be used for an
-	// encoding-agnostic representation of character offsets.
-	PositionEncodingKindUTF32 PositionEncodingKind = "utf-32"
-)
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentColorRegistrationOptions
+type DocumentColorRegistrationOptions struct {
+	*TextDocumentRegistrationOptions
+	*DocumentColorOptions
+	*StaticRegistrationOptions
+}
 
-// General client capabilities.
+// A document filter describes a top level text document or
+// a notebook cell document.
 //
-// @since 3.16.0
+// @since 3.17.0 - proposed support for NotebookCellTextDocumentFilter.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#generalClientCapabilities
-type GeneralClientCapabilities struct {
-	// Client capability that signals how the client
-	// handles stale requests (e.g. a request
-	// for which the client will not process the response
-	// anymore since the information is outdated).
-	//
-	// @since 3.17.0
-	StaleRequestSupport *GeneralClientCapabilitiesStaleRequestSupport `json:"staleRequestSupport,omitempty"`
-	// Client capabilities specific to regular expressions.
-	//
-	// @since 3.16.0
-	RegularExpressions *RegularExpressionsClientCapabilities `json:"regularExpressions,omitempty"`
-	// Client capabilities specific to the client's markdown parser.
-	//
-	// @since 3.16.0
-	Markdown *MarkdownClientCapabilities `json:"markdown,omitempty"`
-	// The position encodings supported by the client. Client and server
-	// have to agree on the same position encoding to ensure that offsets
-	// (e.g. character position in a line) are interpreted the same on both
-	// sides.
-	//
-	// To keep the protocol backwards compatible the following applies: if
-	// the value 'utf-16' is missing from the array of position encodings
-	// servers can assume that the client supports UTF-16. UTF-16 is
-	// therefore a mandatory encoding.
-	//
-	// If omitted it defaults to ['utf-16'].
-	//
-	// Implementation considerations: since the conversion from one encoding
-	// into another requires the content of the file / line the conversion
-	// is best done where the file is read which is usually on the server
-	// side.
-	//
-	// @since 3.17.0
-	PositionEncodings []PositionEncodingKind `json:"positionEncodings,omitempty"`
-}
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentFilter
+type DocumentFilter = *TextDocumentFilterOrNotebookCellTextDocumentFilter
 
-// Client capability that signals how the client
-// handles stale requests (e.g. a request
-// for which the client will not process the response
-// anymore since the information is outdated).
+// Client capabilities of a {@link DocumentFormattingRequest}.
 //
-// @since 3.17.0
-func (g *GeneralClientCapabilities) GetStaleRequestSupport() *GeneralClientCapabilitiesStaleRequestSupport {
-	if g == nil {
-		var zero *GeneralClientCapabilitiesStaleRequestSupport
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentFormattingClientCapabilities
+type DocumentFormattingClientCapabilities struct {
+	// Whether formatting supports dynamic registration.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+}
+
+// Whether formatting supports dynamic registration.
+func (d *DocumentFormattingClientCapabilities) GetDynamicRegistration() bool {
+	if d == nil {
+		var zero bool
 		return zero
 	}
-	return g.StaleRequestSupport
+	return d.DynamicRegistration
 }
 
-// Client capabilities specific to regular expressions.
+// Provider options for a {@link DocumentFormattingRequest}.
 //
-// @since 3.16.0
-func (g *GeneralClientCapabilities) GetRegularExpressions() *RegularExpressionsClientCapabilities {
-	if g == nil {
-		var zero *RegularExpressionsClientCapabilities
-		return zero
-	}
-	return g.RegularExpressions
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentFormattingOptions
+type DocumentFormattingOptions struct {
+	*WorkDoneProgressOptions
 }
 
-// Client capabilities specific to the client's markdown parser.
+// The parameters of a {@link DocumentFormattingRequest}.
 //
-// @since 3.16.0
-func (g *GeneralClientCapabilities) GetMarkdown() *MarkdownClientCapabilities {
-	if g == nil {
-		var zero *MarkdownClientCapabilities
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentFormattingParams
+type DocumentFormattingParams struct {
+	*WorkDoneProgressParams
+	// The document to format.
+	TextDocument *TextDocumentIdentifier `json:"textDocument"`
+	// The format options.
+	Options *FormattingOptions `json:"options"`
+}
+
+// The document to format.
+func (d *DocumentFormattingParams) GetTextDocument() *TextDocumentIdentifier {
+	if d == nil {
+		var zero *TextDocumentIdentifier
 		return zero
 	}
-	return g.Markdown
+	return d.TextDocument
 }
 
-// The position encodings supported by the client. Client and server
-// have to agree on the same position encoding to ensure that offsets
-// (e.g. character position in a line) are interpreted the same on both
-// sides.
-//
-// To keep the protocol backwards compatible the following applies: if
-// the value 'utf-16' is missing from the array of position encodings
-// servers can assume that the client supports UTF-16. UTF-16 is
-// therefore a mandatory encoding.
-//
-// If omitted it defaults to ['utf-16'].
-//
-// Implementation considerations: since the conversion from one encoding
-// into another requires the content of the file / line the conversion
-// is best done where the file is read which is usually on the server
-// side.
-//
-// @since 3.17.0
-func (g *GeneralClientCapabilities) GetPositionEncodings() []PositionEncodingKind {
-	if g == nil {
-		var zero []PositionEncodingKind
+// The format options.
+func (d *DocumentFormattingParams) GetOptions() *FormattingOptions {
+	if d == nil {
+		var zero *FormattingOptions
 		return zero
 	}
-	return g.PositionEncodings
+	return d.Options
 }
 
-type stringLSPAnyMap map[string]LSPAny
-
-// LSP object definition.
-// @since 3.17.0
+// Client Capabilities for a {@link DocumentHighlightRequest}.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#lSPObject
-type LSPObject = stringLSPAnyMap
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentHighlightClientCapabilities
+type DocumentHighlightClientCapabilities struct {
+	// Whether document highlight supports dynamic registration.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+}
 
-type LSPAnySlice []LSPAny
+// Whether document highlight supports dynamic registration.
+func (d *DocumentHighlightClientCapabilities) GetDynamicRegistration() bool {
+	if d == nil {
+		var zero bool
+		return zero
+	}
+	return d.DynamicRegistration
+}
 
-// LSP arrays.
-// @since 3.17.0
+// Provider options for a {@link DocumentHighlightRequest}.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#lSPArray
-type LSPArray = LSPAnySlice
-
-type Uinteger int
-
-type Decimal float64
-
-// LSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBoolean contains either of the following types:
-//   - [LSPObject]
-//   - [LSPArray]
-//   - [String]
-//   - [Integer]
-//   - [Uinteger]
-//   - [Decimal]
-//   - [Boolean]
-type LSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBoolean struct {
-	Value LSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBooleanValue
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentHighlightOptions
+type DocumentHighlightOptions struct {
+	*WorkDoneProgressOptions
 }
 
-// LSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBooleanValue is either of the following types:
-//   - [LSPObject]
-//   - [LSPArray]
-//   - [String]
-//   - [Integer]
-//   - [Uinteger]
-//   - [Decimal]
-//   - [Boolean]
+// The client capabilities of a {@link DocumentLinkRequest}.
 //
-//sumtype:decl
-type LSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBooleanValue interface {
-	isLSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBooleanValue()
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentLinkClientCapabilities
+type DocumentLinkClientCapabilities struct {
+	// Whether document link supports dynamic registration.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	// Whether the client supports the `tooltip` property on `DocumentLink`.
+	//
+	// @since 3.15.0
+	TooltipSupport bool `json:"tooltipSupport,omitempty"`
 }
 
-func (LSPObject) isLSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBooleanValue() {}
-func (LSPArray) isLSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBooleanValue()  {}
-func (String) isLSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBooleanValue()    {}
-func (Integer) isLSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBooleanValue()   {}
-func (Uinteger) isLSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBooleanValue()  {}
-func (Decimal) isLSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBooleanValue()   {}
-func (Boolean) isLSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBooleanValue()   {}
-
-func (l *LSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBoolean) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var lSPObjectValue LSPObject
-	if err := json.Unmarshal(data, &lSPObjectValue); err == nil {
-		l.Value = lSPObjectValue
-		return nil
-	}
-	var lSPArrayValue LSPArray
-	if err := json.Unmarshal(data, &lSPArrayValue); err == nil {
-		l.Value = lSPArrayValue
-		return nil
-	}
-	var stringValue String
-	if err := json.Unmarshal(data, &stringValue); err == nil {
-		l.Value = stringValue
-		return nil
-	}
-	var integerValue Integer
-	if err := json.Unmarshal(data, &integerValue); err == nil {
-		l.Value = integerValue
-		return nil
-	}
-	var uintegerValue Uinteger
-	if err := json.Unmarshal(data, &uintegerValue); err == nil {
-		l.Value = uintegerValue
-		return nil
-	}
-	var decimalValue Decimal
-	if err := json.Unmarshal(data, &decimalValue); err == nil {
-		l.Value = decimalValue
-		return nil
-	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		l.Value = booleanValue
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*LSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBoolean](),
+// Whether document link supports dynamic registration.
+func (d *DocumentLinkClientCapabilities) GetDynamicRegistration() bool {
+	if d == nil {
+		var zero bool
+		return zero
 	}
+	return d.DynamicRegistration
 }
 
-func (l *LSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBoolean) MarshalJSON() ([]byte, error) {
-	return json.Marshal(l.Value)
+// Whether the client supports the `tooltip` property on `DocumentLink`.
+//
+// @since 3.15.0
+func (d *DocumentLinkClientCapabilities) GetTooltipSupport() bool {
+	if d == nil {
+		var zero bool
+		return zero
+	}
+	return d.TooltipSupport
 }
 
-// The LSP any type.
-// Please note that strictly speaking a property with the value `undefined`
-// can't be converted into JSON preserving the property name. However for
-// convenience it is allowed and assumed that all these properties are
-// optional as well.
-// @since 3.17.0
+// Provider options for a {@link DocumentLinkRequest}.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#lSPAny
-type LSPAny = *LSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBoolean
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentLinkOptions
+type DocumentLinkOptions struct {
+	*WorkDoneProgressOptions
+	// Document links have a resolve provider as well.
+	ResolveProvider bool `json:"resolveProvider,omitempty"`
+}
 
-// Defines the capabilities provided by the client.
+// Document links have a resolve provider as well.
+func (d *DocumentLinkOptions) GetResolveProvider() bool {
+	if d == nil {
+		var zero bool
+		return zero
+	}
+	return d.ResolveProvider
+}
+
+// Client capabilities of a {@link DocumentOnTypeFormattingRequest}.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#clientCapabilities
-type ClientCapabilities struct {
-	// Workspace specific client capabilities.
-	Workspace *WorkspaceClientCapabilities `json:"workspace,omitempty"`
-	// Text document specific client capabilities.
-	TextDocument *TextDocumentClientCapabilities `json:"textDocument,omitempty"`
-	// Capabilities specific to the notebook document support.
-	//
-	// @since 3.17.0
-	NotebookDocument *NotebookDocumentClientCapabilities `json:"notebookDocument,omitempty"`
-	// Window specific client capabilities.
-	Window *WindowClientCapabilities `json:"window,omitempty"`
-	// General client capabilities.
-	//
-	// @since 3.16.0
-	General *GeneralClientCapabilities `json:"general,omitempty"`
-	// Experimental client capabilities.
-	Experimental LSPAny `json:"experimental,omitempty"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentOnTypeFormattingClientCapabilities
+type DocumentOnTypeFormattingClientCapabilities struct {
+	// Whether on type formatting supports dynamic registration.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+}
+
+// Whether on type formatting supports dynamic registration.
+func (d *DocumentOnTypeFormattingClientCapabilities) GetDynamicRegistration() bool {
+	if d == nil {
+		var zero bool
+		return zero
+	}
+	return d.DynamicRegistration
+}
+
+// Provider options for a {@link DocumentOnTypeFormattingRequest}.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentOnTypeFormattingOptions
+type DocumentOnTypeFormattingOptions struct {
+	// A character on which formatting should be triggered, like `{`.
+	FirstTriggerCharacter string `json:"firstTriggerCharacter"`
+	// More trigger characters.
+	MoreTriggerCharacter []string `json:"moreTriggerCharacter,omitempty"`
 }
 
-// Workspace specific client capabilities.
-func (c *ClientCapabilities) GetWorkspace() *WorkspaceClientCapabilities {
-	if c == nil {
-		var zero *WorkspaceClientCapabilities
+// A character on which formatting should be triggered, like `{`.
+func (d *DocumentOnTypeFormattingOptions) GetFirstTriggerCharacter() string {
+	if d == nil {
+		var zero string
 		return zero
 	}
-	return c.Workspace
+	return d.FirstTriggerCharacter
 }
 
-// Text document specific client capabilities.
-func (c *ClientCapabilities) GetTextDocument() *TextDocumentClientCapabilities {
-	if c == nil {
-		var zero *TextDocumentClientCapabilities
+// More trigger characters.
+func (d *DocumentOnTypeFormattingOptions) GetMoreTriggerCharacter() []string {
+	if d == nil {
+		var zero []string
 		return zero
 	}
-	return c.TextDocument
+	return d.MoreTriggerCharacter
 }
 
-// Capabilities specific to the notebook document support.
+// The parameters of a {@link DocumentOnTypeFormattingRequest}.
 //
-// @since 3.17.0
-func (c *ClientCapabilities) GetNotebookDocument() *NotebookDocumentClientCapabilities {
-	if c == nil {
-		var zero *NotebookDocumentClientCapabilities
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentOnTypeFormattingParams
+type DocumentOnTypeFormattingParams struct {
+	// The document to format.
+	TextDocument *TextDocumentIdentifier `json:"textDocument"`
+	// The position around which the on type formatting should happen.
+	// This is not necessarily the exact position where the character denoted
+	// by the property `ch` got typed.
+	Position *Position `json:"position"`
+	// The character that has been typed that triggered the formatting
+	// on type request. That is not necessarily the last character that
+	// got inserted into the document since the client could auto insert
+	// characters as well (e.g. like automatic brace completion).
+	Ch string `json:"ch"`
+	// The formatting options.
+	Options *FormattingOptions `json:"options"`
+}
+
+// The document to format.
+func (d *DocumentOnTypeFormattingParams) GetTextDocument() *TextDocumentIdentifier {
+	if d == nil {
+		var zero *TextDocumentIdentifier
 		return zero
 	}
-	return c.NotebookDocument
+	return d.TextDocument
 }
 
-// Window specific client capabilities.
-func (c *ClientCapabilities) GetWindow() *WindowClientCapabilities {
-	if c == nil {
-		var zero *WindowClientCapabilities
+// The position around which the on type formatting should happen.
+// This is not necessarily the exact position where the character denoted
+// by the property `ch` got typed.
+func (d *DocumentOnTypeFormattingParams) GetPosition() *Position {
+	if d == nil {
+		var zero *Position
 		return zero
 	}
-	return c.Window
+	return d.Position
 }
 
-// General client capabilities.
-//
-// @since 3.16.0
-func (c *ClientCapabilities) GetGeneral() *GeneralClientCapabilities {
-	if c == nil {
-		var zero *GeneralClientCapabilities
+// The character that has been typed that triggered the formatting
+// on type request. That is not necessarily the last character that
+// got inserted into the document since the client could auto insert
+// characters as well (e.g. like automatic brace completion).
+func (d *DocumentOnTypeFormattingParams) GetCh() string {
+	if d == nil {
+		var zero string
 		return zero
 	}
-	return c.General
+	return d.Ch
 }
 
-// Experimental client capabilities.
-func (c *ClientCapabilities) GetExperimental() LSPAny {
-	if c == nil {
-		var zero LSPAny
+// The formatting options.
+func (d *DocumentOnTypeFormattingParams) GetOptions() *FormattingOptions {
+	if d == nil {
+		var zero *FormattingOptions
 		return zero
 	}
-	return c.Experimental
+	return d.Options
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#traceValues
-type TraceValues string
-
-const (
-	// Turn tracing off.
-	TraceValuesOff TraceValues = "off"
-	// Trace messages only.
-	TraceValuesMessages TraceValues = "messages"
-	// Verbose message tracing.
-	TraceValuesVerbose TraceValues = "verbose"
-)
-
-var validTraceValuesValues = map[string]bool{
-	"off":      true,
-	"messages": true,
-	"verbose":  true,
+// Client capabilities of a {@link DocumentRangeFormattingRequest}.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentRangeFormattingClientCapabilities
+type DocumentRangeFormattingClientCapabilities struct {
+	// Whether range formatting supports dynamic registration.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	// Whether the client supports formatting multiple ranges at once.
+	//
+	// @since 3.18.0
+	// @proposed
+	RangesSupport bool `json:"rangesSupport,omitempty"`
 }
 
-func (t *TraceValues) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var stringValue string
-	if err := json.Unmarshal(data, &stringValue); err != nil {
-		return err
-	}
-	if !validTraceValuesValues[stringValue] {
-		return fmt.Errorf("cannot unmarshal %v into TraceValues: custom values are not supported", stringValue)
+// Whether range formatting supports dynamic registration.
+func (d *DocumentRangeFormattingClientCapabilities) GetDynamicRegistration() bool {
+	if d == nil {
+		var zero bool
+		return zero
 	}
-	*t = TraceValues(stringValue)
-
-	return nil
+	return d.DynamicRegistration
 }
 
-func (t TraceValues) MarshalJSON() ([]byte, error) {
-	var stringValue = string(t)
-	if !validTraceValuesValues[stringValue] {
-		return nil, fmt.Errorf("cannot marshal %v into TraceValues: custom values are not supported", stringValue)
+// Whether the client supports formatting multiple ranges at once.
+//
+// @since 3.18.0
+// @proposed
+func (d *DocumentRangeFormattingClientCapabilities) GetRangesSupport() bool {
+	if d == nil {
+		var zero bool
+		return zero
 	}
-	return json.Marshal(stringValue)
-
+	return d.RangesSupport
 }
 
-// The initialize parameters
+// Provider options for a {@link DocumentRangeFormattingRequest}.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#xInitializeParams
-type XInitializeParams[InitializationOptions any] struct {
-	*WorkDoneProgressParams
-	// The process Id of the parent process that started
-	// the server.
-	//
-	// Is `null` if the process has not been started by another process.
-	// If the parent process is not alive then the server should exit.
-	ProcessId int `json:"processId"`
-	// Information about the client
-	//
-	// @since 3.15.0
-	ClientInfo *XInitializeParamsClientInfo `json:"clientInfo,omitempty"`
-	// The locale the client is currently showing the user interface
-	// in. This must not necessarily be the locale of the operating
-	// system.
-	//
-	// Uses IETF language tags as the value's syntax
-	// (See https://en.wikipedia.org/wiki/IETF_language_tag)
-	//
-	// @since 3.16.0
-	Locale string `json:"locale,omitempty"`
-	// The rootPath of the workspace. Is null
-	// if no folder is open.
-	//
-	// Deprecated: in favour of rootUri.
-	RootPath string `json:"rootPath,omitempty"`
-	// The rootUri of the workspace. Is null if no
-	// folder is open. If both `rootPath` and `rootUri` are set
-	// `rootUri` wins.
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentRangeFormattingOptions
+type DocumentRangeFormattingOptions struct {
+	*WorkDoneProgressOptions
+	// Whether the server supports formatting multiple ranges at once.
 	//
-	// Deprecated: in favour of workspaceFolders.
-	RootUri string `json:"rootUri"`
-	// The capabilities provided by the client (editor or tool)
-	Capabilities *ClientCapabilities `json:"capabilities"`
-	// User provided initialization options.
-	InitializationOptions InitializationOptions `json:"initializationOptions,omitempty"`
-	// The initial trace setting. If omitted trace is disabled ('off').
-	Trace TraceValues `json:"trace,omitempty"`
+	// @since 3.18.0
+	// @proposed
+	RangesSupport bool `json:"rangesSupport,omitempty"`
 }
 
-// The process Id of the parent process that started
-// the server.
+// Whether the server supports formatting multiple ranges at once.
 //
-// Is `null` if the process has not been started by another process.
-// If the parent process is not alive then the server should exit.
-func (x *XInitializeParams[InitializationOptions]) GetProcessId() int {
-	if x == nil {
-		var zero int
+// @since 3.18.0
+// @proposed
+func (d *DocumentRangeFormattingOptions) GetRangesSupport() bool {
+	if d == nil {
+		var zero bool
 		return zero
 	}
-	return x.ProcessId
+	return d.RangesSupport
 }
 
-// Information about the client
+// A document selector is the combination of one or many document filters.
 //
-// @since 3.15.0
-func (x *XInitializeParams[InitializationOptions]) GetClientInfo() *XInitializeParamsClientInfo {
-	if x == nil {
-		var zero *XInitializeParamsClientInfo
+// @sample `let sel:DocumentSelector = [{ language: 'typescript' }, { language: 'json', pattern: '**∕tsconfig.json' }]`;
+//
+// The use of a string as a document filter is deprecated @since 3.16.0.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentSelector
+type DocumentSelector = []DocumentFilter
+
+// Represents programming constructs like variables, classes, interfaces etc.
+// that appear in a document. Document symbols can be hierarchical and they
+// have two ranges: one that encloses its definition and one that points to
+// its most interesting range, e.g. the range of an identifier.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentSymbol
+type DocumentSymbol struct {
+	// The name of this symbol. Will be displayed in the user interface and therefore must not be
+	// an empty string or a string only consisting of white spaces.
+	Name string `json:"name"`
+	// More detail for this symbol, e.g the signature of a function.
+	Detail string `json:"detail,omitempty"`
+	// The kind of this symbol.
+	Kind SymbolKind `json:"kind"`
+	// Tags for this document symbol.
+	//
+	// @since 3.16.0
+	Tags []SymbolTag `json:"tags,omitempty"`
+	// Indicates if this symbol is deprecated.
+	//
+	// Deprecated: Use tags instead
+	Deprecated bool `json:"deprecated,omitempty"`
+	// The range enclosing this symbol not including leading/trailing whitespace but everything else
+	// like comments. This information is typically used to determine if the clients cursor is
+	// inside the symbol to reveal in the symbol in the UI.
+	Range *Range `json:"range"`
+	// The range that should be selected and revealed when this symbol is being picked, e.g the name of a function.
+	// Must be contained by the `range`.
+	SelectionRange *Range `json:"selectionRange"`
+	// Children of this symbol, e.g. properties of a class.
+	Children []*DocumentSymbol `json:"children,omitempty"`
+}
+
+// The name of this symbol. Will be displayed in the user interface and therefore must not be
+// an empty string or a string only consisting of white spaces.
+func (d *DocumentSymbol) GetName() string {
+	if d == nil {
+		var zero string
 		return zero
 	}
-	return x.ClientInfo
+	return d.Name
 }
 
-// The locale the client is currently showing the user interface
-// in. This must not necessarily be the locale of the operating
-// system.
-//
-// Uses IETF language tags as the value's syntax
-// (See https://en.wikipedia.org/wiki/IETF_language_tag)
-//
-// @since 3.16.0
-func (x *XInitializeParams[InitializationOptions]) GetLocale() string {
-	if x == nil {
+// More detail for this symbol, e.g the signature of a function.
+func (d *DocumentSymbol) GetDetail() string {
+	if d == nil {
 		var zero string
 		return zero
 	}
-	return x.Locale
+	return d.Detail
 }
 
-// The rootPath of the workspace. Is null
-// if no folder is open.
+// The kind of this symbol.
+func (d *DocumentSymbol) GetKind() SymbolKind {
+	if d == nil {
+		var zero SymbolKind
+		return zero
+	}
+	return d.Kind
+}
+
+// Tags for this document symbol.
 //
-// Deprecated: in favour of rootUri.
-func (x *XInitializeParams[InitializationOptions]) GetRootPath() string {
-	if x == nil {
-		var zero string
+// @since 3.16.0
+func (d *DocumentSymbol) GetTags() []SymbolTag {
+	if d == nil {
+		var zero []SymbolTag
 		return zero
 	}
-	return x.RootPath
+	return d.Tags
 }
 
-// The rootUri of the workspace. Is null if no
-// folder is open. If both `rootPath` and `rootUri` are set
-// `rootUri` wins.
+// Indicates if this symbol is deprecated.
 //
-// Deprecated: in favour of workspaceFolders.
-func (x *XInitializeParams[InitializationOptions]) GetRootUri() string {
-	if x == nil {
-		var zero string
+// Deprecated: Use tags instead
+func (d *DocumentSymbol) GetDeprecated() bool {
+	if d == nil {
+		var zero bool
 		return zero
 	}
-	return x.RootUri
+	return d.Deprecated
 }
 
-// The capabilities provided by the client (editor or tool)
-func (x *XInitializeParams[InitializationOptions]) GetCapabilities() *ClientCapabilities {
-	if x == nil {
-		var zero *ClientCapabilities
+// The range enclosing this symbol not including leading/trailing whitespace but everything else
+// like comments. This information is typically used to determine if the clients cursor is
+// inside the symbol to reveal in the symbol in the UI.
+func (d *DocumentSymbol) GetRange() *Range {
+	if d == nil {
+		var zero *Range
 		return zero
 	}
-	return x.Capabilities
+	return d.Range
 }
 
-// User provided initialization options.
-func (x *XInitializeParams[InitializationOptions]) GetInitializationOptions() InitializationOptions {
-	if x == nil {
-		var zero InitializationOptions
+// The range that should be selected and revealed when this symbol is being picked, e.g the name of a function.
+// Must be contained by the `range`.
+func (d *DocumentSymbol) GetSelectionRange() *Range {
+	if d == nil {
+		var zero *Range
 		return zero
 	}
-	return x.InitializationOptions
+	return d.SelectionRange
 }
 
-// The initial trace setting. If omitted trace is disabled ('off').
-func (x *XInitializeParams[InitializationOptions]) GetTrace() TraceValues {
-	if x == nil {
-		var zero TraceValues
+// Children of this symbol, e.g. properties of a class.
+func (d *DocumentSymbol) GetChildren() []*DocumentSymbol {
+	if d == nil {
+		var zero []*DocumentSymbol
 		return zero
 	}
-	return x.Trace
+	return d.Children
 }
 
-// A workspace folder inside a client.
+// Client Capabilities for a {@link DocumentSymbolRequest}.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#workspaceFolder
-type WorkspaceFolder struct {
-	// The associated URI for this workspace folder.
-	Uri string `json:"uri"`
-	// The name of the workspace folder. Used to refer to this
-	// workspace folder in the user interface.
-	Name string `json:"name"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentSymbolClientCapabilities
+type DocumentSymbolClientCapabilities struct {
+	// Whether document symbol supports dynamic registration.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	// Specific capabilities for the `SymbolKind` in the
+	// `textDocument/documentSymbol` request.
+	SymbolKind *DocumentSymbolClientCapabilitiesSymbolKind `json:"symbolKind,omitempty"`
+	// The client supports hierarchical document symbols.
+	HierarchicalDocumentSymbolSupport bool `json:"hierarchicalDocumentSymbolSupport,omitempty"`
+	// The client supports tags on `SymbolInformation`. Tags are supported on
+	// `DocumentSymbol` if `hierarchicalDocumentSymbolSupport` is set to true.
+	// Clients supporting tags have to handle unknown tags gracefully.
+	//
+	// @since 3.16.0
+	TagSupport *DocumentSymbolClientCapabilitiesTagSupport `json:"tagSupport,omitempty"`
+	// The client supports an additional label presented in the UI when
+	// registering a document symbol provider.
+	//
+	// @since 3.16.0
+	LabelSupport bool `json:"labelSupport,omitempty"`
 }
 
-// The associated URI for this workspace folder.
-func (w *WorkspaceFolder) GetUri() string {
-	if w == nil {
-		var zero string
+// Whether document symbol supports dynamic registration.
+func (d *DocumentSymbolClientCapabilities) GetDynamicRegistration() bool {
+	if d == nil {
+		var zero bool
 		return zero
 	}
-	return w.Uri
+	return d.DynamicRegistration
 }
 
-// The name of the workspace folder. Used to refer to this
-// workspace folder in the user interface.
-func (w *WorkspaceFolder) GetName() string {
-	if w == nil {
-		var zero string
+// Specific capabilities for the `SymbolKind` in the
+// `textDocument/documentSymbol` request.
+func (d *DocumentSymbolClientCapabilities) GetSymbolKind() *DocumentSymbolClientCapabilitiesSymbolKind {
+	if d == nil {
+		var zero *DocumentSymbolClientCapabilitiesSymbolKind
 		return zero
 	}
-	return w.Name
+	return d.SymbolKind
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#workspaceFoldersInitializeParams
-type WorkspaceFoldersInitializeParams struct {
-	// The workspace folders configured in the client when the server starts.
-	//
-	// This property is only available if the client supports workspace folders.
-	// It can be `null` if the client supports workspace folders but none are
-	// configured.
-	//
-	// @since 3.6.0
-	WorkspaceFolders []*WorkspaceFolder `json:"workspaceFolders,omitempty"`
+// The client supports hierarchical document symbols.
+func (d *DocumentSymbolClientCapabilities) GetHierarchicalDocumentSymbolSupport() bool {
+	if d == nil {
+		var zero bool
+		return zero
+	}
+	return d.HierarchicalDocumentSymbolSupport
 }
 
-// The workspace folders configured in the client when the server starts.
-//
-// This property is only available if the client supports workspace folders.
-// It can be `null` if the client supports workspace folders but none are
-// configured.
+// The client supports tags on `SymbolInformation`. Tags are supported on
+// `DocumentSymbol` if `hierarchicalDocumentSymbolSupport` is set to true.
+// Clients supporting tags have to handle unknown tags gracefully.
 //
-// @since 3.6.0
-func (w *WorkspaceFoldersInitializeParams) GetWorkspaceFolders() []*WorkspaceFolder {
-	if w == nil {
-		var zero []*WorkspaceFolder
+// @since 3.16.0
+func (d *DocumentSymbolClientCapabilities) GetTagSupport() *DocumentSymbolClientCapabilitiesTagSupport {
+	if d == nil {
+		var zero *DocumentSymbolClientCapabilitiesTagSupport
 		return zero
 	}
-	return w.WorkspaceFolders
-}
-
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#initializeParams
-type InitializeParams[InitializationOptions any] struct {
-	*XInitializeParams[InitializationOptions]
-	*WorkspaceFoldersInitializeParams
+	return d.TagSupport
 }
 
-// Defines how the host (editor) should sync
-// document changes to the language server.
+// The client supports an additional label presented in the UI when
+// registering a document symbol provider.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocumentSyncKind
-type TextDocumentSyncKind uint32
-
-const (
-	// Documents should not be synced at all.
-	TextDocumentSyncKindNone TextDocumentSyncKind = 0
-	// Documents are synced by always sending the full content
-	// of the document.
-	TextDocumentSyncKindFull TextDocumentSyncKind = 1
-	// Documents are synced by sending the full content on open.
-	// After that only incremental updates to the document are
-	// send.
-	TextDocumentSyncKindIncremental TextDocumentSyncKind = 2
-)
+// @since 3.16.0
+func (d *DocumentSymbolClientCapabilities) GetLabelSupport() bool {
+	if d == nil {
+		var zero bool
+		return zero
+	}
+	return d.LabelSupport
+}
 
-var validTextDocumentSyncKindValues = map[uint32]bool{
-	0: true,
-	1: true,
-	2: true,
+type DocumentSymbolClientCapabilitiesSymbolKind struct {
+	// The symbol kind values the client supports. When this
+	// property exists the client also guarantees that it will
+	// handle values outside its set gracefully and falls back
+	// to a default value when unknown.
+	//
+	// If this property is not present the client only supports
+	// the symbol kinds from `File` to `Array` as defined in
+	// the initial version of the protocol.
+	ValueSet []SymbolKind `json:"valueSet,omitempty"`
 }
 
-func (t *TextDocumentSyncKind) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var uint32Value uint32
-	if err := json.Unmarshal(data, &uint32Value); err != nil {
-		return err
-	}
-	if !validTextDocumentSyncKindValues[uint32Value] {
-		return fmt.Errorf("cannot unmarshal %v into TextDocumentSyncKind: custom values are not supported", uint32Value)
+// The symbol kind values the client supports. When this
+// property exists the client also guarantees that it will
+// handle values outside its set gracefully and falls back
+// to a default value when unknown.
+//
+// If this property is not present the client only supports
+// the symbol kinds from `File` to `Array` as defined in
+// the initial version of the protocol.
+func (d *DocumentSymbolClientCapabilitiesSymbolKind) GetValueSet() []SymbolKind {
+	if d == nil {
+		return *new([]SymbolKind)
 	}
-	*t = TextDocumentSyncKind(uint32Value)
+	return d.ValueSet
+}
 
-	return nil
+type DocumentSymbolClientCapabilitiesTagSupport struct {
+	// The tags supported by the client.
+	ValueSet []SymbolTag `json:"valueSet"`
 }
 
-func (t TextDocumentSyncKind) MarshalJSON() ([]byte, error) {
-	var uint32Value = uint32(t)
-	if !validTextDocumentSyncKindValues[uint32Value] {
-		return nil, fmt.Errorf("cannot marshal %v into TextDocumentSyncKind: custom values are not supported", uint32Value)
+// The tags supported by the client.
+func (d *DocumentSymbolClientCapabilitiesTagSupport) GetValueSet() []SymbolTag {
+	if d == nil {
+		return *new([]SymbolTag)
 	}
-	return json.Marshal(uint32Value)
-
+	return d.ValueSet
 }
 
-// Save options.
+// Provider options for a {@link DocumentSymbolRequest}.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#saveOptions
-type SaveOptions struct {
-	// The client is supposed to include the content on save.
-	IncludeText bool `json:"includeText,omitempty"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentSymbolOptions
+type DocumentSymbolOptions struct {
+	*WorkDoneProgressOptions
+	// A human-readable string that is shown when multiple outlines trees
+	// are shown for the same document.
+	//
+	// @since 3.16.0
+	Label string `json:"label,omitempty"`
 }
 
-// The client is supposed to include the content on save.
-func (s *SaveOptions) GetIncludeText() bool {
-	if s == nil {
-		var zero bool
+// A human-readable string that is shown when multiple outlines trees
+// are shown for the same document.
+//
+// @since 3.16.0
+func (d *DocumentSymbolOptions) GetLabel() string {
+	if d == nil {
+		var zero string
 		return zero
 	}
-	return s.IncludeText
-}
-
-// BooleanOrSaveOptions contains either of the following types:
-//   - [Boolean]
-//   - [*SaveOptions]
-type BooleanOrSaveOptions struct {
-	Value BooleanOrSaveOptionsValue
+	return d.Label
 }
 
-// BooleanOrSaveOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*SaveOptions]
+// Parameters for a {@link DocumentSymbolRequest}.
 //
-//sumtype:decl
-type BooleanOrSaveOptionsValue interface {
-	isBooleanOrSaveOptionsValue()
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentSymbolParams
+type DocumentSymbolParams struct {
+	*WorkDoneProgressParams
+	*PartialResultParams
+	// The text document.
+	TextDocument *TextDocumentIdentifier `json:"textDocument"`
 }
 
-func (Boolean) isBooleanOrSaveOptionsValue()      {}
-func (*SaveOptions) isBooleanOrSaveOptionsValue() {}
-
-func (b *BooleanOrSaveOptions) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
-		return nil
-	}
-	var saveOptionsValue *SaveOptions
-	if err := json.Unmarshal(data, &saveOptionsValue); err == nil {
-		b.Value = saveOptionsValue
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrSaveOptions](),
+// The text document.
+func (d *DocumentSymbolParams) GetTextDocument() *TextDocumentIdentifier {
+	if d == nil {
+		var zero *TextDocumentIdentifier
+		return zero
 	}
+	return d.TextDocument
 }
 
-func (b *BooleanOrSaveOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
-}
+type DocumentSymbolSlice []*DocumentSymbol
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocumentSyncOptions
-type TextDocumentSyncOptions struct {
-	// Open and close notifications are sent to the server. If omitted open close notification should not
-	// be sent.
-	OpenClose bool `json:"openClose,omitempty"`
-	// Change notifications are sent to the server. See TextDocumentSyncKind.None, TextDocumentSyncKind.Full
-	// and TextDocumentSyncKind.Incremental. If omitted it defaults to TextDocumentSyncKind.None.
-	Change TextDocumentSyncKind `json:"change,omitempty"`
-	// If present will save notifications are sent to the server. If omitted the notification should not be
-	// sent.
-	WillSave bool `json:"willSave,omitempty"`
-	// If present will save wait until requests are sent to the server. If omitted the request should not be
-	// sent.
-	WillSaveWaitUntil bool `json:"willSaveWaitUntil,omitempty"`
-	// If present save notifications are sent to the server. If omitted the notification should not be
-	// sent.
-	Save *BooleanOrSaveOptions `json:"save,omitempty"`
-}
+// Predefined error codes.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#errorCodes
+type ErrorCodes int32
 
-// Open and close notifications are sent to the server. If omitted open close notification should not
-// be sent.
-func (t *TextDocumentSyncOptions) GetOpenClose() bool {
-	if t == nil {
-		var zero bool
-		return zero
-	}
-	return t.OpenClose
-}
+const (
+	ErrorCodesParseError     ErrorCodes = -32700
+	ErrorCodesInvalidRequest ErrorCodes = -32600
+	ErrorCodesMethodNotFound ErrorCodes = -32601
+	ErrorCodesInvalidParams  ErrorCodes = -32602
+	ErrorCodesInternalError  ErrorCodes = -32603
+	// Error code indicating that a server received a notification or
+	// request before the server has received the `initialize` request.
+	ErrorCodesServerNotInitialized ErrorCodes = -32002
+	ErrorCodesUnknownErrorCode     ErrorCodes = -32001
+)
 
-// Change notifications are sent to the server. See TextDocumentSyncKind.None, TextDocumentSyncKind.Full
-// and TextDocumentSyncKind.Incremental. If omitted it defaults to TextDocumentSyncKind.None.
-func (t *TextDocumentSyncOptions) GetChange() TextDocumentSyncKind {
-	if t == nil {
-		var zero TextDocumentSyncKind
-		return zero
-	}
-	return t.Change
+// The client capabilities of a {@link ExecuteCommandRequest}.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#executeCommandClientCapabilities
+type ExecuteCommandClientCapabilities struct {
+	// Execute command supports dynamic registration.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
 }
 
-// If present will save notifications are sent to the server. If omitted the notification should not be
-// sent.
-func (t *TextDocumentSyncOptions) GetWillSave() bool {
-	if t == nil {
+// Execute command supports dynamic registration.
+func (e *ExecuteCommandClientCapabilities) GetDynamicRegistration() bool {
+	if e == nil {
 		var zero bool
 		return zero
 	}
-	return t.WillSave
+	return e.DynamicRegistration
 }
 
-// If present will save wait until requests are sent to the server. If omitted the request should not be
-// sent.
-func (t *TextDocumentSyncOptions) GetWillSaveWaitUntil() bool {
-	if t == nil {
-		var zero bool
-		return zero
-	}
-	return t.WillSaveWaitUntil
+// The server capabilities of a {@link ExecuteCommandRequest}.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#executeCommandOptions
+type ExecuteCommandOptions struct {
+	*WorkDoneProgressOptions
+	// The commands to be executed on the server
+	Commands []string `json:"commands"`
 }
 
-// If present save notifications are sent to the server. If omitted the notification should not be
-// sent.
-func (t *TextDocumentSyncOptions) GetSave() *BooleanOrSaveOptions {
-	if t == nil {
-		var zero *BooleanOrSaveOptions
+// The commands to be executed on the server
+func (e *ExecuteCommandOptions) GetCommands() []string {
+	if e == nil {
+		var zero []string
 		return zero
 	}
-	return t.Save
+	return e.Commands
 }
 
-// TextDocumentSyncOptionsOrTextDocumentSyncKind contains either of the following types:
-//   - [*TextDocumentSyncOptions]
-//   - [TextDocumentSyncKind]
-type TextDocumentSyncOptionsOrTextDocumentSyncKind struct {
-	Value TextDocumentSyncOptionsOrTextDocumentSyncKindValue
-}
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#failureHandlingKind
+type FailureHandlingKind string
 
-// TextDocumentSyncOptionsOrTextDocumentSyncKindValue is either of the following types:
-//   - [*TextDocumentSyncOptions]
-//   - [TextDocumentSyncKind]
-//
-//sumtype:decl
-type TextDocumentSyncOptionsOrTextDocumentSyncKindValue interface {
-	isTextDocumentSyncOptionsOrTextDocumentSyncKindValue()
-}
+const (
+	// Applying the workspace change is simply aborted if one of the changes provided
+	// fails. All operations executed before the failing operation stay executed.
+	FailureHandlingKindAbort FailureHandlingKind = "abort"
+	// All operations are executed transactional. That means they either all
+	// succeed or no changes at all are applied to the workspace.
+	FailureHandlingKindTransactional FailureHandlingKind = "transactional"
+	// If the workspace edit contains only textual file changes they are executed transactional.
+	// If resource changes (create, rename or delete file) are part of the change the failure
+	// handling strategy is abort.
+	FailureHandlingKindTextOnlyTransactional FailureHandlingKind = "textOnlyTransactional"
+	// The client tries to undo the operations already executed. But there is no
+	// guarantee that this is succeeding.
+	FailureHandlingKindUndo FailureHandlingKind = "undo"
+)
 
-func (*TextDocumentSyncOptions) isTextDocumentSyncOptionsOrTextDocumentSyncKindValue() {}
-func (TextDocumentSyncKind) isTextDocumentSyncOptionsOrTextDocumentSyncKindValue()     {}
+var validFailureHandlingKindValues = map[string]bool{
+	"abort":                 true,
+	"transactional":         true,
+	"textOnlyTransactional": true,
+	"undo":                  true,
+}
 
-func (t *TextDocumentSyncOptionsOrTextDocumentSyncKind) UnmarshalJSON(data []byte) error {
+func (f *FailureHandlingKind) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var textDocumentSyncOptionsValue *TextDocumentSyncOptions
-	if err := json.Unmarshal(data, &textDocumentSyncOptionsValue); err == nil {
-		t.Value = textDocumentSyncOptionsValue
-		return nil
-	}
-	var textDocumentSyncKindValue TextDocumentSyncKind
-	if err := json.Unmarshal(data, &textDocumentSyncKindValue); err == nil {
-		t.Value = textDocumentSyncKindValue
-		return nil
+	var stringValue string
+	if err := json.Unmarshal(data, &stringValue); err != nil {
+		return err
 	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*TextDocumentSyncOptionsOrTextDocumentSyncKind](),
+	if !validFailureHandlingKindValues[stringValue] {
+		return fmt.Errorf("cannot unmarshal %v into FailureHandlingKind: custom values are not supported", stringValue)
 	}
-}
+	*f = FailureHandlingKind(stringValue)
 
-func (t *TextDocumentSyncOptionsOrTextDocumentSyncKind) MarshalJSON() ([]byte, error) {
-	return json.Marshal(t.Value)
+	return nil
 }
 
-type NotebookDocumentFilterOr1 struct {
-	// The type of the enclosing notebook.
-	NotebookType string `json:"notebookType"`
-	// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
-	Scheme string `json:"scheme,omitempty"`
-	// A glob pattern.
-	Pattern string `json:"pattern,omitempty"`
+func (f FailureHandlingKind) MarshalJSON() ([]byte, error) {
+	var stringValue = string(f)
+	if !validFailureHandlingKindValues[stringValue] {
+		return nil, fmt.Errorf("cannot marshal %v into FailureHandlingKind: custom values are not supported", stringValue)
+	}
+	return json.Marshal(stringValue)
+
 }
 
-// The type of the enclosing notebook.
-func (n *NotebookDocumentFilterOr1) GetNotebookType() string {
-	if n == nil {
-		return *new(string)
-	}
-	return n.NotebookType
+// The file event type
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#fileChangeType
+type FileChangeType uint32
+
+const (
+	// The file got created.
+	FileChangeTypeCreated FileChangeType = 1
+	// The file got changed.
+	FileChangeTypeChanged FileChangeType = 2
+	// The file got deleted.
+	FileChangeTypeDeleted FileChangeType = 3
+)
+
+var validFileChangeTypeValues = map[uint32]bool{
+	1: true,
+	2: true,
+	3: true,
 }
 
-// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
-func (n *NotebookDocumentFilterOr1) GetScheme() string {
-	if n == nil {
-		return *new(string)
+func (f *FileChangeType) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var uint32Value uint32
+	if err := json.Unmarshal(data, &uint32Value); err != nil {
+		return err
 	}
-	return n.Scheme
+	if !validFileChangeTypeValues[uint32Value] {
+		return fmt.Errorf("cannot unmarshal %v into FileChangeType: custom values are not supported", uint32Value)
+	}
+	*f = FileChangeType(uint32Value)
+
+	return nil
 }
 
-// A glob pattern.
-func (n *NotebookDocumentFilterOr1) GetPattern() string {
-	if n == nil {
-		return *new(string)
+func (f FileChangeType) MarshalJSON() ([]byte, error) {
+	var uint32Value = uint32(f)
+	if !validFileChangeTypeValues[uint32Value] {
+		return nil, fmt.Errorf("cannot marshal %v into FileChangeType: custom values are not supported", uint32Value)
 	}
-	return n.Pattern
-}
+	return json.Marshal(uint32Value)
 
-type NotebookDocumentFilterOr2 struct {
-	// The type of the enclosing notebook.
-	NotebookType string `json:"notebookType,omitempty"`
-	// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
-	Scheme string `json:"scheme"`
-	// A glob pattern.
-	Pattern string `json:"pattern,omitempty"`
 }
 
-// The type of the enclosing notebook.
-func (n *NotebookDocumentFilterOr2) GetNotebookType() string {
-	if n == nil {
-		return *new(string)
-	}
-	return n.NotebookType
+// An event describing a file change.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#fileEvent
+type FileEvent struct {
+	// The file's uri.
+	Uri string `json:"uri"`
+	// The change type.
+	Type FileChangeType `json:"type"`
 }
 
-// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
-func (n *NotebookDocumentFilterOr2) GetScheme() string {
-	if n == nil {
-		return *new(string)
+// The file's uri.
+func (f *FileEvent) GetUri() string {
+	if f == nil {
+		var zero string
+		return zero
 	}
-	return n.Scheme
+	return f.Uri
 }
 
-// A glob pattern.
-func (n *NotebookDocumentFilterOr2) GetPattern() string {
-	if n == nil {
-		return *new(string)
+// The change type.
+func (f *FileEvent) GetType() FileChangeType {
+	if f == nil {
+		var zero FileChangeType
+		return zero
 	}
-	return n.Pattern
+	return f.Type
 }
 
-type NotebookDocumentFilterOr3 struct {
-	// The type of the enclosing notebook.
-	NotebookType string `json:"notebookType,omitempty"`
-	// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
-	Scheme string `json:"scheme,omitempty"`
-	// A glob pattern.
-	Pattern string `json:"pattern"`
+// Capabilities relating to events from file operations by the user in the client.
+//
+// These events do not come from the file system, they come from user operations
+// like renaming a file in the UI.
+//
+// @since 3.16.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#fileOperationClientCapabilities
+type FileOperationClientCapabilities struct {
+	// Whether the client supports dynamic registration for file requests/notifications.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	// The client has support for sending didCreateFiles notifications.
+	DidCreate bool `json:"didCreate,omitempty"`
+	// The client has support for sending willCreateFiles requests.
+	WillCreate bool `json:"willCreate,omitempty"`
+	// The client has support for sending didRenameFiles notifications.
+	DidRename bool `json:"didRename,omitempty"`
+	// The client has support for sending willRenameFiles requests.
+	WillRename bool `json:"willRename,omitempty"`
+	// The client has support for sending didDeleteFiles notifications.
+	DidDelete bool `json:"didDelete,omitempty"`
+	// The client has support for sending willDeleteFiles requests.
+	WillDelete bool `json:"willDelete,omitempty"`
 }
 
-// The type of the enclosing notebook.
-func (n *NotebookDocumentFilterOr3) GetNotebookType() string {
-	if n == nil {
-		return *new(string)
+// Whether the client supports dynamic registration for file requests/notifications.
+func (f *FileOperationClientCapabilities) GetDynamicRegistration() bool {
+	if f == nil {
+		var zero bool
+		return zero
 	}
-	return n.NotebookType
+	return f.DynamicRegistration
 }
 
-// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
-func (n *NotebookDocumentFilterOr3) GetScheme() string {
-	if n == nil {
-		return *new(string)
+// The client has support for sending didCreateFiles notifications.
+func (f *FileOperationClientCapabilities) GetDidCreate() bool {
+	if f == nil {
+		var zero bool
+		return zero
 	}
-	return n.Scheme
+	return f.DidCreate
 }
 
-// A glob pattern.
-func (n *NotebookDocumentFilterOr3) GetPattern() string {
-	if n == nil {
-		return *new(string)
+// The client has support for sending willCreateFiles requests.
+func (f *FileOperationClientCapabilities) GetWillCreate() bool {
+	if f == nil {
+		var zero bool
+		return zero
 	}
-	return n.Pattern
-}
-
-// NotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3 contains either of the following types:
-//   - [*NotebookDocumentFilterOr1]
-//   - [*NotebookDocumentFilterOr2]
-//   - [*NotebookDocumentFilterOr3]
-type NotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3 struct {
-	Value NotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3Value
+	return f.WillCreate
 }
 
-// NotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3Value is either of the following types:
-//   - [*NotebookDocumentFilterOr1]
-//   - [*NotebookDocumentFilterOr2]
-//   - [*NotebookDocumentFilterOr3]
-//
-//sumtype:decl
-type NotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3Value interface {
-	isNotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3Value()
+// The client has support for sending didRenameFiles notifications.
+func (f *FileOperationClientCapabilities) GetDidRename() bool {
+	if f == nil {
+		var zero bool
+		return zero
+	}
+	return f.DidRename
 }
 
-func (*NotebookDocumentFilterOr1) isNotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3Value() {
-}
-func (*NotebookDocumentFilterOr2) isNotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3Value() {
-}
-func (*NotebookDocumentFilterOr3) isNotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3Value() {
+// The client has support for sending willRenameFiles requests.
+func (f *FileOperationClientCapabilities) GetWillRename() bool {
+	if f == nil {
+		var zero bool
+		return zero
+	}
+	return f.WillRename
 }
 
-func (n *NotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var notebookDocumentFilterOr1Value *NotebookDocumentFilterOr1
-	if err := json.Unmarshal(data, &notebookDocumentFilterOr1Value); err == nil {
-		n.Value = notebookDocumentFilterOr1Value
-		return nil
-	}
-	var notebookDocumentFilterOr2Value *NotebookDocumentFilterOr2
-	if err := json.Unmarshal(data, &notebookDocumentFilterOr2Value); err == nil {
-		n.Value = notebookDocumentFilterOr2Value
-		return nil
-	}
-	var notebookDocumentFilterOr3Value *NotebookDocumentFilterOr3
-	if err := json.Unmarshal(data, &notebookDocumentFilterOr3Value); err == nil {
-		n.Value = notebookDocumentFilterOr3Value
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*NotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3](),
+// The client has support for sending didDeleteFiles notifications.
+func (f *FileOperationClientCapabilities) GetDidDelete() bool {
+	if f == nil {
+		var zero bool
+		return zero
 	}
+	return f.DidDelete
 }
 
-func (n *NotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Value)
+// The client has support for sending willDeleteFiles requests.
+func (f *FileOperationClientCapabilities) GetWillDelete() bool {
+	if f == nil {
+		var zero bool
+		return zero
+	}
+	return f.WillDelete
 }
 
-// A notebook document filter denotes a notebook document by
-// different properties. The properties will be match
-// against the notebook's URI (same as with documents)
+// A filter to describe in which file operation requests or notifications
+// the server is interested in receiving.
 //
-// @since 3.17.0
+// @since 3.16.0
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#notebookDocumentFilter
-type NotebookDocumentFilter = *NotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3
-
-// StringOrNotebookDocumentFilter contains either of the following types:
-//   - [String]
-//   - [NotebookDocumentFilter]
-type StringOrNotebookDocumentFilter struct {
-	Value StringOrNotebookDocumentFilterValue
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#fileOperationFilter
+type FileOperationFilter struct {
+	// A Uri scheme like `file` or `untitled`.
+	Scheme string `json:"scheme,omitempty"`
+	// The actual file operation pattern.
+	Pattern *FileOperationPattern `json:"pattern"`
 }
 
-// StringOrNotebookDocumentFilterValue is either of the following types:
-//   - [String]
-//   - [NotebookDocumentFilter]
-//
-//sumtype:decl
-type StringOrNotebookDocumentFilterValue interface {
-	isStringOrNotebookDocumentFilterValue()
+// A Uri scheme like `file` or `untitled`.
+func (f *FileOperationFilter) GetScheme() string {
+	if f == nil {
+		var zero string
+		return zero
+	}
+	return f.Scheme
 }
 
-func (String) isStringOrNotebookDocumentFilterValue()                 {}
-func (NotebookDocumentFilter) isStringOrNotebookDocumentFilterValue() {}
-
-func (s *StringOrNotebookDocumentFilter) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var stringValue String
-	if err := json.Unmarshal(data, &stringValue); err == nil {
-		s.Value = stringValue
-		return nil
-	}
-	var notebookDocumentFilterValue NotebookDocumentFilter
-	if err := json.Unmarshal(data, &notebookDocumentFilterValue); err == nil {
-		s.Value = notebookDocumentFilterValue
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*StringOrNotebookDocumentFilter](),
+// The actual file operation pattern.
+func (f *FileOperationFilter) GetPattern() *FileOperationPattern {
+	if f == nil {
+		var zero *FileOperationPattern
+		return zero
 	}
+	return f.Pattern
+}
+
+// Options for notifications/requests for user operations on files.
+//
+// @since 3.16.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#fileOperationOptions
+type FileOperationOptions struct {
+	// The server is interested in receiving didCreateFiles notifications.
+	DidCreate *FileOperationRegistrationOptions `json:"didCreate,omitempty"`
+	// The server is interested in receiving willCreateFiles requests.
+	WillCreate *FileOperationRegistrationOptions `json:"willCreate,omitempty"`
+	// The server is interested in receiving didRenameFiles notifications.
+	DidRename *FileOperationRegistrationOptions `json:"didRename,omitempty"`
+	// The server is interested in receiving willRenameFiles requests.
+	WillRename *FileOperationRegistrationOptions `json:"willRename,omitempty"`
+	// The server is interested in receiving didDeleteFiles file notifications.
+	DidDelete *FileOperationRegistrationOptions `json:"didDelete,omitempty"`
+	// The server is interested in receiving willDeleteFiles file requests.
+	WillDelete *FileOperationRegistrationOptions `json:"willDelete,omitempty"`
 }
 
-func (s *StringOrNotebookDocumentFilter) MarshalJSON() ([]byte, error) {
-	return json.Marshal(s.Value)
+// The server is interested in receiving didCreateFiles notifications.
+func (f *FileOperationOptions) GetDidCreate() *FileOperationRegistrationOptions {
+	if f == nil {
+		var zero *FileOperationRegistrationOptions
+		return zero
+	}
+	return f.DidCreate
 }
 
-type NotebookDocumentSyncOptionsNotebookSelectorOr1Cells struct {
-	Language string `json:"language"`
+// The server is interested in receiving willCreateFiles requests.
+func (f *FileOperationOptions) GetWillCreate() *FileOperationRegistrationOptions {
+	if f == nil {
+		var zero *FileOperationRegistrationOptions
+		return zero
+	}
+	return f.WillCreate
 }
 
-func (n *NotebookDocumentSyncOptionsNotebookSelectorOr1Cells) GetLanguage() string {
-	if n == nil {
-		return *new(string)
+// The server is interested in receiving didRenameFiles notifications.
+func (f *FileOperationOptions) GetDidRename() *FileOperationRegistrationOptions {
+	if f == nil {
+		var zero *FileOperationRegistrationOptions
+		return zero
 	}
-	return n.Language
+	return f.DidRename
 }
 
-type NotebookDocumentSyncOptionsNotebookSelectorOr1 struct {
-	// The notebook to be synced If a string
-	// value is provided it matches against the
-	// notebook type. '*' matches every notebook.
-	Notebook *StringOrNotebookDocumentFilter `json:"notebook"`
-	// The cells of the matching notebook to be synced.
-	Cells []*NotebookDocumentSyncOptionsNotebookSelectorOr1Cells `json:"cells,omitempty"`
+// The server is interested in receiving willRenameFiles requests.
+func (f *FileOperationOptions) GetWillRename() *FileOperationRegistrationOptions {
+	if f == nil {
+		var zero *FileOperationRegistrationOptions
+		return zero
+	}
+	return f.WillRename
 }
 
-// The notebook to be synced If a string
-// value is provided it matches against the
-// notebook type. '*' matches every notebook.
-func (n *NotebookDocumentSyncOptionsNotebookSelectorOr1) GetNotebook() *StringOrNotebookDocumentFilter {
-	if n == nil {
-		return *new(*StringOrNotebookDocumentFilter)
+// The server is interested in receiving didDeleteFiles file notifications.
+func (f *FileOperationOptions) GetDidDelete() *FileOperationRegistrationOptions {
+	if f == nil {
+		var zero *FileOperationRegistrationOptions
+		return zero
 	}
-	return n.Notebook
+	return f.DidDelete
 }
 
-// The cells of the matching notebook to be synced.
-func (n *NotebookDocumentSyncOptionsNotebookSelectorOr1) GetCells() []*NotebookDocumentSyncOptionsNotebookSelectorOr1Cells {
-	if n == nil {
-		return *new([]*NotebookDocumentSyncOptionsNotebookSelectorOr1Cells)
+// The server is interested in receiving willDeleteFiles file requests.
+func (f *FileOperationOptions) GetWillDelete() *FileOperationRegistrationOptions {
+	if f == nil {
+		var zero *FileOperationRegistrationOptions
+		return zero
 	}
-	return n.Cells
+	return f.WillDelete
 }
 
-type NotebookDocumentSyncOptionsNotebookSelectorOr2Cells struct {
-	Language string `json:"language"`
+// A pattern to describe in which file operation requests or notifications
+// the server is interested in receiving.
+//
+// @since 3.16.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#fileOperationPattern
+type FileOperationPattern struct {
+	// The glob pattern to match. Glob patterns can have the following syntax:
+	// - `*` to match one or more characters in a path segment
+	// - `?` to match on one character in a path segment
+	// - `**` to match any number of path segments, including none
+	// - `{}` to group sub patterns into an OR expression. (e.g. `**​/*.{ts,js}` matches all TypeScript and JavaScript files)
+	// - `[]` to declare a range of characters to match in a path segment (e.g., `example.[0-9]` to match on `example.0`, `example.1`, …)
+	// - `[!...]` to negate a range of characters to match in a path segment (e.g., `example.[!0-9]` to match on `example.a`, `example.b`, but not `example.0`)
+	Glob string `json:"glob"`
+	// Whether to match files or folders with this pattern.
+	//
+	// Matches both if undefined.
+	Matches FileOperationPatternKind `json:"matches,omitempty"`
+	// Additional options used during matching.
+	Options *FileOperationPatternOptions `json:"options,omitempty"`
 }
 
-func (n *NotebookDocumentSyncOptionsNotebookSelectorOr2Cells) GetLanguage() string {
-	if n == nil {
-		return *new(string)
+// The glob pattern to match. Glob patterns can have the following syntax:
+// - `*` to match one or more characters in a path segment
+// - `?` to match on one character in a path segment
+// - `**` to match any number of path segments, including none
+// - `{}` to group sub patterns into an OR expression. (e.g. `**​/*.{ts,js}` matches all TypeScript and JavaScript files)
+// - `[]` to declare a range of characters to match in a path segment (e.g., `example.[0-9]` to match on `example.0`, `example.1`, …)
+// - `[!...]` to negate a range of characters to match in a path segment (e.g., `example.[!0-9]` to match on `example.a`, `example.b`, but not `example.0`)
+func (f *FileOperationPattern) GetGlob() string {
+	if f == nil {
+		var zero string
+		return zero
 	}
-	return n.Language
+	return f.Glob
 }
 
-type NotebookDocumentSyncOptionsNotebookSelectorOr2 struct {
-	// The notebook to be synced If a string
-	// value is provided it matches against the
-	// notebook type. '*' matches every notebook.
-	Notebook *StringOrNotebookDocumentFilter `json:"notebook,omitempty"`
-	// The cells of the matching notebook to be synced.
-	Cells []*NotebookDocumentSyncOptionsNotebookSelectorOr2Cells `json:"cells"`
+// Whether to match files or folders with this pattern.
+//
+// Matches both if undefined.
+func (f *FileOperationPattern) GetMatches() FileOperationPatternKind {
+	if f == nil {
+		var zero FileOperationPatternKind
+		return zero
+	}
+	return f.Matches
 }
 
-// The notebook to be synced If a string
-// value is provided it matches against the
-// notebook type. '*' matches every notebook.
-func (n *NotebookDocumentSyncOptionsNotebookSelectorOr2) GetNotebook() *StringOrNotebookDocumentFilter {
-	if n == nil {
-		return *new(*StringOrNotebookDocumentFilter)
+// Additional options used during matching.
+func (f *FileOperationPattern) GetOptions() *FileOperationPatternOptions {
+	if f == nil {
+		var zero *FileOperationPatternOptions
+		return zero
 	}
-	return n.Notebook
+	return f.Options
 }
 
-// The cells of the matching notebook to be synced.
-func (n *NotebookDocumentSyncOptionsNotebookSelectorOr2) GetCells() []*NotebookDocumentSyncOptionsNotebookSelectorOr2Cells {
-	if n == nil {
-		return *new([]*NotebookDocumentSyncOptionsNotebookSelectorOr2Cells)
+// A pattern kind describing if a glob pattern matches a file a folder or
+// both.
+//
+// @since 3.16.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#fileOperationPatternKind
+type FileOperationPatternKind string
+
+const (
+	// The pattern matches a file only.
+	FileOperationPatternKindfile FileOperationPatternKind = "file"
+	// The pattern matches a folder only.
+	FileOperationPatternKindfolder FileOperationPatternKind = "folder"
+)
+
+var validFileOperationPatternKindValues = map[string]bool{
+	"file":   true,
+	"folder": true,
+}
+
+func (f *FileOperationPatternKind) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
 	}
-	return n.Cells
+	var stringValue string
+	if err := json.Unmarshal(data, &stringValue); err != nil {
+		return err
+	}
+	if !validFileOperationPatternKindValues[stringValue] {
+		return fmt.Errorf("cannot unmarshal %v into FileOperationPatternKind: custom values are not supported", stringValue)
+	}
+	*f = FileOperationPatternKind(stringValue)
+
+	return nil
 }
 
-// NotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2 contains either of the following types:
-//   - [*NotebookDocumentSyncOptionsNotebookSelectorOr1]
-//   - [*NotebookDocumentSyncOptionsNotebookSelectorOr2]
-type NotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2 struct {
-	Value NotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2Value
+func (f FileOperationPatternKind) MarshalJSON() ([]byte, error) {
+	var stringValue = string(f)
+	if !validFileOperationPatternKindValues[stringValue] {
+		return nil, fmt.Errorf("cannot marshal %v into FileOperationPatternKind: custom values are not supported", stringValue)
+	}
+	return json.Marshal(stringValue)
+
 }
 
-// NotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2Value is either of the following types:
-//   - [*NotebookDocumentSyncOptionsNotebookSelectorOr1]
-//   - [*NotebookDocumentSyncOptionsNotebookSelectorOr2]
+// Matching options for the file operation pattern.
 //
-//sumtype:decl
-type NotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2Value interface {
-	isNotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2Value()
+// @since 3.16.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#fileOperationPatternOptions
+type FileOperationPatternOptions struct {
+	// The pattern should be matched ignoring casing.
+	IgnoreCase bool `json:"ignoreCase,omitempty"`
 }
 
-func (*NotebookDocumentSyncOptionsNotebookSelectorOr1) isNotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2Value() {
+// The pattern should be matched ignoring casing.
+func (f *FileOperationPatternOptions) GetIgnoreCase() bool {
+	if f == nil {
+		var zero bool
+		return zero
+	}
+	return f.IgnoreCase
 }
-func (*NotebookDocumentSyncOptionsNotebookSelectorOr2) isNotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2Value() {
+
+// The options to register for file operations.
+//
+// @since 3.16.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#fileOperationRegistrationOptions
+type FileOperationRegistrationOptions struct {
+	// The actual filters.
+	Filters []*FileOperationFilter `json:"filters"`
 }
 
-func (n *NotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var notebookDocumentSyncOptionsNotebookSelectorOr1Value *NotebookDocumentSyncOptionsNotebookSelectorOr1
-	if err := json.Unmarshal(data, &notebookDocumentSyncOptionsNotebookSelectorOr1Value); err == nil {
-		n.Value = notebookDocumentSyncOptionsNotebookSelectorOr1Value
-		return nil
-	}
-	var notebookDocumentSyncOptionsNotebookSelectorOr2Value *NotebookDocumentSyncOptionsNotebookSelectorOr2
-	if err := json.Unmarshal(data, &notebookDocumentSyncOptionsNotebookSelectorOr2Value); err == nil {
-		n.Value = notebookDocumentSyncOptionsNotebookSelectorOr2Value
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*NotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2](),
+// The actual filters.
+func (f *FileOperationRegistrationOptions) GetFilters() []*FileOperationFilter {
+	if f == nil {
+		var zero []*FileOperationFilter
+		return zero
 	}
+	return f.Filters
 }
 
-func (n *NotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Value)
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#foldingRangeClientCapabilities
+type FoldingRangeClientCapabilities struct {
+	// Whether implementation supports dynamic registration for folding range
+	// providers. If this is set to `true` the client supports the new
+	// `FoldingRangeRegistrationOptions` return value for the corresponding
+	// server capability as well.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	// The maximum number of folding ranges that the client prefers to receive
+	// per document. The value serves as a hint, servers are free to follow the
+	// limit.
+	RangeLimit Optional[int] `json:"rangeLimit,omitempty"`
+	// If set, the client signals that it only supports folding complete lines.
+	// If set, client will ignore specified `startCharacter` and `endCharacter`
+	// properties in a FoldingRange.
+	LineFoldingOnly bool `json:"lineFoldingOnly,omitempty"`
+	// Specific options for the folding range kind.
+	//
+	// @since 3.17.0
+	FoldingRangeKind *FoldingRangeClientCapabilitiesFoldingRangeKind `json:"foldingRangeKind,omitempty"`
+	// Specific options for the folding range.
+	//
+	// @since 3.17.0
+	FoldingRange *FoldingRangeClientCapabilitiesFoldingRange `json:"foldingRange,omitempty"`
 }
 
-// Options specific to a notebook plus its cells
-// to be synced to the server.
-//
-// If a selector provides a notebook document
-// filter but no cell selector all cells of a
-// matching notebook document will be synced.
-//
-// If a selector provides no notebook document
-// filter but only a cell selector all notebook
-// document that contain at least one matching
-// cell will be synced.
-//
-// @since 3.17.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#notebookDocumentSyncOptions
-type NotebookDocumentSyncOptions struct {
-	// The notebooks to be synced
-	NotebookSelector []*NotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2 `json:"notebookSelector"`
-	// Whether save notification should be forwarded to
-	// the server. Will only be honored if mode === `notebook`.
-	Save bool `json:"save,omitempty"`
+// Whether implementation supports dynamic registration for folding range
+// providers. If this is set to `true` the client supports the new
+// `FoldingRangeRegistrationOptions` return value for the corresponding
+// server capability as well.
+func (f *FoldingRangeClientCapabilities) GetDynamicRegistration() bool {
+	if f == nil {
+		var zero bool
+		return zero
+	}
+	return f.DynamicRegistration
 }
 
-// The notebooks to be synced
-func (n *NotebookDocumentSyncOptions) GetNotebookSelector() []*NotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2 {
-	if n == nil {
-		var zero []*NotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2
+// The maximum number of folding ranges that the client prefers to receive
+// per document. The value serves as a hint, servers are free to follow the
+// limit.
+func (f *FoldingRangeClientCapabilities) GetRangeLimit() Optional[int] {
+	if f == nil {
+		var zero Optional[int]
 		return zero
 	}
-	return n.NotebookSelector
+	return f.RangeLimit
 }
 
-// Whether save notification should be forwarded to
-// the server. Will only be honored if mode === `notebook`.
-func (n *NotebookDocumentSyncOptions) GetSave() bool {
-	if n == nil {
+// If set, the client signals that it only supports folding complete lines.
+// If set, client will ignore specified `startCharacter` and `endCharacter`
+// properties in a FoldingRange.
+func (f *FoldingRangeClientCapabilities) GetLineFoldingOnly() bool {
+	if f == nil {
 		var zero bool
 		return zero
 	}
-	return n.Save
+	return f.LineFoldingOnly
 }
 
-// Static registration options to be returned in the initialize
-// request.
+// Specific options for the folding range kind.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#staticRegistrationOptions
-type StaticRegistrationOptions struct {
-	// The id used to register the request. The id can be used to deregister
-	// the request again. See also Registration#id.
-	Id string `json:"id,omitempty"`
-}
-
-// The id used to register the request. The id can be used to deregister
-// the request again. See also Registration#id.
-func (s *StaticRegistrationOptions) GetId() string {
-	if s == nil {
-		var zero string
+// @since 3.17.0
+func (f *FoldingRangeClientCapabilities) GetFoldingRangeKind() *FoldingRangeClientCapabilitiesFoldingRangeKind {
+	if f == nil {
+		var zero *FoldingRangeClientCapabilitiesFoldingRangeKind
 		return zero
 	}
-	return s.Id
+	return f.FoldingRangeKind
 }
 
-// Registration options specific to a notebook.
+// Specific options for the folding range.
 //
 // @since 3.17.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#notebookDocumentSyncRegistrationOptions
-type NotebookDocumentSyncRegistrationOptions struct {
-	*NotebookDocumentSyncOptions
-	*StaticRegistrationOptions
+func (f *FoldingRangeClientCapabilities) GetFoldingRange() *FoldingRangeClientCapabilitiesFoldingRange {
+	if f == nil {
+		var zero *FoldingRangeClientCapabilitiesFoldingRange
+		return zero
+	}
+	return f.FoldingRange
 }
 
-// NotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptions contains either of the following types:
-//   - [*NotebookDocumentSyncOptions]
-//   - [*NotebookDocumentSyncRegistrationOptions]
-type NotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptions struct {
-	Value NotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptionsValue
+type FoldingRangeClientCapabilitiesFoldingRange struct {
+	// If set, the client signals that it supports setting collapsedText on
+	// folding ranges to display custom labels instead of the default text.
+	//
+	// @since 3.17.0
+	CollapsedText bool `json:"collapsedText,omitempty"`
 }
 
-// NotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptionsValue is either of the following types:
-//   - [*NotebookDocumentSyncOptions]
-//   - [*NotebookDocumentSyncRegistrationOptions]
+// If set, the client signals that it supports setting collapsedText on
+// folding ranges to display custom labels instead of the default text.
 //
-//sumtype:decl
-type NotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptionsValue interface {
-	isNotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptionsValue()
+// @since 3.17.0
+func (f *FoldingRangeClientCapabilitiesFoldingRange) GetCollapsedText() bool {
+	if f == nil {
+		return *new(bool)
+	}
+	return f.CollapsedText
 }
 
-func (*NotebookDocumentSyncOptions) isNotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptionsValue() {
-}
-func (*NotebookDocumentSyncRegistrationOptions) isNotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptionsValue() {
+type FoldingRangeClientCapabilitiesFoldingRangeKind struct {
+	// The folding range kind values the client supports. When this
+	// property exists the client also guarantees that it will
+	// handle values outside its set gracefully and falls back
+	// to a default value when unknown.
+	ValueSet []FoldingRangeKind `json:"valueSet,omitempty"`
 }
 
-func (n *NotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptions) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var notebookDocumentSyncOptionsValue *NotebookDocumentSyncOptions
-	if err := json.Unmarshal(data, &notebookDocumentSyncOptionsValue); err == nil {
-		n.Value = notebookDocumentSyncOptionsValue
-		return nil
-	}
-	var notebookDocumentSyncRegistrationOptionsValue *NotebookDocumentSyncRegistrationOptions
-	if err := json.Unmarshal(data, &notebookDocumentSyncRegistrationOptionsValue); err == nil {
-		n.Value = notebookDocumentSyncRegistrationOptionsValue
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*NotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptions](),
+// The folding range kind values the client supports. When this
+// property exists the client also guarantees that it will
+// handle values outside its set gracefully and falls back
+// to a default value when unknown.
+func (f *FoldingRangeClientCapabilitiesFoldingRangeKind) GetValueSet() []FoldingRangeKind {
+	if f == nil {
+		return *new([]FoldingRangeKind)
 	}
+	return f.ValueSet
 }
 
-func (n *NotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Value)
-}
+// A set of predefined range kinds.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#foldingRangeKind
+type FoldingRangeKind string
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#workDoneProgressOptions
-type WorkDoneProgressOptions struct {
-	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
+const (
+	// Folding range for a comment
+	FoldingRangeKindComment FoldingRangeKind = "comment"
+	// Folding range for an import or include
+	FoldingRangeKindImports FoldingRangeKind = "imports"
+	// Folding range for a region (e.g. `#region`)
+	FoldingRangeKindRegion FoldingRangeKind = "region"
+)
+
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#foldingRangeOptions
+type FoldingRangeOptions struct {
+	*WorkDoneProgressOptions
 }
 
-func (w *WorkDoneProgressOptions) GetWorkDoneProgress() bool {
-	if w == nil {
-		var zero bool
-		return zero
-	}
-	return w.WorkDoneProgress
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#foldingRangeRegistrationOptions
+type FoldingRangeRegistrationOptions struct {
+	*TextDocumentRegistrationOptions
+	*FoldingRangeOptions
+	*StaticRegistrationOptions
 }
 
-type CompletionOptionsCompletionItem struct {
-	// The server has support for completion item label
-	// details (see also `CompletionItemLabelDetails`) when
-	// receiving a completion item in a resolve call.
+// Client workspace capabilities specific to folding ranges
+//
+// @since 3.18.0
+// @proposed
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#foldingRangeWorkspaceClientCapabilities
+type FoldingRangeWorkspaceClientCapabilities struct {
+	// Whether the client implementation supports a refresh request sent from the
+	// server to the client.
 	//
-	// @since 3.17.0
-	LabelDetailsSupport bool `json:"labelDetailsSupport,omitempty"`
+	// Note that this event is global and will force the client to refresh all
+	// folding ranges currently shown. It should be used with absolute care and is
+	// useful for situation where a server for example detects a project wide
+	// change that requires such a calculation.
+	//
+	// @since 3.18.0
+	// @proposed
+	RefreshSupport bool `json:"refreshSupport,omitempty"`
 }
 
-// The server has support for completion item label
-// details (see also `CompletionItemLabelDetails`) when
-// receiving a completion item in a resolve call.
+// Whether the client implementation supports a refresh request sent from the
+// server to the client.
 //
-// @since 3.17.0
-func (c *CompletionOptionsCompletionItem) GetLabelDetailsSupport() bool {
-	if c == nil {
-		return *new(bool)
+// Note that this event is global and will force the client to refresh all
+// folding ranges currently shown. It should be used with absolute care and is
+// useful for situation where a server for example detects a project wide
+// change that requires such a calculation.
+//
+// @since 3.18.0
+// @proposed
+func (f *FoldingRangeWorkspaceClientCapabilities) GetRefreshSupport() bool {
+	if f == nil {
+		var zero bool
+		return zero
 	}
-	return c.LabelDetailsSupport
+	return f.RefreshSupport
 }
 
-// Completion options.
+// Value-object describing what options formatting should use.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#completionOptions
-type CompletionOptions struct {
-	*WorkDoneProgressOptions
-	// Most tools trigger completion request automatically without explicitly requesting
-	// it using a keyboard shortcut (e.g. Ctrl+Space). Typically they do so when the user
-	// starts to type an identifier. For example if the user types `c` in a JavaScript file
-	// code complete will automatically pop up present `console` besides others as a
-	// completion item. Characters that make up identifiers don't need to be listed here.
-	//
-	// If code complete should automatically be trigger on characters not being valid inside
-	// an identifier (for example `.` in JavaScript) list them in `triggerCharacters`.
-	TriggerCharacters []string `json:"triggerCharacters,omitempty"`
-	// The list of all possible characters that commit a completion. This field can be used
-	// if clients don't support individual commit characters per completion item. See
-	// `ClientCapabilities.textDocument.completion.completionItem.commitCharactersSupport`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#formattingOptions
+type FormattingOptions struct {
+	// Size of a tab in spaces.
+	TabSize int `json:"tabSize"`
+	// Prefer spaces over tabs.
+	InsertSpaces bool `json:"insertSpaces"`
+	// Trim trailing whitespace on a line.
 	//
-	// If a server provides both `allCommitCharacters` and commit characters on an individual
-	// completion item the ones on the completion item win.
+	// @since 3.15.0
+	TrimTrailingWhitespace bool `json:"trimTrailingWhitespace,omitempty"`
+	// Insert a newline character at the end of the file if one does not exist.
 	//
-	// @since 3.2.0
-	AllCommitCharacters []string `json:"allCommitCharacters,omitempty"`
-	// The server provides support to resolve additional
-	// information for a completion item.
-	ResolveProvider bool `json:"resolveProvider,omitempty"`
-	// The server supports the following `CompletionItem` specific
-	// capabilities.
+	// @since 3.15.0
+	InsertFinalNewline bool `json:"insertFinalNewline,omitempty"`
+	// Trim all newlines after the final newline at the end of the file.
 	//
-	// @since 3.17.0
-	CompletionItem *CompletionOptionsCompletionItem `json:"completionItem,omitempty"`
+	// @since 3.15.0
+	TrimFinalNewlines bool `json:"trimFinalNewlines,omitempty"`
 }
 
-// Most tools trigger completion request automatically without explicitly requesting
-// it using a keyboard shortcut (e.g. Ctrl+Space). Typically they do so when the user
-// starts to type an identifier. For example if the user types `c` in a JavaScript file
-// code complete will automatically pop up present `console` besides others as a
-// completion item. Characters that make up identifiers don't need to be listed here.
-//
-// If code complete should automatically be trigger on characters not being valid inside
-// an identifier (for example `.` in JavaScript) list them in `triggerCharacters`.
-func (c *CompletionOptions) GetTriggerCharacters() []string {
-	if c == nil {
-		var zero []string
+// Size of a tab in spaces.
+func (f *FormattingOptions) GetTabSize() int {
+	if f == nil {
+		var zero int
 		return zero
 	}
-	return c.TriggerCharacters
+	return f.TabSize
 }
 
-// The list of all possible characters that commit a completion. This field can be used
-// if clients don't support individual commit characters per completion item. See
-// `ClientCapabilities.textDocument.completion.completionItem.commitCharactersSupport`
-//
-// If a server provides both `allCommitCharacters` and commit characters on an individual
-// completion item the ones on the completion item win.
-//
-// @since 3.2.0
-func (c *CompletionOptions) GetAllCommitCharacters() []string {
-	if c == nil {
-		var zero []string
+// Prefer spaces over tabs.
+func (f *FormattingOptions) GetInsertSpaces() bool {
+	if f == nil {
+		var zero bool
 		return zero
 	}
-	return c.AllCommitCharacters
+	return f.InsertSpaces
 }
 
-// The server provides support to resolve additional
-// information for a completion item.
-func (c *CompletionOptions) GetResolveProvider() bool {
-	if c == nil {
+// Trim trailing whitespace on a line.
+//
+// @since 3.15.0
+func (f *FormattingOptions) GetTrimTrailingWhitespace() bool {
+	if f == nil {
 		var zero bool
 		return zero
 	}
-	return c.ResolveProvider
+	return f.TrimTrailingWhitespace
 }
 
-// The server supports the following `CompletionItem` specific
-// capabilities.
+// Insert a newline character at the end of the file if one does not exist.
 //
-// @since 3.17.0
-func (c *CompletionOptions) GetCompletionItem() *CompletionOptionsCompletionItem {
-	if c == nil {
-		var zero *CompletionOptionsCompletionItem
+// @since 3.15.0
+func (f *FormattingOptions) GetInsertFinalNewline() bool {
+	if f == nil {
+		var zero bool
 		return zero
 	}
-	return c.CompletionItem
+	return f.InsertFinalNewline
 }
 
-// Hover options.
+// Trim all newlines after the final newline at the end of the file.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#hoverOptions
-type HoverOptions struct {
-	*WorkDoneProgressOptions
-}
-
-// BooleanOrHoverOptions contains either of the following types:
-//   - [Boolean]
-//   - [*HoverOptions]
-type BooleanOrHoverOptions struct {
-	Value BooleanOrHoverOptionsValue
+// @since 3.15.0
+func (f *FormattingOptions) GetTrimFinalNewlines() bool {
+	if f == nil {
+		var zero bool
+		return zero
+	}
+	return f.TrimFinalNewlines
 }
 
-// BooleanOrHoverOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*HoverOptions]
-//
-//sumtype:decl
-type BooleanOrHoverOptionsValue interface {
-	isBooleanOrHoverOptionsValue()
+type FullTextDocumentContentChangeEvent struct {
+	// The new text of the whole document.
+	Text string `json:"text"`
 }
 
-func (Boolean) isBooleanOrHoverOptionsValue()       {}
-func (*HoverOptions) isBooleanOrHoverOptionsValue() {}
-
-func (b *BooleanOrHoverOptions) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
-		return nil
-	}
-	var hoverOptionsValue *HoverOptions
-	if err := json.Unmarshal(data, &hoverOptionsValue); err == nil {
-		b.Value = hoverOptionsValue
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrHoverOptions](),
+// The new text of the whole document.
+func (f *FullTextDocumentContentChangeEvent) GetText() string {
+	if f == nil {
+		return *new(string)
 	}
+	return f.Text
 }
 
-func (b *BooleanOrHoverOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
-}
-
-// Server Capabilities for a {@link SignatureHelpRequest}.
+// General client capabilities.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#signatureHelpOptions
-type SignatureHelpOptions struct {
-	*WorkDoneProgressOptions
-	// List of characters that trigger signature help automatically.
-	TriggerCharacters []string `json:"triggerCharacters,omitempty"`
-	// List of characters that re-trigger signature help.
+// @since 3.16.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#generalClientCapabilities
+type GeneralClientCapabilities struct {
+	// Client capability that signals how the client
+	// handles stale requests (e.g. a request
+	// for which the client will not process the response
+	// anymore since the information is outdated).
 	//
-	// These trigger characters are only active when signature help is already showing. All trigger characters
-	// are also counted as re-trigger characters.
+	// @since 3.17.0
+	StaleRequestSupport *GeneralClientCapabilitiesStaleRequestSupport `json:"staleRequestSupport,omitempty"`
+	// Client capabilities specific to regular expressions.
 	//
-	// @since 3.15.0
-	RetriggerCharacters []string `json:"retriggerCharacters,omitempty"`
+	// @since 3.16.0
+	RegularExpressions *RegularExpressionsClientCapabilities `json:"regularExpressions,omitempty"`
+	// Client capabilities specific to the client's markdown parser.
+	//
+	// @since 3.16.0
+	Markdown *MarkdownClientCapabilities `json:"markdown,omitempty"`
+	// The position encodings supported by the client. Client and server
+	// have to agree on the same position encoding to ensure that offsets
+	// (e.g. character position in a line) are interpreted the same on both
+	// sides.
+	//
+	// To keep the protocol backwards compatible the following applies: if
+	// the value 'utf-16' is missing from the array of position encodings
+	// servers can assume that the client supports UTF-16. UTF-16 is
+	// therefore a mandatory encoding.
+	//
+	// If omitted it defaults to ['utf-16'].
+	//
+	// Implementation considerations: since the conversion from one encoding
+	// into another requires the content of the file / line the conversion
+	// is best done where the file is read which is usually on the server
+	// side.
+	//
+	// @since 3.17.0
+	PositionEncodings []PositionEncodingKind `json:"positionEncodings,omitempty"`
 }
 
-// List of characters that trigger signature help automatically.
-func (s *SignatureHelpOptions) GetTriggerCharacters() []string {
-	if s == nil {
-		var zero []string
+// Client capability that signals how the client
+// handles stale requests (e.g. a request
+// for which the client will not process the response
+// anymore since the information is outdated).
+//
+// @since 3.17.0
+func (g *GeneralClientCapabilities) GetStaleRequestSupport() *GeneralClientCapabilitiesStaleRequestSupport {
+	if g == nil {
+		var zero *GeneralClientCapabilitiesStaleRequestSupport
 		return zero
 	}
-	return s.TriggerCharacters
+	return g.StaleRequestSupport
 }
 
-// List of characters that re-trigger signature help.
-//
-// These trigger characters are only active when signature help is already showing. All trigger characters
-// are also counted as re-trigger characters.
+// Client capabilities specific to regular expressions.
 //
-// @since 3.15.0
-func (s *SignatureHelpOptions) GetRetriggerCharacters() []string {
-	if s == nil {
-		var zero []string
+// @since 3.16.0
+func (g *GeneralClientCapabilities) GetRegularExpressions() *RegularExpressionsClientCapabilities {
+	if g == nil {
+		var zero *RegularExpressionsClientCapabilities
 		return zero
 	}
-	return s.RetriggerCharacters
-}
-
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#declarationOptions
-type DeclarationOptions struct {
-	*WorkDoneProgressOptions
+	return g.RegularExpressions
 }
 
-type TextDocumentFilterOr1 struct {
-	// A language id, like `typescript`.
-	Language string `json:"language"`
-	// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
-	Scheme string `json:"scheme,omitempty"`
-	// A glob pattern, like **​/*.{ts,js}. See TextDocumentFilter for examples.
-	Pattern string `json:"pattern,omitempty"`
+// Client capabilities specific to the client's markdown parser.
+//
+// @since 3.16.0
+func (g *GeneralClientCapabilities) GetMarkdown() *MarkdownClientCapabilities {
+	if g == nil {
+		var zero *MarkdownClientCapabilities
+		return zero
+	}
+	return g.Markdown
 }
 
-// A language id, like `typescript`.
-func (t *TextDocumentFilterOr1) GetLanguage() string {
-	if t == nil {
-		return *new(string)
+// The position encodings supported by the client. Client and server
+// have to agree on the same position encoding to ensure that offsets
+// (e.g. character position in a line) are interpreted the same on both
+// sides.
+//
+// To keep the protocol backwards compatible the following applies: if
+// the value 'utf-16' is missing from the array of position encodings
+// servers can assume that the client supports UTF-16. UTF-16 is
+// therefore a mandatory encoding.
+//
+// If omitted it defaults to ['utf-16'].
+//
+// Implementation considerations: since the conversion from one encoding
+// into another requires the content of the file / line the conversion
+// is best done where the file is read which is usually on the server
+// side.
+//
+// @since 3.17.0
+func (g *GeneralClientCapabilities) GetPositionEncodings() []PositionEncodingKind {
+	if g == nil {
+		var zero []PositionEncodingKind
+		return zero
 	}
-	return t.Language
+	return g.PositionEncodings
 }
 
-// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
-func (t *TextDocumentFilterOr1) GetScheme() string {
-	if t == nil {
-		return *new(string)
-	}
-	return t.Scheme
+type GeneralClientCapabilitiesStaleRequestSupport struct {
+	// The client will actively cancel the request.
+	Cancel bool `json:"cancel"`
+	// The list of requests for which the client
+	// will retry the request if it receives a
+	// response with error code `ContentModified`
+	RetryOnContentModified []string `json:"retryOnContentModified"`
 }
 
-// A glob pattern, like **​/*.{ts,js}. See TextDocumentFilter for examples.
-func (t *TextDocumentFilterOr1) GetPattern() string {
-	if t == nil {
-		return *new(string)
+// The client will actively cancel the request.
+func (g *GeneralClientCapabilitiesStaleRequestSupport) GetCancel() bool {
+	if g == nil {
+		return *new(bool)
 	}
-	return t.Pattern
+	return g.Cancel
 }
 
-type TextDocumentFilterOr2 struct {
-	// A language id, like `typescript`.
-	Language string `json:"language,omitempty"`
-	// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
-	Scheme string `json:"scheme"`
-	// A glob pattern, like **​/*.{ts,js}. See TextDocumentFilter for examples.
-	Pattern string `json:"pattern,omitempty"`
+// The list of requests for which the client
+// will retry the request if it receives a
+// response with error code `ContentModified`
+func (g *GeneralClientCapabilitiesStaleRequestSupport) GetRetryOnContentModified() []string {
+	if g == nil {
+		return *new([]string)
+	}
+	return g.RetryOnContentModified
 }
 
-// A language id, like `typescript`.
-func (t *TextDocumentFilterOr2) GetLanguage() string {
-	if t == nil {
-		return *new(string)
-	}
-	return t.Language
+// The result of a hover request.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#hover
+type Hover struct {
+	// The hover's content
+	Contents *MarkupContentOrMarkedStringOrMarkedStringSlice `json:"contents"`
+	// An optional range inside the text document that is used to
+	// visualize the hover, e.g. by changing the background color.
+	Range *Range `json:"range,omitempty"`
 }
 
-// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
-func (t *TextDocumentFilterOr2) GetScheme() string {
-	if t == nil {
-		return *new(string)
+// The hover's content
+func (h *Hover) GetContents() *MarkupContentOrMarkedStringOrMarkedStringSlice {
+	if h == nil {
+		var zero *MarkupContentOrMarkedStringOrMarkedStringSlice
+		return zero
 	}
-	return t.Scheme
+	return h.Contents
 }
 
-// A glob pattern, like **​/*.{ts,js}. See TextDocumentFilter for examples.
-func (t *TextDocumentFilterOr2) GetPattern() string {
-	if t == nil {
-		return *new(string)
+// An optional range inside the text document that is used to
+// visualize the hover, e.g. by changing the background color.
+func (h *Hover) GetRange() *Range {
+	if h == nil {
+		var zero *Range
+		return zero
 	}
-	return t.Pattern
+	return h.Range
 }
 
-type TextDocumentFilterOr3 struct {
-	// A language id, like `typescript`.
-	Language string `json:"language,omitempty"`
-	// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
-	Scheme string `json:"scheme,omitempty"`
-	// A glob pattern, like **​/*.{ts,js}. See TextDocumentFilter for examples.
-	Pattern string `json:"pattern"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#hoverClientCapabilities
+type HoverClientCapabilities struct {
+	// Whether hover supports dynamic registration.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	// Client supports the following content formats for the content
+	// property. The order describes the preferred format of the client.
+	ContentFormat []MarkupKind `json:"contentFormat,omitempty"`
 }
 
-// A language id, like `typescript`.
-func (t *TextDocumentFilterOr3) GetLanguage() string {
-	if t == nil {
-		return *new(string)
+// Whether hover supports dynamic registration.
+func (h *HoverClientCapabilities) GetDynamicRegistration() bool {
+	if h == nil {
+		var zero bool
+		return zero
 	}
-	return t.Language
+	return h.DynamicRegistration
 }
 
-// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
-func (t *TextDocumentFilterOr3) GetScheme() string {
-	if t == nil {
-		return *new(string)
+// Client supports the following content formats for the content
+// property. The order describes the preferred format of the client.
+func (h *HoverClientCapabilities) GetContentFormat() []MarkupKind {
+	if h == nil {
+		var zero []MarkupKind
+		return zero
 	}
-	return t.Scheme
+	return h.ContentFormat
 }
 
-// A glob pattern, like **​/*.{ts,js}. See TextDocumentFilter for examples.
-func (t *TextDocumentFilterOr3) GetPattern() string {
-	if t == nil {
-		return *new(string)
-	}
-	return t.Pattern
+// Hover options.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#hoverOptions
+type HoverOptions struct {
+	*WorkDoneProgressOptions
 }
 
-// TextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3 contains either of the following types:
-//   - [*TextDocumentFilterOr1]
-//   - [*TextDocumentFilterOr2]
-//   - [*TextDocumentFilterOr3]
-type TextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3 struct {
-	Value TextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3Value
+// Parameters for a {@link HoverRequest}.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#hoverParams
+type HoverParams struct {
+	*TextDocumentPositionParams
+	*WorkDoneProgressParams
 }
 
-// TextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3Value is either of the following types:
-//   - [*TextDocumentFilterOr1]
-//   - [*TextDocumentFilterOr2]
-//   - [*TextDocumentFilterOr3]
+// @since 3.6.0
 //
-//sumtype:decl
-type TextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3Value interface {
-	isTextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3Value()
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#implementationClientCapabilities
+type ImplementationClientCapabilities struct {
+	// Whether implementation supports dynamic registration. If this is set to `true`
+	// the client supports the new `ImplementationRegistrationOptions` return value
+	// for the corresponding server capability as well.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	// The client supports additional metadata in the form of definition links.
+	//
+	// @since 3.14.0
+	LinkSupport bool `json:"linkSupport,omitempty"`
 }
 
-func (*TextDocumentFilterOr1) isTextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3Value() {
-}
-func (*TextDocumentFilterOr2) isTextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3Value() {
-}
-func (*TextDocumentFilterOr3) isTextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3Value() {
+// Whether implementation supports dynamic registration. If this is set to `true`
+// the client supports the new `ImplementationRegistrationOptions` return value
+// for the corresponding server capability as well.
+func (i *ImplementationClientCapabilities) GetDynamicRegistration() bool {
+	if i == nil {
+		var zero bool
+		return zero
+	}
+	return i.DynamicRegistration
 }
 
-func (t *TextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var textDocumentFilterOr1Value *TextDocumentFilterOr1
-	if err := json.Unmarshal(data, &textDocumentFilterOr1Value); err == nil {
-		t.Value = textDocumentFilterOr1Value
-		return nil
-	}
-	var textDocumentFilterOr2Value *TextDocumentFilterOr2
-	if err := json.Unmarshal(data, &textDocumentFilterOr2Value); err == nil {
-		t.Value = textDocumentFilterOr2Value
-		return nil
-	}
-	var textDocumentFilterOr3Value *TextDocumentFilterOr3
-	if err := json.Unmarshal(data, &textDocumentFilterOr3Value); err == nil {
-		t.Value = textDocumentFilterOr3Value
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*TextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3](),
+// The client supports additional metadata in the form of definition links.
+//
+// @since 3.14.0
+func (i *ImplementationClientCapabilities) GetLinkSupport() bool {
+	if i == nil {
+		var zero bool
+		return zero
 	}
+	return i.LinkSupport
 }
 
-func (t *TextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3) MarshalJSON() ([]byte, error) {
-	return json.Marshal(t.Value)
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#implementationOptions
+type ImplementationOptions struct {
+	*WorkDoneProgressOptions
 }
 
-// A document filter denotes a document by different properties like
-// the {@link TextDocument.languageId language}, the {@link Uri.scheme scheme} of
-// its resource, or a glob-pattern that is applied to the {@link TextDocument.fileName path}.
-//
-// Glob patterns can have the following syntax:
-// - `*` to match one or more characters in a path segment
-// - `?` to match on one character in a path segment
-// - `**` to match any number of path segments, including none
-// - `{}` to group sub patterns into an OR expression. (e.g. `**​/*.{ts,js}` matches all TypeScript and JavaScript files)
-// - `[]` to declare a range of characters to match in a path segment (e.g., `example.[0-9]` to match on `example.0`, `example.1`, …)
-// - `[!...]` to negate a range of characters to match in a path segment (e.g., `example.[!0-9]` to match on `example.a`, `example.b`, but not `example.0`)
-//
-// @sample A language filter that applies to typescript files on disk: `{ language: 'typescript', scheme: 'file' }`
-// @sample A language filter that applies to all package.json paths: `{ language: 'json', pattern: '**package.json' }`
-//
-// @since 3.17.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocumentFilter
-type TextDocumentFilter = *TextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#implementationRegistrationOptions
+type ImplementationRegistrationOptions struct {
+	*TextDocumentRegistrationOptions
+	*ImplementationOptions
+	*StaticRegistrationOptions
+}
 
-// A notebook cell text document filter denotes a cell text
-// document by different properties.
-//
-// @since 3.17.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#notebookCellTextDocumentFilter
-type NotebookCellTextDocumentFilter struct {
-	// A filter that matches against the notebook
-	// containing the notebook cell. If a string
-	// value is provided it matches against the
-	// notebook type. '*' matches every notebook.
-	Notebook *StringOrNotebookDocumentFilter `json:"notebook"`
-	// A language id like `python`.
+type IncrementalTextDocumentContentChangeEvent struct {
+	// The range of the document that changed.
+	Range *Range `json:"range"`
+	// The optional length of the range that got replaced.
 	//
-	// Will be matched against the language id of the
-	// notebook cell document. '*' matches every language.
-	Language string `json:"language,omitempty"`
+	// Deprecated: use range instead.
+	RangeLength Optional[int] `json:"rangeLength,omitempty"`
+	// The new text for the provided range.
+	Text string `json:"text"`
 }
 
-// A filter that matches against the notebook
-// containing the notebook cell. If a string
-// value is provided it matches against the
-// notebook type. '*' matches every notebook.
-func (n *NotebookCellTextDocumentFilter) GetNotebook() *StringOrNotebookDocumentFilter {
-	if n == nil {
-		var zero *StringOrNotebookDocumentFilter
-		return zero
+// The range of the document that changed.
+func (i *IncrementalTextDocumentContentChangeEvent) GetRange() *Range {
+	if i == nil {
+		return *new(*Range)
 	}
-	return n.Notebook
+	return i.Range
 }
 
-// A language id like `python`.
+// The optional length of the range that got replaced.
 //
-// Will be matched against the language id of the
-// notebook cell document. '*' matches every language.
-func (n *NotebookCellTextDocumentFilter) GetLanguage() string {
-	if n == nil {
-		var zero string
-		return zero
+// Deprecated: use range instead.
+func (i *IncrementalTextDocumentContentChangeEvent) GetRangeLength() Optional[int] {
+	if i == nil {
+		return *new(Optional[int])
 	}
-	return n.Language
+	return i.RangeLength
 }
 
-// TextDocumentFilterOrNotebookCellTextDocumentFilter contains either of the following types:
-//   - [TextDocumentFilter]
-//   - [*NotebookCellTextDocumentFilter]
-type TextDocumentFilterOrNotebookCellTextDocumentFilter struct {
-	Value TextDocumentFilterOrNotebookCellTextDocumentFilterValue
+// The new text for the provided range.
+func (i *IncrementalTextDocumentContentChangeEvent) GetText() string {
+	if i == nil {
+		return *new(string)
+	}
+	return i.Text
 }
 
-// TextDocumentFilterOrNotebookCellTextDocumentFilterValue is either of the following types:
-//   - [TextDocumentFilter]
-//   - [*NotebookCellTextDocumentFilter]
+// IncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEvent contains either of the following types:
+//   - [*IncrementalTextDocumentContentChangeEvent]
+//   - [*FullTextDocumentContentChangeEvent]
+type IncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEvent struct {
+	Value IncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEventValue
+}
+
+// IncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEventValue is either of the following types:
+//   - [*IncrementalTextDocumentContentChangeEvent]
+//   - [*FullTextDocumentContentChangeEvent]
 //
 //sumtype:decl
-type TextDocumentFilterOrNotebookCellTextDocumentFilterValue interface {
-	isTextDocumentFilterOrNotebookCellTextDocumentFilterValue()
+type IncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEventValue interface {
+	isIncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEventValue()
 }
 
-func (TextDocumentFilter) isTextDocumentFilterOrNotebookCellTextDocumentFilterValue()              {}
-func (*NotebookCellTextDocumentFilter) isTextDocumentFilterOrNotebookCellTextDocumentFilterValue() {}
+func (*IncrementalTextDocumentContentChangeEvent) isIncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEventValue() {
+}
+func (*FullTextDocumentContentChangeEvent) isIncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEventValue() {
+}
 
-func (t *TextDocumentFilterOrNotebookCellTextDocumentFilter) UnmarshalJSON(data []byte) error {
+func (i *IncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEvent) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var textDocumentFilterValue TextDocumentFilter
-	if err := json.Unmarshal(data, &textDocumentFilterValue); err == nil {
-		t.Value = textDocumentFilterValue
-		return nil
+	var unmarshalledData map[string]any
+	err := json.Unmarshal(data, &unmarshalledData)
+	if err != nil {
+		return err
 	}
-	var notebookCellTextDocumentFilterValue *NotebookCellTextDocumentFilter
-	if err := json.Unmarshal(data, &notebookCellTextDocumentFilterValue); err == nil {
-		t.Value = notebookCellTextDocumentFilterValue
+	fields := slices.Collect(maps.Keys(unmarshalledData))
+	var incrementalTextDocumentContentChangeEventValue *IncrementalTextDocumentContentChangeEvent
+	if slices.Contains(fields, "range") {
+		if err := json.Unmarshal(data, &incrementalTextDocumentContentChangeEventValue); err == nil {
+			i.Value = incrementalTextDocumentContentChangeEventValue
+			return nil
+		}
+	}
+	var fullTextDocumentContentChangeEventValue *FullTextDocumentContentChangeEvent
+	if err := json.Unmarshal(data, &fullTextDocumentContentChangeEventValue); err == nil {
+		i.Value = fullTextDocumentContentChangeEventValue
 		return nil
 	}
 	return &json.UnmarshalTypeError{
 		Value: string(data),
-		Type:  reflect.TypeFor[*TextDocumentFilterOrNotebookCellTextDocumentFilter](),
+		Type:  reflect.TypeFor[*IncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEvent](),
 	}
 }
 
-func (t *TextDocumentFilterOrNotebookCellTextDocumentFilter) MarshalJSON() ([]byte, error) {
-	return json.Marshal(t.Value)
+func (i *IncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.Value)
 }
 
-// A document filter describes a top level text document or
-// a notebook cell document.
-//
-// @since 3.17.0 - proposed support for NotebookCellTextDocumentFilter.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentFilter
-type DocumentFilter = *TextDocumentFilterOrNotebookCellTextDocumentFilter
-
-// A document selector is the combination of one or many document filters.
-//
-// @sample `let sel:DocumentSelector = [{ language: 'typescript' }, { language: 'json', pattern: '**∕tsconfig.json' }]`;
-//
-// The use of a string as a document filter is deprecated @since 3.16.0.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentSelector
-type DocumentSelector = []DocumentFilter
-
-// General text document registration options.
+// The data type of the ResponseError if the
+// initialize request fails.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocumentRegistrationOptions
-type TextDocumentRegistrationOptions struct {
-	// A document selector to identify the scope of the registration. If set to null
-	// the document selector provided on the client side will be used.
-	DocumentSelector DocumentSelector `json:"documentSelector"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#initializeError
+type InitializeError struct {
+	// Indicates whether the client execute the following retry logic:
+	// (1) show the message provided by the ResponseError to the user
+	// (2) user selects retry or cancel
+	// (3) if user selected retry the initialize method is sent again.
+	Retry bool `json:"retry"`
 }
 
-// A document selector to identify the scope of the registration. If set to null
-// the document selector provided on the client side will be used.
-func (t *TextDocumentRegistrationOptions) GetDocumentSelector() DocumentSelector {
-	if t == nil {
-		var zero DocumentSelector
+// Indicates whether the client execute the following retry logic:
+// (1) show the message provided by the ResponseError to the user
+// (2) user selects retry or cancel
+// (3) if user selected retry the initialize method is sent again.
+func (i *InitializeError) GetRetry() bool {
+	if i == nil {
+		var zero bool
 		return zero
 	}
-	return t.DocumentSelector
+	return i.Retry
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#declarationRegistrationOptions
-type DeclarationRegistrationOptions struct {
-	*DeclarationOptions
-	*TextDocumentRegistrationOptions
-	*StaticRegistrationOptions
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#initializeParams
+type InitializeParams[InitializationOptions any] struct {
+	*XInitializeParams[InitializationOptions]
+	*WorkspaceFoldersInitializeParams
 }
 
-// BooleanOrDeclarationOptionsOrDeclarationRegistrationOptions contains either of the following types:
-//   - [Boolean]
-//   - [*DeclarationOptions]
-//   - [*DeclarationRegistrationOptions]
-type BooleanOrDeclarationOptionsOrDeclarationRegistrationOptions struct {
-	Value BooleanOrDeclarationOptionsOrDeclarationRegistrationOptionsValue
+// The result returned from an initialize request.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#initializeResult
+type InitializeResult struct {
+	// The capabilities the language server provides.
+	Capabilities *ServerCapabilities `json:"capabilities"`
+	// Information about the server.
+	//
+	// @since 3.15.0
+	ServerInfo *InitializeResultServerInfo `json:"serverInfo,omitempty"`
 }
 
-// BooleanOrDeclarationOptionsOrDeclarationRegistrationOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*DeclarationOptions]
-//   - [*DeclarationRegistrationOptions]
+// The capabilities the language server provides.
+func (i *InitializeResult) GetCapabilities() *ServerCapabilities {
+	if i == nil {
+		var zero *ServerCapabilities
+		return zero
+	}
+	return i.Capabilities
+}
+
+// Information about the server.
 //
-//sumtype:decl
-type BooleanOrDeclarationOptionsOrDeclarationRegistrationOptionsValue interface {
-	isBooleanOrDeclarationOptionsOrDeclarationRegistrationOptionsValue()
+// @since 3.15.0
+func (i *InitializeResult) GetServerInfo() *InitializeResultServerInfo {
+	if i == nil {
+		var zero *InitializeResultServerInfo
+		return zero
+	}
+	return i.ServerInfo
 }
 
-func (Boolean) isBooleanOrDeclarationOptionsOrDeclarationRegistrationOptionsValue()             {}
-func (*DeclarationOptions) isBooleanOrDeclarationOptionsOrDeclarationRegistrationOptionsValue() {}
-func (*DeclarationRegistrationOptions) isBooleanOrDeclarationOptionsOrDeclarationRegistrationOptionsValue() {
+type InitializeResultServerInfo struct {
+	// The name of the server as defined by the server.
+	Name string `json:"name"`
+	// The server's version as defined by the server.
+	Version string `json:"version,omitempty"`
 }
 
-func (b *BooleanOrDeclarationOptionsOrDeclarationRegistrationOptions) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
-		return nil
-	}
-	var declarationOptionsValue *DeclarationOptions
-	if err := json.Unmarshal(data, &declarationOptionsValue); err == nil {
-		b.Value = declarationOptionsValue
-		return nil
-	}
-	var declarationRegistrationOptionsValue *DeclarationRegistrationOptions
-	if err := json.Unmarshal(data, &declarationRegistrationOptionsValue); err == nil {
-		b.Value = declarationRegistrationOptionsValue
-		return nil
+// The name of the server as defined by the server.
+func (i *InitializeResultServerInfo) GetName() string {
+	if i == nil {
+		return *new(string)
 	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrDeclarationOptionsOrDeclarationRegistrationOptions](),
+	return i.Name
+}
+
+// The server's version as defined by the server.
+func (i *InitializeResultServerInfo) GetVersion() string {
+	if i == nil {
+		return *new(string)
 	}
+	return i.Version
 }
 
-func (b *BooleanOrDeclarationOptionsOrDeclarationRegistrationOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#initializedParams
+type InitializedParams struct {
 }
 
-// Server Capabilities for a {@link DefinitionRequest}.
+// Inlay hint client capabilities.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#definitionOptions
-type DefinitionOptions struct {
-	*WorkDoneProgressOptions
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#inlayHintClientCapabilities
+type InlayHintClientCapabilities struct {
+	// Whether inlay hints support dynamic registration.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	// Indicates which properties a client can resolve lazily on an inlay
+	// hint.
+	ResolveSupport *InlayHintClientCapabilitiesResolveSupport `json:"resolveSupport,omitempty"`
+}
+
+// Whether inlay hints support dynamic registration.
+func (i *InlayHintClientCapabilities) GetDynamicRegistration() bool {
+	if i == nil {
+		var zero bool
+		return zero
+	}
+	return i.DynamicRegistration
 }
 
-// BooleanOrDefinitionOptions contains either of the following types:
-//   - [Boolean]
-//   - [*DefinitionOptions]
-type BooleanOrDefinitionOptions struct {
-	Value BooleanOrDefinitionOptionsValue
+// Indicates which properties a client can resolve lazily on an inlay
+// hint.
+func (i *InlayHintClientCapabilities) GetResolveSupport() *InlayHintClientCapabilitiesResolveSupport {
+	if i == nil {
+		var zero *InlayHintClientCapabilitiesResolveSupport
+		return zero
+	}
+	return i.ResolveSupport
 }
 
-// BooleanOrDefinitionOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*DefinitionOptions]
-//
-//sumtype:decl
-type BooleanOrDefinitionOptionsValue interface {
-	isBooleanOrDefinitionOptionsValue()
+type InlayHintClientCapabilitiesResolveSupport struct {
+	// The properties that a client can resolve lazily.
+	Properties []string `json:"properties"`
 }
 
-func (Boolean) isBooleanOrDefinitionOptionsValue()            {}
-func (*DefinitionOptions) isBooleanOrDefinitionOptionsValue() {}
-
-func (b *BooleanOrDefinitionOptions) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
-		return nil
-	}
-	var definitionOptionsValue *DefinitionOptions
-	if err := json.Unmarshal(data, &definitionOptionsValue); err == nil {
-		b.Value = definitionOptionsValue
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrDefinitionOptions](),
+// The properties that a client can resolve lazily.
+func (i *InlayHintClientCapabilitiesResolveSupport) GetProperties() []string {
+	if i == nil {
+		return *new([]string)
 	}
+	return i.Properties
 }
 
-func (b *BooleanOrDefinitionOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
+// Inlay hint options used during static registration.
+//
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#inlayHintOptions
+type InlayHintOptions struct {
+	*WorkDoneProgressOptions
+	// The server provides support to resolve additional
+	// information for an inlay hint item.
+	ResolveProvider bool `json:"resolveProvider,omitempty"`
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#typeDefinitionOptions
-type TypeDefinitionOptions struct {
-	*WorkDoneProgressOptions
+// The server provides support to resolve additional
+// information for an inlay hint item.
+func (i *InlayHintOptions) GetResolveProvider() bool {
+	if i == nil {
+		var zero bool
+		return zero
+	}
+	return i.ResolveProvider
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#typeDefinitionRegistrationOptions
-type TypeDefinitionRegistrationOptions struct {
+// Inlay hint options used during static or dynamic registration.
+//
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#inlayHintRegistrationOptions
+type InlayHintRegistrationOptions struct {
+	*InlayHintOptions
 	*TextDocumentRegistrationOptions
-	*TypeDefinitionOptions
 	*StaticRegistrationOptions
 }
 
-// BooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptions contains either of the following types:
-//   - [Boolean]
-//   - [*TypeDefinitionOptions]
-//   - [*TypeDefinitionRegistrationOptions]
-type BooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptions struct {
-	Value BooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptionsValue
+// Client workspace capabilities specific to inlay hints.
+//
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#inlayHintWorkspaceClientCapabilities
+type InlayHintWorkspaceClientCapabilities struct {
+	// Whether the client implementation supports a refresh request sent from
+	// the server to the client.
+	//
+	// Note that this event is global and will force the client to refresh all
+	// inlay hints currently shown. It should be used with absolute care and
+	// is useful for situation where a server for example detects a project wide
+	// change that requires such a calculation.
+	RefreshSupport bool `json:"refreshSupport,omitempty"`
 }
 
-// BooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*TypeDefinitionOptions]
-//   - [*TypeDefinitionRegistrationOptions]
+// Whether the client implementation supports a refresh request sent from
+// the server to the client.
 //
-//sumtype:decl
-type BooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptionsValue interface {
-	isBooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptionsValue()
+// Note that this event is global and will force the client to refresh all
+// inlay hints currently shown. It should be used with absolute care and
+// is useful for situation where a server for example detects a project wide
+// change that requires such a calculation.
+func (i *InlayHintWorkspaceClientCapabilities) GetRefreshSupport() bool {
+	if i == nil {
+		var zero bool
+		return zero
+	}
+	return i.RefreshSupport
 }
 
-func (Boolean) isBooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptionsValue() {}
-func (*TypeDefinitionOptions) isBooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptionsValue() {
-}
-func (*TypeDefinitionRegistrationOptions) isBooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptionsValue() {
+// Client capabilities specific to inline completions.
+//
+// @since 3.18.0
+// @proposed
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#inlineCompletionClientCapabilities
+type InlineCompletionClientCapabilities struct {
+	// Whether implementation supports dynamic registration for inline completion providers.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
 }
 
-func (b *BooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptions) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
-		return nil
-	}
-	var typeDefinitionOptionsValue *TypeDefinitionOptions
-	if err := json.Unmarshal(data, &typeDefinitionOptionsValue); err == nil {
-		b.Value = typeDefinitionOptionsValue
-		return nil
-	}
-	var typeDefinitionRegistrationOptionsValue *TypeDefinitionRegistrationOptions
-	if err := json.Unmarshal(data, &typeDefinitionRegistrationOptionsValue); err == nil {
-		b.Value = typeDefinitionRegistrationOptionsValue
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptions](),
+// Whether implementation supports dynamic registration for inline completion providers.
+func (i *InlineCompletionClientCapabilities) GetDynamicRegistration() bool {
+	if i == nil {
+		var zero bool
+		return zero
 	}
+	return i.DynamicRegistration
 }
 
-func (b *BooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
+// Inline completion options used during static registration.
+//
+// @since 3.18.0
+// @proposed
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#inlineCompletionOptions
+type InlineCompletionOptions struct {
+	*WorkDoneProgressOptions
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#implementationOptions
-type ImplementationOptions struct {
+// Client capabilities specific to inline values.
+//
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#inlineValueClientCapabilities
+type InlineValueClientCapabilities struct {
+	// Whether implementation supports dynamic registration for inline value providers.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+}
+
+// Whether implementation supports dynamic registration for inline value providers.
+func (i *InlineValueClientCapabilities) GetDynamicRegistration() bool {
+	if i == nil {
+		var zero bool
+		return zero
+	}
+	return i.DynamicRegistration
+}
+
+// Inline value options used during static registration.
+//
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#inlineValueOptions
+type InlineValueOptions struct {
 	*WorkDoneProgressOptions
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#implementationRegistrationOptions
-type ImplementationRegistrationOptions struct {
+// Inline value options used during static or dynamic registration.
+//
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#inlineValueRegistrationOptions
+type InlineValueRegistrationOptions struct {
+	*InlineValueOptions
 	*TextDocumentRegistrationOptions
-	*ImplementationOptions
 	*StaticRegistrationOptions
 }
 
-// BooleanOrImplementationOptionsOrImplementationRegistrationOptions contains either of the following types:
-//   - [Boolean]
-//   - [*ImplementationOptions]
-//   - [*ImplementationRegistrationOptions]
-type BooleanOrImplementationOptionsOrImplementationRegistrationOptions struct {
-	Value BooleanOrImplementationOptionsOrImplementationRegistrationOptionsValue
+// Client workspace capabilities specific to inline values.
+//
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#inlineValueWorkspaceClientCapabilities
+type InlineValueWorkspaceClientCapabilities struct {
+	// Whether the client implementation supports a refresh request sent from the
+	// server to the client.
+	//
+	// Note that this event is global and will force the client to refresh all
+	// inline values currently shown. It should be used with absolute care and is
+	// useful for situation where a server for example detects a project wide
+	// change that requires such a calculation.
+	RefreshSupport bool `json:"refreshSupport,omitempty"`
+}
+
+// Whether the client implementation supports a refresh request sent from the
+// server to the client.
+//
+// Note that this event is global and will force the client to refresh all
+// inline values currently shown. It should be used with absolute care and is
+// useful for situation where a server for example detects a project wide
+// change that requires such a calculation.
+func (i *InlineValueWorkspaceClientCapabilities) GetRefreshSupport() bool {
+	if i == nil {
+		var zero bool
+		return zero
+	}
+	return i.RefreshSupport
+}
+
+// A special text edit to provide an insert and a replace operation.
+//
+// @since 3.16.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#insertReplaceEdit
+type InsertReplaceEdit struct {
+	// The string to be inserted.
+	NewText string `json:"newText"`
+	// The range if the insert is requested
+	Insert *Range `json:"insert"`
+	// The range if the replace is requested.
+	Replace *Range `json:"replace"`
 }
 
-// BooleanOrImplementationOptionsOrImplementationRegistrationOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*ImplementationOptions]
-//   - [*ImplementationRegistrationOptions]
-//
-//sumtype:decl
-type BooleanOrImplementationOptionsOrImplementationRegistrationOptionsValue interface {
-	isBooleanOrImplementationOptionsOrImplementationRegistrationOptionsValue()
+// The string to be inserted.
+func (i *InsertReplaceEdit) GetNewText() string {
+	if i == nil {
+		var zero string
+		return zero
+	}
+	return i.NewText
 }
 
-func (Boolean) isBooleanOrImplementationOptionsOrImplementationRegistrationOptionsValue() {}
-func (*ImplementationOptions) isBooleanOrImplementationOptionsOrImplementationRegistrationOptionsValue() {
-}
-func (*ImplementationRegistrationOptions) isBooleanOrImplementationOptionsOrImplementationRegistrationOptionsValue() {
+// The range if the insert is requested
+func (i *InsertReplaceEdit) GetInsert() *Range {
+	if i == nil {
+		var zero *Range
+		return zero
+	}
+	return i.Insert
 }
 
-func (b *BooleanOrImplementationOptionsOrImplementationRegistrationOptions) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
-		return nil
-	}
-	var implementationOptionsValue *ImplementationOptions
-	if err := json.Unmarshal(data, &implementationOptionsValue); err == nil {
-		b.Value = implementationOptionsValue
-		return nil
-	}
-	var implementationRegistrationOptionsValue *ImplementationRegistrationOptions
-	if err := json.Unmarshal(data, &implementationRegistrationOptionsValue); err == nil {
-		b.Value = implementationRegistrationOptionsValue
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrImplementationOptionsOrImplementationRegistrationOptions](),
+// The range if the replace is requested.
+func (i *InsertReplaceEdit) GetReplace() *Range {
+	if i == nil {
+		var zero *Range
+		return zero
 	}
+	return i.Replace
 }
 
-func (b *BooleanOrImplementationOptionsOrImplementationRegistrationOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
+type InsertReplaceRange struct {
+	Insert  *Range `json:"insert"`
+	Replace *Range `json:"replace"`
 }
 
-// Reference options.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#referenceOptions
-type ReferenceOptions struct {
-	*WorkDoneProgressOptions
+func (i *InsertReplaceRange) GetInsert() *Range {
+	if i == nil {
+		return *new(*Range)
+	}
+	return i.Insert
 }
 
-// BooleanOrReferenceOptions contains either of the following types:
-//   - [Boolean]
-//   - [*ReferenceOptions]
-type BooleanOrReferenceOptions struct {
-	Value BooleanOrReferenceOptionsValue
+func (i *InsertReplaceRange) GetReplace() *Range {
+	if i == nil {
+		return *new(*Range)
+	}
+	return i.Replace
 }
 
-// BooleanOrReferenceOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*ReferenceOptions]
+// Defines whether the insert text in a completion item should be interpreted as
+// plain text or a snippet.
 //
-//sumtype:decl
-type BooleanOrReferenceOptionsValue interface {
-	isBooleanOrReferenceOptionsValue()
-}
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#insertTextFormat
+type InsertTextFormat uint32
 
-func (Boolean) isBooleanOrReferenceOptionsValue()           {}
-func (*ReferenceOptions) isBooleanOrReferenceOptionsValue() {}
+const (
+	// The primary text to be inserted is treated as a plain string.
+	InsertTextFormatPlainText InsertTextFormat = 1
+	// The primary text to be inserted is treated as a snippet.
+	//
+	// A snippet can define tab stops and placeholders with `$1`, `$2`
+	// and `${3:foo}`. `$0` defines the final tab stop, it defaults to
+	// the end of the snippet. Placeholders with equal identifiers are linked,
+	// that is typing in one will update others too.
+	//
+	// See also: https://microsoft.github.io/language-server-protocol/specifications/specification-current/#snippet_syntax
+	InsertTextFormatSnippet InsertTextFormat = 2
+)
 
-func (b *BooleanOrReferenceOptions) UnmarshalJSON(data []byte) error {
+var validInsertTextFormatValues = map[uint32]bool{
+	1: true,
+	2: true,
+}
+
+func (i *InsertTextFormat) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
-		return nil
-	}
-	var referenceOptionsValue *ReferenceOptions
-	if err := json.Unmarshal(data, &referenceOptionsValue); err == nil {
-		b.Value = referenceOptionsValue
-		return nil
+	var uint32Value uint32
+	if err := json.Unmarshal(data, &uint32Value); err != nil {
+		return err
 	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrReferenceOptions](),
+	if !validInsertTextFormatValues[uint32Value] {
+		return fmt.Errorf("cannot unmarshal %v into InsertTextFormat: custom values are not supported", uint32Value)
 	}
-}
+	*i = InsertTextFormat(uint32Value)
 
-func (b *BooleanOrReferenceOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
+	return nil
 }
 
-// Provider options for a {@link DocumentHighlightRequest}.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentHighlightOptions
-type DocumentHighlightOptions struct {
-	*WorkDoneProgressOptions
-}
+func (i InsertTextFormat) MarshalJSON() ([]byte, error) {
+	var uint32Value = uint32(i)
+	if !validInsertTextFormatValues[uint32Value] {
+		return nil, fmt.Errorf("cannot marshal %v into InsertTextFormat: custom values are not supported", uint32Value)
+	}
+	return json.Marshal(uint32Value)
 
-// BooleanOrDocumentHighlightOptions contains either of the following types:
-//   - [Boolean]
-//   - [*DocumentHighlightOptions]
-type BooleanOrDocumentHighlightOptions struct {
-	Value BooleanOrDocumentHighlightOptionsValue
 }
 
-// BooleanOrDocumentHighlightOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*DocumentHighlightOptions]
+// How whitespace and indentation is handled during completion
+// item insertion.
 //
-//sumtype:decl
-type BooleanOrDocumentHighlightOptionsValue interface {
-	isBooleanOrDocumentHighlightOptionsValue()
-}
+// @since 3.16.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#insertTextMode
+type InsertTextMode uint32
 
-func (Boolean) isBooleanOrDocumentHighlightOptionsValue()                   {}
-func (*DocumentHighlightOptions) isBooleanOrDocumentHighlightOptionsValue() {}
+const (
+	// The insertion or replace strings is taken as it is. If the
+	// value is multi line the lines below the cursor will be
+	// inserted using the indentation defined in the string value.
+	// The client will not apply any kind of adjustments to the
+	// string.
+	InsertTextModeasIs InsertTextMode = 1
+	// The editor adjusts leading whitespace of new lines so that
+	// they match the indentation up to the cursor of the line for
+	// which the item is accepted.
+	//
+	// Consider a line like this: <2tabs><cursor><3tabs>foo. Accepting a
+	// multi line completion item is indented using 2 tabs and all
+	// following lines inserted will be indented using 2 tabs as well.
+	InsertTextModeadjustIndentation InsertTextMode = 2
+)
 
-func (b *BooleanOrDocumentHighlightOptions) UnmarshalJSON(data []byte) error {
+var validInsertTextModeValues = map[uint32]bool{
+	1: true,
+	2: true,
+}
+
+func (i *InsertTextMode) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
-		return nil
-	}
-	var documentHighlightOptionsValue *DocumentHighlightOptions
-	if err := json.Unmarshal(data, &documentHighlightOptionsValue); err == nil {
-		b.Value = documentHighlightOptionsValue
-		return nil
+	var uint32Value uint32
+	if err := json.Unmarshal(data, &uint32Value); err != nil {
+		return err
 	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrDocumentHighlightOptions](),
+	if !validInsertTextModeValues[uint32Value] {
+		return fmt.Errorf("cannot unmarshal %v into InsertTextMode: custom values are not supported", uint32Value)
 	}
-}
-
-func (b *BooleanOrDocumentHighlightOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
-}
+	*i = InsertTextMode(uint32Value)
 
-// Provider options for a {@link DocumentSymbolRequest}.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentSymbolOptions
-type DocumentSymbolOptions struct {
-	*WorkDoneProgressOptions
-	// A human-readable string that is shown when multiple outlines trees
-	// are shown for the same document.
-	//
-	// @since 3.16.0
-	Label string `json:"label,omitempty"`
+	return nil
 }
 
-// A human-readable string that is shown when multiple outlines trees
-// are shown for the same document.
-//
-// @since 3.16.0
-func (d *DocumentSymbolOptions) GetLabel() string {
-	if d == nil {
-		var zero string
-		return zero
+func (i InsertTextMode) MarshalJSON() ([]byte, error) {
+	var uint32Value = uint32(i)
+	if !validInsertTextModeValues[uint32Value] {
+		return nil, fmt.Errorf("cannot marshal %v into InsertTextMode: custom values are not supported", uint32Value)
 	}
-	return d.Label
-}
+	return json.Marshal(uint32Value)
 
-// BooleanOrDocumentSymbolOptions contains either of the following types:
-//   - [Boolean]
-//   - [*DocumentSymbolOptions]
-type BooleanOrDocumentSymbolOptions struct {
-	Value BooleanOrDocumentSymbolOptionsValue
 }
 
-// BooleanOrDocumentSymbolOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*DocumentSymbolOptions]
+type Integer int
+
+// IntegerOrString contains either of the following types:
+//   - [Integer]
+//   - [String]
+type IntegerOrString struct {
+	Value IntegerOrStringValue
+}
+
+// IntegerOrStringValue is either of the following types:
+//   - [Integer]
+//   - [String]
 //
 //sumtype:decl
-type BooleanOrDocumentSymbolOptionsValue interface {
-	isBooleanOrDocumentSymbolOptionsValue()
+type IntegerOrStringValue interface {
+	isIntegerOrStringValue()
 }
 
-func (Boolean) isBooleanOrDocumentSymbolOptionsValue()                {}
-func (*DocumentSymbolOptions) isBooleanOrDocumentSymbolOptionsValue() {}
+func (Integer) isIntegerOrStringValue() {}
+func (String) isIntegerOrStringValue()  {}
 
-func (b *BooleanOrDocumentSymbolOptions) UnmarshalJSON(data []byte) error {
+func (i *IntegerOrString) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
+	var integerValue Integer
+	if err := json.Unmarshal(data, &integerValue); err == nil {
+		i.Value = integerValue
 		return nil
 	}
-	var documentSymbolOptionsValue *DocumentSymbolOptions
-	if err := json.Unmarshal(data, &documentSymbolOptionsValue); err == nil {
-		b.Value = documentSymbolOptionsValue
+	var stringValue String
+	if err := json.Unmarshal(data, &stringValue); err == nil {
+		i.Value = stringValue
 		return nil
 	}
 	return &json.UnmarshalTypeError{
 		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrDocumentSymbolOptions](),
+		Type:  reflect.TypeFor[*IntegerOrString](),
 	}
 }
 
-func (b *BooleanOrDocumentSymbolOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
+func (i *IntegerOrString) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.Value)
 }
 
-// Provider options for a {@link CodeActionRequest}.
+// The LSP any type.
+// Please note that strictly speaking a property with the value `undefined`
+// can't be converted into JSON preserving the property name. However for
+// convenience it is allowed and assumed that all these properties are
+// optional as well.
+// @since 3.17.0
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#codeActionOptions
-type CodeActionOptions struct {
-	*WorkDoneProgressOptions
-	// CodeActionKinds that this server may return.
-	//
-	// The list of kinds may be generic, such as `CodeActionKind.Refactor`, or the server
-	// may list out every specific kind they provide.
-	CodeActionKinds []CodeActionKind `json:"codeActionKinds,omitempty"`
-	// The server provides support to resolve additional
-	// information for a code action.
-	//
-	// @since 3.16.0
-	ResolveProvider bool `json:"resolveProvider,omitempty"`
-}
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#lSPAny
+type LSPAny = *LSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBoolean
 
-// CodeActionKinds that this server may return.
+type LSPAnySlice []LSPAny
+
+// LSP arrays.
+// @since 3.17.0
 //
-// The list of kinds may be generic, such as `CodeActionKind.Refactor`, or the server
-// may list out every specific kind they provide.
-func (c *CodeActionOptions) GetCodeActionKinds() []CodeActionKind {
-	if c == nil {
-		var zero []CodeActionKind
-		return zero
-	}
-	return c.CodeActionKinds
-}
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#lSPArray
+type LSPArray = LSPAnySlice
 
-// The server provides support to resolve additional
-// information for a code action.
+// LSP object definition.
+// @since 3.17.0
 //
-// @since 3.16.0
-func (c *CodeActionOptions) GetResolveProvider() bool {
-	if c == nil {
-		var zero bool
-		return zero
-	}
-	return c.ResolveProvider
-}
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#lSPObject
+type LSPObject = stringLSPAnyMap
 
-// BooleanOrCodeActionOptions contains either of the following types:
+// LSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBoolean contains either of the following types:
+//   - [LSPObject]
+//   - [LSPArray]
+//   - [String]
+//   - [Integer]
+//   - [Uinteger]
+//   - [Decimal]
 //   - [Boolean]
-//   - [*CodeActionOptions]
-type BooleanOrCodeActionOptions struct {
-	Value BooleanOrCodeActionOptionsValue
+type LSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBoolean struct {
+	Value LSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBooleanValue
 }
 
-// BooleanOrCodeActionOptionsValue is either of the following types:
+// LSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBooleanValue is either of the following types:
+//   - [LSPObject]
+//   - [LSPArray]
+//   - [String]
+//   - [Integer]
+//   - [Uinteger]
+//   - [Decimal]
 //   - [Boolean]
-//   - [*CodeActionOptions]
 //
 //sumtype:decl
-type BooleanOrCodeActionOptionsValue interface {
-	isBooleanOrCodeActionOptionsValue()
+type LSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBooleanValue interface {
+	isLSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBooleanValue()
 }
 
-func (Boolean) isBooleanOrCodeActionOptionsValue()            {}
-func (*CodeActionOptions) isBooleanOrCodeActionOptionsValue() {}
+func (LSPObject) isLSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBooleanValue() {}
+func (LSPArray) isLSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBooleanValue()  {}
+func (String) isLSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBooleanValue()    {}
+func (Integer) isLSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBooleanValue()   {}
+func (Uinteger) isLSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBooleanValue()  {}
+func (Decimal) isLSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBooleanValue()   {}
+func (Boolean) isLSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBooleanValue()   {}
 
-func (b *BooleanOrCodeActionOptions) UnmarshalJSON(data []byte) error {
+func (l *LSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBoolean) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
+	var lSPObjectValue LSPObject
+	if err := json.Unmarshal(data, &lSPObjectValue); err == nil {
+		l.Value = lSPObjectValue
 		return nil
 	}
-	var codeActionOptionsValue *CodeActionOptions
-	if err := json.Unmarshal(data, &codeActionOptionsValue); err == nil {
-		b.Value = codeActionOptionsValue
+	var lSPArrayValue LSPArray
+	if err := json.Unmarshal(data, &lSPArrayValue); err == nil {
+		l.Value = lSPArrayValue
+		return nil
+	}
+	var stringValue String
+	if err := json.Unmarshal(data, &stringValue); err == nil {
+		l.Value = stringValue
+		return nil
+	}
+	var integerValue Integer
+	if err := json.Unmarshal(data, &integerValue); err == nil {
+		l.Value = integerValue
+		return nil
+	}
+	var uintegerValue Uinteger
+	if err := json.Unmarshal(data, &uintegerValue); err == nil {
+		l.Value = uintegerValue
+		return nil
+	}
+	var decimalValue Decimal
+	if err := json.Unmarshal(data, &decimalValue); err == nil {
+		l.Value = decimalValue
+		return nil
+	}
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		l.Value = booleanValue
 		return nil
 	}
 	return &json.UnmarshalTypeError{
 		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrCodeActionOptions](),
+		Type:  reflect.TypeFor[*LSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBoolean](),
 	}
 }
 
-func (b *BooleanOrCodeActionOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
+func (l *LSPObjectOrLSPArrayOrStringOrIntegerOrUintegerOrDecimalOrBoolean) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.Value)
 }
 
-// Code Lens provider options of a {@link CodeLensRequest}.
+// Client capabilities for the linked editing range request.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#codeLensOptions
-type CodeLensOptions struct {
-	*WorkDoneProgressOptions
-	// Code lens has a resolve provider as well.
-	ResolveProvider bool `json:"resolveProvider,omitempty"`
+// @since 3.16.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#linkedEditingRangeClientCapabilities
+type LinkedEditingRangeClientCapabilities struct {
+	// Whether implementation supports dynamic registration. If this is set to `true`
+	// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
+	// return value for the corresponding server capability as well.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
 }
 
-// Code lens has a resolve provider as well.
-func (c *CodeLensOptions) GetResolveProvider() bool {
-	if c == nil {
+// Whether implementation supports dynamic registration. If this is set to `true`
+// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
+// return value for the corresponding server capability as well.
+func (l *LinkedEditingRangeClientCapabilities) GetDynamicRegistration() bool {
+	if l == nil {
 		var zero bool
 		return zero
 	}
-	return c.ResolveProvider
+	return l.DynamicRegistration
 }
 
-// Provider options for a {@link DocumentLinkRequest}.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentLinkOptions
-type DocumentLinkOptions struct {
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#linkedEditingRangeOptions
+type LinkedEditingRangeOptions struct {
 	*WorkDoneProgressOptions
-	// Document links have a resolve provider as well.
-	ResolveProvider bool `json:"resolveProvider,omitempty"`
 }
 
-// Document links have a resolve provider as well.
-func (d *DocumentLinkOptions) GetResolveProvider() bool {
-	if d == nil {
-		var zero bool
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#linkedEditingRangeRegistrationOptions
+type LinkedEditingRangeRegistrationOptions struct {
+	*TextDocumentRegistrationOptions
+	*LinkedEditingRangeOptions
+	*StaticRegistrationOptions
+}
+
+// Represents a location inside a resource, such as a line
+// inside a text file.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#location
+type Location struct {
+	Uri   string `json:"uri"`
+	Range *Range `json:"range"`
+}
+
+func (l *Location) GetUri() string {
+	if l == nil {
+		var zero string
+		return zero
+	}
+	return l.Uri
+}
+
+func (l *Location) GetRange() *Range {
+	if l == nil {
+		var zero *Range
+		return zero
+	}
+	return l.Range
+}
+
+// Represents the connection of two locations. Provides additional metadata over normal {@link Location locations},
+// including an origin range.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#locationLink
+type LocationLink struct {
+	// Span of the origin of this link.
+	//
+	// Used as the underlined span for mouse interaction. Defaults to the word range at
+	// the definition position.
+	OriginSelectionRange *Range `json:"originSelectionRange,omitempty"`
+	// The target resource identifier of this link.
+	TargetUri string `json:"targetUri"`
+	// The full target range of this link. If the target for example is a symbol then target range is the
+	// range enclosing this symbol not including leading/trailing whitespace but everything else
+	// like comments. This information is typically used to highlight the range in the editor.
+	TargetRange *Range `json:"targetRange"`
+	// The range that should be selected and revealed when this link is being followed, e.g the name of a function.
+	// Must be contained by the `targetRange`. See also `DocumentSymbol#range`
+	TargetSelectionRange *Range `json:"targetSelectionRange"`
+}
+
+// Span of the origin of this link.
+//
+// Used as the underlined span for mouse interaction. Defaults to the word range at
+// the definition position.
+func (l *LocationLink) GetOriginSelectionRange() *Range {
+	if l == nil {
+		var zero *Range
+		return zero
+	}
+	return l.OriginSelectionRange
+}
+
+// The target resource identifier of this link.
+func (l *LocationLink) GetTargetUri() string {
+	if l == nil {
+		var zero string
 		return zero
 	}
-	return d.ResolveProvider
+	return l.TargetUri
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentColorOptions
-type DocumentColorOptions struct {
-	*WorkDoneProgressOptions
+// The full target range of this link. If the target for example is a symbol then target range is the
+// range enclosing this symbol not including leading/trailing whitespace but everything else
+// like comments. This information is typically used to highlight the range in the editor.
+func (l *LocationLink) GetTargetRange() *Range {
+	if l == nil {
+		var zero *Range
+		return zero
+	}
+	return l.TargetRange
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentColorRegistrationOptions
-type DocumentColorRegistrationOptions struct {
-	*TextDocumentRegistrationOptions
-	*DocumentColorOptions
-	*StaticRegistrationOptions
+// The range that should be selected and revealed when this link is being followed, e.g the name of a function.
+// Must be contained by the `targetRange`. See also `DocumentSymbol#range`
+func (l *LocationLink) GetTargetSelectionRange() *Range {
+	if l == nil {
+		var zero *Range
+		return zero
+	}
+	return l.TargetSelectionRange
 }
 
-// BooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptions contains either of the following types:
-//   - [Boolean]
-//   - [*DocumentColorOptions]
-//   - [*DocumentColorRegistrationOptions]
-type BooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptions struct {
-	Value BooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptionsValue
+// LocationOrLocationSlice contains either of the following types:
+//   - [*Location]
+//   - [LocationSlice]
+type LocationOrLocationSlice struct {
+	Value LocationOrLocationSliceValue
 }
 
-// BooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*DocumentColorOptions]
-//   - [*DocumentColorRegistrationOptions]
+// LocationOrLocationSliceValue is either of the following types:
+//   - [*Location]
+//   - [LocationSlice]
 //
 //sumtype:decl
-type BooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptionsValue interface {
-	isBooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptionsValue()
+type LocationOrLocationSliceValue interface {
+	isLocationOrLocationSliceValue()
 }
 
-func (Boolean) isBooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptionsValue() {}
-func (*DocumentColorOptions) isBooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptionsValue() {
-}
-func (*DocumentColorRegistrationOptions) isBooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptionsValue() {
-}
+func (*Location) isLocationOrLocationSliceValue()     {}
+func (LocationSlice) isLocationOrLocationSliceValue() {}
 
-func (b *BooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptions) UnmarshalJSON(data []byte) error {
+func (l *LocationOrLocationSlice) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
-		return nil
-	}
-	var documentColorOptionsValue *DocumentColorOptions
-	if err := json.Unmarshal(data, &documentColorOptionsValue); err == nil {
-		b.Value = documentColorOptionsValue
+	var locationValue *Location
+	if err := json.Unmarshal(data, &locationValue); err == nil {
+		l.Value = locationValue
 		return nil
 	}
-	var documentColorRegistrationOptionsValue *DocumentColorRegistrationOptions
-	if err := json.Unmarshal(data, &documentColorRegistrationOptionsValue); err == nil {
-		b.Value = documentColorRegistrationOptionsValue
+	var locationSliceValue LocationSlice
+	if err := json.Unmarshal(data, &locationSliceValue); err == nil {
+		l.Value = locationSliceValue
 		return nil
 	}
 	return &json.UnmarshalTypeError{
 		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptions](),
+		Type:  reflect.TypeFor[*LocationOrLocationSlice](),
 	}
 }
 
-func (b *BooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
-}
-
-// Server capabilities for a {@link WorkspaceSymbolRequest}.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#workspaceSymbolOptions
-type WorkspaceSymbolOptions struct {
-	*WorkDoneProgressOptions
-	// The server provides support to resolve additional
-	// information for a workspace symbol.
-	//
-	// @since 3.17.0
-	ResolveProvider bool `json:"resolveProvider,omitempty"`
+func (l *LocationOrLocationSlice) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.Value)
 }
 
-// The server provides support to resolve additional
-// information for a workspace symbol.
-//
-// @since 3.17.0
-func (w *WorkspaceSymbolOptions) GetResolveProvider() bool {
-	if w == nil {
-		var zero bool
-		return zero
-	}
-	return w.ResolveProvider
-}
+type LocationSlice []*Location
 
-// BooleanOrWorkspaceSymbolOptions contains either of the following types:
-//   - [Boolean]
-//   - [*WorkspaceSymbolOptions]
-type BooleanOrWorkspaceSymbolOptions struct {
-	Value BooleanOrWorkspaceSymbolOptionsValue
+// LocationSliceOrDefinitionLinkSlice contains either of the following types:
+//   - [LocationSlice]
+//   - [DefinitionLinkSlice]
+type LocationSliceOrDefinitionLinkSlice struct {
+	Value LocationSliceOrDefinitionLinkSliceValue
 }
 
-// BooleanOrWorkspaceSymbolOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*WorkspaceSymbolOptions]
+// LocationSliceOrDefinitionLinkSliceValue is either of the following types:
+//   - [LocationSlice]
+//   - [DefinitionLinkSlice]
 //
 //sumtype:decl
-type BooleanOrWorkspaceSymbolOptionsValue interface {
-	isBooleanOrWorkspaceSymbolOptionsValue()
+type LocationSliceOrDefinitionLinkSliceValue interface {
+	isLocationSliceOrDefinitionLinkSliceValue()
 }
 
-func (Boolean) isBooleanOrWorkspaceSymbolOptionsValue()                 {}
-func (*WorkspaceSymbolOptions) isBooleanOrWorkspaceSymbolOptionsValue() {}
+func (LocationSlice) isLocationSliceOrDefinitionLinkSliceValue()       {}
+func (DefinitionLinkSlice) isLocationSliceOrDefinitionLinkSliceValue() {}
 
-func (b *BooleanOrWorkspaceSymbolOptions) UnmarshalJSON(data []byte) error {
+func (l *LocationSliceOrDefinitionLinkSlice) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
+	var locationSliceValue LocationSlice
+	if err := json.Unmarshal(data, &locationSliceValue); err == nil {
+		l.Value = locationSliceValue
 		return nil
 	}
-	var workspaceSymbolOptionsValue *WorkspaceSymbolOptions
-	if err := json.Unmarshal(data, &workspaceSymbolOptionsValue); err == nil {
-		b.Value = workspaceSymbolOptionsValue
+	var definitionLinkSliceValue DefinitionLinkSlice
+	if err := json.Unmarshal(data, &definitionLinkSliceValue); err == nil {
+		l.Value = definitionLinkSliceValue
 		return nil
 	}
 	return &json.UnmarshalTypeError{
 		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrWorkspaceSymbolOptions](),
+		Type:  reflect.TypeFor[*LocationSliceOrDefinitionLinkSlice](),
 	}
 }
 
-func (b *BooleanOrWorkspaceSymbolOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
+func (l *LocationSliceOrDefinitionLinkSlice) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.Value)
 }
 
-// Provider options for a {@link DocumentFormattingRequest}.
+// The log message parameters.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentFormattingOptions
-type DocumentFormattingOptions struct {
-	*WorkDoneProgressOptions
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#logMessageParams
+type LogMessageParams struct {
+	// The message type. See {@link MessageType}
+	Type MessageType `json:"type"`
+	// The actual message.
+	Message string `json:"message"`
 }
 
-// BooleanOrDocumentFormattingOptions contains either of the following types:
-//   - [Boolean]
-//   - [*DocumentFormattingOptions]
-type BooleanOrDocumentFormattingOptions struct {
-	Value BooleanOrDocumentFormattingOptionsValue
+// The message type. See {@link MessageType}
+func (l *LogMessageParams) GetType() MessageType {
+	if l == nil {
+		var zero MessageType
+		return zero
+	}
+	return l.Type
 }
 
-// BooleanOrDocumentFormattingOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*DocumentFormattingOptions]
+// The actual message.
+func (l *LogMessageParams) GetMessage() string {
+	if l == nil {
+		var zero string
+		return zero
+	}
+	return l.Message
+}
+
+// Client capabilities specific to the used markdown parser.
 //
-//sumtype:decl
-type BooleanOrDocumentFormattingOptionsValue interface {
-	isBooleanOrDocumentFormattingOptionsValue()
+// @since 3.16.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#markdownClientCapabilities
+type MarkdownClientCapabilities struct {
+	// The name of the parser.
+	Parser string `json:"parser"`
+	// The version of the parser.
+	Version string `json:"version,omitempty"`
+	// A list of HTML tags that the client allows / supports in
+	// Markdown.
+	//
+	// @since 3.17.0
+	AllowedTags []string `json:"allowedTags,omitempty"`
 }
 
-func (Boolean) isBooleanOrDocumentFormattingOptionsValue()                    {}
-func (*DocumentFormattingOptions) isBooleanOrDocumentFormattingOptionsValue() {}
+// The name of the parser.
+func (m *MarkdownClientCapabilities) GetParser() string {
+	if m == nil {
+		var zero string
+		return zero
+	}
+	return m.Parser
+}
+
+// The version of the parser.
+func (m *MarkdownClientCapabilities) GetVersion() string {
+	if m == nil {
+		var zero string
+		return zero
+	}
+	return m.Version
+}
+
+// A list of HTML tags that the client allows / supports in
+// Markdown.
+//
+// @since 3.17.0
+func (m *MarkdownClientCapabilities) GetAllowedTags() []string {
+	if m == nil {
+		var zero []string
+		return zero
+	}
+	return m.AllowedTags
+}
+
+// MarkedString can be used to render human readable text. It is either a markdown string
+// or a code-block that provides a language and a code snippet. The language identifier
+// is semantically equal to the optional language identifier in fenced code blocks in GitHub
+// issues. See https://help.github.com/articles/creating-and-highlighting-code-blocks/#syntax-highlighting
+//
+// The pair of a language and a value is an equivalent to markdown:
+// ```${language}
+// ${value}
+// ```
+//
+// Note that markdown strings will be sanitized - that means html will be escaped.
+//
+// Deprecated: use MarkupContent instead.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#markedString
+type MarkedString = *StringOrMarkedStringOr2
+
+type MarkedStringOr2 struct {
+	Language string `json:"language"`
+	Value    string `json:"value"`
+}
 
-func (b *BooleanOrDocumentFormattingOptions) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
-		return nil
-	}
-	var documentFormattingOptionsValue *DocumentFormattingOptions
-	if err := json.Unmarshal(data, &documentFormattingOptionsValue); err == nil {
-		b.Value = documentFormattingOptionsValue
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrDocumentFormattingOptions](),
+func (m *MarkedStringOr2) GetLanguage() string {
+	if m == nil {
+		return *new(string)
 	}
+	return m.Language
 }
 
-func (b *BooleanOrDocumentFormattingOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
+func (m *MarkedStringOr2) GetValue() string {
+	if m == nil {
+		return *new(string)
+	}
+	return m.Value
 }
 
-// Provider options for a {@link DocumentRangeFormattingRequest}.
+type MarkedStringSlice []MarkedString
+
+// A `MarkupContent` literal represents a string value which content is interpreted base on its
+// kind flag. Currently the protocol supports `plaintext` and `markdown` as markup kinds.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentRangeFormattingOptions
-type DocumentRangeFormattingOptions struct {
-	*WorkDoneProgressOptions
-	// Whether the server supports formatting multiple ranges at once.
-	//
-	// @since 3.18.0
-	// @proposed
-	RangesSupport bool `json:"rangesSupport,omitempty"`
+// If the kind is `markdown` then the value can contain fenced code blocks like in GitHub issues.
+// See https://help.github.com/articles/creating-and-highlighting-code-blocks/#syntax-highlighting
+//
+// Here is an example how such a string can be constructed using JavaScript / TypeScript:
+// ```ts
+//
+//	let markdown: MarkdownContent = {
+//	 kind: MarkupKind.Markdown,
+//	 value: [
+//	   '# Header',
+//	   'Some text',
+//	   '```typescript',
+//	   'someCode();',
+//	   '```'
+//	 ].join('\n')
+//	};
+//
+// ```
+//
+// *Please Note* that clients might sanitize the return markdown. A client could decide to
+// remove HTML from the markdown to avoid script execution.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#markupContent
+type MarkupContent struct {
+	// The type of the Markup
+	Kind MarkupKind `json:"kind"`
+	// The content itself
+	Value string `json:"value"`
 }
 
-// Whether the server supports formatting multiple ranges at once.
-//
-// @since 3.18.0
-// @proposed
-func (d *DocumentRangeFormattingOptions) GetRangesSupport() bool {
-	if d == nil {
-		var zero bool
+// The type of the Markup
+func (m *MarkupContent) GetKind() MarkupKind {
+	if m == nil {
+		var zero MarkupKind
 		return zero
 	}
-	return d.RangesSupport
+	return m.Kind
 }
 
-// BooleanOrDocumentRangeFormattingOptions contains either of the following types:
-//   - [Boolean]
-//   - [*DocumentRangeFormattingOptions]
-type BooleanOrDocumentRangeFormattingOptions struct {
-	Value BooleanOrDocumentRangeFormattingOptionsValue
+// The content itself
+func (m *MarkupContent) GetValue() string {
+	if m == nil {
+		var zero string
+		return zero
+	}
+	return m.Value
 }
 
-// BooleanOrDocumentRangeFormattingOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*DocumentRangeFormattingOptions]
+// MarkupContentOrMarkedStringOrMarkedStringSlice contains either of the following types:
+//   - [*MarkupContent]
+//   - [MarkedString]
+//   - [MarkedStringSlice]
+type MarkupContentOrMarkedStringOrMarkedStringSlice struct {
+	Value MarkupContentOrMarkedStringOrMarkedStringSliceValue
+}
+
+// MarkupContentOrMarkedStringOrMarkedStringSliceValue is either of the following types:
+//   - [*MarkupContent]
+//   - [MarkedString]
+//   - [MarkedStringSlice]
 //
 //sumtype:decl
-type BooleanOrDocumentRangeFormattingOptionsValue interface {
-	isBooleanOrDocumentRangeFormattingOptionsValue()
+type MarkupContentOrMarkedStringOrMarkedStringSliceValue interface {
+	isMarkupContentOrMarkedStringOrMarkedStringSliceValue()
 }
 
-func (Boolean) isBooleanOrDocumentRangeFormattingOptionsValue()                         {}
-func (*DocumentRangeFormattingOptions) isBooleanOrDocumentRangeFormattingOptionsValue() {}
+func (*MarkupContent) isMarkupContentOrMarkedStringOrMarkedStringSliceValue()    {}
+func (MarkedString) isMarkupContentOrMarkedStringOrMarkedStringSliceValue()      {}
+func (MarkedStringSlice) isMarkupContentOrMarkedStringOrMarkedStringSliceValue() {}
 
-func (b *BooleanOrDocumentRangeFormattingOptions) UnmarshalJSON(data []byte) error {
+func (m *MarkupContentOrMarkedStringOrMarkedStringSlice) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
+	var markupContentValue *MarkupContent
+	if err := json.Unmarshal(data, &markupContentValue); err == nil {
+		m.Value = markupContentValue
 		return nil
 	}
-	var documentRangeFormattingOptionsValue *DocumentRangeFormattingOptions
-	if err := json.Unmarshal(data, &documentRangeFormattingOptionsValue); err == nil {
-		b.Value = documentRangeFormattingOptionsValue
+	var markedStringValue MarkedString
+	if err := json.Unmarshal(data, &markedStringValue); err == nil {
+		m.Value = markedStringValue
+		return nil
+	}
+	var markedStringSliceValue MarkedStringSlice
+	if err := json.Unmarshal(data, &markedStringSliceValue); err == nil {
+		m.Value = markedStringSliceValue
 		return nil
 	}
 	return &json.UnmarshalTypeError{
 		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrDocumentRangeFormattingOptions](),
+		Type:  reflect.TypeFor[*MarkupContentOrMarkedStringOrMarkedStringSlice](),
 	}
 }
 
-func (b *BooleanOrDocumentRangeFormattingOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
+func (m *MarkupContentOrMarkedStringOrMarkedStringSlice) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Value)
 }
 
-// Provider options for a {@link DocumentOnTypeFormattingRequest}.
+// Describes the content type that a client supports in various
+// result literals like `Hover`, `ParameterInfo` or `CompletionItem`.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentOnTypeFormattingOptions
-type DocumentOnTypeFormattingOptions struct {
-	// A character on which formatting should be triggered, like `{`.
-	FirstTriggerCharacter string `json:"firstTriggerCharacter"`
-	// More trigger characters.
-	MoreTriggerCharacter []string `json:"moreTriggerCharacter,omitempty"`
-}
+// Please note that `MarkupKinds` must not start with a `$`. This kinds
+// are reserved for internal usage.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#markupKind
+type MarkupKind string
 
-// A character on which formatting should be triggered, like `{`.
-func (d *DocumentOnTypeFormattingOptions) GetFirstTriggerCharacter() string {
-	if d == nil {
-		var zero string
-		return zero
-	}
-	return d.FirstTriggerCharacter
+const (
+	// Plain text is supported as a content format
+	MarkupKindPlainText MarkupKind = "plaintext"
+	// Markdown is supported as a content format
+	MarkupKindMarkdown MarkupKind = "markdown"
+)
+
+var validMarkupKindValues = map[string]bool{
+	"plaintext": true,
+	"markdown":  true,
 }
 
-// More trigger characters.
-func (d *DocumentOnTypeFormattingOptions) GetMoreTriggerCharacter() []string {
-	if d == nil {
-		var zero []string
-		return zero
+func (m *MarkupKind) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
 	}
-	return d.MoreTriggerCharacter
-}
+	var stringValue string
+	if err := json.Unmarshal(data, &stringValue); err != nil {
+		return err
+	}
+	if !validMarkupKindValues[stringValue] {
+		return fmt.Errorf("cannot unmarshal %v into MarkupKind: custom values are not supported", stringValue)
+	}
+	*m = MarkupKind(stringValue)
 
-// Provider options for a {@link RenameRequest}.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#renameOptions
-type RenameOptions struct {
-	*WorkDoneProgressOptions
-	// Renames should be checked and tested before being executed.
-	//
-	// @since version 3.12.0
-	PrepareProvider bool `json:"prepareProvider,omitempty"`
+	return nil
 }
 
-// Renames should be checked and tested before being executed.
-//
-// @since version 3.12.0
-func (r *RenameOptions) GetPrepareProvider() bool {
-	if r == nil {
-		var zero bool
-		return zero
+func (m MarkupKind) MarshalJSON() ([]byte, error) {
+	var stringValue = string(m)
+	if !validMarkupKindValues[stringValue] {
+		return nil, fmt.Errorf("cannot marshal %v into MarkupKind: custom values are not supported", stringValue)
 	}
-	return r.PrepareProvider
-}
+	return json.Marshal(stringValue)
 
-// BooleanOrRenameOptions contains either of the following types:
-//   - [Boolean]
-//   - [*RenameOptions]
-type BooleanOrRenameOptions struct {
-	Value BooleanOrRenameOptionsValue
 }
 
-// BooleanOrRenameOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*RenameOptions]
+// The message type
 //
-//sumtype:decl
-type BooleanOrRenameOptionsValue interface {
-	isBooleanOrRenameOptionsValue()
-}
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#messageType
+type MessageType uint32
 
-func (Boolean) isBooleanOrRenameOptionsValue()        {}
-func (*RenameOptions) isBooleanOrRenameOptionsValue() {}
+const (
+	// An error message.
+	MessageTypeError MessageType = 1
+	// A warning message.
+	MessageTypeWarning MessageType = 2
+	// An information message.
+	MessageTypeInfo MessageType = 3
+	// A log message.
+	MessageTypeLog MessageType = 4
+	// A debug message.
+	//
+	// @since 3.18.0
+	MessageTypeDebug MessageType = 5
+)
+
+var validMessageTypeValues = map[uint32]bool{
+	1: true,
+	2: true,
+	3: true,
+	4: true,
+	5: true,
+}
 
-func (b *BooleanOrRenameOptions) UnmarshalJSON(data []byte) error {
+func (m *MessageType) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
-		return nil
-	}
-	var renameOptionsValue *RenameOptions
-	if err := json.Unmarshal(data, &renameOptionsValue); err == nil {
-		b.Value = renameOptionsValue
-		return nil
+	var uint32Value uint32
+	if err := json.Unmarshal(data, &uint32Value); err != nil {
+		return err
 	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrRenameOptions](),
+	if !validMessageTypeValues[uint32Value] {
+		return fmt.Errorf("cannot unmarshal %v into MessageType: custom values are not supported", uint32Value)
 	}
-}
-
-func (b *BooleanOrRenameOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
-}
+	*m = MessageType(uint32Value)
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#foldingRangeOptions
-type FoldingRangeOptions struct {
-	*WorkDoneProgressOptions
+	return nil
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#foldingRangeRegistrationOptions
-type FoldingRangeRegistrationOptions struct {
-	*TextDocumentRegistrationOptions
-	*FoldingRangeOptions
-	*StaticRegistrationOptions
-}
+func (m MessageType) MarshalJSON() ([]byte, error) {
+	var uint32Value = uint32(m)
+	if !validMessageTypeValues[uint32Value] {
+		return nil, fmt.Errorf("cannot marshal %v into MessageType: custom values are not supported", uint32Value)
+	}
+	return json.Marshal(uint32Value)
 
-// BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptions contains either of the following types:
-//   - [Boolean]
-//   - [*FoldingRangeOptions]
-//   - [*FoldingRangeRegistrationOptions]
-type BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptions struct {
-	Value BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptionsValue
 }
 
-// BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*FoldingRangeOptions]
-//   - [*FoldingRangeRegistrationOptions]
+// Client capabilities specific to the moniker request.
 //
-//sumtype:decl
-type BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptionsValue interface {
-	isBooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptionsValue()
-}
-
-func (Boolean) isBooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptionsValue()              {}
-func (*FoldingRangeOptions) isBooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptionsValue() {}
-func (*FoldingRangeRegistrationOptions) isBooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptionsValue() {
+// @since 3.16.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#monikerClientCapabilities
+type MonikerClientCapabilities struct {
+	// Whether moniker supports dynamic registration. If this is set to `true`
+	// the client supports the new `MonikerRegistrationOptions` return value
+	// for the corresponding server capability as well.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
 }
 
-func (b *BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptions) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
-		return nil
-	}
-	var foldingRangeOptionsValue *FoldingRangeOptions
-	if err := json.Unmarshal(data, &foldingRangeOptionsValue); err == nil {
-		b.Value = foldingRangeOptionsValue
-		return nil
-	}
-	var foldingRangeRegistrationOptionsValue *FoldingRangeRegistrationOptions
-	if err := json.Unmarshal(data, &foldingRangeRegistrationOptionsValue); err == nil {
-		b.Value = foldingRangeRegistrationOptionsValue
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptions](),
+// Whether moniker supports dynamic registration. If this is set to `true`
+// the client supports the new `MonikerRegistrationOptions` return value
+// for the corresponding server capability as well.
+func (m *MonikerClientCapabilities) GetDynamicRegistration() bool {
+	if m == nil {
+		var zero bool
+		return zero
 	}
+	return m.DynamicRegistration
 }
 
-func (b *BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
-}
-
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#selectionRangeOptions
-type SelectionRangeOptions struct {
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#monikerOptions
+type MonikerOptions struct {
 	*WorkDoneProgressOptions
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#selectionRangeRegistrationOptions
-type SelectionRangeRegistrationOptions struct {
-	*SelectionRangeOptions
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#monikerRegistrationOptions
+type MonikerRegistrationOptions struct {
 	*TextDocumentRegistrationOptions
-	*StaticRegistrationOptions
-}
-
-// BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptions contains either of the following types:
-//   - [Boolean]
-//   - [*SelectionRangeOptions]
-//   - [*SelectionRangeRegistrationOptions]
-type BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptions struct {
-	Value BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptionsValue
+	*MonikerOptions
 }
 
-// BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*SelectionRangeOptions]
-//   - [*SelectionRangeRegistrationOptions]
+// A notebook cell text document filter denotes a cell text
+// document by different properties.
 //
-//sumtype:decl
-type BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptionsValue interface {
-	isBooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptionsValue()
-}
-
-func (Boolean) isBooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptionsValue() {}
-func (*SelectionRangeOptions) isBooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptionsValue() {
-}
-func (*SelectionRangeRegistrationOptions) isBooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptionsValue() {
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#notebookCellTextDocumentFilter
+type NotebookCellTextDocumentFilter struct {
+	// A filter that matches against the notebook
+	// containing the notebook cell. If a string
+	// value is provided it matches against the
+	// notebook type. '*' matches every notebook.
+	Notebook *StringOrNotebookDocumentFilter `json:"notebook"`
+	// A language id like `python`.
+	//
+	// Will be matched against the language id of the
+	// notebook cell document. '*' matches every language.
+	Language string `json:"language,omitempty"`
 }
 
-func (b *BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptions) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
-		return nil
-	}
-	var selectionRangeOptionsValue *SelectionRangeOptions
-	if err := json.Unmarshal(data, &selectionRangeOptionsValue); err == nil {
-		b.Value = selectionRangeOptionsValue
-		return nil
-	}
-	var selectionRangeRegistrationOptionsValue *SelectionRangeRegistrationOptions
-	if err := json.Unmarshal(data, &selectionRangeRegistrationOptionsValue); err == nil {
-		b.Value = selectionRangeRegistrationOptionsValue
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptions](),
+// A filter that matches against the notebook
+// containing the notebook cell. If a string
+// value is provided it matches against the
+// notebook type. '*' matches every notebook.
+func (n *NotebookCellTextDocumentFilter) GetNotebook() *StringOrNotebookDocumentFilter {
+	if n == nil {
+		var zero *StringOrNotebookDocumentFilter
+		return zero
 	}
+	return n.Notebook
 }
 
-func (b *BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
+// A language id like `python`.
+//
+// Will be matched against the language id of the
+// notebook cell document. '*' matches every language.
+func (n *NotebookCellTextDocumentFilter) GetLanguage() string {
+	if n == nil {
+		var zero string
+		return zero
+	}
+	return n.Language
 }
 
-// The server capabilities of a {@link ExecuteCommandRequest}.
+// Capabilities specific to the notebook document support.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#executeCommandOptions
-type ExecuteCommandOptions struct {
-	*WorkDoneProgressOptions
-	// The commands to be executed on the server
-	Commands []string `json:"commands"`
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#notebookDocumentClientCapabilities
+type NotebookDocumentClientCapabilities struct {
+	// Capabilities specific to notebook document synchronization
+	//
+	// @since 3.17.0
+	Synchronization *NotebookDocumentSyncClientCapabilities `json:"synchronization"`
 }
 
-// The commands to be executed on the server
-func (e *ExecuteCommandOptions) GetCommands() []string {
-	if e == nil {
-		var zero []string
+// Capabilities specific to notebook document synchronization
+//
+// @since 3.17.0
+func (n *NotebookDocumentClientCapabilities) GetSynchronization() *NotebookDocumentSyncClientCapabilities {
+	if n == nil {
+		var zero *NotebookDocumentSyncClientCapabilities
 		return zero
 	}
-	return e.Commands
+	return n.Synchronization
 }
 
-// Call hierarchy options used during static registration.
+// A notebook document filter denotes a notebook document by
+// different properties. The properties will be match
+// against the notebook's URI (same as with documents)
 //
-// @since 3.16.0
+// @since 3.17.0
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#callHierarchyOptions
-type CallHierarchyOptions struct {
-	*WorkDoneProgressOptions
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#notebookDocumentFilter
+type NotebookDocumentFilter = *NotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3
+
+type NotebookDocumentFilterOr1 struct {
+	// The type of the enclosing notebook.
+	NotebookType string `json:"notebookType"`
+	// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
+	Scheme string `json:"scheme,omitempty"`
+	// A glob pattern.
+	Pattern string `json:"pattern,omitempty"`
+}
+
+// The type of the enclosing notebook.
+func (n *NotebookDocumentFilterOr1) GetNotebookType() string {
+	if n == nil {
+		return *new(string)
+	}
+	return n.NotebookType
+}
+
+// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
+func (n *NotebookDocumentFilterOr1) GetScheme() string {
+	if n == nil {
+		return *new(string)
+	}
+	return n.Scheme
 }
 
-// Call hierarchy options used during static or dynamic registration.
-//
-// @since 3.16.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#callHierarchyRegistrationOptions
-type CallHierarchyRegistrationOptions struct {
-	*TextDocumentRegistrationOptions
-	*CallHierarchyOptions
-	*StaticRegistrationOptions
+// A glob pattern.
+func (n *NotebookDocumentFilterOr1) GetPattern() string {
+	if n == nil {
+		return *new(string)
+	}
+	return n.Pattern
 }
 
-// BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptions contains either of the following types:
-//   - [Boolean]
-//   - [*CallHierarchyOptions]
-//   - [*CallHierarchyRegistrationOptions]
-type BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptions struct {
-	Value BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptionsValue
+// NotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3 contains either of the following types:
+//   - [*NotebookDocumentFilterOr1]
+//   - [*NotebookDocumentFilterOr2]
+//   - [*NotebookDocumentFilterOr3]
+type NotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3 struct {
+	Value NotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3Value
 }
 
-// BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*CallHierarchyOptions]
-//   - [*CallHierarchyRegistrationOptions]
+// NotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3Value is either of the following types:
+//   - [*NotebookDocumentFilterOr1]
+//   - [*NotebookDocumentFilterOr2]
+//   - [*NotebookDocumentFilterOr3]
 //
 //sumtype:decl
-type BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptionsValue interface {
-	isBooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptionsValue()
+type NotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3Value interface {
+	isNotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3Value()
 }
 
-func (Boolean) isBooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptionsValue() {}
-func (*CallHierarchyOptions) isBooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptionsValue() {
+func (*NotebookDocumentFilterOr1) isNotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3Value() {
 }
-func (*CallHierarchyRegistrationOptions) isBooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptionsValue() {
+func (*NotebookDocumentFilterOr2) isNotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3Value() {
+}
+func (*NotebookDocumentFilterOr3) isNotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3Value() {
 }
 
-func (b *BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptions) UnmarshalJSON(data []byte) error {
+func (n *NotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
+	var notebookDocumentFilterOr1Value *NotebookDocumentFilterOr1
+	if err := json.Unmarshal(data, &notebookDocumentFilterOr1Value); err == nil {
+		n.Value = notebookDocumentFilterOr1Value
 		return nil
 	}
-	var callHierarchyOptionsValue *CallHierarchyOptions
-	if err := json.Unmarshal(data, &callHierarchyOptionsValue); err == nil {
-		b.Value = callHierarchyOptionsValue
+	var notebookDocumentFilterOr2Value *NotebookDocumentFilterOr2
+	if err := json.Unmarshal(data, &notebookDocumentFilterOr2Value); err == nil {
+		n.Value = notebookDocumentFilterOr2Value
 		return nil
 	}
-	var callHierarchyRegistrationOptionsValue *CallHierarchyRegistrationOptions
-	if err := json.Unmarshal(data, &callHierarchyRegistrationOptionsValue); err == nil {
-		b.Value = callHierarchyRegistrationOptionsValue
+	var notebookDocumentFilterOr3Value *NotebookDocumentFilterOr3
+	if err := json.Unmarshal(data, &notebookDocumentFilterOr3Value); err == nil {
+		n.Value = notebookDocumentFilterOr3Value
 		return nil
 	}
 	return &json.UnmarshalTypeError{
 		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptions](),
+		Type:  reflect.TypeFor[*NotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3](),
 	}
 }
 
-func (b *BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
+func (n *NotebookDocumentFilterOr1OrNotebookDocumentFilterOr2OrNotebookDocumentFilterOr3) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.Value)
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#linkedEditingRangeOptions
-type LinkedEditingRangeOptions struct {
-	*WorkDoneProgressOptions
+type NotebookDocumentFilterOr2 struct {
+	// The type of the enclosing notebook.
+	NotebookType string `json:"notebookType,omitempty"`
+	// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
+	Scheme string `json:"scheme"`
+	// A glob pattern.
+	Pattern string `json:"pattern,omitempty"`
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#linkedEditingRangeRegistrationOptions
-type LinkedEditingRangeRegistrationOptions struct {
-	*TextDocumentRegistrationOptions
-	*LinkedEditingRangeOptions
-	*StaticRegistrationOptions
+// The type of the enclosing notebook.
+func (n *NotebookDocumentFilterOr2) GetNotebookType() string {
+	if n == nil {
+		return *new(string)
+	}
+	return n.NotebookType
 }
 
-// BooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptions contains either of the following types:
-//   - [Boolean]
-//   - [*LinkedEditingRangeOptions]
-//   - [*LinkedEditingRangeRegistrationOptions]
-type BooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptions struct {
-	Value BooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptionsValue
+// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
+func (n *NotebookDocumentFilterOr2) GetScheme() string {
+	if n == nil {
+		return *new(string)
+	}
+	return n.Scheme
 }
 
-// BooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*LinkedEditingRangeOptions]
-//   - [*LinkedEditingRangeRegistrationOptions]
-//
-//sumtype:decl
-type BooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptionsValue interface {
-	isBooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptionsValue()
+// A glob pattern.
+func (n *NotebookDocumentFilterOr2) GetPattern() string {
+	if n == nil {
+		return *new(string)
+	}
+	return n.Pattern
 }
 
-func (Boolean) isBooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptionsValue() {}
-func (*LinkedEditingRangeOptions) isBooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptionsValue() {
-}
-func (*LinkedEditingRangeRegistrationOptions) isBooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptionsValue() {
+type NotebookDocumentFilterOr3 struct {
+	// The type of the enclosing notebook.
+	NotebookType string `json:"notebookType,omitempty"`
+	// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
+	Scheme string `json:"scheme,omitempty"`
+	// A glob pattern.
+	Pattern string `json:"pattern"`
 }
 
-func (b *BooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptions) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
-		return nil
-	}
-	var linkedEditingRangeOptionsValue *LinkedEditingRangeOptions
-	if err := json.Unmarshal(data, &linkedEditingRangeOptionsValue); err == nil {
-		b.Value = linkedEditingRangeOptionsValue
-		return nil
-	}
-	var linkedEditingRangeRegistrationOptionsValue *LinkedEditingRangeRegistrationOptions
-	if err := json.Unmarshal(data, &linkedEditingRangeRegistrationOptionsValue); err == nil {
-		b.Value = linkedEditingRangeRegistrationOptionsValue
-		return nil
+// The type of the enclosing notebook.
+func (n *NotebookDocumentFilterOr3) GetNotebookType() string {
+	if n == nil {
+		return *new(string)
 	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptions](),
+	return n.NotebookType
+}
+
+// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
+func (n *NotebookDocumentFilterOr3) GetScheme() string {
+	if n == nil {
+		return *new(string)
 	}
+	return n.Scheme
 }
 
-func (b *BooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
+// A glob pattern.
+func (n *NotebookDocumentFilterOr3) GetPattern() string {
+	if n == nil {
+		return *new(string)
+	}
+	return n.Pattern
 }
 
-// @since 3.16.0
+// Notebook specific client capabilities.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#semanticTokensLegend
-type SemanticTokensLegend struct {
-	// The token types a server uses.
-	TokenTypes []string `json:"tokenTypes"`
-	// The token modifiers a server uses.
-	TokenModifiers []string `json:"tokenModifiers"`
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#notebookDocumentSyncClientCapabilities
+type NotebookDocumentSyncClientCapabilities struct {
+	// Whether implementation supports dynamic registration. If this is
+	// set to `true` the client supports the new
+	// `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
+	// return value for the corresponding server capability as well.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	// The client supports sending execution summary data per cell.
+	ExecutionSummarySupport bool `json:"executionSummarySupport,omitempty"`
 }
 
-// The token types a server uses.
-func (s *SemanticTokensLegend) GetTokenTypes() []string {
-	if s == nil {
-		var zero []string
+// Whether implementation supports dynamic registration. If this is
+// set to `true` the client supports the new
+// `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
+// return value for the corresponding server capability as well.
+func (n *NotebookDocumentSyncClientCapabilities) GetDynamicRegistration() bool {
+	if n == nil {
+		var zero bool
 		return zero
 	}
-	return s.TokenTypes
+	return n.DynamicRegistration
 }
 
-// The token modifiers a server uses.
-func (s *SemanticTokensLegend) GetTokenModifiers() []string {
-	if s == nil {
-		var zero []string
+// The client supports sending execution summary data per cell.
+func (n *NotebookDocumentSyncClientCapabilities) GetExecutionSummarySupport() bool {
+	if n == nil {
+		var zero bool
 		return zero
 	}
-	return s.TokenModifiers
+	return n.ExecutionSummarySupport
 }
 
-type SemanticTokensOptionsRangeOr2 struct {
+// Options specific to a notebook plus its cells
+// to be synced to the server.
+//
+// If a selector provides a notebook document
+// filter but no cell selector all cells of a
+// matching notebook document will be synced.
+//
+// If a selector provides no notebook document
+// filter but only a cell selector all notebook
+// document that contain at least one matching
+// cell will be synced.
+//
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#notebookDocumentSyncOptions
+type NotebookDocumentSyncOptions struct {
+	// The notebooks to be synced
+	NotebookSelector []*NotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2 `json:"notebookSelector"`
+	// Whether save notification should be forwarded to
+	// the server. Will only be honored if mode === `notebook`.
+	Save bool `json:"save,omitempty"`
 }
 
-// BooleanOrSemanticTokensOptionsRangeOr2 contains either of the following types:
-//   - [Boolean]
-//   - [*SemanticTokensOptionsRangeOr2]
-type BooleanOrSemanticTokensOptionsRangeOr2 struct {
-	Value BooleanOrSemanticTokensOptionsRangeOr2Value
+// The notebooks to be synced
+func (n *NotebookDocumentSyncOptions) GetNotebookSelector() []*NotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2 {
+	if n == nil {
+		var zero []*NotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2
+		return zero
+	}
+	return n.NotebookSelector
+}
+
+// Whether save notification should be forwarded to
+// the server. Will only be honored if mode === `notebook`.
+func (n *NotebookDocumentSyncOptions) GetSave() bool {
+	if n == nil {
+		var zero bool
+		return zero
+	}
+	return n.Save
 }
 
-// BooleanOrSemanticTokensOptionsRangeOr2Value is either of the following types:
-//   - [Boolean]
-//   - [*SemanticTokensOptionsRangeOr2]
-//
-//sumtype:decl
-type BooleanOrSemanticTokensOptionsRangeOr2Value interface {
-	isBooleanOrSemanticTokensOptionsRangeOr2Value()
+type NotebookDocumentSyncOptionsNotebookSelectorOr1 struct {
+	// The notebook to be synced If a string
+	// value is provided it matches against the
+	// notebook type. '*' matches every notebook.
+	Notebook *StringOrNotebookDocumentFilter `json:"notebook"`
+	// The cells of the matching notebook to be synced.
+	Cells []*NotebookDocumentSyncOptionsNotebookSelectorOr1Cells `json:"cells,omitempty"`
 }
 
-func (Boolean) isBooleanOrSemanticTokensOptionsRangeOr2Value()                        {}
-func (*SemanticTokensOptionsRangeOr2) isBooleanOrSemanticTokensOptionsRangeOr2Value() {}
-
-func (b *BooleanOrSemanticTokensOptionsRangeOr2) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
-		return nil
-	}
-	var semanticTokensOptionsRangeOr2Value *SemanticTokensOptionsRangeOr2
-	if err := json.Unmarshal(data, &semanticTokensOptionsRangeOr2Value); err == nil {
-		b.Value = semanticTokensOptionsRangeOr2Value
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrSemanticTokensOptionsRangeOr2](),
+// The notebook to be synced If a string
+// value is provided it matches against the
+// notebook type. '*' matches every notebook.
+func (n *NotebookDocumentSyncOptionsNotebookSelectorOr1) GetNotebook() *StringOrNotebookDocumentFilter {
+	if n == nil {
+		return *new(*StringOrNotebookDocumentFilter)
 	}
+	return n.Notebook
 }
 
-func (b *BooleanOrSemanticTokensOptionsRangeOr2) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
+// The cells of the matching notebook to be synced.
+func (n *NotebookDocumentSyncOptionsNotebookSelectorOr1) GetCells() []*NotebookDocumentSyncOptionsNotebookSelectorOr1Cells {
+	if n == nil {
+		return *new([]*NotebookDocumentSyncOptionsNotebookSelectorOr1Cells)
+	}
+	return n.Cells
 }
 
-type SemanticTokensOptionsFullOr2 struct {
-	// The server supports deltas for full documents.
-	Delta bool `json:"delta,omitempty"`
+type NotebookDocumentSyncOptionsNotebookSelectorOr1Cells struct {
+	Language string `json:"language"`
 }
 
-// The server supports deltas for full documents.
-func (s *SemanticTokensOptionsFullOr2) GetDelta() bool {
-	if s == nil {
-		return *new(bool)
+func (n *NotebookDocumentSyncOptionsNotebookSelectorOr1Cells) GetLanguage() string {
+	if n == nil {
+		return *new(string)
 	}
-	return s.Delta
+	return n.Language
 }
 
-// BooleanOrSemanticTokensOptionsFullOr2 contains either of the following types:
-//   - [Boolean]
-//   - [*SemanticTokensOptionsFullOr2]
-type BooleanOrSemanticTokensOptionsFullOr2 struct {
-	Value BooleanOrSemanticTokensOptionsFullOr2Value
+// NotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2 contains either of the following types:
+//   - [*NotebookDocumentSyncOptionsNotebookSelectorOr1]
+//   - [*NotebookDocumentSyncOptionsNotebookSelectorOr2]
+type NotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2 struct {
+	Value NotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2Value
 }
 
-// BooleanOrSemanticTokensOptionsFullOr2Value is either of the following types:
-//   - [Boolean]
-//   - [*SemanticTokensOptionsFullOr2]
+// NotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2Value is either of the following types:
+//   - [*NotebookDocumentSyncOptionsNotebookSelectorOr1]
+//   - [*NotebookDocumentSyncOptionsNotebookSelectorOr2]
 //
 //sumtype:decl
-type BooleanOrSemanticTokensOptionsFullOr2Value interface {
-	isBooleanOrSemanticTokensOptionsFullOr2Value()
+type NotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2Value interface {
+	isNotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2Value()
 }
 
-func (Boolean) isBooleanOrSemanticTokensOptionsFullOr2Value()                       {}
-func (*SemanticTokensOptionsFullOr2) isBooleanOrSemanticTokensOptionsFullOr2Value() {}
+func (*NotebookDocumentSyncOptionsNotebookSelectorOr1) isNotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2Value() {
+}
+func (*NotebookDocumentSyncOptionsNotebookSelectorOr2) isNotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2Value() {
+}
 
-func (b *BooleanOrSemanticTokensOptionsFullOr2) UnmarshalJSON(data []byte) error {
+func (n *NotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
+	var notebookDocumentSyncOptionsNotebookSelectorOr1Value *NotebookDocumentSyncOptionsNotebookSelectorOr1
+	if err := json.Unmarshal(data, &notebookDocumentSyncOptionsNotebookSelectorOr1Value); err == nil {
+		n.Value = notebookDocumentSyncOptionsNotebookSelectorOr1Value
 		return nil
 	}
-	var semanticTokensOptionsFullOr2Value *SemanticTokensOptionsFullOr2
-	if err := json.Unmarshal(data, &semanticTokensOptionsFullOr2Value); err == nil {
-		b.Value = semanticTokensOptionsFullOr2Value
+	var notebookDocumentSyncOptionsNotebookSelectorOr2Value *NotebookDocumentSyncOptionsNotebookSelectorOr2
+	if err := json.Unmarshal(data, &notebookDocumentSyncOptionsNotebookSelectorOr2Value); err == nil {
+		n.Value = notebookDocumentSyncOptionsNotebookSelectorOr2Value
 		return nil
 	}
 	return &json.UnmarshalTypeError{
 		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrSemanticTokensOptionsFullOr2](),
+		Type:  reflect.TypeFor[*NotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2](),
 	}
 }
 
-func (b *BooleanOrSemanticTokensOptionsFullOr2) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
+func (n *NotebookDocumentSyncOptionsNotebookSelectorOr1OrNotebookDocumentSyncOptionsNotebookSelectorOr2) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.Value)
 }
 
-// @since 3.16.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#semanticTokensOptions
-type SemanticTokensOptions struct {
-	*WorkDoneProgressOptions
-	// The legend used by the server
-	Legend *SemanticTokensLegend `json:"legend"`
-	// Server supports providing semantic tokens for a specific range
-	// of a document.
-	Range *BooleanOrSemanticTokensOptionsRangeOr2 `json:"range,omitempty"`
-	// Server supports providing semantic tokens for a full document.
-	Full *BooleanOrSemanticTokensOptionsFullOr2 `json:"full,omitempty"`
+type NotebookDocumentSyncOptionsNotebookSelectorOr2 struct {
+	// The notebook to be synced If a string
+	// value is provided it matches against the
+	// notebook type. '*' matches every notebook.
+	Notebook *StringOrNotebookDocumentFilter `json:"notebook,omitempty"`
+	// The cells of the matching notebook to be synced.
+	Cells []*NotebookDocumentSyncOptionsNotebookSelectorOr2Cells `json:"cells"`
 }
 
-// The legend used by the server
-func (s *SemanticTokensOptions) GetLegend() *SemanticTokensLegend {
-	if s == nil {
-		var zero *SemanticTokensLegend
-		return zero
+// The notebook to be synced If a string
+// value is provided it matches against the
+// notebook type. '*' matches every notebook.
+func (n *NotebookDocumentSyncOptionsNotebookSelectorOr2) GetNotebook() *StringOrNotebookDocumentFilter {
+	if n == nil {
+		return *new(*StringOrNotebookDocumentFilter)
 	}
-	return s.Legend
+	return n.Notebook
 }
 
-// Server supports providing semantic tokens for a specific range
-// of a document.
-func (s *SemanticTokensOptions) GetRange() *BooleanOrSemanticTokensOptionsRangeOr2 {
-	if s == nil {
-		var zero *BooleanOrSemanticTokensOptionsRangeOr2
-		return zero
+// The cells of the matching notebook to be synced.
+func (n *NotebookDocumentSyncOptionsNotebookSelectorOr2) GetCells() []*NotebookDocumentSyncOptionsNotebookSelectorOr2Cells {
+	if n == nil {
+		return *new([]*NotebookDocumentSyncOptionsNotebookSelectorOr2Cells)
 	}
-	return s.Range
+	return n.Cells
 }
 
-// Server supports providing semantic tokens for a full document.
-func (s *SemanticTokensOptions) GetFull() *BooleanOrSemanticTokensOptionsFullOr2 {
-	if s == nil {
-		var zero *BooleanOrSemanticTokensOptionsFullOr2
-		return zero
-	}
-	return s.Full
+type NotebookDocumentSyncOptionsNotebookSelectorOr2Cells struct {
+	Language string `json:"language"`
 }
 
-// @since 3.16.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#semanticTokensRegistrationOptions
-type SemanticTokensRegistrationOptions struct {
-	*TextDocumentRegistrationOptions
-	*SemanticTokensOptions
-	*StaticRegistrationOptions
+func (n *NotebookDocumentSyncOptionsNotebookSelectorOr2Cells) GetLanguage() string {
+	if n == nil {
+		return *new(string)
+	}
+	return n.Language
 }
 
-// SemanticTokensOptionsOrSemanticTokensRegistrationOptions contains either of the following types:
-//   - [*SemanticTokensOptions]
-//   - [*SemanticTokensRegistrationOptions]
-type SemanticTokensOptionsOrSemanticTokensRegistrationOptions struct {
-	Value SemanticTokensOptionsOrSemanticTokensRegistrationOptionsValue
+// NotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptions contains either of the following types:
+//   - [*NotebookDocumentSyncOptions]
+//   - [*NotebookDocumentSyncRegistrationOptions]
+type NotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptions struct {
+	Value NotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptionsValue
 }
 
-// SemanticTokensOptionsOrSemanticTokensRegistrationOptionsValue is either of the following types:
-//   - [*SemanticTokensOptions]
-//   - [*SemanticTokensRegistrationOptions]
+// NotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptionsValue is either of the following types:
+//   - [*NotebookDocumentSyncOptions]
+//   - [*NotebookDocumentSyncRegistrationOptions]
 //
 //sumtype:decl
-type SemanticTokensOptionsOrSemanticTokensRegistrationOptionsValue interface {
-	isSemanticTokensOptionsOrSemanticTokensRegistrationOptionsValue()
+type NotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptionsValue interface {
+	isNotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptionsValue()
 }
 
-func (*SemanticTokensOptions) isSemanticTokensOptionsOrSemanticTokensRegistrationOptionsValue() {}
-func (*SemanticTokensRegistrationOptions) isSemanticTokensOptionsOrSemanticTokensRegistrationOptionsValue() {
+func (*NotebookDocumentSyncOptions) isNotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptionsValue() {
+}
+func (*NotebookDocumentSyncRegistrationOptions) isNotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptionsValue() {
 }
 
-func (s *SemanticTokensOptionsOrSemanticTokensRegistrationOptions) UnmarshalJSON(data []byte) error {
+func (n *NotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptions) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var semanticTokensOptionsValue *SemanticTokensOptions
-	if err := json.Unmarshal(data, &semanticTokensOptionsValue); err == nil {
-		s.Value = semanticTokensOptionsValue
+	var notebookDocumentSyncOptionsValue *NotebookDocumentSyncOptions
+	if err := json.Unmarshal(data, &notebookDocumentSyncOptionsValue); err == nil {
+		n.Value = notebookDocumentSyncOptionsValue
 		return nil
 	}
-	var semanticTokensRegistrationOptionsValue *SemanticTokensRegistrationOptions
-	if err := json.Unmarshal(data, &semanticTokensRegistrationOptionsValue); err == nil {
-		s.Value = semanticTokensRegistrationOptionsValue
+	var notebookDocumentSyncRegistrationOptionsValue *NotebookDocumentSyncRegistrationOptions
+	if err := json.Unmarshal(data, &notebookDocumentSyncRegistrationOptionsValue); err == nil {
+		n.Value = notebookDocumentSyncRegistrationOptionsValue
 		return nil
 	}
 	return &json.UnmarshalTypeError{
 		Value: string(data),
-		Type:  reflect.TypeFor[*SemanticTokensOptionsOrSemanticTokensRegistrationOptions](),
+		Type:  reflect.TypeFor[*NotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptions](),
 	}
 }
 
-func (s *SemanticTokensOptionsOrSemanticTokensRegistrationOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(s.Value)
+func (n *NotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.Value)
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#monikerOptions
-type MonikerOptions struct {
-	*WorkDoneProgressOptions
+// Registration options specific to a notebook.
+//
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#notebookDocumentSyncRegistrationOptions
+type NotebookDocumentSyncRegistrationOptions struct {
+	*NotebookDocumentSyncOptions
+	*StaticRegistrationOptions
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#monikerRegistrationOptions
-type MonikerRegistrationOptions struct {
-	*TextDocumentRegistrationOptions
-	*MonikerOptions
-}
+// Optional is a JSON value which is either present or not.
+type Optional[T any] []T
 
-// BooleanOrMonikerOptionsOrMonikerRegistrationOptions contains either of the following types:
-//   - [Boolean]
-//   - [*MonikerOptions]
-//   - [*MonikerRegistrationOptions]
-type BooleanOrMonikerOptionsOrMonikerRegistrationOptions struct {
-	Value BooleanOrMonikerOptionsOrMonikerRegistrationOptionsValue
+// NewOptional returns an [Optional] which is present.
+func NewOptional[T any](value T) Optional[T] {
+	return Optional[T]([]T{value})
 }
 
-// BooleanOrMonikerOptionsOrMonikerRegistrationOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*MonikerOptions]
-//   - [*MonikerRegistrationOptions]
-//
-//sumtype:decl
-type BooleanOrMonikerOptionsOrMonikerRegistrationOptionsValue interface {
-	isBooleanOrMonikerOptionsOrMonikerRegistrationOptionsValue()
+func (o Optional[T]) IsPresent() bool {
+	return o != nil
 }
 
-func (Boolean) isBooleanOrMonikerOptionsOrMonikerRegistrationOptionsValue()                     {}
-func (*MonikerOptions) isBooleanOrMonikerOptionsOrMonikerRegistrationOptionsValue()             {}
-func (*MonikerRegistrationOptions) isBooleanOrMonikerOptionsOrMonikerRegistrationOptionsValue() {}
+func (o Optional[T]) Get() T {
+	if !o.IsPresent() {
+		panic("get of an absent value")
+	}
+	return o[0]
+}
 
-func (b *BooleanOrMonikerOptionsOrMonikerRegistrationOptions) UnmarshalJSON(data []byte) error {
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
-		return nil
-	}
-	var monikerOptionsValue *MonikerOptions
-	if err := json.Unmarshal(data, &monikerOptionsValue); err == nil {
-		b.Value = monikerOptionsValue
-		return nil
-	}
-	var monikerRegistrationOptionsValue *MonikerRegistrationOptions
-	if err := json.Unmarshal(data, &monikerRegistrationOptionsValue); err == nil {
-		b.Value = monikerRegistrationOptionsValue
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrMonikerOptionsOrMonikerRegistrationOptions](),
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
 	}
+	*o = Optional[T]{v}
+	return nil
 }
 
-func (b *BooleanOrMonikerOptionsOrMonikerRegistrationOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if o.IsPresent() {
+		return json.Marshal(o[0])
+	}
+	return []byte("null"), nil
 }
 
-// Type hierarchy options used during static registration.
-//
-// @since 3.17.0
+// A text document identifier to optionally denote a specific version of a text document.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#typeHierarchyOptions
-type TypeHierarchyOptions struct {
-	*WorkDoneProgressOptions
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#optionalVersionedTextDocumentIdentifier
+type OptionalVersionedTextDocumentIdentifier struct {
+	*TextDocumentIdentifier
+	// The version number of this document. If a versioned text document identifier
+	// is sent from the server to the client and the file is not open in the editor
+	// (the server has not received an open notification before) the server can send
+	// `null` to indicate that the version is unknown and the content on disk is the
+	// truth (as specified with document content ownership).
+	Version int `json:"version"`
 }
 
-// Type hierarchy options used during static or dynamic registration.
-//
-// @since 3.17.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#typeHierarchyRegistrationOptions
-type TypeHierarchyRegistrationOptions struct {
-	*TextDocumentRegistrationOptions
-	*TypeHierarchyOptions
-	*StaticRegistrationOptions
+// The version number of this document. If a versioned text document identifier
+// is sent from the server to the client and the file is not open in the editor
+// (the server has not received an open notification before) the server can send
+// `null` to indicate that the version is unknown and the content on disk is the
+// truth (as specified with document content ownership).
+func (o *OptionalVersionedTextDocumentIdentifier) GetVersion() int {
+	if o == nil {
+		var zero int
+		return zero
+	}
+	return o.Version
 }
 
-// BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptions contains either of the following types:
-//   - [Boolean]
-//   - [*TypeHierarchyOptions]
-//   - [*TypeHierarchyRegistrationOptions]
-type BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptions struct {
-	Value BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptionsValue
+// Represents a parameter of a callable-signature. A parameter can
+// have a label and a doc-comment.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#parameterInformation
+type ParameterInformation struct {
+	// The label of this parameter information.
+	//
+	// Either a string or an inclusive start and exclusive end offsets within its containing
+	// signature label. (see SignatureInformation.label). The offsets are based on a UTF-16
+	// string representation as `Position` and `Range` does.
+	//
+	// *Note*: a label of type string should be a substring of its containing signature label.
+	// Its intended use case is to highlight the parameter label part in the `SignatureInformation.label`.
+	Label *StringOrParameterInformationLabelRange `json:"label"`
+	// The human-readable doc-comment of this parameter. Will be shown
+	// in the UI but can be omitted.
+	Documentation *StringOrMarkupContent `json:"documentation,omitempty"`
 }
 
-// BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*TypeHierarchyOptions]
-//   - [*TypeHierarchyRegistrationOptions]
+// The label of this parameter information.
 //
-//sumtype:decl
-type BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptionsValue interface {
-	isBooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptionsValue()
+// Either a string or an inclusive start and exclusive end offsets within its containing
+// signature label. (see SignatureInformation.label). The offsets are based on a UTF-16
+// string representation as `Position` and `Range` does.
+//
+// *Note*: a label of type string should be a substring of its containing signature label.
+// Its intended use case is to highlight the parameter label part in the `SignatureInformation.label`.
+func (p *ParameterInformation) GetLabel() *StringOrParameterInformationLabelRange {
+	if p == nil {
+		var zero *StringOrParameterInformationLabelRange
+		return zero
+	}
+	return p.Label
 }
 
-func (Boolean) isBooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptionsValue() {}
-func (*TypeHierarchyOptions) isBooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptionsValue() {
+// The human-readable doc-comment of this parameter. Will be shown
+// in the UI but can be omitted.
+func (p *ParameterInformation) GetDocumentation() *StringOrMarkupContent {
+	if p == nil {
+		var zero *StringOrMarkupContent
+		return zero
+	}
+	return p.Documentation
 }
-func (*TypeHierarchyRegistrationOptions) isBooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptionsValue() {
+
+type ParameterInformationLabelRange struct {
+	Start int
+	End   int
 }
 
-func (b *BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptions) UnmarshalJSON(data []byte) error {
+func (p *ParameterInformationLabelRange) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
-		return nil
+	items := make([]json.RawMessage, 2)
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
 	}
-	var typeHierarchyOptionsValue *TypeHierarchyOptions
-	if err := json.Unmarshal(data, &typeHierarchyOptionsValue); err == nil {
-		b.Value = typeHierarchyOptionsValue
-		return nil
+	if len(items) != 2 {
+		return fmt.Errorf("protocol: cannot unmarshal %s into ParameterInformationLabelRange: expected tuple with 2 items, got %d", data, len(items))
 	}
-	var typeHierarchyRegistrationOptionsValue *TypeHierarchyRegistrationOptions
-	if err := json.Unmarshal(data, &typeHierarchyRegistrationOptionsValue); err == nil {
-		b.Value = typeHierarchyRegistrationOptionsValue
-		return nil
+	var item0 int
+	if err := json.Unmarshal(items[0], &item0); err != nil {
+		return &json.UnmarshalTypeError{
+			Value:  string(items[0]),
+			Type:   reflect.TypeFor[int](),
+			Struct: "ParameterInformationLabelRange",
+			Field:  "Start",
+		}
 	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptions](),
+	p.Start = item0
+	var item1 int
+	if err := json.Unmarshal(items[1], &item1); err != nil {
+		return &json.UnmarshalTypeError{
+			Value:  string(items[1]),
+			Type:   reflect.TypeFor[int](),
+			Struct: "ParameterInformationLabelRange",
+			Field:  "End",
+		}
+	}
+	p.End = item1
+	return nil
+}
+
+func (p *ParameterInformationLabelRange) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{
+		p.Start,
+		p.End,
+	})
+}
+
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#partialResultParams
+type PartialResultParams struct {
+	// An optional token that a server can use to report partial results (e.g. streaming) to
+	// the client.
+	PartialResultToken ProgressToken `json:"partialResultToken,omitempty"`
+}
+
+// An optional token that a server can use to report partial results (e.g. streaming) to
+// the client.
+func (p *PartialResultParams) GetPartialResultToken() ProgressToken {
+	if p == nil {
+		var zero ProgressToken
+		return zero
+	}
+	return p.PartialResultToken
+}
+
+// Position in a text document expressed as zero-based line and character
+// offset. Prior to 3.17 the offsets were always based on a UTF-16 string
+// representation. So a string of the form `a𐐀b` the character offset of the
+// character `a` is 0, the character offset of `𐐀` is 1 and the character
+// offset of b is 3 since `𐐀` is represented using two code units in UTF-16.
+// Since 3.17 clients and servers can agree on a different string encoding
+// representation (e.g. UTF-8). The client announces it's supported encoding
+// via the client capability [`general.positionEncodings`](https://microsoft.github.io/language-server-protocol/specifications/specification-current/#clientCapabilities).
+// The value is an array of position encodings the client supports, with
+// decreasing preference (e.g. the encoding at index `0` is the most preferred
+// one). To stay backwards compatible the only mandatory encoding is UTF-16
+// represented via the string `utf-16`. The server can pick one of the
+// encodings offered by the client and signals that encoding back to the
+// client via the initialize result's property
+// [`capabilities.positionEncoding`](https://microsoft.github.io/language-server-protocol/specifications/specification-current/#serverCapabilities). If the string value
+// `utf-16` is missing from the client's capability `general.positionEncodings`
+// servers can safely assume that the client supports UTF-16. If the server
+// omits the position encoding in its initialize result the encoding defaults
+// to the string value `utf-16`. Implementation considerations: since the
+// conversion from one encoding into another requires the content of the
+// file / line the conversion is best done where the file is read which is
+// usually on the server side.
+//
+// Positions are line end character agnostic. So you can not specify a position
+// that denotes `\r|\n` or `\n|` where `|` represents the character offset.
+//
+// @since 3.17.0 - support for negotiated position encoding.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#position
+type Position struct {
+	// Line position in a document (zero-based).
+	//
+	// If a line number is greater than the number of lines in a document, it defaults back to the number of lines in the document.
+	// If a line number is negative, it defaults to 0.
+	Line int `json:"line"`
+	// Character offset on a line in a document (zero-based).
+	//
+	// The meaning of this offset is determined by the negotiated
+	// `PositionEncodingKind`.
+	//
+	// If the character value is greater than the line length it defaults back to the
+	// line length.
+	Character int `json:"character"`
+}
+
+// Line position in a document (zero-based).
+//
+// If a line number is greater than the number of lines in a document, it defaults back to the number of lines in the document.
+// If a line number is negative, it defaults to 0.
+func (p *Position) GetLine() int {
+	if p == nil {
+		var zero int
+		return zero
 	}
+	return p.Line
 }
 
-func (b *BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
-}
-
-// Inline value options used during static registration.
+// Character offset on a line in a document (zero-based).
 //
-// @since 3.17.0
+// The meaning of this offset is determined by the negotiated
+// `PositionEncodingKind`.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#inlineValueOptions
-type InlineValueOptions struct {
-	*WorkDoneProgressOptions
+// If the character value is greater than the line length it defaults back to the
+// line length.
+func (p *Position) GetCharacter() int {
+	if p == nil {
+		var zero int
+		return zero
+	}
+	return p.Character
 }
 
-// Inline value options used during static or dynamic registration.
+// A set of predefined position encoding kinds.
 //
 // @since 3.17.0
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#inlineValueRegistrationOptions
-type InlineValueRegistrationOptions struct {
-	*InlineValueOptions
-	*TextDocumentRegistrationOptions
-	*StaticRegistrationOptions
-}
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#positionEncodingKind
+type PositionEncodingKind string
 
-// BooleanOrInlineValueOptionsOrInlineValueRegistrationOptions contains either of the following types:
-//   - [Boolean]
-//   - [*InlineValueOptions]
-//   - [*InlineValueRegistrationOptions]
-type BooleanOrInlineValueOptionsOrInlineValueRegistrationOptions struct {
-	Value BooleanOrInlineValueOptionsOrInlineValueRegistrationOptionsValue
-}
+const (
+	// Character offsets count UTF-8 code units (e.g. bytes).
+	PositionEncodingKindUTF8 PositionEncodingKind = "utf-8"
+	// Character offsets count UTF-16 code units.
+	//
+	// This is the default and must always be supported
+	// by servers
+	PositionEncodingKindUTF16 PositionEncodingKind = "utf-16"
+	// Character offsets count UTF-32 code units.
+	//
+	// Implementation note: these are the same as Unicode codepoints,
+	// so this `PositionEncodingKind` may also be used for an
+	// encoding-agnostic representation of character offsets.
+	PositionEncodingKindUTF32 PositionEncodingKind = "utf-32"
+)
 
-// BooleanOrInlineValueOptionsOrInlineValueRegistrationOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*InlineValueOptions]
-//   - [*InlineValueRegistrationOptions]
-//
-//sumtype:decl
-type BooleanOrInlineValueOptionsOrInlineValueRegistrationOptionsValue interface {
-	isBooleanOrInlineValueOptionsOrInlineValueRegistrationOptionsValue()
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#prepareRenameParams
+type PrepareRenameParams struct {
+	*TextDocumentPositionParams
+	*WorkDoneProgressParams
 }
 
-func (Boolean) isBooleanOrInlineValueOptionsOrInlineValueRegistrationOptionsValue()             {}
-func (*InlineValueOptions) isBooleanOrInlineValueOptionsOrInlineValueRegistrationOptionsValue() {}
-func (*InlineValueRegistrationOptions) isBooleanOrInlineValueOptionsOrInlineValueRegistrationOptionsValue() {
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#prepareRenameResult
+type PrepareRenameResult = *RangeOrPrepareRenameResultOr2OrPrepareRenameResultOr3
+
+type PrepareRenameResultOr2 struct {
+	Range       *Range `json:"range"`
+	Placeholder string `json:"placeholder"`
 }
 
-func (b *BooleanOrInlineValueOptionsOrInlineValueRegistrationOptions) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
-		return nil
-	}
-	var inlineValueOptionsValue *InlineValueOptions
-	if err := json.Unmarshal(data, &inlineValueOptionsValue); err == nil {
-		b.Value = inlineValueOptionsValue
-		return nil
-	}
-	var inlineValueRegistrationOptionsValue *InlineValueRegistrationOptions
-	if err := json.Unmarshal(data, &inlineValueRegistrationOptionsValue); err == nil {
-		b.Value = inlineValueRegistrationOptionsValue
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrInlineValueOptionsOrInlineValueRegistrationOptions](),
+func (p *PrepareRenameResultOr2) GetRange() *Range {
+	if p == nil {
+		return *new(*Range)
 	}
+	return p.Range
 }
 
-func (b *BooleanOrInlineValueOptionsOrInlineValueRegistrationOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
+func (p *PrepareRenameResultOr2) GetPlaceholder() string {
+	if p == nil {
+		return *new(string)
+	}
+	return p.Placeholder
 }
 
-// Inlay hint options used during static registration.
-//
-// @since 3.17.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#inlayHintOptions
-type InlayHintOptions struct {
-	*WorkDoneProgressOptions
-	// The server provides support to resolve additional
-	// information for an inlay hint item.
-	ResolveProvider bool `json:"resolveProvider,omitempty"`
+type PrepareRenameResultOr3 struct {
+	DefaultBehavior bool `json:"defaultBehavior"`
 }
 
-// The server provides support to resolve additional
-// information for an inlay hint item.
-func (i *InlayHintOptions) GetResolveProvider() bool {
-	if i == nil {
-		var zero bool
-		return zero
+func (p *PrepareRenameResultOr3) GetDefaultBehavior() bool {
+	if p == nil {
+		return *new(bool)
 	}
-	return i.ResolveProvider
-}
-
-// Inlay hint options used during static or dynamic registration.
-//
-// @since 3.17.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#inlayHintRegistrationOptions
-type InlayHintRegistrationOptions struct {
-	*InlayHintOptions
-	*TextDocumentRegistrationOptions
-	*StaticRegistrationOptions
+	return p.DefaultBehavior
 }
 
-// BooleanOrInlayHintOptionsOrInlayHintRegistrationOptions contains either of the following types:
-//   - [Boolean]
-//   - [*InlayHintOptions]
-//   - [*InlayHintRegistrationOptions]
-type BooleanOrInlayHintOptionsOrInlayHintRegistrationOptions struct {
-	Value BooleanOrInlayHintOptionsOrInlayHintRegistrationOptionsValue
-}
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#prepareSupportDefaultBehavior
+type PrepareSupportDefaultBehavior uint32
 
-// BooleanOrInlayHintOptionsOrInlayHintRegistrationOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*InlayHintOptions]
-//   - [*InlayHintRegistrationOptions]
-//
-//sumtype:decl
-type BooleanOrInlayHintOptionsOrInlayHintRegistrationOptionsValue interface {
-	isBooleanOrInlayHintOptionsOrInlayHintRegistrationOptionsValue()
-}
+const (
+	// The client's default behavior is to select the identifier
+	// according the to language's syntax rule.
+	PrepareSupportDefaultBehaviorIdentifier PrepareSupportDefaultBehavior = 1
+)
 
-func (Boolean) isBooleanOrInlayHintOptionsOrInlayHintRegistrationOptionsValue()           {}
-func (*InlayHintOptions) isBooleanOrInlayHintOptionsOrInlayHintRegistrationOptionsValue() {}
-func (*InlayHintRegistrationOptions) isBooleanOrInlayHintOptionsOrInlayHintRegistrationOptionsValue() {
+var validPrepareSupportDefaultBehaviorValues = map[uint32]bool{
+	1: true,
 }
 
-func (b *BooleanOrInlayHintOptionsOrInlayHintRegistrationOptions) UnmarshalJSON(data []byte) error {
+func (p *PrepareSupportDefaultBehavior) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
-		return nil
-	}
-	var inlayHintOptionsValue *InlayHintOptions
-	if err := json.Unmarshal(data, &inlayHintOptionsValue); err == nil {
-		b.Value = inlayHintOptionsValue
-		return nil
-	}
-	var inlayHintRegistrationOptionsValue *InlayHintRegistrationOptions
-	if err := json.Unmarshal(data, &inlayHintRegistrationOptionsValue); err == nil {
-		b.Value = inlayHintRegistrationOptionsValue
-		return nil
+	var uint32Value uint32
+	if err := json.Unmarshal(data, &uint32Value); err != nil {
+		return err
 	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrInlayHintOptionsOrInlayHintRegistrationOptions](),
+	if !validPrepareSupportDefaultBehaviorValues[uint32Value] {
+		return fmt.Errorf("cannot unmarshal %v into PrepareSupportDefaultBehavior: custom values are not supported", uint32Value)
 	}
+	*p = PrepareSupportDefaultBehavior(uint32Value)
+
+	return nil
 }
 
-func (b *BooleanOrInlayHintOptionsOrInlayHintRegistrationOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
+func (p PrepareSupportDefaultBehavior) MarshalJSON() ([]byte, error) {
+	var uint32Value = uint32(p)
+	if !validPrepareSupportDefaultBehaviorValues[uint32Value] {
+		return nil, fmt.Errorf("cannot marshal %v into PrepareSupportDefaultBehavior: custom values are not supported", uint32Value)
+	}
+	return json.Marshal(uint32Value)
+
 }
 
-// Diagnostic options.
-//
-// @since 3.17.0
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#progressToken
+type ProgressToken = *IntegerOrString
+
+// The publish diagnostic client capabilities.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#diagnosticOptions
-type DiagnosticOptions struct {
-	*WorkDoneProgressOptions
-	// An optional identifier under which the diagnostics are
-	// managed by the client.
-	Identifier string `json:"identifier,omitempty"`
-	// Whether the language has inter file dependencies meaning that
-	// editing code in one file can result in a different diagnostic
-	// set in another file. Inter file dependencies are common for
-	// most programming languages and typically uncommon for linters.
-	InterFileDependencies bool `json:"interFileDependencies"`
-	// The server provides support for workspace diagnostics as well.
-	WorkspaceDiagnostics bool `json:"workspaceDiagnostics"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#publishDiagnosticsClientCapabilities
+type PublishDiagnosticsClientCapabilities struct {
+	// Whether the clients accepts diagnostics with related information.
+	RelatedInformation bool `json:"relatedInformation,omitempty"`
+	// Client supports the tag property to provide meta data about a diagnostic.
+	// Clients supporting tags have to handle unknown tags gracefully.
+	//
+	// @since 3.15.0
+	TagSupport *PublishDiagnosticsClientCapabilitiesTagSupport `json:"tagSupport,omitempty"`
+	// Whether the client interprets the version property of the
+	// `textDocument/publishDiagnostics` notification's parameter.
+	//
+	// @since 3.15.0
+	VersionSupport bool `json:"versionSupport,omitempty"`
+	// Client supports a codeDescription property
+	//
+	// @since 3.16.0
+	CodeDescriptionSupport bool `json:"codeDescriptionSupport,omitempty"`
+	// Whether code action supports the `data` property which is
+	// preserved between a `textDocument/publishDiagnostics` and
+	// `textDocument/codeAction` request.
+	//
+	// @since 3.16.0
+	DataSupport bool `json:"dataSupport,omitempty"`
 }
 
-// An optional identifier under which the diagnostics are
-// managed by the client.
-func (d *DiagnosticOptions) GetIdentifier() string {
-	if d == nil {
-		var zero string
+// Whether the clients accepts diagnostics with related information.
+func (p *PublishDiagnosticsClientCapabilities) GetRelatedInformation() bool {
+	if p == nil {
+		var zero bool
 		return zero
 	}
-	return d.Identifier
+	return p.RelatedInformation
 }
 
-// Whether the language has inter file dependencies meaning that
-// editing code in one file can result in a different diagnostic
-// set in another file. Inter file dependencies are common for
-// most programming languages and typically uncommon for linters.
-func (d *DiagnosticOptions) GetInterFileDependencies() bool {
-	if d == nil {
-		var zero bool
+// Client supports the tag property to provide meta data about a diagnostic.
+// Clients supporting tags have to handle unknown tags gracefully.
+//
+// @since 3.15.0
+func (p *PublishDiagnosticsClientCapabilities) GetTagSupport() *PublishDiagnosticsClientCapabilitiesTagSupport {
+	if p == nil {
+		var zero *PublishDiagnosticsClientCapabilitiesTagSupport
 		return zero
 	}
-	return d.InterFileDependencies
+	return p.TagSupport
 }
 
-// The server provides support for workspace diagnostics as well.
-func (d *DiagnosticOptions) GetWorkspaceDiagnostics() bool {
-	if d == nil {
+// Whether the client interprets the version property of the
+// `textDocument/publishDiagnostics` notification's parameter.
+//
+// @since 3.15.0
+func (p *PublishDiagnosticsClientCapabilities) GetVersionSupport() bool {
+	if p == nil {
 		var zero bool
 		return zero
 	}
-	return d.WorkspaceDiagnostics
+	return p.VersionSupport
 }
 
-// Diagnostic registration options.
-//
-// @since 3.17.0
+// Client supports a codeDescription property
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#diagnosticRegistrationOptions
-type DiagnosticRegistrationOptions struct {
-	*TextDocumentRegistrationOptions
-	*DiagnosticOptions
-	*StaticRegistrationOptions
-}
-
-// DiagnosticOptionsOrDiagnosticRegistrationOptions contains either of the following types:
-//   - [*DiagnosticOptions]
-//   - [*DiagnosticRegistrationOptions]
-type DiagnosticOptionsOrDiagnosticRegistrationOptions struct {
-	Value DiagnosticOptionsOrDiagnosticRegistrationOptionsValue
+// @since 3.16.0
+func (p *PublishDiagnosticsClientCapabilities) GetCodeDescriptionSupport() bool {
+	if p == nil {
+		var zero bool
+		return zero
+	}
+	return p.CodeDescriptionSupport
 }
 
-// DiagnosticOptionsOrDiagnosticRegistrationOptionsValue is either of the following types:
-//   - [*DiagnosticOptions]
-//   - [*DiagnosticRegistrationOptions]
+// Whether code action supports the `data` property which is
+// preserved between a `textDocument/publishDiagnostics` and
+// `textDocument/codeAction` request.
 //
-//sumtype:decl
-type DiagnosticOptionsOrDiagnosticRegistrationOptionsValue interface {
-	isDiagnosticOptionsOrDiagnosticRegistrationOptionsValue()
-}
-
-func (*DiagnosticOptions) isDiagnosticOptionsOrDiagnosticRegistrationOptionsValue()             {}
-func (*DiagnosticRegistrationOptions) isDiagnosticOptionsOrDiagnosticRegistrationOptionsValue() {}
-
-func (d *DiagnosticOptionsOrDiagnosticRegistrationOptions) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var diagnosticOptionsValue *DiagnosticOptions
-	if err := json.Unmarshal(data, &diagnosticOptionsValue); err == nil {
-		d.Value = diagnosticOptionsValue
-		return nil
-	}
-	var diagnosticRegistrationOptionsValue *DiagnosticRegistrationOptions
-	if err := json.Unmarshal(data, &diagnosticRegistrationOptionsValue); err == nil {
-		d.Value = diagnosticRegistrationOptionsValue
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*DiagnosticOptionsOrDiagnosticRegistrationOptions](),
+// @since 3.16.0
+func (p *PublishDiagnosticsClientCapabilities) GetDataSupport() bool {
+	if p == nil {
+		var zero bool
+		return zero
 	}
+	return p.DataSupport
 }
 
-func (d *DiagnosticOptionsOrDiagnosticRegistrationOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(d.Value)
-}
-
-// Inline completion options used during static registration.
-//
-// @since 3.18.0
-// @proposed
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#inlineCompletionOptions
-type InlineCompletionOptions struct {
-	*WorkDoneProgressOptions
+type PublishDiagnosticsClientCapabilitiesTagSupport struct {
+	// The tags supported by the client.
+	ValueSet []DiagnosticTag `json:"valueSet"`
 }
 
-// BooleanOrInlineCompletionOptions contains either of the following types:
-//   - [Boolean]
-//   - [*InlineCompletionOptions]
-type BooleanOrInlineCompletionOptions struct {
-	Value BooleanOrInlineCompletionOptionsValue
+// The tags supported by the client.
+func (p *PublishDiagnosticsClientCapabilitiesTagSupport) GetValueSet() []DiagnosticTag {
+	if p == nil {
+		return *new([]DiagnosticTag)
+	}
+	return p.ValueSet
 }
 
-// BooleanOrInlineCompletionOptionsValue is either of the following types:
-//   - [Boolean]
-//   - [*InlineCompletionOptions]
+// The publish diagnostic notification's parameters.
 //
-//sumtype:decl
-type BooleanOrInlineCompletionOptionsValue interface {
-	isBooleanOrInlineCompletionOptionsValue()
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#publishDiagnosticsParams
+type PublishDiagnosticsParams struct {
+	// The URI for which diagnostic information is reported.
+	Uri string `json:"uri"`
+	// Optional the version number of the document the diagnostics are published for.
+	//
+	// @since 3.15.0
+	Version Optional[int] `json:"version,omitempty"`
+	// An array of diagnostic information items.
+	Diagnostics []*Diagnostic `json:"diagnostics"`
 }
 
-func (Boolean) isBooleanOrInlineCompletionOptionsValue()                  {}
-func (*InlineCompletionOptions) isBooleanOrInlineCompletionOptionsValue() {}
-
-func (b *BooleanOrInlineCompletionOptions) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		b.Value = booleanValue
-		return nil
-	}
-	var inlineCompletionOptionsValue *InlineCompletionOptions
-	if err := json.Unmarshal(data, &inlineCompletionOptionsValue); err == nil {
-		b.Value = inlineCompletionOptionsValue
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*BooleanOrInlineCompletionOptions](),
+// The URI for which diagnostic information is reported.
+func (p *PublishDiagnosticsParams) GetUri() string {
+	if p == nil {
+		var zero string
+		return zero
 	}
+	return p.Uri
 }
 
-func (b *BooleanOrInlineCompletionOptions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b.Value)
-}
-
-// StringOrBoolean contains either of the following types:
-//   - [String]
-//   - [Boolean]
-type StringOrBoolean struct {
-	Value StringOrBooleanValue
-}
-
-// StringOrBooleanValue is either of the following types:
-//   - [String]
-//   - [Boolean]
+// Optional the version number of the document the diagnostics are published for.
 //
-//sumtype:decl
-type StringOrBooleanValue interface {
-	isStringOrBooleanValue()
-}
-
-func (String) isStringOrBooleanValue()  {}
-func (Boolean) isStringOrBooleanValue() {}
-
-func (s *StringOrBoolean) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var stringValue String
-	if err := json.Unmarshal(data, &stringValue); err == nil {
-		s.Value = stringValue
-		return nil
-	}
-	var booleanValue Boolean
-	if err := json.Unmarshal(data, &booleanValue); err == nil {
-		s.Value = booleanValue
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*StringOrBoolean](),
+// @since 3.15.0
+func (p *PublishDiagnosticsParams) GetVersion() Optional[int] {
+	if p == nil {
+		var zero Optional[int]
+		return zero
 	}
+	return p.Version
 }
 
-func (s *StringOrBoolean) MarshalJSON() ([]byte, error) {
-	return json.Marshal(s.Value)
+// An array of diagnostic information items.
+func (p *PublishDiagnosticsParams) GetDiagnostics() []*Diagnostic {
+	if p == nil {
+		var zero []*Diagnostic
+		return zero
+	}
+	return p.Diagnostics
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#workspaceFoldersServerCapabilities
-type WorkspaceFoldersServerCapabilities struct {
-	// The server has support for workspace folders
-	Supported bool `json:"supported,omitempty"`
-	// Whether the server wants to receive workspace folder
-	// change notifications.
-	//
-	// If a string is provided the string is treated as an ID
-	// under which the notification is registered on the client
-	// side. The ID can be used to unregister for these events
-	// using the `client/unregisterCapability` request.
-	ChangeNotifications *StringOrBoolean `json:"changeNotifications,omitempty"`
+// A range in a text document expressed as (zero-based) start and end positions.
+//
+// If you want to specify a range that contains a line including the line ending
+// character(s) then use an end position denoting the start of the next line.
+// For example:
+// ```ts
+//
+//	{
+//	    start: { line: 5, character: 23 }
+//	    end : { line 6, character : 0 }
+//	}
+//
+// ```
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#range
+type Range struct {
+	// The range's start position.
+	Start *Position `json:"start"`
+	// The range's end position.
+	End *Position `json:"end"`
 }
 
-// The server has support for workspace folders
-func (w *WorkspaceFoldersServerCapabilities) GetSupported() bool {
-	if w == nil {
-		var zero bool
+// The range's start position.
+func (r *Range) GetStart() *Position {
+	if r == nil {
+		var zero *Position
 		return zero
 	}
-	return w.Supported
+	return r.Start
 }
 
-// Whether the server wants to receive workspace folder
-// change notifications.
-//
-// If a string is provided the string is treated as an ID
-// under which the notification is registered on the client
-// side. The ID can be used to unregister for these events
-// using the `client/unregisterCapability` request.
-func (w *WorkspaceFoldersServerCapabilities) GetChangeNotifications() *StringOrBoolean {
-	if w == nil {
-		var zero *StringOrBoolean
+// The range's end position.
+func (r *Range) GetEnd() *Position {
+	if r == nil {
+		var zero *Position
 		return zero
 	}
-	return w.ChangeNotifications
+	return r.End
 }
 
-// A pattern kind describing if a glob pattern matches a file a folder or
-// both.
-//
-// @since 3.16.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#fileOperationPatternKind
-type FileOperationPatternKind string
-
-const (
-	// The pattern matches a file only.
-	FileOperationPatternKindfile FileOperationPatternKind = "file"
-	// The pattern matches a folder only.
-	FileOperationPatternKindfolder FileOperationPatternKind = "folder"
-)
+// RangeOrInsertReplaceRange contains either of the following types:
+//   - [*Range]
+//   - [*InsertReplaceRange]
+type RangeOrInsertReplaceRange struct {
+	Value RangeOrInsertReplaceRangeValue
+}
 
-var validFileOperationPatternKindValues = map[string]bool{
-	"file":   true,
-	"folder": true,
+// RangeOrInsertReplaceRangeValue is either of the following types:
+//   - [*Range]
+//   - [*InsertReplaceRange]
+//
+//sumtype:decl
+type RangeOrInsertReplaceRangeValue interface {
+	isRangeOrInsertReplaceRangeValue()
 }
 
-func (f *FileOperationPatternKind) UnmarshalJSON(data []byte) error {
+func (*Range) isRangeOrInsertReplaceRangeValue()              {}
+func (*InsertReplaceRange) isRangeOrInsertReplaceRangeValue() {}
+
+func (r *RangeOrInsertReplaceRange) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var stringValue string
-	if err := json.Unmarshal(data, &stringValue); err != nil {
-		return err
+	var rangeValue *Range
+	if err := json.Unmarshal(data, &rangeValue); err == nil {
+		r.Value = rangeValue
+		return nil
 	}
-	if !validFileOperationPatternKindValues[stringValue] {
-		return fmt.Errorf("cannot unmarshal %v into FileOperationPatternKind: custom values are not supported", stringValue)
+	var insertReplaceRangeValue *InsertReplaceRange
+	if err := json.Unmarshal(data, &insertReplaceRangeValue); err == nil {
+		r.Value = insertReplaceRangeValue
+		return nil
 	}
-	*f = FileOperationPatternKind(stringValue)
-
-	return nil
-}
-
-func (f FileOperationPatternKind) MarshalJSON() ([]byte, error) {
-	var stringValue = string(f)
-	if !validFileOperationPatternKindValues[stringValue] {
-		return nil, fmt.Errorf("cannot marshal %v into FileOperationPatternKind: custom values are not supported", stringValue)
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*RangeOrInsertReplaceRange](),
 	}
-	return json.Marshal(stringValue)
-
 }
 
-// Matching options for the file operation pattern.
-//
-// @since 3.16.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#fileOperationPatternOptions
-type FileOperationPatternOptions struct {
-	// The pattern should be matched ignoring casing.
-	IgnoreCase bool `json:"ignoreCase,omitempty"`
+func (r *RangeOrInsertReplaceRange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.Value)
 }
 
-// The pattern should be matched ignoring casing.
-func (f *FileOperationPatternOptions) GetIgnoreCase() bool {
-	if f == nil {
-		var zero bool
-		return zero
-	}
-	return f.IgnoreCase
+// RangeOrPrepareRenameResultOr2OrPrepareRenameResultOr3 contains either of the following types:
+//   - [*Range]
+//   - [*PrepareRenameResultOr2]
+//   - [*PrepareRenameResultOr3]
+type RangeOrPrepareRenameResultOr2OrPrepareRenameResultOr3 struct {
+	Value RangeOrPrepareRenameResultOr2OrPrepareRenameResultOr3Value
 }
 
-// A pattern to describe in which file operation requests or notifications
-// the server is interested in receiving.
-//
-// @since 3.16.0
+// RangeOrPrepareRenameResultOr2OrPrepareRenameResultOr3Value is either of the following types:
+//   - [*Range]
+//   - [*PrepareRenameResultOr2]
+//   - [*PrepareRenameResultOr3]
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#fileOperationPattern
-type FileOperationPattern struct {
-	// The glob pattern to match. Glob patterns can have the following syntax:
-	// - `*` to match one or more characters in a path segment
-	// - `?` to match on one character in a path segment
-	// - `**` to match any number of path segments, including none
-	// - `{}` to group sub patterns into an OR expression. (e.g. `**​/*.{ts,js}` matches all TypeScript and JavaScript files)
-	// - `[]` to declare a range of characters to match in a path segment (e.g., `example.[0-9]` to match on `example.0`, `example.1`, …)
-	// - `[!...]` to negate a range of characters to match in a path segment (e.g., `example.[!0-9]` to match on `example.a`, `example.b`, but not `example.0`)
-	Glob string `json:"glob"`
-	// Whether to match files or folders with this pattern.
-	//
-	// Matches both if undefined.
-	Matches FileOperationPatternKind `json:"matches,omitempty"`
-	// Additional options used during matching.
-	Options *FileOperationPatternOptions `json:"options,omitempty"`
+//sumtype:decl
+type RangeOrPrepareRenameResultOr2OrPrepareRenameResultOr3Value interface {
+	isRangeOrPrepareRenameResultOr2OrPrepareRenameResultOr3Value()
 }
 
-// The glob pattern to match. Glob patterns can have the following syntax:
-// - `*` to match one or more characters in a path segment
-// - `?` to match on one character in a path segment
-// - `**` to match any number of path segments, including none
-// - `{}` to group sub patterns into an OR expression. (e.g. `**​/*.{ts,js}` matches all TypeScript and JavaScript files)
-// - `[]` to declare a range of characters to match in a path segment (e.g., `example.[0-9]` to match on `example.0`, `example.1`, …)
-// - `[!...]` to negate a range of characters to match in a path segment (e.g., `example.[!0-9]` to match on `example.a`, `example.b`, but not `example.0`)
-func (f *FileOperationPattern) GetGlob() string {
-	if f == nil {
-		var zero string
-		return zero
+func (*Range) isRangeOrPrepareRenameResultOr2OrPrepareRenameResultOr3Value()                  {}
+func (*PrepareRenameResultOr2) isRangeOrPrepareRenameResultOr2OrPrepareRenameResultOr3Value() {}
+func (*PrepareRenameResultOr3) isRangeOrPrepareRenameResultOr2OrPrepareRenameResultOr3Value() {}
+
+func (r *RangeOrPrepareRenameResultOr2OrPrepareRenameResultOr3) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var rangeValue *Range
+	if err := json.Unmarshal(data, &rangeValue); err == nil {
+		r.Value = rangeValue
+		return nil
+	}
+	var prepareRenameResultOr2Value *PrepareRenameResultOr2
+	if err := json.Unmarshal(data, &prepareRenameResultOr2Value); err == nil {
+		r.Value = prepareRenameResultOr2Value
+		return nil
+	}
+	var prepareRenameResultOr3Value *PrepareRenameResultOr3
+	if err := json.Unmarshal(data, &prepareRenameResultOr3Value); err == nil {
+		r.Value = prepareRenameResultOr3Value
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*RangeOrPrepareRenameResultOr2OrPrepareRenameResultOr3](),
 	}
-	return f.Glob
 }
 
-// Whether to match files or folders with this pattern.
+func (r *RangeOrPrepareRenameResultOr2OrPrepareRenameResultOr3) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.Value)
+}
+
+// Client Capabilities for a {@link ReferencesRequest}.
 //
-// Matches both if undefined.
-func (f *FileOperationPattern) GetMatches() FileOperationPatternKind {
-	if f == nil {
-		var zero FileOperationPatternKind
-		return zero
-	}
-	return f.Matches
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#referenceClientCapabilities
+type ReferenceClientCapabilities struct {
+	// Whether references supports dynamic registration.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
 }
 
-// Additional options used during matching.
-func (f *FileOperationPattern) GetOptions() *FileOperationPatternOptions {
-	if f == nil {
-		var zero *FileOperationPatternOptions
+// Whether references supports dynamic registration.
+func (r *ReferenceClientCapabilities) GetDynamicRegistration() bool {
+	if r == nil {
+		var zero bool
 		return zero
 	}
-	return f.Options
+	return r.DynamicRegistration
 }
 
-// A filter to describe in which file operation requests or notifications
-// the server is interested in receiving.
-//
-// @since 3.16.0
+// Value-object that contains additional information when
+// requesting references.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#fileOperationFilter
-type FileOperationFilter struct {
-	// A Uri scheme like `file` or `untitled`.
-	Scheme string `json:"scheme,omitempty"`
-	// The actual file operation pattern.
-	Pattern *FileOperationPattern `json:"pattern"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#referenceContext
+type ReferenceContext struct {
+	// Include the declaration of the current symbol.
+	IncludeDeclaration bool `json:"includeDeclaration"`
 }
 
-// A Uri scheme like `file` or `untitled`.
-func (f *FileOperationFilter) GetScheme() string {
-	if f == nil {
-		var zero string
+// Include the declaration of the current symbol.
+func (r *ReferenceContext) GetIncludeDeclaration() bool {
+	if r == nil {
+		var zero bool
 		return zero
 	}
-	return f.Scheme
+	return r.IncludeDeclaration
 }
 
-// The actual file operation pattern.
-func (f *FileOperationFilter) GetPattern() *FileOperationPattern {
-	if f == nil {
-		var zero *FileOperationPattern
-		return zero
-	}
-	return f.Pattern
+// Reference options.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#referenceOptions
+type ReferenceOptions struct {
+	*WorkDoneProgressOptions
 }
 
-// The options to register for file operations.
-//
-// @since 3.16.0
+// Parameters for a {@link ReferencesRequest}.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#fileOperationRegistrationOptions
-type FileOperationRegistrationOptions struct {
-	// The actual filters.
-	Filters []*FileOperationFilter `json:"filters"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#referenceParams
+type ReferenceParams struct {
+	*TextDocumentPositionParams
+	*WorkDoneProgressParams
+	*PartialResultParams
+	Context *ReferenceContext `json:"context"`
 }
 
-// The actual filters.
-func (f *FileOperationRegistrationOptions) GetFilters() []*FileOperationFilter {
-	if f == nil {
-		var zero []*FileOperationFilter
+func (r *ReferenceParams) GetContext() *ReferenceContext {
+	if r == nil {
+		var zero *ReferenceContext
 		return zero
 	}
-	return f.Filters
+	return r.Context
 }
 
-// Options for notifications/requests for user operations on files.
+// General parameters to register for a notification or to register a provider.
 //
-// @since 3.16.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#fileOperationOptions
-type FileOperationOptions struct {
-	// The server is interested in receiving didCreateFiles notifications.
-	DidCreate *FileOperationRegistrationOptions `json:"didCreate,omitempty"`
-	// The server is interested in receiving willCreateFiles requests.
-	WillCreate *FileOperationRegistrationOptions `json:"willCreate,omitempty"`
-	// The server is interested in receiving didRenameFiles notifications.
-	DidRename *FileOperationRegistrationOptions `json:"didRename,omitempty"`
-	// The server is interested in receiving willRenameFiles requests.
-	WillRename *FileOperationRegistrationOptions `json:"willRename,omitempty"`
-	// The server is interested in receiving didDeleteFiles file notifications.
-	DidDelete *FileOperationRegistrationOptions `json:"didDelete,omitempty"`
-	// The server is interested in receiving willDeleteFiles file requests.
-	WillDelete *FileOperationRegistrationOptions `json:"willDelete,omitempty"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#registration
+type Registration struct {
+	// The id used to register the request. The id can be used to deregister
+	// the request again.
+	Id string `json:"id"`
+	// The method / capability to register for.
+	Method string `json:"method"`
+	// Options necessary for the registration.
+	RegisterOptions LSPAny `json:"registerOptions,omitempty"`
 }
 
-// The server is interested in receiving didCreateFiles notifications.
-func (f *FileOperationOptions) GetDidCreate() *FileOperationRegistrationOptions {
-	if f == nil {
-		var zero *FileOperationRegistrationOptions
+// The id used to register the request. The id can be used to deregister
+// the request again.
+func (r *Registration) GetId() string {
+	if r == nil {
+		var zero string
 		return zero
 	}
-	return f.DidCreate
+	return r.Id
 }
 
-// The server is interested in receiving willCreateFiles requests.
-func (f *FileOperationOptions) GetWillCreate() *FileOperationRegistrationOptions {
-	if f == nil {
-		var zero *FileOperationRegistrationOptions
+// The method / capability to register for.
+func (r *Registration) GetMethod() string {
+	if r == nil {
+		var zero string
 		return zero
 	}
-	return f.WillCreate
+	return r.Method
 }
 
-// The server is interested in receiving didRenameFiles notifications.
-func (f *FileOperationOptions) GetDidRename() *FileOperationRegistrationOptions {
-	if f == nil {
-		var zero *FileOperationRegistrationOptions
+// Options necessary for the registration.
+func (r *Registration) GetRegisterOptions() LSPAny {
+	if r == nil {
+		var zero LSPAny
 		return zero
 	}
-	return f.DidRename
+	return r.RegisterOptions
 }
 
-// The server is interested in receiving willRenameFiles requests.
-func (f *FileOperationOptions) GetWillRename() *FileOperationRegistrationOptions {
-	if f == nil {
-		var zero *FileOperationRegistrationOptions
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#registrationParams
+type RegistrationParams struct {
+	Registrations []*Registration `json:"registrations"`
+}
+
+func (r *RegistrationParams) GetRegistrations() []*Registration {
+	if r == nil {
+		var zero []*Registration
 		return zero
 	}
-	return f.WillRename
+	return r.Registrations
 }
 
-// The server is interested in receiving didDeleteFiles file notifications.
-func (f *FileOperationOptions) GetDidDelete() *FileOperationRegistrationOptions {
-	if f == nil {
-		var zero *FileOperationRegistrationOptions
+// Client capabilities specific to regular expressions.
+//
+// @since 3.16.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#regularExpressionsClientCapabilities
+type RegularExpressionsClientCapabilities struct {
+	// The engine's name.
+	Engine string `json:"engine"`
+	// The engine's version.
+	Version string `json:"version,omitempty"`
+}
+
+// The engine's name.
+func (r *RegularExpressionsClientCapabilities) GetEngine() string {
+	if r == nil {
+		var zero string
 		return zero
 	}
-	return f.DidDelete
+	return r.Engine
 }
 
-// The server is interested in receiving willDeleteFiles file requests.
-func (f *FileOperationOptions) GetWillDelete() *FileOperationRegistrationOptions {
-	if f == nil {
-		var zero *FileOperationRegistrationOptions
+// The engine's version.
+func (r *RegularExpressionsClientCapabilities) GetVersion() string {
+	if r == nil {
+		var zero string
 		return zero
 	}
-	return f.WillDelete
+	return r.Version
 }
 
-type ServerCapabilitiesWorkspace struct {
-	// The server supports workspace folder.
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#renameClientCapabilities
+type RenameClientCapabilities struct {
+	// Whether rename supports dynamic registration.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	// Client supports testing for validity of rename operations
+	// before execution.
 	//
-	// @since 3.6.0
-	WorkspaceFolders *WorkspaceFoldersServerCapabilities `json:"workspaceFolders,omitempty"`
-	// The server is interested in notifications/requests for operations on files.
+	// @since 3.12.0
+	PrepareSupport bool `json:"prepareSupport,omitempty"`
+	// Client supports the default behavior result.
+	//
+	// The value indicates the default behavior used by the
+	// client.
 	//
 	// @since 3.16.0
-	FileOperations *FileOperationOptions `json:"fileOperations,omitempty"`
+	PrepareSupportDefaultBehavior PrepareSupportDefaultBehavior `json:"prepareSupportDefaultBehavior,omitempty"`
+	// Whether the client honors the change annotations in
+	// text edits and resource operations returned via the
+	// rename request's workspace edit by for example presenting
+	// the workspace edit in the user interface and asking
+	// for confirmation.
+	//
+	// @since 3.16.0
+	HonorsChangeAnnotations bool `json:"honorsChangeAnnotations,omitempty"`
 }
 
-// The server supports workspace folder.
+// Whether rename supports dynamic registration.
+func (r *RenameClientCapabilities) GetDynamicRegistration() bool {
+	if r == nil {
+		var zero bool
+		return zero
+	}
+	return r.DynamicRegistration
+}
+
+// Client supports testing for validity of rename operations
+// before execution.
 //
-// @since 3.6.0
-func (s *ServerCapabilitiesWorkspace) GetWorkspaceFolders() *WorkspaceFoldersServerCapabilities {
-	if s == nil {
-		return *new(*WorkspaceFoldersServerCapabilities)
+// @since 3.12.0
+func (r *RenameClientCapabilities) GetPrepareSupport() bool {
+	if r == nil {
+		var zero bool
+		return zero
 	}
-	return s.WorkspaceFolders
+	return r.PrepareSupport
 }
 
-// The server is interested in notifications/requests for operations on files.
+// Client supports the default behavior result.
+//
+// The value indicates the default behavior used by the
+// client.
 //
 // @since 3.16.0
-func (s *ServerCapabilitiesWorkspace) GetFileOperations() *FileOperationOptions {
-	if s == nil {
-		return *new(*FileOperationOptions)
+func (r *RenameClientCapabilities) GetPrepareSupportDefaultBehavior() PrepareSupportDefaultBehavior {
+	if r == nil {
+		var zero PrepareSupportDefaultBehavior
+		return zero
 	}
-	return s.FileOperations
+	return r.PrepareSupportDefaultBehavior
 }
 
-// Defines the capabilities provided by a language
-// server.
+// Whether the client honors the change annotations in
+// text edits and resource operations returned via the
+// rename request's workspace edit by for example presenting
+// the workspace edit in the user interface and asking
+// for confirmation.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#serverCapabilities
-type ServerCapabilities struct {
-	// The position encoding the server picked from the encodings offered
-	// by the client via the client capability `general.positionEncodings`.
-	//
-	// If the client didn't provide any position encodings the only valid
-	// value that a server can return is 'utf-16'.
-	//
-	// If omitted it defaults to 'utf-16'.
-	//
-	// @since 3.17.0
-	PositionEncoding PositionEncodingKind `json:"positionEncoding,omitempty"`
-	// Defines how text documents are synced. Is either a detailed structure
-	// defining each notification or for backwards compatibility the
-	// TextDocumentSyncKind number.
-	TextDocumentSync *TextDocumentSyncOptionsOrTextDocumentSyncKind `json:"textDocumentSync,omitempty"`
-	// Defines how notebook documents are synced.
-	//
-	// @since 3.17.0
-	NotebookDocumentSync *NotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptions `json:"notebookDocumentSync,omitempty"`
-	// The server provides completion support.
-	CompletionProvider *CompletionOptions `json:"completionProvider,omitempty"`
-	// The server provides hover support.
-	HoverProvider *BooleanOrHoverOptions `json:"hoverProvider,omitempty"`
-	// The server provides signature help support.
-	SignatureHelpProvider *SignatureHelpOptions `json:"signatureHelpProvider,omitempty"`
-	// The server provides Goto Declaration support.
-	DeclarationProvider *BooleanOrDeclarationOptionsOrDeclarationRegistrationOptions `json:"declarationProvider,omitempty"`
-	// The server provides goto definition support.
-	DefinitionProvider *BooleanOrDefinitionOptions `json:"definitionProvider,omitempty"`
-	// The server provides Goto Type Definition support.
-	TypeDefinitionProvider *BooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptions `json:"typeDefinitionProvider,omitempty"`
-	// The server provides Goto Implementation support.
-	ImplementationProvider *BooleanOrImplementationOptionsOrImplementationRegistrationOptions `json:"implementationProvider,omitempty"`
-	// The server provides find references support.
-	ReferencesProvider *BooleanOrReferenceOptions `json:"referencesProvider,omitempty"`
-	// The server provides document highlight support.
-	DocumentHighlightProvider *BooleanOrDocumentHighlightOptions `json:"documentHighlightProvider,omitempty"`
-	// The server provides document symbol support.
-	DocumentSymbolProvider *BooleanOrDocumentSymbolOptions `json:"documentSymbolProvider,omitempty"`
-	// The server provides code actions. CodeActionOptions may only be
-	// specified if the client states that it supports
-	// `codeActionLiteralSupport` in its initial `initialize` request.
-	CodeActionProvider *BooleanOrCodeActionOptions `json:"codeActionProvider,omitempty"`
-	// The server provides code lens.
-	CodeLensProvider *CodeLensOptions `json:"codeLensProvider,omitempty"`
-	// The server provides document link support.
-	DocumentLinkProvider *DocumentLinkOptions `json:"documentLinkProvider,omitempty"`
-	// The server provides color provider support.
-	ColorProvider *BooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptions `json:"colorProvider,omitempty"`
-	// The server provides workspace symbol support.
-	WorkspaceSymbolProvider *BooleanOrWorkspaceSymbolOptions `json:"workspaceSymbolProvider,omitempty"`
-	// The server provides document formatting.
-	DocumentFormattingProvider *BooleanOrDocumentFormattingOptions `json:"documentFormattingProvider,omitempty"`
-	// The server provides document range formatting.
-	DocumentRangeFormattingProvider *BooleanOrDocumentRangeFormattingOptions `json:"documentRangeFormattingProvider,omitempty"`
-	// The server provides document formatting on typing.
-	DocumentOnTypeFormattingProvider *DocumentOnTypeFormattingOptions `json:"documentOnTypeFormattingProvider,omitempty"`
-	// The server provides rename support. RenameOptions may only be
-	// specified if the client states that it supports
-	// `prepareSupport` in its initial `initialize` request.
-	RenameProvider *BooleanOrRenameOptions `json:"renameProvider,omitempty"`
-	// The server provides folding provider support.
-	FoldingRangeProvider *BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptions `json:"foldingRangeProvider,omitempty"`
-	// The server provides selection range support.
-	SelectionRangeProvider *BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptions `json:"selectionRangeProvider,omitempty"`
-	// The server provides execute command support.
-	ExecuteCommandProvider *ExecuteCommandOptions `json:"executeCommandProvider,omitempty"`
-	// The server provides call hierarchy support.
-	//
-	// @since 3.16.0
-	CallHierarchyProvider *BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptions `json:"callHierarchyProvider,omitempty"`
-	// The server provides linked editing range support.
-	//
-	// @since 3.16.0
-	LinkedEditingRangeProvider *BooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptions `json:"linkedEditingRangeProvider,omitempty"`
-	// The server provides semantic tokens support.
-	//
-	// @since 3.16.0
-	SemanticTokensProvider *SemanticTokensOptionsOrSemanticTokensRegistrationOptions `json:"semanticTokensProvider,omitempty"`
-	// The server provides moniker support.
-	//
-	// @since 3.16.0
-	MonikerProvider *BooleanOrMonikerOptionsOrMonikerRegistrationOptions `json:"monikerProvider,omitempty"`
-	// The server provides type hierarchy support.
-	//
-	// @since 3.17.0
-	TypeHierarchyProvider *BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptions `json:"typeHierarchyProvider,omitempty"`
-	// The server provides inline values.
-	//
-	// @since 3.17.0
-	InlineValueProvider *BooleanOrInlineValueOptionsOrInlineValueRegistrationOptions `json:"inlineValueProvider,omitempty"`
-	// The server provides inlay hints.
-	//
-	// @since 3.17.0
-	InlayHintProvider *BooleanOrInlayHintOptionsOrInlayHintRegistrationOptions `json:"inlayHintProvider,omitempty"`
-	// The server has support for pull model diagnostics.
-	//
-	// @since 3.17.0
-	DiagnosticProvider *DiagnosticOptionsOrDiagnosticRegistrationOptions `json:"diagnosticProvider,omitempty"`
-	// Inline completion options used during static registration.
-	//
-	// @since 3.18.0
-	// @proposed
-	InlineCompletionProvider *BooleanOrInlineCompletionOptions `json:"inlineCompletionProvider,omitempty"`
-	// Workspace specific server capabilities.
-	Workspace *ServerCapabilitiesWorkspace `json:"workspace,omitempty"`
-	// Experimental server capabilities.
-	Experimental LSPAny `json:"experimental,omitempty"`
+// @since 3.16.0
+func (r *RenameClientCapabilities) GetHonorsChangeAnnotations() bool {
+	if r == nil {
+		var zero bool
+		return zero
+	}
+	return r.HonorsChangeAnnotations
 }
 
-// The position encoding the server picked from the encodings offered
-// by the client via the client capability `general.positionEncodings`.
-//
-// If the client didn't provide any position encodings the only valid
-// value that a server can return is 'utf-16'.
-//
-// If omitted it defaults to 'utf-16'.
+// Rename file operation
 //
-// @since 3.17.0
-func (s *ServerCapabilities) GetPositionEncoding() PositionEncodingKind {
-	if s == nil {
-		var zero PositionEncodingKind
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#renameFile
+type RenameFile struct {
+	*ResourceOperation
+	// A rename
+	Kind RenameFileKind `json:"kind"`
+	// The old (existing) location.
+	OldUri string `json:"oldUri"`
+	// The new location.
+	NewUri string `json:"newUri"`
+	// Rename options.
+	Options *RenameFileOptions `json:"options,omitempty"`
+}
+
+// A rename
+func (r *RenameFile) GetKind() RenameFileKind {
+	if r == nil {
+		var zero RenameFileKind
 		return zero
 	}
-	return s.PositionEncoding
+	return r.Kind
 }
 
-// Defines how text documents are synced. Is either a detailed structure
-// defining each notification or for backwards compatibility the
-// TextDocumentSyncKind number.
-func (s *ServerCapabilities) GetTextDocumentSync() *TextDocumentSyncOptionsOrTextDocumentSyncKind {
-	if s == nil {
-		var zero *TextDocumentSyncOptionsOrTextDocumentSyncKind
+// The old (existing) location.
+func (r *RenameFile) GetOldUri() string {
+	if r == nil {
+		var zero string
 		return zero
 	}
-	return s.TextDocumentSync
+	return r.OldUri
 }
 
-// Defines how notebook documents are synced.
-//
-// @since 3.17.0
-func (s *ServerCapabilities) GetNotebookDocumentSync() *NotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptions {
-	if s == nil {
-		var zero *NotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptions
+// The new location.
+func (r *RenameFile) GetNewUri() string {
+	if r == nil {
+		var zero string
 		return zero
 	}
-	return s.NotebookDocumentSync
+	return r.NewUri
 }
 
-// The server provides completion support.
-func (s *ServerCapabilities) GetCompletionProvider() *CompletionOptions {
-	if s == nil {
-		var zero *CompletionOptions
+// Rename options.
+func (r *RenameFile) GetOptions() *RenameFileOptions {
+	if r == nil {
+		var zero *RenameFileOptions
 		return zero
 	}
-	return s.CompletionProvider
+	return r.Options
 }
 
-// The server provides hover support.
-func (s *ServerCapabilities) GetHoverProvider() *BooleanOrHoverOptions {
-	if s == nil {
-		var zero *BooleanOrHoverOptions
-		return zero
+type RenameFileKind struct{}
+
+func (RenameFileKind) String() string {
+	return "rename"
+}
+
+func (*RenameFileKind) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
-	return s.HoverProvider
+	if value != "rename" {
+		return fmt.Errorf("cannot unmarshal %v into RenameFileKind: value must be rename", value)
+	}
+	return nil
 }
 
-// The server provides signature help support.
-func (s *ServerCapabilities) GetSignatureHelpProvider() *SignatureHelpOptions {
-	if s == nil {
-		var zero *SignatureHelpOptions
+func (RenameFileKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal("rename")
+}
+
+// Rename file options
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#renameFileOptions
+type RenameFileOptions struct {
+	// Overwrite target if existing. Overwrite wins over `ignoreIfExists`
+	Overwrite bool `json:"overwrite,omitempty"`
+	// Ignores if target exists.
+	IgnoreIfExists bool `json:"ignoreIfExists,omitempty"`
+}
+
+// Overwrite target if existing. Overwrite wins over `ignoreIfExists`
+func (r *RenameFileOptions) GetOverwrite() bool {
+	if r == nil {
+		var zero bool
 		return zero
 	}
-	return s.SignatureHelpProvider
+	return r.Overwrite
 }
 
-// The server provides Goto Declaration support.
-func (s *ServerCapabilities) GetDeclarationProvider() *BooleanOrDeclarationOptionsOrDeclarationRegistrationOptions {
-	if s == nil {
-		var zero *BooleanOrDeclarationOptionsOrDeclarationRegistrationOptions
+// Ignores if target exists.
+func (r *RenameFileOptions) GetIgnoreIfExists() bool {
+	if r == nil {
+		var zero bool
 		return zero
 	}
-	return s.DeclarationProvider
+	return r.IgnoreIfExists
 }
 
-// The server provides goto definition support.
-func (s *ServerCapabilities) GetDefinitionProvider() *BooleanOrDefinitionOptions {
-	if s == nil {
-		var zero *BooleanOrDefinitionOptions
+// Provider options for a {@link RenameRequest}.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#renameOptions
+type RenameOptions struct {
+	*WorkDoneProgressOptions
+	// Renames should be checked and tested before being executed.
+	//
+	// @since version 3.12.0
+	PrepareProvider bool `json:"prepareProvider,omitempty"`
+}
+
+// Renames should be checked and tested before being executed.
+//
+// @since version 3.12.0
+func (r *RenameOptions) GetPrepareProvider() bool {
+	if r == nil {
+		var zero bool
 		return zero
 	}
-	return s.DefinitionProvider
+	return r.PrepareProvider
 }
 
-// The server provides Goto Type Definition support.
-func (s *ServerCapabilities) GetTypeDefinitionProvider() *BooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptions {
-	if s == nil {
-		var zero *BooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptions
+// The parameters of a {@link RenameRequest}.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#renameParams
+type RenameParams struct {
+	*WorkDoneProgressParams
+	// The document to rename.
+	TextDocument *TextDocumentIdentifier `json:"textDocument"`
+	// The position at which this request was sent.
+	Position *Position `json:"position"`
+	// The new name of the symbol. If the given name is not valid the
+	// request must return a {@link ResponseError} with an
+	// appropriate message set.
+	NewName string `json:"newName"`
+}
+
+// The document to rename.
+func (r *RenameParams) GetTextDocument() *TextDocumentIdentifier {
+	if r == nil {
+		var zero *TextDocumentIdentifier
 		return zero
 	}
-	return s.TypeDefinitionProvider
+	return r.TextDocument
 }
 
-// The server provides Goto Implementation support.
-func (s *ServerCapabilities) GetImplementationProvider() *BooleanOrImplementationOptionsOrImplementationRegistrationOptions {
-	if s == nil {
-		var zero *BooleanOrImplementationOptionsOrImplementationRegistrationOptions
+// The position at which this request was sent.
+func (r *RenameParams) GetPosition() *Position {
+	if r == nil {
+		var zero *Position
 		return zero
 	}
-	return s.ImplementationProvider
+	return r.Position
 }
 
-// The server provides find references support.
-func (s *ServerCapabilities) GetReferencesProvider() *BooleanOrReferenceOptions {
-	if s == nil {
-		var zero *BooleanOrReferenceOptions
+// The new name of the symbol. If the given name is not valid the
+// request must return a {@link ResponseError} with an
+// appropriate message set.
+func (r *RenameParams) GetNewName() string {
+	if r == nil {
+		var zero string
 		return zero
 	}
-	return s.ReferencesProvider
+	return r.NewName
 }
 
-// The server provides document highlight support.
-func (s *ServerCapabilities) GetDocumentHighlightProvider() *BooleanOrDocumentHighlightOptions {
-	if s == nil {
-		var zero *BooleanOrDocumentHighlightOptions
+// A generic resource operation.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#resourceOperation
+type ResourceOperation struct {
+	// The resource operation kind.
+	Kind string `json:"kind"`
+	// An optional annotation identifier describing the operation.
+	//
+	// @since 3.16.0
+	AnnotationId ChangeAnnotationIdentifier `json:"annotationId,omitempty"`
+}
+
+// The resource operation kind.
+func (r *ResourceOperation) GetKind() string {
+	if r == nil {
+		var zero string
 		return zero
 	}
-	return s.DocumentHighlightProvider
+	return r.Kind
 }
 
-// The server provides document symbol support.
-func (s *ServerCapabilities) GetDocumentSymbolProvider() *BooleanOrDocumentSymbolOptions {
-	if s == nil {
-		var zero *BooleanOrDocumentSymbolOptions
+// An optional annotation identifier describing the operation.
+//
+// @since 3.16.0
+func (r *ResourceOperation) GetAnnotationId() ChangeAnnotationIdentifier {
+	if r == nil {
+		var zero ChangeAnnotationIdentifier
 		return zero
 	}
-	return s.DocumentSymbolProvider
+	return r.AnnotationId
 }
 
-// The server provides code actions. CodeActionOptions may only be
-// specified if the client states that it supports
-// `codeActionLiteralSupport` in its initial `initialize` request.
-func (s *ServerCapabilities) GetCodeActionProvider() *BooleanOrCodeActionOptions {
-	if s == nil {
-		var zero *BooleanOrCodeActionOptions
-		return zero
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#resourceOperationKind
+type ResourceOperationKind string
+
+const (
+	// Supports creating new files and folders.
+	ResourceOperationKindCreate ResourceOperationKind = "create"
+	// Supports renaming existing files and folders.
+	ResourceOperationKindRename ResourceOperationKind = "rename"
+	// Supports deleting existing files and folders.
+	ResourceOperationKindDelete ResourceOperationKind = "delete"
+)
+
+var validResourceOperationKindValues = map[string]bool{
+	"create": true,
+	"rename": true,
+	"delete": true,
+}
+
+func (r *ResourceOperationKind) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
 	}
-	return s.CodeActionProvider
+	var stringValue string
+	if err := json.Unmarshal(data, &stringValue); err != nil {
+		return err
+	}
+	if !validResourceOperationKindValues[stringValue] {
+		return fmt.Errorf("cannot unmarshal %v into ResourceOperationKind: custom values are not supported", stringValue)
+	}
+	*r = ResourceOperationKind(stringValue)
+
+	return nil
 }
 
-// The server provides code lens.
-func (s *ServerCapabilities) GetCodeLensProvider() *CodeLensOptions {
-	if s == nil {
-		var zero *CodeLensOptions
-		return zero
+func (r ResourceOperationKind) MarshalJSON() ([]byte, error) {
+	var stringValue = string(r)
+	if !validResourceOperationKindValues[stringValue] {
+		return nil, fmt.Errorf("cannot marshal %v into ResourceOperationKind: custom values are not supported", stringValue)
 	}
-	return s.CodeLensProvider
+	return json.Marshal(stringValue)
+
+}
+
+// Save options.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#saveOptions
+type SaveOptions struct {
+	// The client is supposed to include the content on save.
+	IncludeText bool `json:"includeText,omitempty"`
 }
 
-// The server provides document link support.
-func (s *ServerCapabilities) GetDocumentLinkProvider() *DocumentLinkOptions {
+// The client is supposed to include the content on save.
+func (s *SaveOptions) GetIncludeText() bool {
 	if s == nil {
-		var zero *DocumentLinkOptions
+		var zero bool
 		return zero
 	}
-	return s.DocumentLinkProvider
+	return s.IncludeText
 }
 
-// The server provides color provider support.
-func (s *ServerCapabilities) GetColorProvider() *BooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptions {
-	if s == nil {
-		var zero *BooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptions
-		return zero
-	}
-	return s.ColorProvider
+// A selection range represents a part of a selection hierarchy. A selection range
+// may have a parent selection range that contains it.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#selectionRange
+type SelectionRange struct {
+	// The {@link Range range} of this selection range.
+	Range *Range `json:"range"`
+	// The parent selection range containing this range. Therefore `parent.range` must contain `this.range`.
+	Parent *SelectionRange `json:"parent,omitempty"`
 }
 
-// The server provides workspace symbol support.
-func (s *ServerCapabilities) GetWorkspaceSymbolProvider() *BooleanOrWorkspaceSymbolOptions {
+// The {@link Range range} of this selection range.
+func (s *SelectionRange) GetRange() *Range {
 	if s == nil {
-		var zero *BooleanOrWorkspaceSymbolOptions
+		var zero *Range
 		return zero
 	}
-	return s.WorkspaceSymbolProvider
+	return s.Range
 }
 
-// The server provides document formatting.
-func (s *ServerCapabilities) GetDocumentFormattingProvider() *BooleanOrDocumentFormattingOptions {
+// The parent selection range containing this range. Therefore `parent.range` must contain `this.range`.
+func (s *SelectionRange) GetParent() *SelectionRange {
 	if s == nil {
-		var zero *BooleanOrDocumentFormattingOptions
+		var zero *SelectionRange
 		return zero
 	}
-	return s.DocumentFormattingProvider
+	return s.Parent
 }
 
-// The server provides document range formatting.
-func (s *ServerCapabilities) GetDocumentRangeFormattingProvider() *BooleanOrDocumentRangeFormattingOptions {
-	if s == nil {
-		var zero *BooleanOrDocumentRangeFormattingOptions
-		return zero
-	}
-	return s.DocumentRangeFormattingProvider
+type SelectionRangeSlice []*SelectionRange
+
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#selectionRangeClientCapabilities
+type SelectionRangeClientCapabilities struct {
+	// Whether implementation supports dynamic registration for selection range providers. If this is set to `true`
+	// the client supports the new `SelectionRangeRegistrationOptions` return value for the corresponding server
+	// capability as well.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
 }
 
-// The server provides document formatting on typing.
-func (s *ServerCapabilities) GetDocumentOnTypeFormattingProvider() *DocumentOnTypeFormattingOptions {
+// Whether implementation supports dynamic registration for selection range providers. If this is set to `true`
+// the client supports the new `SelectionRangeRegistrationOptions` return value for the corresponding server
+// capability as well.
+func (s *SelectionRangeClientCapabilities) GetDynamicRegistration() bool {
 	if s == nil {
-		var zero *DocumentOnTypeFormattingOptions
+		var zero bool
 		return zero
 	}
-	return s.DocumentOnTypeFormattingProvider
+	return s.DynamicRegistration
 }
 
-// The server provides rename support. RenameOptions may only be
-// specified if the client states that it supports
-// `prepareSupport` in its initial `initialize` request.
-func (s *ServerCapabilities) GetRenameProvider() *BooleanOrRenameOptions {
-	if s == nil {
-		var zero *BooleanOrRenameOptions
-		return zero
-	}
-	return s.RenameProvider
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#selectionRangeOptions
+type SelectionRangeOptions struct {
+	*WorkDoneProgressOptions
 }
 
-// The server provides folding provider support.
-func (s *ServerCapabilities) GetFoldingRangeProvider() *BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptions {
-	if s == nil {
-		var zero *BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptions
-		return zero
-	}
-	return s.FoldingRangeProvider
+// A parameter literal used in selection range requests.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#selectionRangeParams
+type SelectionRangeParams struct {
+	*WorkDoneProgressParams
+	*PartialResultParams
+	// The text document.
+	TextDocument *TextDocumentIdentifier `json:"textDocument"`
+	// The positions inside the text document.
+	Positions []*Position `json:"positions"`
 }
 
-// The server provides selection range support.
-func (s *ServerCapabilities) GetSelectionRangeProvider() *BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptions {
+// The text document.
+func (s *SelectionRangeParams) GetTextDocument() *TextDocumentIdentifier {
 	if s == nil {
-		var zero *BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptions
+		var zero *TextDocumentIdentifier
 		return zero
 	}
-	return s.SelectionRangeProvider
+	return s.TextDocument
 }
 
-// The server provides execute command support.
-func (s *ServerCapabilities) GetExecuteCommandProvider() *ExecuteCommandOptions {
+// The positions inside the text document.
+func (s *SelectionRangeParams) GetPositions() []*Position {
 	if s == nil {
-		var zero *ExecuteCommandOptions
+		var zero []*Position
 		return zero
 	}
-	return s.ExecuteCommandProvider
+	return s.Positions
+}
+
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#selectionRangeRegistrationOptions
+type SelectionRangeRegistrationOptions struct {
+	*SelectionRangeOptions
+	*TextDocumentRegistrationOptions
+	*StaticRegistrationOptions
 }
 
-// The server provides call hierarchy support.
-//
 // @since 3.16.0
-func (s *ServerCapabilities) GetCallHierarchyProvider() *BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptions {
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#semanticTokensClientCapabilities
+type SemanticTokensClientCapabilities struct {
+	// Whether implementation supports dynamic registration. If this is set to `true`
+	// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
+	// return value for the corresponding server capability as well.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	// Which requests the client supports and might send to the server
+	// depending on the server's capability. Please note that clients might not
+	// show semantic tokens or degrade some of the user experience if a range
+	// or full request is advertised by the client but not provided by the
+	// server. If for example the client capability `requests.full` and
+	// `request.range` are both set to true but the server only provides a
+	// range provider the client might not render a minimap correctly or might
+	// even decide to not show any semantic tokens at all.
+	Requests *SemanticTokensClientCapabilitiesRequests `json:"requests"`
+	// The token types that the client supports.
+	TokenTypes []string `json:"tokenTypes"`
+	// The token modifiers that the client supports.
+	TokenModifiers []string `json:"tokenModifiers"`
+	// The token formats the clients supports.
+	Formats []TokenFormat `json:"formats"`
+	// Whether the client supports tokens that can overlap each other.
+	OverlappingTokenSupport bool `json:"overlappingTokenSupport,omitempty"`
+	// Whether the client supports tokens that can span multiple lines.
+	MultilineTokenSupport bool `json:"multilineTokenSupport,omitempty"`
+	// Whether the client allows the server to actively cancel a
+	// semantic token request, e.g. supports returning
+	// LSPErrorCodes.ServerCancelled. If a server does the client
+	// needs to retrigger the request.
+	//
+	// @since 3.17.0
+	ServerCancelSupport bool `json:"serverCancelSupport,omitempty"`
+	// Whether the client uses semantic tokens to augment existing
+	// syntax tokens. If set to `true` client side created syntax
+	// tokens and semantic tokens are both used for colorization. If
+	// set to `false` the client only uses the returned semantic tokens
+	// for colorization.
+	//
+	// If the value is `undefined` then the client behavior is not
+	// specified.
+	//
+	// @since 3.17.0
+	AugmentsSyntaxTokens bool `json:"augmentsSyntaxTokens,omitempty"`
+}
+
+// Whether implementation supports dynamic registration. If this is set to `true`
+// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
+// return value for the corresponding server capability as well.
+func (s *SemanticTokensClientCapabilities) GetDynamicRegistration() bool {
 	if s == nil {
-		var zero *BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptions
+		var zero bool
 		return zero
 	}
-	return s.CallHierarchyProvider
+	return s.DynamicRegistration
 }
 
-// The server provides linked editing range support.
-//
-// @since 3.16.0
-func (s *ServerCapabilities) GetLinkedEditingRangeProvider() *BooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptions {
+// Which requests the client supports and might send to the server
+// depending on the server's capability. Please note that clients might not
+// show semantic tokens or degrade some of the user experience if a range
+// or full request is advertised by the client but not provided by the
+// server. If for example the client capability `requests.full` and
+// `request.range` are both set to true but the server only provides a
+// range provider the client might not render a minimap correctly or might
+// even decide to not show any semantic tokens at all.
+func (s *SemanticTokensClientCapabilities) GetRequests() *SemanticTokensClientCapabilitiesRequests {
 	if s == nil {
-		var zero *BooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptions
+		var zero *SemanticTokensClientCapabilitiesRequests
 		return zero
 	}
-	return s.LinkedEditingRangeProvider
+	return s.Requests
 }
 
-// The server provides semantic tokens support.
-//
-// @since 3.16.0
-func (s *ServerCapabilities) GetSemanticTokensProvider() *SemanticTokensOptionsOrSemanticTokensRegistrationOptions {
+// The token types that the client supports.
+func (s *SemanticTokensClientCapabilities) GetTokenTypes() []string {
 	if s == nil {
-		var zero *SemanticTokensOptionsOrSemanticTokensRegistrationOptions
+		var zero []string
 		return zero
 	}
-	return s.SemanticTokensProvider
+	return s.TokenTypes
 }
 
-// The server provides moniker support.
-//
-// @since 3.16.0
-func (s *ServerCapabilities) GetMonikerProvider() *BooleanOrMonikerOptionsOrMonikerRegistrationOptions {
+// The token modifiers that the client supports.
+func (s *SemanticTokensClientCapabilities) GetTokenModifiers() []string {
 	if s == nil {
-		var zero *BooleanOrMonikerOptionsOrMonikerRegistrationOptions
+		var zero []string
 		return zero
 	}
-	return s.MonikerProvider
+	return s.TokenModifiers
 }
 
-// The server provides type hierarchy support.
-//
-// @since 3.17.0
-func (s *ServerCapabilities) GetTypeHierarchyProvider() *BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptions {
+// The token formats the clients supports.
+func (s *SemanticTokensClientCapabilities) GetFormats() []TokenFormat {
 	if s == nil {
-		var zero *BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptions
+		var zero []TokenFormat
 		return zero
 	}
-	return s.TypeHierarchyProvider
+	return s.Formats
 }
 
-// The server provides inline values.
-//
-// @since 3.17.0
-func (s *ServerCapabilities) GetInlineValueProvider() *BooleanOrInlineValueOptionsOrInlineValueRegistrationOptions {
+// Whether the client supports tokens that can overlap each other.
+func (s *SemanticTokensClientCapabilities) GetOverlappingTokenSupport() bool {
 	if s == nil {
-		var zero *BooleanOrInlineValueOptionsOrInlineValueRegistrationOptions
+		var zero bool
 		return zero
 	}
-	return s.InlineValueProvider
+	return s.OverlappingTokenSupport
 }
 
-// The server provides inlay hints.
-//
-// @since 3.17.0
-func (s *ServerCapabilities) GetInlayHintProvider() *BooleanOrInlayHintOptionsOrInlayHintRegistrationOptions {
+// Whether the client supports tokens that can span multiple lines.
+func (s *SemanticTokensClientCapabilities) GetMultilineTokenSupport() bool {
 	if s == nil {
-		var zero *BooleanOrInlayHintOptionsOrInlayHintRegistrationOptions
+		var zero bool
 		return zero
 	}
-	return s.InlayHintProvider
+	return s.MultilineTokenSupport
 }
 
-// The server has support for pull model diagnostics.
+// Whether the client allows the server to actively cancel a
+// semantic token request, e.g. supports returning
+// LSPErrorCodes.ServerCancelled. If a server does the client
+// needs to retrigger the request.
 //
 // @since 3.17.0
-func (s *ServerCapabilities) GetDiagnosticProvider() *DiagnosticOptionsOrDiagnosticRegistrationOptions {
+func (s *SemanticTokensClientCapabilities) GetServerCancelSupport() bool {
 	if s == nil {
-		var zero *DiagnosticOptionsOrDiagnosticRegistrationOptions
+		var zero bool
 		return zero
 	}
-	return s.DiagnosticProvider
+	return s.ServerCancelSupport
 }
 
-// Inline completion options used during static registration.
+// Whether the client uses semantic tokens to augment existing
+// syntax tokens. If set to `true` client side created syntax
+// tokens and semantic tokens are both used for colorization. If
+// set to `false` the client only uses the returned semantic tokens
+// for colorization.
 //
-// @since 3.18.0
-// @proposed
-func (s *ServerCapabilities) GetInlineCompletionProvider() *BooleanOrInlineCompletionOptions {
+// If the value is `undefined` then the client behavior is not
+// specified.
+//
+// @since 3.17.0
+func (s *SemanticTokensClientCapabilities) GetAugmentsSyntaxTokens() bool {
 	if s == nil {
-		var zero *BooleanOrInlineCompletionOptions
+		var zero bool
 		return zero
 	}
-	return s.InlineCompletionProvider
+	return s.AugmentsSyntaxTokens
 }
 
-// Workspace specific server capabilities.
-func (s *ServerCapabilities) GetWorkspace() *ServerCapabilitiesWorkspace {
+type SemanticTokensClientCapabilitiesRequests struct {
+	// The client will send the `textDocument/semanticTokens/range` request if
+	// the server provides a corresponding handler.
+	Range *BooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2 `json:"range,omitempty"`
+	// The client will send the `textDocument/semanticTokens/full` request if
+	// the server provides a corresponding handler.
+	Full *BooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2 `json:"full,omitempty"`
+}
+
+// The client will send the `textDocument/semanticTokens/range` request if
+// the server provides a corresponding handler.
+func (s *SemanticTokensClientCapabilitiesRequests) GetRange() *BooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2 {
 	if s == nil {
-		var zero *ServerCapabilitiesWorkspace
-		return zero
+		return *new(*BooleanOrSemanticTokensClientCapabilitiesRequestsRangeOr2)
 	}
-	return s.Workspace
+	return s.Range
 }
 
-// Experimental server capabilities.
-func (s *ServerCapabilities) GetExperimental() LSPAny {
+// The client will send the `textDocument/semanticTokens/full` request if
+// the server provides a corresponding handler.
+func (s *SemanticTokensClientCapabilitiesRequests) GetFull() *BooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2 {
 	if s == nil {
-		var zero LSPAny
-		return zero
+		return *new(*BooleanOrSemanticTokensClientCapabilitiesRequestsFullOr2)
 	}
-	return s.Experimental
+	return s.Full
 }
 
-type InitializeResultServerInfo struct {
-	// The name of the server as defined by the server.
-	Name string `json:"name"`
-	// The server's version as defined by the server.
-	Version string `json:"version,omitempty"`
+type SemanticTokensClientCapabilitiesRequestsFullOr2 struct {
+	// The client will send the `textDocument/semanticTokens/full/delta` request if
+	// the server provides a corresponding handler.
+	Delta bool `json:"delta,omitempty"`
 }
 
-// The name of the server as defined by the server.
-func (i *InitializeResultServerInfo) GetName() string {
-	if i == nil {
-		return *new(string)
+// The client will send the `textDocument/semanticTokens/full/delta` request if
+// the server provides a corresponding handler.
+func (s *SemanticTokensClientCapabilitiesRequestsFullOr2) GetDelta() bool {
+	if s == nil {
+		return *new(bool)
 	}
-	return i.Name
+	return s.Delta
 }
 
-// The server's version as defined by the server.
-func (i *InitializeResultServerInfo) GetVersion() string {
-	if i == nil {
-		return *new(string)
-	}
-	return i.Version
+type SemanticTokensClientCapabilitiesRequestsRangeOr2 struct {
 }
 
-// The result returned from an initialize request.
+// @since 3.16.0
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#initializeResult
-type InitializeResult struct {
-	// The capabilities the language server provides.
-	Capabilities *ServerCapabilities `json:"capabilities"`
-	// Information about the server.
-	//
-	// @since 3.15.0
-	ServerInfo *InitializeResultServerInfo `json:"serverInfo,omitempty"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#semanticTokensLegend
+type SemanticTokensLegend struct {
+	// The token types a server uses.
+	TokenTypes []string `json:"tokenTypes"`
+	// The token modifiers a server uses.
+	TokenModifiers []string `json:"tokenModifiers"`
 }
 
-// The capabilities the language server provides.
-func (i *InitializeResult) GetCapabilities() *ServerCapabilities {
-	if i == nil {
-		var zero *ServerCapabilities
+// The token types a server uses.
+func (s *SemanticTokensLegend) GetTokenTypes() []string {
+	if s == nil {
+		var zero []string
 		return zero
 	}
-	return i.Capabilities
+	return s.TokenTypes
 }
 
-// Information about the server.
-//
-// @since 3.15.0
-func (i *InitializeResult) GetServerInfo() *InitializeResultServerInfo {
-	if i == nil {
-		var zero *InitializeResultServerInfo
+// The token modifiers a server uses.
+func (s *SemanticTokensLegend) GetTokenModifiers() []string {
+	if s == nil {
+		var zero []string
 		return zero
 	}
-	return i.ServerInfo
+	return s.TokenModifiers
 }
 
-// The data type of the ResponseError if the
-// initialize request fails.
+// @since 3.16.0
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#initializeError
-type InitializeError struct {
-	// Indicates whether the client execute the following retry logic:
-	// (1) show the message provided by the ResponseError to the user
-	// (2) user selects retry or cancel
-	// (3) if user selected retry the initialize method is sent again.
-	Retry bool `json:"retry"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#semanticTokensOptions
+type SemanticTokensOptions struct {
+	*WorkDoneProgressOptions
+	// The legend used by the server
+	Legend *SemanticTokensLegend `json:"legend"`
+	// Server supports providing semantic tokens for a specific range
+	// of a document.
+	Range *BooleanOrSemanticTokensOptionsRangeOr2 `json:"range,omitempty"`
+	// Server supports providing semantic tokens for a full document.
+	Full *BooleanOrSemanticTokensOptionsFullOr2 `json:"full,omitempty"`
 }
 
-// Indicates whether the client execute the following retry logic:
-// (1) show the message provided by the ResponseError to the user
-// (2) user selects retry or cancel
-// (3) if user selected retry the initialize method is sent again.
-func (i *InitializeError) GetRetry() bool {
-	if i == nil {
-		var zero bool
+// The legend used by the server
+func (s *SemanticTokensOptions) GetLegend() *SemanticTokensLegend {
+	if s == nil {
+		var zero *SemanticTokensLegend
 		return zero
 	}
-	return i.Retry
+	return s.Legend
 }
 
-// A literal to identify a text document in the client.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocumentIdentifier
-type TextDocumentIdentifier struct {
-	// The text document's uri.
-	Uri string `json:"uri"`
+// Server supports providing semantic tokens for a specific range
+// of a document.
+func (s *SemanticTokensOptions) GetRange() *BooleanOrSemanticTokensOptionsRangeOr2 {
+	if s == nil {
+		var zero *BooleanOrSemanticTokensOptionsRangeOr2
+		return zero
+	}
+	return s.Range
 }
 
-// The text document's uri.
-func (t *TextDocumentIdentifier) GetUri() string {
-	if t == nil {
-		var zero string
+// Server supports providing semantic tokens for a full document.
+func (s *SemanticTokensOptions) GetFull() *BooleanOrSemanticTokensOptionsFullOr2 {
+	if s == nil {
+		var zero *BooleanOrSemanticTokensOptionsFullOr2
 		return zero
 	}
-	return t.Uri
+	return s.Full
 }
 
-// Position in a text document expressed as zero-based line and character
-// offset. Prior to 3.17 the offsets were always based on a UTF-16 string
-// representation. So a string of the form `a𐐀b` the character offset of the
-// character `a` is 0, the character offset of `𐐀` is 1 and the character
-// offset of b is 3 since `𐐀` is represented using two code units in UTF-16.
-// Since 3.17 clients and servers can agree on a different string encoding
-// representation (e.g. UTF-8). The client announces it's supported encoding
-// via the client capability [`general.positionEncodings`](https://microsoft.github.io/language-server-protocol/specifications/specification-current/#clientCapabilities).
-// The value is an array of position encodings the client supports, with
-// decreasing preference (e.g. the encoding at index `0` is the most preferred
-// one). To stay backwards compatible the only mandatory encoding is UTF-16
-// represented via the string `utf-16`. The server can pick one of the
-// encodings offered by the client and signals that encoding back to the
-// client via the initialize result's property
-// [`capabilities.positionEncoding`](https://microsoft.github.io/language-server-protocol/specifications/specification-current/#serverCapabilities). If the string value
-// `utf-16` is missing from the client's capability `general.positionEncodings`
-// servers can safely assume that the client supports UTF-16. If the server
-// omits the position encoding in its initialize result the encoding defaults
-// to the string value `utf-16`. Implementation considerations: since the
-// conversion from one encoding into another requires the content of the
-// file / line the conversion is best done where the file is read which is
-// usually on the server side.
-//
-// Positions are line end character agnostic. So you can not specify a position
-// that denotes `\r|\n` or `\n|` where `|` represents the character offset.
-//
-// @since 3.17.0 - support for negotiated position encoding.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#position
-type Position struct {
-	// Line position in a document (zero-based).
-	//
-	// If a line number is greater than the number of lines in a document, it defaults back to the number of lines in the document.
-	// If a line number is negative, it defaults to 0.
-	Line int `json:"line"`
-	// Character offset on a line in a document (zero-based).
-	//
-	// The meaning of this offset is determined by the negotiated
-	// `PositionEncodingKind`.
-	//
-	// If the character value is greater than the line length it defaults back to the
-	// line length.
-	Character int `json:"character"`
+type SemanticTokensOptionsFullOr2 struct {
+	// The server supports deltas for full documents.
+	Delta bool `json:"delta,omitempty"`
 }
 
-// Line position in a document (zero-based).
-//
-// If a line number is greater than the number of lines in a document, it defaults back to the number of lines in the document.
-// If a line number is negative, it defaults to 0.
-func (p *Position) GetLine() int {
-	if p == nil {
-		var zero int
-		return zero
+// The server supports deltas for full documents.
+func (s *SemanticTokensOptionsFullOr2) GetDelta() bool {
+	if s == nil {
+		return *new(bool)
 	}
-	return p.Line
+	return s.Delta
 }
 
-// Character offset on a line in a document (zero-based).
-//
-// The meaning of this offset is determined by the negotiated
-// `PositionEncodingKind`.
-//
-// If the character value is greater than the line length it defaults back to the
-// line length.
-func (p *Position) GetCharacter() int {
-	if p == nil {
-		var zero int
-		return zero
-	}
-	return p.Character
+// SemanticTokensOptionsOrSemanticTokensRegistrationOptions contains either of the following types:
+//   - [*SemanticTokensOptions]
+//   - [*SemanticTokensRegistrationOptions]
+type SemanticTokensOptionsOrSemanticTokensRegistrationOptions struct {
+	Value SemanticTokensOptionsOrSemanticTokensRegistrationOptionsValue
 }
 
-// A parameter literal used in requests to pass a text document and a position inside that
-// document.
+// SemanticTokensOptionsOrSemanticTokensRegistrationOptionsValue is either of the following types:
+//   - [*SemanticTokensOptions]
+//   - [*SemanticTokensRegistrationOptions]
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocumentPositionParams
-type TextDocumentPositionParams struct {
-	// The text document.
-	TextDocument *TextDocumentIdentifier `json:"textDocument"`
-	// The position inside the text document.
-	Position *Position `json:"position"`
+//sumtype:decl
+type SemanticTokensOptionsOrSemanticTokensRegistrationOptionsValue interface {
+	isSemanticTokensOptionsOrSemanticTokensRegistrationOptionsValue()
+}
+
+func (*SemanticTokensOptions) isSemanticTokensOptionsOrSemanticTokensRegistrationOptionsValue() {}
+func (*SemanticTokensRegistrationOptions) isSemanticTokensOptionsOrSemanticTokensRegistrationOptionsValue() {
+}
+
+func (s *SemanticTokensOptionsOrSemanticTokensRegistrationOptions) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var semanticTokensOptionsValue *SemanticTokensOptions
+	if err := json.Unmarshal(data, &semanticTokensOptionsValue); err == nil {
+		s.Value = semanticTokensOptionsValue
+		return nil
+	}
+	var semanticTokensRegistrationOptionsValue *SemanticTokensRegistrationOptions
+	if err := json.Unmarshal(data, &semanticTokensRegistrationOptionsValue); err == nil {
+		s.Value = semanticTokensRegistrationOptionsValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*SemanticTokensOptionsOrSemanticTokensRegistrationOptions](),
+	}
 }
 
-// The text document.
-func (t *TextDocumentPositionParams) GetTextDocument() *TextDocumentIdentifier {
-	if t == nil {
-		var zero *TextDocumentIdentifier
-		return zero
-	}
-	return t.TextDocument
+func (s *SemanticTokensOptionsOrSemanticTokensRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Value)
 }
 
-// The position inside the text document.
-func (t *TextDocumentPositionParams) GetPosition() *Position {
-	if t == nil {
-		var zero *Position
-		return zero
-	}
-	return t.Position
+type SemanticTokensOptionsRangeOr2 struct {
 }
 
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#partialResultParams
-type PartialResultParams struct {
-	// An optional token that a server can use to report partial results (e.g. streaming) to
-	// the client.
-	PartialResultToken ProgressToken `json:"partialResultToken,omitempty"`
+// @since 3.16.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#semanticTokensRegistrationOptions
+type SemanticTokensRegistrationOptions struct {
+	*TextDocumentRegistrationOptions
+	*SemanticTokensOptions
+	*StaticRegistrationOptions
 }
 
-// An optional token that a server can use to report partial results (e.g. streaming) to
-// the client.
-func (p *PartialResultParams) GetPartialResultToken() ProgressToken {
-	if p == nil {
-		var zero ProgressToken
+// @since 3.16.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#semanticTokensWorkspaceClientCapabilities
+type SemanticTokensWorkspaceClientCapabilities struct {
+	// Whether the client implementation supports a refresh request sent from
+	// the server to the client.
+	//
+	// Note that this event is global and will force the client to refresh all
+	// semantic tokens currently shown. It should be used with absolute care
+	// and is useful for situation where a server for example detects a project
+	// wide change that requires such a calculation.
+	RefreshSupport bool `json:"refreshSupport,omitempty"`
+}
+
+// Whether the client implementation supports a refresh request sent from
+// the server to the client.
+//
+// Note that this event is global and will force the client to refresh all
+// semantic tokens currently shown. It should be used with absolute care
+// and is useful for situation where a server for example detects a project
+// wide change that requires such a calculation.
+func (s *SemanticTokensWorkspaceClientCapabilities) GetRefreshSupport() bool {
+	if s == nil {
+		var zero bool
 		return zero
 	}
-	return p.PartialResultToken
+	return s.RefreshSupport
 }
 
-// How a completion was triggered
+// Defines the capabilities provided by a language
+// server.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#completionTriggerKind
-type CompletionTriggerKind uint32
-
-const (
-	// Completion was triggered by typing an identifier (24x7 code
-	// complete), manual invocation (e.g Ctrl+Space) or via API.
-	CompletionTriggerKindInvoked CompletionTriggerKind = 1
-	// Completion was triggered by a trigger character specified by
-	// the `triggerCharacters` properties of the `CompletionRegistrationOptions`.
-	CompletionTriggerKindTriggerCharacter CompletionTriggerKind = 2
-	// Completion was re-triggered as current completion list is incomplete
-	CompletionTriggerKindTriggerForIncompleteCompletions CompletionTriggerKind = 3
-)
-
-var validCompletionTriggerKindValues = map[uint32]bool{
-	1: true,
-	2: true,
-	3: true,
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#serverCapabilities
+type ServerCapabilities struct {
+	// The position encoding the server picked from the encodings offered
+	// by the client via the client capability `general.positionEncodings`.
+	//
+	// If the client didn't provide any position encodings the only valid
+	// value that a server can return is 'utf-16'.
+	//
+	// If omitted it defaults to 'utf-16'.
+	//
+	// @since 3.17.0
+	PositionEncoding PositionEncodingKind `json:"positionEncoding,omitempty"`
+	// Defines how text documents are synced. Is either a detailed structure
+	// defining each notification or for backwards compatibility the
+	// TextDocumentSyncKind number.
+	TextDocumentSync *TextDocumentSyncOptionsOrTextDocumentSyncKind `json:"textDocumentSync,omitempty"`
+	// Defines how notebook documents are synced.
+	//
+	// @since 3.17.0
+	NotebookDocumentSync *NotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptions `json:"notebookDocumentSync,omitempty"`
+	// The server provides completion support.
+	CompletionProvider *CompletionOptions `json:"completionProvider,omitempty"`
+	// The server provides hover support.
+	HoverProvider *BooleanOrHoverOptions `json:"hoverProvider,omitempty"`
+	// The server provides signature help support.
+	SignatureHelpProvider *SignatureHelpOptions `json:"signatureHelpProvider,omitempty"`
+	// The server provides Goto Declaration support.
+	DeclarationProvider *BooleanOrDeclarationOptionsOrDeclarationRegistrationOptions `json:"declarationProvider,omitempty"`
+	// The server provides goto definition support.
+	DefinitionProvider *BooleanOrDefinitionOptions `json:"definitionProvider,omitempty"`
+	// The server provides Goto Type Definition support.
+	TypeDefinitionProvider *BooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptions `json:"typeDefinitionProvider,omitempty"`
+	// The server provides Goto Implementation support.
+	ImplementationProvider *BooleanOrImplementationOptionsOrImplementationRegistrationOptions `json:"implementationProvider,omitempty"`
+	// The server provides find references support.
+	ReferencesProvider *BooleanOrReferenceOptions `json:"referencesProvider,omitempty"`
+	// The server provides document highlight support.
+	DocumentHighlightProvider *BooleanOrDocumentHighlightOptions `json:"documentHighlightProvider,omitempty"`
+	// The server provides document symbol support.
+	DocumentSymbolProvider *BooleanOrDocumentSymbolOptions `json:"documentSymbolProvider,omitempty"`
+	// The server provides code actions. CodeActionOptions may only be
+	// specified if the client states that it supports
+	// `codeActionLiteralSupport` in its initial `initialize` request.
+	CodeActionProvider *BooleanOrCodeActionOptions `json:"codeActionProvider,omitempty"`
+	// The server provides code lens.
+	CodeLensProvider *CodeLensOptions `json:"codeLensProvider,omitempty"`
+	// The server provides document link support.
+	DocumentLinkProvider *DocumentLinkOptions `json:"documentLinkProvider,omitempty"`
+	// The server provides color provider support.
+	ColorProvider *BooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptions `json:"colorProvider,omitempty"`
+	// The server provides workspace symbol support.
+	WorkspaceSymbolProvider *BooleanOrWorkspaceSymbolOptions `json:"workspaceSymbolProvider,omitempty"`
+	// The server provides document formatting.
+	DocumentFormattingProvider *BooleanOrDocumentFormattingOptions `json:"documentFormattingProvider,omitempty"`
+	// The server provides document range formatting.
+	DocumentRangeFormattingProvider *BooleanOrDocumentRangeFormattingOptions `json:"documentRangeFormattingProvider,omitempty"`
+	// The server provides document formatting on typing.
+	DocumentOnTypeFormattingProvider *DocumentOnTypeFormattingOptions `json:"documentOnTypeFormattingProvider,omitempty"`
+	// The server provides rename support. RenameOptions may only be
+	// specified if the client states that it supports
+	// `prepareSupport` in its initial `initialize` request.
+	RenameProvider *BooleanOrRenameOptions `json:"renameProvider,omitempty"`
+	// The server provides folding provider support.
+	FoldingRangeProvider *BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptions `json:"foldingRangeProvider,omitempty"`
+	// The server provides selection range support.
+	SelectionRangeProvider *BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptions `json:"selectionRangeProvider,omitempty"`
+	// The server provides execute command support.
+	ExecuteCommandProvider *ExecuteCommandOptions `json:"executeCommandProvider,omitempty"`
+	// The server provides call hierarchy support.
+	//
+	// @since 3.16.0
+	CallHierarchyProvider *BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptions `json:"callHierarchyProvider,omitempty"`
+	// The server provides linked editing range support.
+	//
+	// @since 3.16.0
+	LinkedEditingRangeProvider *BooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptions `json:"linkedEditingRangeProvider,omitempty"`
+	// The server provides semantic tokens support.
+	//
+	// @since 3.16.0
+	SemanticTokensProvider *SemanticTokensOptionsOrSemanticTokensRegistrationOptions `json:"semanticTokensProvider,omitempty"`
+	// The server provides moniker support.
+	//
+	// @since 3.16.0
+	MonikerProvider *BooleanOrMonikerOptionsOrMonikerRegistrationOptions `json:"monikerProvider,omitempty"`
+	// The server provides type hierarchy support.
+	//
+	// @since 3.17.0
+	TypeHierarchyProvider *BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptions `json:"typeHierarchyProvider,omitempty"`
+	// The server provides inline values.
+	//
+	// @since 3.17.0
+	InlineValueProvider *BooleanOrInlineValueOptionsOrInlineValueRegistrationOptions `json:"inlineValueProvider,omitempty"`
+	// The server provides inlay hints.
+	//
+	// @since 3.17.0
+	InlayHintProvider *BooleanOrInlayHintOptionsOrInlayHintRegistrationOptions `json:"inlayHintProvider,omitempty"`
+	// The server has support for pull model diagnostics.
+	//
+	// @since 3.17.0
+	DiagnosticProvider *DiagnosticOptionsOrDiagnosticRegistrationOptions `json:"diagnosticProvider,omitempty"`
+	// Inline completion options used during static registration.
+	//
+	// @since 3.18.0
+	// @proposed
+	InlineCompletionProvider *BooleanOrInlineCompletionOptions `json:"inlineCompletionProvider,omitempty"`
+	// Workspace specific server capabilities.
+	Workspace *ServerCapabilitiesWorkspace `json:"workspace,omitempty"`
+	// Experimental server capabilities.
+	Experimental LSPAny `json:"experimental,omitempty"`
 }
 
-func (c *CompletionTriggerKind) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var uint32Value uint32
-	if err := json.Unmarshal(data, &uint32Value); err != nil {
-		return err
-	}
-	if !validCompletionTriggerKindValues[uint32Value] {
-		return fmt.Errorf("cannot unmarshal %v into CompletionTriggerKind: custom values are not supported", uint32Value)
+// The position encoding the server picked from the encodings offered
+// by the client via the client capability `general.positionEncodings`.
+//
+// If the client didn't provide any position encodings the only valid
+// value that a server can return is 'utf-16'.
+//
+// If omitted it defaults to 'utf-16'.
+//
+// @since 3.17.0
+func (s *ServerCapabilities) GetPositionEncoding() PositionEncodingKind {
+	if s == nil {
+		var zero PositionEncodingKind
+		return zero
 	}
-	*c = CompletionTriggerKind(uint32Value)
-
-	return nil
+	return s.PositionEncoding
 }
 
-func (c CompletionTriggerKind) MarshalJSON() ([]byte, error) {
-	var uint32Value = uint32(c)
-	if !validCompletionTriggerKindValues[uint32Value] {
-		return nil, fmt.Errorf("cannot marshal %v into CompletionTriggerKind: custom values are not supported", uint32Value)
+// Defines how text documents are synced. Is either a detailed structure
+// defining each notification or for backwards compatibility the
+// TextDocumentSyncKind number.
+func (s *ServerCapabilities) GetTextDocumentSync() *TextDocumentSyncOptionsOrTextDocumentSyncKind {
+	if s == nil {
+		var zero *TextDocumentSyncOptionsOrTextDocumentSyncKind
+		return zero
 	}
-	return json.Marshal(uint32Value)
-
+	return s.TextDocumentSync
 }
 
-// Contains additional information about the context in which a completion request is triggered.
+// Defines how notebook documents are synced.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#completionContext
-type CompletionContext struct {
-	// How the completion was triggered.
-	TriggerKind CompletionTriggerKind `json:"triggerKind"`
-	// The trigger character (a single character) that has trigger code complete.
-	// Is undefined if `triggerKind !== CompletionTriggerKind.TriggerCharacter`
-	TriggerCharacter string `json:"triggerCharacter,omitempty"`
+// @since 3.17.0
+func (s *ServerCapabilities) GetNotebookDocumentSync() *NotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptions {
+	if s == nil {
+		var zero *NotebookDocumentSyncOptionsOrNotebookDocumentSyncRegistrationOptions
+		return zero
+	}
+	return s.NotebookDocumentSync
 }
 
-// How the completion was triggered.
-func (c *CompletionContext) GetTriggerKind() CompletionTriggerKind {
-	if c == nil {
-		var zero CompletionTriggerKind
+// The server provides completion support.
+func (s *ServerCapabilities) GetCompletionProvider() *CompletionOptions {
+	if s == nil {
+		var zero *CompletionOptions
 		return zero
 	}
-	return c.TriggerKind
+	return s.CompletionProvider
 }
 
-// The trigger character (a single character) that has trigger code complete.
-// Is undefined if `triggerKind !== CompletionTriggerKind.TriggerCharacter`
-func (c *CompletionContext) GetTriggerCharacter() string {
-	if c == nil {
-		var zero string
+// The server provides hover support.
+func (s *ServerCapabilities) GetHoverProvider() *BooleanOrHoverOptions {
+	if s == nil {
+		var zero *BooleanOrHoverOptions
 		return zero
 	}
-	return c.TriggerCharacter
+	return s.HoverProvider
 }
 
-// Completion parameters
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#completionParams
-type CompletionParams struct {
-	*TextDocumentPositionParams
-	*WorkDoneProgressParams
-	*PartialResultParams
-	// The completion context. This is only available if the client specifies
-	// to send this using the client capability `textDocument.completion.contextSupport === true`
-	Context *CompletionContext `json:"context,omitempty"`
+// The server provides signature help support.
+func (s *ServerCapabilities) GetSignatureHelpProvider() *SignatureHelpOptions {
+	if s == nil {
+		var zero *SignatureHelpOptions
+		return zero
+	}
+	return s.SignatureHelpProvider
 }
 
-// The completion context. This is only available if the client specifies
-// to send this using the client capability `textDocument.completion.contextSupport === true`
-func (c *CompletionParams) GetContext() *CompletionContext {
-	if c == nil {
-		var zero *CompletionContext
+// The server provides Goto Declaration support.
+func (s *ServerCapabilities) GetDeclarationProvider() *BooleanOrDeclarationOptionsOrDeclarationRegistrationOptions {
+	if s == nil {
+		var zero *BooleanOrDeclarationOptionsOrDeclarationRegistrationOptions
 		return zero
 	}
-	return c.Context
+	return s.DeclarationProvider
 }
 
-// Additional details for a completion item label.
-//
-// @since 3.17.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#completionItemLabelDetails
-type CompletionItemLabelDetails struct {
-	// An optional string which is rendered less prominently directly after {@link CompletionItem.label label},
-	// without any spacing. Should be used for function signatures and type annotations.
-	Detail string `json:"detail,omitempty"`
-	// An optional string which is rendered less prominently after {@link CompletionItem.detail}. Should be used
-	// for fully qualified names and file paths.
-	Description string `json:"description,omitempty"`
+// The server provides goto definition support.
+func (s *ServerCapabilities) GetDefinitionProvider() *BooleanOrDefinitionOptions {
+	if s == nil {
+		var zero *BooleanOrDefinitionOptions
+		return zero
+	}
+	return s.DefinitionProvider
 }
 
-// An optional string which is rendered less prominently directly after {@link CompletionItem.label label},
-// without any spacing. Should be used for function signatures and type annotations.
-func (c *CompletionItemLabelDetails) GetDetail() string {
-	if c == nil {
-		var zero string
+// The server provides Goto Type Definition support.
+func (s *ServerCapabilities) GetTypeDefinitionProvider() *BooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptions {
+	if s == nil {
+		var zero *BooleanOrTypeDefinitionOptionsOrTypeDefinitionRegistrationOptions
 		return zero
 	}
-	return c.Detail
+	return s.TypeDefinitionProvider
 }
 
-// An optional string which is rendered less prominently after {@link CompletionItem.detail}. Should be used
-// for fully qualified names and file paths.
-func (c *CompletionItemLabelDetails) GetDescription() string {
-	if c == nil {
-		var zero string
+// The server provides Goto Implementation support.
+func (s *ServerCapabilities) GetImplementationProvider() *BooleanOrImplementationOptionsOrImplementationRegistrationOptions {
+	if s == nil {
+		var zero *BooleanOrImplementationOptionsOrImplementationRegistrationOptions
 		return zero
 	}
-	return c.Description
+	return s.ImplementationProvider
 }
 
-// A `MarkupContent` literal represents a string value which content is interpreted base on its
-// kind flag. Currently the protocol supports `plaintext` and `markdown` as markup kinds.
-//
-// If the kind is `markdown` then the value can contain fenced code blocks like in GitHub issues.
-// See https://help.github.com/articles/creating-and-highlighting-code-blocks/#syntax-highlighting
-//
-// Here is an example how such a string can be constructed using JavaScript / TypeScript:
-// ```ts
-//
-//	let markdown: MarkdownContent = {
-//	 kind: MarkupKind.Markdown,
-//	 value: [
-//	   '# Header',
-//	   'Some text',
-//	   '```typescript',
-//	   'someCode();',
-//	   '```'
-//	 ].join('\n')
-//	};
-//
-// ```
-//
-// *Please Note* that clients might sanitize the return markdown. A client could decide to
-// remove HTML from the markdown to avoid script execution.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#markupContent
-type MarkupContent struct {
-	// The type of the Markup
-	Kind MarkupKind `json:"kind"`
-	// The content itself
-	Value string `json:"value"`
+// The server provides find references support.
+func (s *ServerCapabilities) GetReferencesProvider() *BooleanOrReferenceOptions {
+	if s == nil {
+		var zero *BooleanOrReferenceOptions
+		return zero
+	}
+	return s.ReferencesProvider
 }
 
-// The type of the Markup
-func (m *MarkupContent) GetKind() MarkupKind {
-	if m == nil {
-		var zero MarkupKind
+// The server provides document highlight support.
+func (s *ServerCapabilities) GetDocumentHighlightProvider() *BooleanOrDocumentHighlightOptions {
+	if s == nil {
+		var zero *BooleanOrDocumentHighlightOptions
 		return zero
 	}
-	return m.Kind
+	return s.DocumentHighlightProvider
 }
 
-// The content itself
-func (m *MarkupContent) GetValue() string {
-	if m == nil {
-		var zero string
+// The server provides document symbol support.
+func (s *ServerCapabilities) GetDocumentSymbolProvider() *BooleanOrDocumentSymbolOptions {
+	if s == nil {
+		var zero *BooleanOrDocumentSymbolOptions
 		return zero
 	}
-	return m.Value
+	return s.DocumentSymbolProvider
 }
 
-// StringOrMarkupContent contains either of the following types:
-//   - [String]
-//   - [*MarkupContent]
-type StringOrMarkupContent struct {
-	Value StringOrMarkupContentValue
+// The server provides code actions. CodeActionOptions may only be
+// specified if the client states that it supports
+// `codeActionLiteralSupport` in its initial `initialize` request.
+func (s *ServerCapabilities) GetCodeActionProvider() *BooleanOrCodeActionOptions {
+	if s == nil {
+		var zero *BooleanOrCodeActionOptions
+		return zero
+	}
+	return s.CodeActionProvider
 }
 
-// StringOrMarkupContentValue is either of the following types:
-//   - [String]
-//   - [*MarkupContent]
-//
-//sumtype:decl
-type StringOrMarkupContentValue interface {
-	isStringOrMarkupContentValue()
+// The server provides code lens.
+func (s *ServerCapabilities) GetCodeLensProvider() *CodeLensOptions {
+	if s == nil {
+		var zero *CodeLensOptions
+		return zero
+	}
+	return s.CodeLensProvider
 }
 
-func (String) isStringOrMarkupContentValue()         {}
-func (*MarkupContent) isStringOrMarkupContentValue() {}
-
-func (s *StringOrMarkupContent) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var stringValue String
-	if err := json.Unmarshal(data, &stringValue); err == nil {
-		s.Value = stringValue
-		return nil
-	}
-	var markupContentValue *MarkupContent
-	if err := json.Unmarshal(data, &markupContentValue); err == nil {
-		s.Value = markupContentValue
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*StringOrMarkupContent](),
+// The server provides document link support.
+func (s *ServerCapabilities) GetDocumentLinkProvider() *DocumentLinkOptions {
+	if s == nil {
+		var zero *DocumentLinkOptions
+		return zero
 	}
+	return s.DocumentLinkProvider
 }
 
-func (s *StringOrMarkupContent) MarshalJSON() ([]byte, error) {
-	return json.Marshal(s.Value)
+// The server provides color provider support.
+func (s *ServerCapabilities) GetColorProvider() *BooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptions {
+	if s == nil {
+		var zero *BooleanOrDocumentColorOptionsOrDocumentColorRegistrationOptions
+		return zero
+	}
+	return s.ColorProvider
 }
 
-// Defines whether the insert text in a completion item should be interpreted as
-// plain text or a snippet.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#insertTextFormat
-type InsertTextFormat uint32
-
-const (
-	// The primary text to be inserted is treated as a plain string.
-	InsertTextFormatPlainText InsertTextFormat = 1
-	// The primary text to be inserted is treated as a snippet.
-	//
-	// A snippet can define tab stops and placeholders with `$1`, `$2`
-	// and `${3:foo}`. `$0` defines the final tab stop, it defaults to
-	// the end of the snippet. Placeholders with equal identifiers are linked,
-	// that is typing in one will update others too.
-	//
-	// See also: https://microsoft.github.io/language-server-protocol/specifications/specification-current/#snippet_syntax
-	InsertTextFormatSnippet InsertTextFormat = 2
-)
-
-var validInsertTextFormatValues = map[uint32]bool{
-	1: true,
-	2: true,
+// The server provides workspace symbol support.
+func (s *ServerCapabilities) GetWorkspaceSymbolProvider() *BooleanOrWorkspaceSymbolOptions {
+	if s == nil {
+		var zero *BooleanOrWorkspaceSymbolOptions
+		return zero
+	}
+	return s.WorkspaceSymbolProvider
 }
 
-func (i *InsertTextFormat) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var uint32Value uint32
-	if err := json.Unmarshal(data, &uint32Value); err != nil {
-		return err
-	}
-	if !validInsertTextFormatValues[uint32Value] {
-		return fmt.Errorf("cannot unmarshal %v into InsertTextFormat: custom values are not supported", uint32Value)
+// The server provides document formatting.
+func (s *ServerCapabilities) GetDocumentFormattingProvider() *BooleanOrDocumentFormattingOptions {
+	if s == nil {
+		var zero *BooleanOrDocumentFormattingOptions
+		return zero
 	}
-	*i = InsertTextFormat(uint32Value)
+	return s.DocumentFormattingProvider
+}
 
-	return nil
+// The server provides document range formatting.
+func (s *ServerCapabilities) GetDocumentRangeFormattingProvider() *BooleanOrDocumentRangeFormattingOptions {
+	if s == nil {
+		var zero *BooleanOrDocumentRangeFormattingOptions
+		return zero
+	}
+	return s.DocumentRangeFormattingProvider
 }
 
-func (i InsertTextFormat) MarshalJSON() ([]byte, error) {
-	var uint32Value = uint32(i)
-	if !validInsertTextFormatValues[uint32Value] {
-		return nil, fmt.Errorf("cannot marshal %v into InsertTextFormat: custom values are not supported", uint32Value)
+// The server provides document formatting on typing.
+func (s *ServerCapabilities) GetDocumentOnTypeFormattingProvider() *DocumentOnTypeFormattingOptions {
+	if s == nil {
+		var zero *DocumentOnTypeFormattingOptions
+		return zero
 	}
-	return json.Marshal(uint32Value)
+	return s.DocumentOnTypeFormattingProvider
+}
 
+// The server provides rename support. RenameOptions may only be
+// specified if the client states that it supports
+// `prepareSupport` in its initial `initialize` request.
+func (s *ServerCapabilities) GetRenameProvider() *BooleanOrRenameOptions {
+	if s == nil {
+		var zero *BooleanOrRenameOptions
+		return zero
+	}
+	return s.RenameProvider
 }
 
-// A range in a text document expressed as (zero-based) start and end positions.
-//
-// If you want to specify a range that contains a line including the line ending
-// character(s) then use an end position denoting the start of the next line.
-// For example:
-// ```ts
-//
-//	{
-//	    start: { line: 5, character: 23 }
-//	    end : { line 6, character : 0 }
-//	}
-//
-// ```
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#range
-type Range struct {
-	// The range's start position.
-	Start *Position `json:"start"`
-	// The range's end position.
-	End *Position `json:"end"`
+// The server provides folding provider support.
+func (s *ServerCapabilities) GetFoldingRangeProvider() *BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptions {
+	if s == nil {
+		var zero *BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptions
+		return zero
+	}
+	return s.FoldingRangeProvider
 }
 
-// The range's start position.
-func (r *Range) GetStart() *Position {
-	if r == nil {
-		var zero *Position
+// The server provides selection range support.
+func (s *ServerCapabilities) GetSelectionRangeProvider() *BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptions {
+	if s == nil {
+		var zero *BooleanOrSelectionRangeOptionsOrSelectionRangeRegistrationOptions
 		return zero
 	}
-	return r.Start
+	return s.SelectionRangeProvider
 }
 
-// The range's end position.
-func (r *Range) GetEnd() *Position {
-	if r == nil {
-		var zero *Position
+// The server provides execute command support.
+func (s *ServerCapabilities) GetExecuteCommandProvider() *ExecuteCommandOptions {
+	if s == nil {
+		var zero *ExecuteCommandOptions
 		return zero
 	}
-	return r.End
+	return s.ExecuteCommandProvider
 }
 
-// A text edit applicable to a text document.
+// The server provides call hierarchy support.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textEdit
-type TextEdit struct {
-	// The range of the text document to be manipulated. To insert
-	// text into a document create a range where start === end.
-	Range *Range `json:"range"`
-	// The string to be inserted. For delete operations use an
-	// empty string.
-	NewText string `json:"newText"`
+// @since 3.16.0
+func (s *ServerCapabilities) GetCallHierarchyProvider() *BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptions {
+	if s == nil {
+		var zero *BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptions
+		return zero
+	}
+	return s.CallHierarchyProvider
 }
 
-// The range of the text document to be manipulated. To insert
-// text into a document create a range where start === end.
-func (t *TextEdit) GetRange() *Range {
-	if t == nil {
-		var zero *Range
+// The server provides linked editing range support.
+//
+// @since 3.16.0
+func (s *ServerCapabilities) GetLinkedEditingRangeProvider() *BooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptions {
+	if s == nil {
+		var zero *BooleanOrLinkedEditingRangeOptionsOrLinkedEditingRangeRegistrationOptions
 		return zero
 	}
-	return t.Range
+	return s.LinkedEditingRangeProvider
 }
 
-// The string to be inserted. For delete operations use an
-// empty string.
-func (t *TextEdit) GetNewText() string {
-	if t == nil {
-		var zero string
+// The server provides semantic tokens support.
+//
+// @since 3.16.0
+func (s *ServerCapabilities) GetSemanticTokensProvider() *SemanticTokensOptionsOrSemanticTokensRegistrationOptions {
+	if s == nil {
+		var zero *SemanticTokensOptionsOrSemanticTokensRegistrationOptions
 		return zero
 	}
-	return t.NewText
+	return s.SemanticTokensProvider
 }
 
-// A special text edit to provide an insert and a replace operation.
+// The server provides moniker support.
 //
 // @since 3.16.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#insertReplaceEdit
-type InsertReplaceEdit struct {
-	// The string to be inserted.
-	NewText string `json:"newText"`
-	// The range if the insert is requested
-	Insert *Range `json:"insert"`
-	// The range if the replace is requested.
-	Replace *Range `json:"replace"`
+func (s *ServerCapabilities) GetMonikerProvider() *BooleanOrMonikerOptionsOrMonikerRegistrationOptions {
+	if s == nil {
+		var zero *BooleanOrMonikerOptionsOrMonikerRegistrationOptions
+		return zero
+	}
+	return s.MonikerProvider
 }
 
-// The string to be inserted.
-func (i *InsertReplaceEdit) GetNewText() string {
-	if i == nil {
-		var zero string
+// The server provides type hierarchy support.
+//
+// @since 3.17.0
+func (s *ServerCapabilities) GetTypeHierarchyProvider() *BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptions {
+	if s == nil {
+		var zero *BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptions
 		return zero
 	}
-	return i.NewText
+	return s.TypeHierarchyProvider
 }
 
-// The range if the insert is requested
-func (i *InsertReplaceEdit) GetInsert() *Range {
-	if i == nil {
-		var zero *Range
+// The server provides inline values.
+//
+// @since 3.17.0
+func (s *ServerCapabilities) GetInlineValueProvider() *BooleanOrInlineValueOptionsOrInlineValueRegistrationOptions {
+	if s == nil {
+		var zero *BooleanOrInlineValueOptionsOrInlineValueRegistrationOptions
 		return zero
 	}
-	return i.Insert
+	return s.InlineValueProvider
 }
 
-// The range if the replace is requested.
-func (i *InsertReplaceEdit) GetReplace() *Range {
-	if i == nil {
-		var zero *Range
+// The server provides inlay hints.
+//
+// @since 3.17.0
+func (s *ServerCapabilities) GetInlayHintProvider() *BooleanOrInlayHintOptionsOrInlayHintRegistrationOptions {
+	if s == nil {
+		var zero *BooleanOrInlayHintOptionsOrInlayHintRegistrationOptions
 		return zero
 	}
-	return i.Replace
+	return s.InlayHintProvider
 }
 
-// TextEditOrInsertReplaceEdit contains either of the following types:
-//   - [*TextEdit]
-//   - [*InsertReplaceEdit]
-type TextEditOrInsertReplaceEdit struct {
-	Value TextEditOrInsertReplaceEditValue
+// The server has support for pull model diagnostics.
+//
+// @since 3.17.0
+func (s *ServerCapabilities) GetDiagnosticProvider() *DiagnosticOptionsOrDiagnosticRegistrationOptions {
+	if s == nil {
+		var zero *DiagnosticOptionsOrDiagnosticRegistrationOptions
+		return zero
+	}
+	return s.DiagnosticProvider
 }
 
-// TextEditOrInsertReplaceEditValue is either of the following types:
-//   - [*TextEdit]
-//   - [*InsertReplaceEdit]
+// Inline completion options used during static registration.
 //
-//sumtype:decl
-type TextEditOrInsertReplaceEditValue interface {
-	isTextEditOrInsertReplaceEditValue()
+// @since 3.18.0
+// @proposed
+func (s *ServerCapabilities) GetInlineCompletionProvider() *BooleanOrInlineCompletionOptions {
+	if s == nil {
+		var zero *BooleanOrInlineCompletionOptions
+		return zero
+	}
+	return s.InlineCompletionProvider
 }
 
-func (*TextEdit) isTextEditOrInsertReplaceEditValue()          {}
-func (*InsertReplaceEdit) isTextEditOrInsertReplaceEditValue() {}
-
-func (t *TextEditOrInsertReplaceEdit) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var textEditValue *TextEdit
-	if err := json.Unmarshal(data, &textEditValue); err == nil {
-		t.Value = textEditValue
-		return nil
+// Workspace specific server capabilities.
+func (s *ServerCapabilities) GetWorkspace() *ServerCapabilitiesWorkspace {
+	if s == nil {
+		var zero *ServerCapabilitiesWorkspace
+		return zero
 	}
-	var insertReplaceEditValue *InsertReplaceEdit
-	if err := json.Unmarshal(data, &insertReplaceEditValue); err == nil {
-		t.Value = insertReplaceEditValue
-		return nil
+	return s.Workspace
+}
+
+// Experimental server capabilities.
+func (s *ServerCapabilities) GetExperimental() LSPAny {
+	if s == nil {
+		var zero LSPAny
+		return zero
 	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*TextEditOrInsertReplaceEdit](),
+	return s.Experimental
+}
+
+type ServerCapabilitiesWorkspace struct {
+	// The server supports workspace folder.
+	//
+	// @since 3.6.0
+	WorkspaceFolders *WorkspaceFoldersServerCapabilities `json:"workspaceFolders,omitempty"`
+	// The server is interested in notifications/requests for operations on files.
+	//
+	// @since 3.16.0
+	FileOperations *FileOperationOptions `json:"fileOperations,omitempty"`
+}
+
+// The server supports workspace folder.
+//
+// @since 3.6.0
+func (s *ServerCapabilitiesWorkspace) GetWorkspaceFolders() *WorkspaceFoldersServerCapabilities {
+	if s == nil {
+		return *new(*WorkspaceFoldersServerCapabilities)
 	}
+	return s.WorkspaceFolders
 }
 
-func (t *TextEditOrInsertReplaceEdit) MarshalJSON() ([]byte, error) {
-	return json.Marshal(t.Value)
+// The server is interested in notifications/requests for operations on files.
+//
+// @since 3.16.0
+func (s *ServerCapabilitiesWorkspace) GetFileOperations() *FileOperationOptions {
+	if s == nil {
+		return *new(*FileOperationOptions)
+	}
+	return s.FileOperations
 }
 
-// Represents a reference to a command. Provides a title which
-// will be used to represent a command in the UI and, optionally,
-// an array of arguments which will be passed to the command handler
-// function when invoked.
+// Client capabilities for the showDocument request.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#command
-type Command struct {
-	// Title of the command, like `save`.
-	Title string `json:"title"`
-	// The identifier of the actual command handler.
-	Command string `json:"command"`
-	// Arguments that the command handler should be
-	// invoked with.
-	Arguments []LSPAny `json:"arguments,omitempty"`
+// @since 3.16.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#showDocumentClientCapabilities
+type ShowDocumentClientCapabilities struct {
+	// The client has support for the showDocument
+	// request.
+	Support bool `json:"support"`
 }
 
-// Title of the command, like `save`.
-func (c *Command) GetTitle() string {
-	if c == nil {
-		var zero string
+// The client has support for the showDocument
+// request.
+func (s *ShowDocumentClientCapabilities) GetSupport() bool {
+	if s == nil {
+		var zero bool
 		return zero
 	}
-	return c.Title
+	return s.Support
 }
 
-// The identifier of the actual command handler.
-func (c *Command) GetCommand() string {
-	if c == nil {
-		var zero string
+// Show message request client capabilities
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#showMessageRequestClientCapabilities
+type ShowMessageRequestClientCapabilities struct {
+	// Capabilities specific to the `MessageActionItem` type.
+	MessageActionItem *ShowMessageRequestClientCapabilitiesMessageActionItem `json:"messageActionItem,omitempty"`
+}
+
+// Capabilities specific to the `MessageActionItem` type.
+func (s *ShowMessageRequestClientCapabilities) GetMessageActionItem() *ShowMessageRequestClientCapabilitiesMessageActionItem {
+	if s == nil {
+		var zero *ShowMessageRequestClientCapabilitiesMessageActionItem
 		return zero
 	}
-	return c.Command
+	return s.MessageActionItem
 }
 
-// Arguments that the command handler should be
-// invoked with.
-func (c *Command) GetArguments() []LSPAny {
-	if c == nil {
-		var zero []LSPAny
-		return zero
+type ShowMessageRequestClientCapabilitiesMessageActionItem struct {
+	// Whether the client supports additional attributes which
+	// are preserved and send back to the server in the
+	// request's response.
+	AdditionalPropertiesSupport bool `json:"additionalPropertiesSupport,omitempty"`
+}
+
+// Whether the client supports additional attributes which
+// are preserved and send back to the server in the
+// request's response.
+func (s *ShowMessageRequestClientCapabilitiesMessageActionItem) GetAdditionalPropertiesSupport() bool {
+	if s == nil {
+		return *new(bool)
 	}
-	return c.Arguments
+	return s.AdditionalPropertiesSupport
 }
 
-// A completion item represents a text snippet that is
-// proposed to complete text that is being typed.
+// Signature help represents the signature of something
+// callable. There can be multiple signature but only one
+// active and only one active parameter.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#completionItem
-type CompletionItem struct {
-	// The label of this completion item.
-	//
-	// The label property is also by default the text that
-	// is inserted when selecting this completion.
-	//
-	// If label details are provided the label itself should
-	// be an unqualified name of the completion item.
-	Label string `json:"label"`
-	// Additional details for the label
-	//
-	// @since 3.17.0
-	LabelDetails *CompletionItemLabelDetails `json:"labelDetails,omitempty"`
-	// The kind of this completion item. Based of the kind
-	// an icon is chosen by the editor.
-	Kind CompletionItemKind `json:"kind,omitempty"`
-	// Tags for this completion item.
-	//
-	// @since 3.15.0
-	Tags []CompletionItemTag `json:"tags,omitempty"`
-	// A human-readable string with additional information
-	// about this item, like type or symbol information.
-	Detail string `json:"detail,omitempty"`
-	// A human-readable string that represents a doc-comment.
-	Documentation *StringOrMarkupContent `json:"documentation,omitempty"`
-	// Indicates if this item is deprecated.
-	// Deprecated: Use `tags` instead.
-	Deprecated bool `json:"deprecated,omitempty"`
-	// Select this item when showing.
-	//
-	// *Note* that only one completion item can be selected and that the
-	// tool / client decides which item that is. The rule is that the *first*
-	// item of those that match best is selected.
-	Preselect bool `json:"preselect,omitempty"`
-	// A string that should be used when comparing this item
-	// with other items. When `falsy` the {@link CompletionItem.label label}
-	// is used.
-	SortText string `json:"sortText,omitempty"`
-	// A string that should be used when filtering a set of
-	// completion items. When `falsy` the {@link CompletionItem.label label}
-	// is used.
-	FilterText string `json:"filterText,omitempty"`
-	// A string that should be inserted into a document when selecting
-	// this completion. When `falsy` the {@link CompletionItem.label label}
-	// is used.
-	//
-	// The `insertText` is subject to interpretation by the client side.
-	// Some tools might not take the string literally. For example
-	// VS Code when code complete is requested in this example
-	// `con<cursor position>` and a completion item with an `insertText` of
-	// `console` is provided it will only insert `sole`. Therefore it is
-	// recommended to use `textEdit` instead since it avoids additional client
-	// side interpretation.
-	InsertText string `json:"insertText,omitempty"`
-	// The format of the insert text. The format applies to both the
-	// `insertText` property and the `newText` property of a provided
-	// `textEdit`. If omitted defaults to `InsertTextFormat.PlainText`.
-	//
-	// Please note that the insertTextFormat doesn't apply to
-	// `additionalTextEdits`.
-	InsertTextFormat InsertTextFormat `json:"insertTextFormat,omitempty"`
-	// How whitespace and indentation is handled during completion
-	// item insertion. If not provided the clients default value depends on
-	// the `textDocument.completion.insertTextMode` client capability.
-	//
-	// @since 3.16.0
-	InsertTextMode InsertTextMode `json:"insertTextMode,omitempty"`
-	// An {@link TextEdit edit} which is applied to a document when selecting
-	// this completion. When an edit is provided the value of
-	// {@link CompletionItem.insertText insertText} is ignored.
-	//
-	// Most editors support two different operations when accepting a completion
-	// item. One is to insert a completion text and the other is to replace an
-	// existing text with a completion text. Since this can usually not be
-	// predetermined by a server it can report both ranges. Clients need to
-	// signal support for `InsertReplaceEdits` via the
-	// `textDocument.completion.insertReplaceSupport` client capability
-	// property.
-	//
-	// *Note 1:* The text edit's range as well as both ranges from an insert
-	// replace edit must be a [single line] and they must contain the position
-	// at which completion has been requested.
-	// *Note 2:* If an `InsertReplaceEdit` is returned the edit's insert range
-	// must be a prefix of the edit's replace range, that means it must be
-	// contained and starting at the same position.
-	//
-	// @since 3.16.0 additional type `InsertReplaceEdit`
-	TextEdit *TextEditOrInsertReplaceEdit `json:"textEdit,omitempty"`
-	// The edit text used if the completion item is part of a CompletionList and
-	// CompletionList defines an item default for the text edit range.
-	//
-	// Clients will only honor this property if they opt into completion list
-	// item defaults using the capability `completionList.itemDefaults`.
-	//
-	// If not provided and a list's default range is provided the label
-	// property is used as a text.
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#signatureHelp
+type SignatureHelp struct {
+	// One or more signatures.
+	Signatures []*SignatureInformation `json:"signatures"`
+	// The active signature. If omitted or the value lies outside the
+	// range of `signatures` the value defaults to zero or is ignored if
+	// the `SignatureHelp` has no signatures.
 	//
-	// @since 3.17.0
-	TextEditText string `json:"textEditText,omitempty"`
-	// An optional array of additional {@link TextEdit text edits} that are applied when
-	// selecting this completion. Edits must not overlap (including the same insert position)
-	// with the main {@link CompletionItem.textEdit edit} nor with themselves.
+	// Whenever possible implementors should make an active decision about
+	// the active signature and shouldn't rely on a default value.
 	//
-	// Additional text edits should be used to change text unrelated to the current cursor position
-	// (for example adding an import statement at the top of the file if the completion item will
-	// insert an unqualified type).
-	AdditionalTextEdits []*TextEdit `json:"additionalTextEdits,omitempty"`
-	// An optional set of characters that when pressed while this completion is active will accept it first and
-	// then type that character. *Note* that all commit characters should have `length=1` and that superfluous
-	// characters will be ignored.
-	CommitCharacters []string `json:"commitCharacters,omitempty"`
-	// An optional {@link Command command} that is executed *after* inserting this completion. *Note* that
-	// additional modifications to the current document should be described with the
-	// {@link CompletionItem.additionalTextEdits additionalTextEdits}-property.
-	Command *Command `json:"command,omitempty"`
-	// A data entry field that is preserved on a completion item between a
-	// {@link CompletionRequest} and a {@link CompletionResolveRequest}.
-	Data LSPAny `json:"data,omitempty"`
+	// In future version of the protocol this property might become
+	// mandatory to better express this.
+	ActiveSignature Optional[int] `json:"activeSignature,omitempty"`
+	// The active parameter of the active signature. If omitted or the value
+	// lies outside the range of `signatures[activeSignature].parameters`
+	// defaults to 0 if the active signature has parameters. If
+	// the active signature has no parameters it is ignored.
+	// In future version of the protocol this property might become
+	// mandatory to better express the active parameter if the
+	// active signature does have any.
+	ActiveParameter Optional[int] `json:"activeParameter,omitempty"`
 }
 
-// The label of this completion item.
+// One or more signatures.
+func (s *SignatureHelp) GetSignatures() []*SignatureInformation {
+	if s == nil {
+		var zero []*SignatureInformation
+		return zero
+	}
+	return s.Signatures
+}
+
+// The active signature. If omitted or the value lies outside the
+// range of `signatures` the value defaults to zero or is ignored if
+// the `SignatureHelp` has no signatures.
 //
-// The label property is also by default the text that
-// is inserted when selecting this completion.
+// Whenever possible implementors should make an active decision about
+// the active signature and shouldn't rely on a default value.
 //
-// If label details are provided the label itself should
-// be an unqualified name of the completion item.
-func (c *CompletionItem) GetLabel() string {
-	if c == nil {
-		var zero string
+// In future version of the protocol this property might become
+// mandatory to better express this.
+func (s *SignatureHelp) GetActiveSignature() Optional[int] {
+	if s == nil {
+		var zero Optional[int]
+		return zero
+	}
+	return s.ActiveSignature
+}
+
+// The active parameter of the active signature. If omitted or the value
+// lies outside the range of `signatures[activeSignature].parameters`
+// defaults to 0 if the active signature has parameters. If
+// the active signature has no parameters it is ignored.
+// In future version of the protocol this property might become
+// mandatory to better express the active parameter if the
+// active signature does have any.
+func (s *SignatureHelp) GetActiveParameter() Optional[int] {
+	if s == nil {
+		var zero Optional[int]
 		return zero
 	}
-	return c.Label
+	return s.ActiveParameter
 }
 
-// Additional details for the label
+// Client Capabilities for a {@link SignatureHelpRequest}.
 //
-// @since 3.17.0
-func (c *CompletionItem) GetLabelDetails() *CompletionItemLabelDetails {
-	if c == nil {
-		var zero *CompletionItemLabelDetails
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#signatureHelpClientCapabilities
+type SignatureHelpClientCapabilities struct {
+	// Whether signature help supports dynamic registration.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	// The client supports the following `SignatureInformation`
+	// specific properties.
+	SignatureInformation *SignatureHelpClientCapabilitiesSignatureInformation `json:"signatureInformation,omitempty"`
+	// The client supports to send additional context information for a
+	// `textDocument/signatureHelp` request. A client that opts into
+	// contextSupport will also support the `retriggerCharacters` on
+	// `SignatureHelpOptions`.
+	//
+	// @since 3.15.0
+	ContextSupport bool `json:"contextSupport,omitempty"`
+}
+
+// Whether signature help supports dynamic registration.
+func (s *SignatureHelpClientCapabilities) GetDynamicRegistration() bool {
+	if s == nil {
+		var zero bool
 		return zero
 	}
-	return c.LabelDetails
+	return s.DynamicRegistration
 }
 
-// The kind of this completion item. Based of the kind
-// an icon is chosen by the editor.
-func (c *CompletionItem) GetKind() CompletionItemKind {
-	if c == nil {
-		var zero CompletionItemKind
+// The client supports the following `SignatureInformation`
+// specific properties.
+func (s *SignatureHelpClientCapabilities) GetSignatureInformation() *SignatureHelpClientCapabilitiesSignatureInformation {
+	if s == nil {
+		var zero *SignatureHelpClientCapabilitiesSignatureInformation
 		return zero
 	}
-	return c.Kind
+	return s.SignatureInformation
 }
 
-// Tags for this completion item.
+// The client supports to send additional context information for a
+// `textDocument/signatureHelp` request. A client that opts into
+// contextSupport will also support the `retriggerCharacters` on
+// `SignatureHelpOptions`.
 //
 // @since 3.15.0
-func (c *CompletionItem) GetTags() []CompletionItemTag {
-	if c == nil {
-		var zero []CompletionItemTag
+func (s *SignatureHelpClientCapabilities) GetContextSupport() bool {
+	if s == nil {
+		var zero bool
 		return zero
 	}
-	return c.Tags
+	return s.ContextSupport
 }
 
-// A human-readable string with additional information
-// about this item, like type or symbol information.
-func (c *CompletionItem) GetDetail() string {
-	if c == nil {
-		var zero string
-		return zero
-	}
-	return c.Detail
+type SignatureHelpClientCapabilitiesSignatureInformation struct {
+	// Client supports the following content formats for the documentation
+	// property. The order describes the preferred format of the client.
+	DocumentationFormat []MarkupKind `json:"documentationFormat,omitempty"`
+	// Client capabilities specific to parameter information.
+	ParameterInformation *SignatureHelpClientCapabilitiesSignatureInformationParameterInformation `json:"parameterInformation,omitempty"`
+	// The client supports the `activeParameter` property on `SignatureInformation`
+	// literal.
+	//
+	// @since 3.16.0
+	ActiveParameterSupport bool `json:"activeParameterSupport,omitempty"`
 }
 
-// A human-readable string that represents a doc-comment.
-func (c *CompletionItem) GetDocumentation() *StringOrMarkupContent {
-	if c == nil {
-		var zero *StringOrMarkupContent
-		return zero
+// Client supports the following content formats for the documentation
+// property. The order describes the preferred format of the client.
+func (s *SignatureHelpClientCapabilitiesSignatureInformation) GetDocumentationFormat() []MarkupKind {
+	if s == nil {
+		return *new([]MarkupKind)
 	}
-	return c.Documentation
+	return s.DocumentationFormat
 }
 
-// Indicates if this item is deprecated.
-// Deprecated: Use `tags` instead.
-func (c *CompletionItem) GetDeprecated() bool {
-	if c == nil {
-		var zero bool
-		return zero
+// Client capabilities specific to parameter information.
+func (s *SignatureHelpClientCapabilitiesSignatureInformation) GetParameterInformation() *SignatureHelpClientCapabilitiesSignatureInformationParameterInformation {
+	if s == nil {
+		return *new(*SignatureHelpClientCapabilitiesSignatureInformationParameterInformation)
 	}
-	return c.Deprecated
+	return s.ParameterInformation
 }
 
-// Select this item when showing.
+// The client supports the `activeParameter` property on `SignatureInformation`
+// literal.
 //
-// *Note* that only one completion item can be selected and that the
-// tool / client decides which item that is. The rule is that the *first*
-// item of those that match best is selected.
-func (c *CompletionItem) GetPreselect() bool {
-	if c == nil {
-		var zero bool
-		return zero
+// @since 3.16.0
+func (s *SignatureHelpClientCapabilitiesSignatureInformation) GetActiveParameterSupport() bool {
+	if s == nil {
+		return *new(bool)
 	}
-	return c.Preselect
+	return s.ActiveParameterSupport
 }
 
-// A string that should be used when comparing this item
-// with other items. When `falsy` the {@link CompletionItem.label label}
-// is used.
-func (c *CompletionItem) GetSortText() string {
-	if c == nil {
-		var zero string
-		return zero
-	}
-	return c.SortText
+type SignatureHelpClientCapabilitiesSignatureInformationParameterInformation struct {
+	// The client supports processing label offsets instead of a
+	// simple label string.
+	//
+	// @since 3.14.0
+	LabelOffsetSupport bool `json:"labelOffsetSupport,omitempty"`
 }
 
-// A string that should be used when filtering a set of
-// completion items. When `falsy` the {@link CompletionItem.label label}
-// is used.
-func (c *CompletionItem) GetFilterText() string {
-	if c == nil {
-		var zero string
-		return zero
+// The client supports processing label offsets instead of a
+// simple label string.
+//
+// @since 3.14.0
+func (s *SignatureHelpClientCapabilitiesSignatureInformationParameterInformation) GetLabelOffsetSupport() bool {
+	if s == nil {
+		return *new(bool)
 	}
-	return c.FilterText
+	return s.LabelOffsetSupport
 }
 
-// A string that should be inserted into a document when selecting
-// this completion. When `falsy` the {@link CompletionItem.label label}
-// is used.
+// Additional information about the context in which a signature help request was triggered.
 //
-// The `insertText` is subject to interpretation by the client side.
-// Some tools might not take the string literally. For example
-// VS Code when code complete is requested in this example
-// `con<cursor position>` and a completion item with an `insertText` of
-// `console` is provided it will only insert `sole`. Therefore it is
-// recommended to use `textEdit` instead since it avoids additional client
-// side interpretation.
-func (c *CompletionItem) GetInsertText() string {
-	if c == nil {
-		var zero string
-		return zero
-	}
-	return c.InsertText
+// @since 3.15.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#signatureHelpContext
+type SignatureHelpContext struct {
+	// Action that caused signature help to be triggered.
+	TriggerKind SignatureHelpTriggerKind `json:"triggerKind"`
+	// Character that caused signature help to be triggered.
+	//
+	// This is undefined when `triggerKind !== SignatureHelpTriggerKind.TriggerCharacter`
+	TriggerCharacter string `json:"triggerCharacter,omitempty"`
+	// `true` if signature help was already showing when it was triggered.
+	//
+	// Retriggers occurs when the signature help is already active and can be caused by actions such as
+	// typing a trigger character, a cursor move, or document content changes.
+	IsRetrigger bool `json:"isRetrigger"`
+	// The currently active `SignatureHelp`.
+	//
+	// The `activeSignatureHelp` has its `SignatureHelp.activeSignature` field updated based on
+	// the user navigating through available signatures.
+	ActiveSignatureHelp *SignatureHelp `json:"activeSignatureHelp,omitempty"`
 }
 
-// The format of the insert text. The format applies to both the
-// `insertText` property and the `newText` property of a provided
-// `textEdit`. If omitted defaults to `InsertTextFormat.PlainText`.
-//
-// Please note that the insertTextFormat doesn't apply to
-// `additionalTextEdits`.
-func (c *CompletionItem) GetInsertTextFormat() InsertTextFormat {
-	if c == nil {
-		var zero InsertTextFormat
+// Action that caused signature help to be triggered.
+func (s *SignatureHelpContext) GetTriggerKind() SignatureHelpTriggerKind {
+	if s == nil {
+		var zero SignatureHelpTriggerKind
 		return zero
 	}
-	return c.InsertTextFormat
+	return s.TriggerKind
 }
 
-// How whitespace and indentation is handled during completion
-// item insertion. If not provided the clients default value depends on
-// the `textDocument.completion.insertTextMode` client capability.
+// Character that caused signature help to be triggered.
 //
-// @since 3.16.0
-func (c *CompletionItem) GetInsertTextMode() InsertTextMode {
-	if c == nil {
-		var zero InsertTextMode
+// This is undefined when `triggerKind !== SignatureHelpTriggerKind.TriggerCharacter`
+func (s *SignatureHelpContext) GetTriggerCharacter() string {
+	if s == nil {
+		var zero string
 		return zero
 	}
-	return c.InsertTextMode
+	return s.TriggerCharacter
 }
 
-// An {@link TextEdit edit} which is applied to a document when selecting
-// this completion. When an edit is provided the value of
-// {@link CompletionItem.insertText insertText} is ignored.
-//
-// Most editors support two different operations when accepting a completion
-// item. One is to insert a completion text and the other is to replace an
-// existing text with a completion text. Since this can usually not be
-// predetermined by a server it can report both ranges. Clients need to
-// signal support for `InsertReplaceEdits` via the
-// `textDocument.completion.insertReplaceSupport` client capability
-// property.
-//
-// *Note 1:* The text edit's range as well as both ranges from an insert
-// replace edit must be a [single line] and they must contain the position
-// at which completion has been requested.
-// *Note 2:* If an `InsertReplaceEdit` is returned the edit's insert range
-// must be a prefix of the edit's replace range, that means it must be
-// contained and starting at the same position.
+// `true` if signature help was already showing when it was triggered.
 //
-// @since 3.16.0 additional type `InsertReplaceEdit`
-func (c *CompletionItem) GetTextEdit() *TextEditOrInsertReplaceEdit {
-	if c == nil {
-		var zero *TextEditOrInsertReplaceEdit
+// Retriggers occurs when the signature help is already active and can be caused by actions such as
+// typing a trigger character, a cursor move, or document content changes.
+func (s *SignatureHelpContext) GetIsRetrigger() bool {
+	if s == nil {
+		var zero bool
 		return zero
 	}
-	return c.TextEdit
+	return s.IsRetrigger
 }
 
-// The edit text used if the completion item is part of a CompletionList and
-// CompletionList defines an item default for the text edit range.
-//
-// Clients will only honor this property if they opt into completion list
-// item defaults using the capability `completionList.itemDefaults`.
-//
-// If not provided and a list's default range is provided the label
-// property is used as a text.
+// The currently active `SignatureHelp`.
 //
-// @since 3.17.0
-func (c *CompletionItem) GetTextEditText() string {
-	if c == nil {
-		var zero string
+// The `activeSignatureHelp` has its `SignatureHelp.activeSignature` field updated based on
+// the user navigating through available signatures.
+func (s *SignatureHelpContext) GetActiveSignatureHelp() *SignatureHelp {
+	if s == nil {
+		var zero *SignatureHelp
 		return zero
 	}
-	return c.TextEditText
+	return s.ActiveSignatureHelp
 }
 
-// An optional array of additional {@link TextEdit text edits} that are applied when
-// selecting this completion. Edits must not overlap (including the same insert position)
-// with the main {@link CompletionItem.textEdit edit} nor with themselves.
+// Server Capabilities for a {@link SignatureHelpRequest}.
 //
-// Additional text edits should be used to change text unrelated to the current cursor position
-// (for example adding an import statement at the top of the file if the completion item will
-// insert an unqualified type).
-func (c *CompletionItem) GetAdditionalTextEdits() []*TextEdit {
-	if c == nil {
-		var zero []*TextEdit
-		return zero
-	}
-	return c.AdditionalTextEdits
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#signatureHelpOptions
+type SignatureHelpOptions struct {
+	*WorkDoneProgressOptions
+	// List of characters that trigger signature help automatically.
+	TriggerCharacters []string `json:"triggerCharacters,omitempty"`
+	// List of characters that re-trigger signature help.
+	//
+	// These trigger characters are only active when signature help is already showing. All trigger characters
+	// are also counted as re-trigger characters.
+	//
+	// @since 3.15.0
+	RetriggerCharacters []string `json:"retriggerCharacters,omitempty"`
 }
 
-// An optional set of characters that when pressed while this completion is active will accept it first and
-// then type that character. *Note* that all commit characters should have `length=1` and that superfluous
-// characters will be ignored.
-func (c *CompletionItem) GetCommitCharacters() []string {
-	if c == nil {
+// List of characters that trigger signature help automatically.
+func (s *SignatureHelpOptions) GetTriggerCharacters() []string {
+	if s == nil {
 		var zero []string
 		return zero
 	}
-	return c.CommitCharacters
+	return s.TriggerCharacters
 }
 
-// An optional {@link Command command} that is executed *after* inserting this completion. *Note* that
-// additional modifications to the current document should be described with the
-// {@link CompletionItem.additionalTextEdits additionalTextEdits}-property.
-func (c *CompletionItem) GetCommand() *Command {
-	if c == nil {
-		var zero *Command
+// List of characters that re-trigger signature help.
+//
+// These trigger characters are only active when signature help is already showing. All trigger characters
+// are also counted as re-trigger characters.
+//
+// @since 3.15.0
+func (s *SignatureHelpOptions) GetRetriggerCharacters() []string {
+	if s == nil {
+		var zero []string
 		return zero
 	}
-	return c.Command
+	return s.RetriggerCharacters
 }
 
-// A data entry field that is preserved on a completion item between a
-// {@link CompletionRequest} and a {@link CompletionResolveRequest}.
-func (c *CompletionItem) GetData() LSPAny {
-	if c == nil {
-		var zero LSPAny
+// Parameters for a {@link SignatureHelpRequest}.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#signatureHelpParams
+type SignatureHelpParams struct {
+	*TextDocumentPositionParams
+	*WorkDoneProgressParams
+	// The signature help context. This is only available if the client specifies
+	// to send this using the client capability `textDocument.signatureHelp.contextSupport === true`
+	//
+	// @since 3.15.0
+	Context *SignatureHelpContext `json:"context,omitempty"`
+}
+
+// The signature help context. This is only available if the client specifies
+// to send this using the client capability `textDocument.signatureHelp.contextSupport === true`
+//
+// @since 3.15.0
+func (s *SignatureHelpParams) GetContext() *SignatureHelpContext {
+	if s == nil {
+		var zero *SignatureHelpContext
 		return zero
 	}
-	return c.Data
+	return s.Context
 }
 
-type CompletionItemSlice []*CompletionItem
+// How a signature help was triggered.
+//
+// @since 3.15.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#signatureHelpTriggerKind
+type SignatureHelpTriggerKind uint32
 
-type InsertReplaceRange struct {
-	Insert  *Range `json:"insert"`
-	Replace *Range `json:"replace"`
+const (
+	// Signature help was invoked manually by the user or by a command.
+	SignatureHelpTriggerKindInvoked SignatureHelpTriggerKind = 1
+	// Signature help was triggered by a trigger character.
+	SignatureHelpTriggerKindTriggerCharacter SignatureHelpTriggerKind = 2
+	// Signature help was triggered by the cursor moving or by the document content changing.
+	SignatureHelpTriggerKindContentChange SignatureHelpTriggerKind = 3
+)
+
+var validSignatureHelpTriggerKindValues = map[uint32]bool{
+	1: true,
+	2: true,
+	3: true,
 }
 
-func (i *InsertReplaceRange) GetInsert() *Range {
-	if i == nil {
-		return *new(*Range)
+func (s *SignatureHelpTriggerKind) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
 	}
-	return i.Insert
+	var uint32Value uint32
+	if err := json.Unmarshal(data, &uint32Value); err != nil {
+		return err
+	}
+	if !validSignatureHelpTriggerKindValues[uint32Value] {
+		return fmt.Errorf("cannot unmarshal %v into SignatureHelpTriggerKind: custom values are not supported", uint32Value)
+	}
+	*s = SignatureHelpTriggerKind(uint32Value)
+
+	return nil
 }
 
-func (i *InsertReplaceRange) GetReplace() *Range {
-	if i == nil {
-		return *new(*Range)
+func (s SignatureHelpTriggerKind) MarshalJSON() ([]byte, error) {
+	var uint32Value = uint32(s)
+	if !validSignatureHelpTriggerKindValues[uint32Value] {
+		return nil, fmt.Errorf("cannot marshal %v into SignatureHelpTriggerKind: custom values are not supported", uint32Value)
 	}
-	return i.Replace
-}
+	return json.Marshal(uint32Value)
 
-// RangeOrInsertReplaceRange contains either of the following types:
-//   - [*Range]
-//   - [*InsertReplaceRange]
-type RangeOrInsertReplaceRange struct {
-	Value RangeOrInsertReplaceRangeValue
 }
 
-// RangeOrInsertReplaceRangeValue is either of the following types:
-//   - [*Range]
-//   - [*InsertReplaceRange]
+// Represents the signature of something callable. A signature
+// can have a label, like a function-name, a doc-comment, and
+// a set of parameters.
 //
-//sumtype:decl
-type RangeOrInsertReplaceRangeValue interface {
-	isRangeOrInsertReplaceRangeValue()
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#signatureInformation
+type SignatureInformation struct {
+	// The label of this signature. Will be shown in
+	// the UI.
+	Label string `json:"label"`
+	// The human-readable doc-comment of this signature. Will be shown
+	// in the UI but can be omitted.
+	Documentation *StringOrMarkupContent `json:"documentation,omitempty"`
+	// The parameters of this signature.
+	Parameters []*ParameterInformation `json:"parameters,omitempty"`
+	// The index of the active parameter.
+	//
+	// If provided, this is used in place of `SignatureHelp.activeParameter`.
+	//
+	// @since 3.16.0
+	ActiveParameter Optional[int] `json:"activeParameter,omitempty"`
 }
 
-func (*Range) isRangeOrInsertReplaceRangeValue()              {}
-func (*InsertReplaceRange) isRangeOrInsertReplaceRangeValue() {}
-
-func (r *RangeOrInsertReplaceRange) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var rangeValue *Range
-	if err := json.Unmarshal(data, &rangeValue); err == nil {
-		r.Value = rangeValue
-		return nil
-	}
-	var insertReplaceRangeValue *InsertReplaceRange
-	if err := json.Unmarshal(data, &insertReplaceRangeValue); err == nil {
-		r.Value = insertReplaceRangeValue
-		return nil
+// The label of this signature. Will be shown in
+// the UI.
+func (s *SignatureInformation) GetLabel() string {
+	if s == nil {
+		var zero string
+		return zero
 	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*RangeOrInsertReplaceRange](),
+	return s.Label
+}
+
+// The human-readable doc-comment of this signature. Will be shown
+// in the UI but can be omitted.
+func (s *SignatureInformation) GetDocumentation() *StringOrMarkupContent {
+	if s == nil {
+		var zero *StringOrMarkupContent
+		return zero
 	}
+	return s.Documentation
 }
 
-func (r *RangeOrInsertReplaceRange) MarshalJSON() ([]byte, error) {
-	return json.Marshal(r.Value)
+// The parameters of this signature.
+func (s *SignatureInformation) GetParameters() []*ParameterInformation {
+	if s == nil {
+		var zero []*ParameterInformation
+		return zero
+	}
+	return s.Parameters
 }
 
-type CompletionListItemDefaults struct {
-	// A default commit character set.
-	//
-	// @since 3.17.0
-	CommitCharacters []string `json:"commitCharacters,omitempty"`
-	// A default edit range.
-	//
-	// @since 3.17.0
-	EditRange *RangeOrInsertReplaceRange `json:"editRange,omitempty"`
-	// A default insert text format.
-	//
-	// @since 3.17.0
-	InsertTextFormat InsertTextFormat `json:"insertTextFormat,omitempty"`
-	// A default insert text mode.
-	//
-	// @since 3.17.0
-	InsertTextMode InsertTextMode `json:"insertTextMode,omitempty"`
-	// A default data value.
-	//
-	// @since 3.17.0
-	Data LSPAny `json:"data,omitempty"`
+// The index of the active parameter.
+//
+// If provided, this is used in place of `SignatureHelp.activeParameter`.
+//
+// @since 3.16.0
+func (s *SignatureInformation) GetActiveParameter() Optional[int] {
+	if s == nil {
+		var zero Optional[int]
+		return zero
+	}
+	return s.ActiveParameter
 }
 
-// A default commit character set.
+// Static registration options to be returned in the initialize
+// request.
 //
-// @since 3.17.0
-func (c *CompletionListItemDefaults) GetCommitCharacters() []string {
-	if c == nil {
-		return *new([]string)
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#staticRegistrationOptions
+type StaticRegistrationOptions struct {
+	// The id used to register the request. The id can be used to deregister
+	// the request again. See also Registration#id.
+	Id string `json:"id,omitempty"`
+}
+
+// The id used to register the request. The id can be used to deregister
+// the request again. See also Registration#id.
+func (s *StaticRegistrationOptions) GetId() string {
+	if s == nil {
+		var zero string
+		return zero
 	}
-	return c.CommitCharacters
+	return s.Id
 }
 
-// A default edit range.
-//
-// @since 3.17.0
-func (c *CompletionListItemDefaults) GetEditRange() *RangeOrInsertReplaceRange {
-	if c == nil {
-		return *new(*RangeOrInsertReplaceRange)
-	}
-	return c.EditRange
+type String string
+
+// StringOrBoolean contains either of the following types:
+//   - [String]
+//   - [Boolean]
+type StringOrBoolean struct {
+	Value StringOrBooleanValue
 }
 
-// A default insert text format.
+// StringOrBooleanValue is either of the following types:
+//   - [String]
+//   - [Boolean]
 //
-// @since 3.17.0
-func (c *CompletionListItemDefaults) GetInsertTextFormat() InsertTextFormat {
-	if c == nil {
-		return *new(InsertTextFormat)
-	}
-	return c.InsertTextFormat
+//sumtype:decl
+type StringOrBooleanValue interface {
+	isStringOrBooleanValue()
 }
 
-// A default insert text mode.
-//
-// @since 3.17.0
-func (c *CompletionListItemDefaults) GetInsertTextMode() InsertTextMode {
-	if c == nil {
-		return *new(InsertTextMode)
+func (String) isStringOrBooleanValue()  {}
+func (Boolean) isStringOrBooleanValue() {}
+
+func (s *StringOrBoolean) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var stringValue String
+	if err := json.Unmarshal(data, &stringValue); err == nil {
+		s.Value = stringValue
+		return nil
+	}
+	var booleanValue Boolean
+	if err := json.Unmarshal(data, &booleanValue); err == nil {
+		s.Value = booleanValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*StringOrBoolean](),
 	}
-	return c.InsertTextMode
 }
 
-// A default data value.
-//
-// @since 3.17.0
-func (c *CompletionListItemDefaults) GetData() LSPAny {
-	if c == nil {
-		return *new(LSPAny)
-	}
-	return c.Data
+func (s *StringOrBoolean) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Value)
 }
 
-// Represents a collection of {@link CompletionItem completion items} to be presented
-// in the editor.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#completionList
-type CompletionList struct {
-	// This list it not complete. Further typing results in recomputing this list.
-	//
-	// Recomputed lists have all their items replaced (not appended) in the
-	// incomplete completion sessions.
-	IsIncomplete bool `json:"isIncomplete"`
-	// In many cases the items of an actual completion result share the same
-	// value for properties like `commitCharacters` or the range of a text
-	// edit. A completion list can therefore define item defaults which will
-	// be used if a completion item itself doesn't specify the value.
-	//
-	// If a completion list specifies a default value and a completion item
-	// also specifies a corresponding value the one from the item is used.
-	//
-	// Servers are only allowed to return default values if the client
-	// signals support for this via the `completionList.itemDefaults`
-	// capability.
-	//
-	// @since 3.17.0
-	ItemDefaults *CompletionListItemDefaults `json:"itemDefaults,omitempty"`
-	// The completion items.
-	Items []*CompletionItem `json:"items"`
+// StringOrMarkedStringOr2 contains either of the following types:
+//   - [String]
+//   - [*MarkedStringOr2]
+type StringOrMarkedStringOr2 struct {
+	Value StringOrMarkedStringOr2Value
 }
 
-// This list it not complete. Further typing results in recomputing this list.
+// StringOrMarkedStringOr2Value is either of the following types:
+//   - [String]
+//   - [*MarkedStringOr2]
 //
-// Recomputed lists have all their items replaced (not appended) in the
-// incomplete completion sessions.
-func (c *CompletionList) GetIsIncomplete() bool {
-	if c == nil {
-		var zero bool
-		return zero
-	}
-	return c.IsIncomplete
+//sumtype:decl
+type StringOrMarkedStringOr2Value interface {
+	isStringOrMarkedStringOr2Value()
 }
 
-// In many cases the items of an actual completion result share the same
-// value for properties like `commitCharacters` or the range of a text
-// edit. A completion list can therefore define item defaults which will
-// be used if a completion item itself doesn't specify the value.
-//
-// If a completion list specifies a default value and a completion item
-// also specifies a corresponding value the one from the item is used.
-//
-// Servers are only allowed to return default values if the client
-// signals support for this via the `completionList.itemDefaults`
-// capability.
-//
-// @since 3.17.0
-func (c *CompletionList) GetItemDefaults() *CompletionListItemDefaults {
-	if c == nil {
-		var zero *CompletionListItemDefaults
-		return zero
+func (String) isStringOrMarkedStringOr2Value()           {}
+func (*MarkedStringOr2) isStringOrMarkedStringOr2Value() {}
+
+func (s *StringOrMarkedStringOr2) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var stringValue String
+	if err := json.Unmarshal(data, &stringValue); err == nil {
+		s.Value = stringValue
+		return nil
+	}
+	var markedStringOr2Value *MarkedStringOr2
+	if err := json.Unmarshal(data, &markedStringOr2Value); err == nil {
+		s.Value = markedStringOr2Value
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*StringOrMarkedStringOr2](),
 	}
-	return c.ItemDefaults
 }
 
-// The completion items.
-func (c *CompletionList) GetItems() []*CompletionItem {
-	if c == nil {
-		var zero []*CompletionItem
-		return zero
-	}
-	return c.Items
+func (s *StringOrMarkedStringOr2) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Value)
 }
 
-// CompletionItemSliceOrCompletionList contains either of the following types:
-//   - [CompletionItemSlice]
-//   - [*CompletionList]
-type CompletionItemSliceOrCompletionList struct {
-	Value CompletionItemSliceOrCompletionListValue
+// StringOrMarkupContent contains either of the following types:
+//   - [String]
+//   - [*MarkupContent]
+type StringOrMarkupContent struct {
+	Value StringOrMarkupContentValue
 }
 
-// CompletionItemSliceOrCompletionListValue is either of the following types:
-//   - [CompletionItemSlice]
-//   - [*CompletionList]
+// StringOrMarkupContentValue is either of the following types:
+//   - [String]
+//   - [*MarkupContent]
 //
 //sumtype:decl
-type CompletionItemSliceOrCompletionListValue interface {
-	isCompletionItemSliceOrCompletionListValue()
+type StringOrMarkupContentValue interface {
+	isStringOrMarkupContentValue()
 }
 
-func (CompletionItemSlice) isCompletionItemSliceOrCompletionListValue() {}
-func (*CompletionList) isCompletionItemSliceOrCompletionListValue()     {}
+func (String) isStringOrMarkupContentValue()         {}
+func (*MarkupContent) isStringOrMarkupContentValue() {}
 
-func (c *CompletionItemSliceOrCompletionList) UnmarshalJSON(data []byte) error {
+func (s *StringOrMarkupContent) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var completionItemSliceValue CompletionItemSlice
-	if err := json.Unmarshal(data, &completionItemSliceValue); err == nil {
-		c.Value = completionItemSliceValue
+	var stringValue String
+	if err := json.Unmarshal(data, &stringValue); err == nil {
+		s.Value = stringValue
 		return nil
 	}
-	var completionListValue *CompletionList
-	if err := json.Unmarshal(data, &completionListValue); err == nil {
-		c.Value = completionListValue
+	var markupContentValue *MarkupContent
+	if err := json.Unmarshal(data, &markupContentValue); err == nil {
+		s.Value = markupContentValue
 		return nil
 	}
 	return &json.UnmarshalTypeError{
 		Value: string(data),
-		Type:  reflect.TypeFor[*CompletionItemSliceOrCompletionList](),
+		Type:  reflect.TypeFor[*StringOrMarkupContent](),
 	}
 }
 
-func (c *CompletionItemSliceOrCompletionList) MarshalJSON() ([]byte, error) {
-	return json.Marshal(c.Value)
+func (s *StringOrMarkupContent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Value)
 }
 
-// Parameters for a {@link HoverRequest}.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#hoverParams
-type HoverParams struct {
-	*TextDocumentPositionParams
-	*WorkDoneProgressParams
+// StringOrNotebookDocumentFilter contains either of the following types:
+//   - [String]
+//   - [NotebookDocumentFilter]
+type StringOrNotebookDocumentFilter struct {
+	Value StringOrNotebookDocumentFilterValue
 }
 
-type MarkedStringOr2 struct {
-	Language string `json:"language"`
-	Value    string `json:"value"`
+// StringOrNotebookDocumentFilterValue is either of the following types:
+//   - [String]
+//   - [NotebookDocumentFilter]
+//
+//sumtype:decl
+type StringOrNotebookDocumentFilterValue interface {
+	isStringOrNotebookDocumentFilterValue()
 }
 
-func (m *MarkedStringOr2) GetLanguage() string {
-	if m == nil {
-		return *new(string)
-	}
-	return m.Language
-}
+func (String) isStringOrNotebookDocumentFilterValue()                 {}
+func (NotebookDocumentFilter) isStringOrNotebookDocumentFilterValue() {}
 
-func (m *MarkedStringOr2) GetValue() string {
-	if m == nil {
-		return *new(string)
+func (s *StringOrNotebookDocumentFilter) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var stringValue String
+	if err := json.Unmarshal(data, &stringValue); err == nil {
+		s.Value = stringValue
+		return nil
+	}
+	var notebookDocumentFilterValue NotebookDocumentFilter
+	if err := json.Unmarshal(data, &notebookDocumentFilterValue); err == nil {
+		s.Value = notebookDocumentFilterValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*StringOrNotebookDocumentFilter](),
 	}
-	return m.Value
 }
 
-// StringOrMarkedStringOr2 contains either of the following types:
+func (s *StringOrNotebookDocumentFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Value)
+}
+
+// StringOrParameterInformationLabelRange contains either of the following types:
 //   - [String]
-//   - [*MarkedStringOr2]
-type StringOrMarkedStringOr2 struct {
-	Value StringOrMarkedStringOr2Value
+//   - [*ParameterInformationLabelRange]
+type StringOrParameterInformationLabelRange struct {
+	Value StringOrParameterInformationLabelRangeValue
 }
 
-// StringOrMarkedStringOr2Value is either of the following types:
+// StringOrParameterInformationLabelRangeValue is either of the following types:
 //   - [String]
-//   - [*MarkedStringOr2]
+//   - [*ParameterInformationLabelRange]
 //
 //sumtype:decl
-type StringOrMarkedStringOr2Value interface {
-	isStringOrMarkedStringOr2Value()
+type StringOrParameterInformationLabelRangeValue interface {
+	isStringOrParameterInformationLabelRangeValue()
 }
 
-func (String) isStringOrMarkedStringOr2Value()           {}
-func (*MarkedStringOr2) isStringOrMarkedStringOr2Value() {}
+func (String) isStringOrParameterInformationLabelRangeValue()                          {}
+func (*ParameterInformationLabelRange) isStringOrParameterInformationLabelRangeValue() {}
 
-func (s *StringOrMarkedStringOr2) UnmarshalJSON(data []byte) error {
+func (s *StringOrParameterInformationLabelRange) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
@@ -9950,143 +10735,221 @@ func (s *StringOrMarkedStringOr2) UnmarshalJSON(data []byte) error {
 		s.Value = stringValue
 		return nil
 	}
-	var markedStringOr2Value *MarkedStringOr2
-	if err := json.Unmarshal(data, &markedStringOr2Value); err == nil {
-		s.Value = markedStringOr2Value
+	var parameterInformationLabelRangeValue *ParameterInformationLabelRange
+	if err := json.Unmarshal(data, &parameterInformationLabelRangeValue); err == nil {
+		s.Value = parameterInformationLabelRangeValue
 		return nil
 	}
 	return &json.UnmarshalTypeError{
 		Value: string(data),
-		Type:  reflect.TypeFor[*StringOrMarkedStringOr2](),
+		Type:  reflect.TypeFor[*StringOrParameterInformationLabelRange](),
 	}
 }
 
-func (s *StringOrMarkedStringOr2) MarshalJSON() ([]byte, error) {
+func (s *StringOrParameterInformationLabelRange) MarshalJSON() ([]byte, error) {
 	return json.Marshal(s.Value)
 }
 
-// MarkedString can be used to render human readable text. It is either a markdown string
-// or a code-block that provides a language and a code snippet. The language identifier
-// is semantically equal to the optional language identifier in fenced code blocks in GitHub
-// issues. See https://help.github.com/articles/creating-and-highlighting-code-blocks/#syntax-highlighting
+// Represents information about programming constructs like variables, classes,
+// interfaces etc.
 //
-// The pair of a language and a value is an equivalent to markdown:
-// ```${language}
-// ${value}
-// ```
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#symbolInformation
+type SymbolInformation struct {
+	*BaseSymbolInformation
+	// Indicates if this symbol is deprecated.
+	//
+	// Deprecated: Use tags instead
+	Deprecated bool `json:"deprecated,omitempty"`
+	// The location of this symbol. The location's range is used by a tool
+	// to reveal the location in the editor. If the symbol is selected in the
+	// tool the range's start information is used to position the cursor. So
+	// the range usually spans more than the actual symbol's name and does
+	// normally include things like visibility modifiers.
+	//
+	// The range doesn't have to denote a node range in the sense of an abstract
+	// syntax tree. It can therefore not be used to re-construct a hierarchy of
+	// the symbols.
+	Location *Location `json:"location"`
+}
+
+// Indicates if this symbol is deprecated.
 //
-// Note that markdown strings will be sanitized - that means html will be escaped.
-// Deprecated: use MarkupContent instead.
+// Deprecated: Use tags instead
+func (s *SymbolInformation) GetDeprecated() bool {
+	if s == nil {
+		var zero bool
+		return zero
+	}
+	return s.Deprecated
+}
+
+// The location of this symbol. The location's range is used by a tool
+// to reveal the location in the editor. If the symbol is selected in the
+// tool the range's start information is used to position the cursor. So
+// the range usually spans more than the actual symbol's name and does
+// normally include things like visibility modifiers.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#markedString
-type MarkedString = *StringOrMarkedStringOr2
+// The range doesn't have to denote a node range in the sense of an abstract
+// syntax tree. It can therefore not be used to re-construct a hierarchy of
+// the symbols.
+func (s *SymbolInformation) GetLocation() *Location {
+	if s == nil {
+		var zero *Location
+		return zero
+	}
+	return s.Location
+}
 
-type MarkedStringSlice []MarkedString
+type SymbolInformationSlice []*SymbolInformation
 
-// MarkupContentOrMarkedStringOrMarkedStringSlice contains either of the following types:
-//   - [*MarkupContent]
-//   - [MarkedString]
-//   - [MarkedStringSlice]
-type MarkupContentOrMarkedStringOrMarkedStringSlice struct {
-	Value MarkupContentOrMarkedStringOrMarkedStringSliceValue
+// SymbolInformationSliceOrDocumentSymbolSlice contains either of the following types:
+//   - [SymbolInformationSlice]
+//   - [DocumentSymbolSlice]
+type SymbolInformationSliceOrDocumentSymbolSlice struct {
+	Value SymbolInformationSliceOrDocumentSymbolSliceValue
 }
 
-// MarkupContentOrMarkedStringOrMarkedStringSliceValue is either of the following types:
-//   - [*MarkupContent]
-//   - [MarkedString]
-//   - [MarkedStringSlice]
+// SymbolInformationSliceOrDocumentSymbolSliceValue is either of the following types:
+//   - [SymbolInformationSlice]
+//   - [DocumentSymbolSlice]
 //
 //sumtype:decl
-type MarkupContentOrMarkedStringOrMarkedStringSliceValue interface {
-	isMarkupContentOrMarkedStringOrMarkedStringSliceValue()
+type SymbolInformationSliceOrDocumentSymbolSliceValue interface {
+	isSymbolInformationSliceOrDocumentSymbolSliceValue()
 }
 
-func (*MarkupContent) isMarkupContentOrMarkedStringOrMarkedStringSliceValue()    {}
-func (MarkedString) isMarkupContentOrMarkedStringOrMarkedStringSliceValue()      {}
-func (MarkedStringSlice) isMarkupContentOrMarkedStringOrMarkedStringSliceValue() {}
+func (SymbolInformationSlice) isSymbolInformationSliceOrDocumentSymbolSliceValue() {}
+func (DocumentSymbolSlice) isSymbolInformationSliceOrDocumentSymbolSliceValue()    {}
 
-func (m *MarkupContentOrMarkedStringOrMarkedStringSlice) UnmarshalJSON(data []byte) error {
+func (s *SymbolInformationSliceOrDocumentSymbolSlice) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var markupContentValue *MarkupContent
-	if err := json.Unmarshal(data, &markupContentValue); err == nil {
-		m.Value = markupContentValue
-		return nil
-	}
-	var markedStringValue MarkedString
-	if err := json.Unmarshal(data, &markedStringValue); err == nil {
-		m.Value = markedStringValue
+	var symbolInformationSliceValue SymbolInformationSlice
+	if err := json.Unmarshal(data, &symbolInformationSliceValue); err == nil {
+		s.Value = symbolInformationSliceValue
 		return nil
 	}
-	var markedStringSliceValue MarkedStringSlice
-	if err := json.Unmarshal(data, &markedStringSliceValue); err == nil {
-		m.Value = markedStringSliceValue
+	var documentSymbolSliceValue DocumentSymbolSlice
+	if err := json.Unmarshal(data, &documentSymbolSliceValue); err == nil {
+		s.Value = documentSymbolSliceValue
 		return nil
 	}
 	return &json.UnmarshalTypeError{
 		Value: string(data),
-		Type:  reflect.TypeFor[*MarkupContentOrMarkedStringOrMarkedStringSlice](),
+		Type:  reflect.TypeFor[*SymbolInformationSliceOrDocumentSymbolSlice](),
 	}
 }
 
-func (m *MarkupContentOrMarkedStringOrMarkedStringSlice) MarshalJSON() ([]byte, error) {
-	return json.Marshal(m.Value)
+func (s *SymbolInformationSliceOrDocumentSymbolSlice) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Value)
 }
 
-// The result of a hover request.
+// A symbol kind.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#hover
-type Hover struct {
-	// The hover's content
-	Contents *MarkupContentOrMarkedStringOrMarkedStringSlice `json:"contents"`
-	// An optional range inside the text document that is used to
-	// visualize the hover, e.g. by changing the background color.
-	Range *Range `json:"range,omitempty"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#symbolKind
+type SymbolKind uint32
+
+const (
+	SymbolKindFile          SymbolKind = 1
+	SymbolKindModule        SymbolKind = 2
+	SymbolKindNamespace     SymbolKind = 3
+	SymbolKindPackage       SymbolKind = 4
+	SymbolKindClass         SymbolKind = 5
+	SymbolKindMethod        SymbolKind = 6
+	SymbolKindProperty      SymbolKind = 7
+	SymbolKindField         SymbolKind = 8
+	SymbolKindConstructor   SymbolKind = 9
+	SymbolKindEnum          SymbolKind = 10
+	SymbolKindInterface     SymbolKind = 11
+	SymbolKindFunction      SymbolKind = 12
+	SymbolKindVariable      SymbolKind = 13
+	SymbolKindConstant      SymbolKind = 14
+	SymbolKindString        SymbolKind = 15
+	SymbolKindNumber        SymbolKind = 16
+	SymbolKindBoolean       SymbolKind = 17
+	SymbolKindArray         SymbolKind = 18
+	SymbolKindObject        SymbolKind = 19
+	SymbolKindKey           SymbolKind = 20
+	SymbolKindNull          SymbolKind = 21
+	SymbolKindEnumMember    SymbolKind = 22
+	SymbolKindStruct        SymbolKind = 23
+	SymbolKindEvent         SymbolKind = 24
+	SymbolKindOperator      SymbolKind = 25
+	SymbolKindTypeParameter SymbolKind = 26
+)
+
+var validSymbolKindValues = map[uint32]bool{
+	1:  true,
+	2:  true,
+	3:  true,
+	4:  true,
+	5:  true,
+	6:  true,
+	7:  true,
+	8:  true,
+	9:  true,
+	10: true,
+	11: true,
+	12: true,
+	13: true,
+	14: true,
+	15: true,
+	16: true,
+	17: true,
+	18: true,
+	19: true,
+	20: true,
+	21: true,
+	22: true,
+	23: true,
+	24: true,
+	25: true,
+	26: true,
 }
 
-// The hover's content
-func (h *Hover) GetContents() *MarkupContentOrMarkedStringOrMarkedStringSlice {
-	if h == nil {
-		var zero *MarkupContentOrMarkedStringOrMarkedStringSlice
-		return zero
+func (s *SymbolKind) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
 	}
-	return h.Contents
+	var uint32Value uint32
+	if err := json.Unmarshal(data, &uint32Value); err != nil {
+		return err
+	}
+	if !validSymbolKindValues[uint32Value] {
+		return fmt.Errorf("cannot unmarshal %v into SymbolKind: custom values are not supported", uint32Value)
+	}
+	*s = SymbolKind(uint32Value)
+
+	return nil
 }
 
-// An optional range inside the text document that is used to
-// visualize the hover, e.g. by changing the background color.
-func (h *Hover) GetRange() *Range {
-	if h == nil {
-		var zero *Range
-		return zero
+func (s SymbolKind) MarshalJSON() ([]byte, error) {
+	var uint32Value = uint32(s)
+	if !validSymbolKindValues[uint32Value] {
+		return nil, fmt.Errorf("cannot marshal %v into SymbolKind: custom values are not supported", uint32Value)
 	}
-	return h.Range
+	return json.Marshal(uint32Value)
+
 }
 
-// How a signature help was triggered.
+// Symbol tags are extra annotations that tweak the rendering of a symbol.
 //
-// @since 3.15.0
+// @since 3.16
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#signatureHelpTriggerKind
-type SignatureHelpTriggerKind uint32
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#symbolTag
+type SymbolTag uint32
 
 const (
-	// Signature help was invoked manually by the user or by a command.
-	SignatureHelpTriggerKindInvoked SignatureHelpTriggerKind = 1
-	// Signature help was triggered by a trigger character.
-	SignatureHelpTriggerKindTriggerCharacter SignatureHelpTriggerKind = 2
-	// Signature help was triggered by the cursor moving or by the document content changing.
-	SignatureHelpTriggerKindContentChange SignatureHelpTriggerKind = 3
+	// Render a symbol as obsolete, usually using a strike-out.
+	SymbolTagDeprecated SymbolTag = 1
 )
 
-var validSignatureHelpTriggerKindValues = map[uint32]bool{
+var validSymbolTagValues = map[uint32]bool{
 	1: true,
-	2: true,
-	3: true,
 }
 
-func (s *SignatureHelpTriggerKind) UnmarshalJSON(data []byte) error {
+func (s *SymbolTag) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
@@ -10094,1108 +10957,1113 @@ func (s *SignatureHelpTriggerKind) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &uint32Value); err != nil {
 		return err
 	}
-	if !validSignatureHelpTriggerKindValues[uint32Value] {
-		return fmt.Errorf("cannot unmarshal %v into SignatureHelpTriggerKind: custom values are not supported", uint32Value)
+	if !validSymbolTagValues[uint32Value] {
+		return fmt.Errorf("cannot unmarshal %v into SymbolTag: custom values are not supported", uint32Value)
 	}
-	*s = SignatureHelpTriggerKind(uint32Value)
+	*s = SymbolTag(uint32Value)
 
 	return nil
 }
 
-func (s SignatureHelpTriggerKind) MarshalJSON() ([]byte, error) {
+func (s SymbolTag) MarshalJSON() ([]byte, error) {
 	var uint32Value = uint32(s)
-	if !validSignatureHelpTriggerKindValues[uint32Value] {
-		return nil, fmt.Errorf("cannot marshal %v into SignatureHelpTriggerKind: custom values are not supported", uint32Value)
+	if !validSymbolTagValues[uint32Value] {
+		return nil, fmt.Errorf("cannot marshal %v into SymbolTag: custom values are not supported", uint32Value)
 	}
 	return json.Marshal(uint32Value)
 
 }
 
-type ParameterInformationLabelRange struct {
-	Start int
-	End   int
+// Text document specific client capabilities.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocumentClientCapabilities
+type TextDocumentClientCapabilities struct {
+	// Defines which synchronization capabilities the client supports.
+	Synchronization *TextDocumentSyncClientCapabilities `json:"synchronization,omitempty"`
+	// Capabilities specific to the `textDocument/completion` request.
+	Completion *CompletionClientCapabilities `json:"completion,omitempty"`
+	// Capabilities specific to the `textDocument/hover` request.
+	Hover *HoverClientCapabilities `json:"hover,omitempty"`
+	// Capabilities specific to the `textDocument/signatureHelp` request.
+	SignatureHelp *SignatureHelpClientCapabilities `json:"signatureHelp,omitempty"`
+	// Capabilities specific to the `textDocument/declaration` request.
+	//
+	// @since 3.14.0
+	Declaration *DeclarationClientCapabilities `json:"declaration,omitempty"`
+	// Capabilities specific to the `textDocument/definition` request.
+	Definition *DefinitionClientCapabilities `json:"definition,omitempty"`
+	// Capabilities specific to the `textDocument/typeDefinition` request.
+	//
+	// @since 3.6.0
+	TypeDefinition *TypeDefinitionClientCapabilities `json:"typeDefinition,omitempty"`
+	// Capabilities specific to the `textDocument/implementation` request.
+	//
+	// @since 3.6.0
+	Implementation *ImplementationClientCapabilities `json:"implementation,omitempty"`
+	// Capabilities specific to the `textDocument/references` request.
+	References *ReferenceClientCapabilities `json:"references,omitempty"`
+	// Capabilities specific to the `textDocument/documentHighlight` request.
+	DocumentHighlight *DocumentHighlightClientCapabilities `json:"documentHighlight,omitempty"`
+	// Capabilities specific to the `textDocument/documentSymbol` request.
+	DocumentSymbol *DocumentSymbolClientCapabilities `json:"documentSymbol,omitempty"`
+	// Capabilities specific to the `textDocument/codeAction` request.
+	CodeAction *CodeActionClientCapabilities `json:"codeAction,omitempty"`
+	// Capabilities specific to the `textDocument/codeLens` request.
+	CodeLens *CodeLensClientCapabilities `json:"codeLens,omitempty"`
+	// Capabilities specific to the `textDocument/documentLink` request.
+	DocumentLink *DocumentLinkClientCapabilities `json:"documentLink,omitempty"`
+	// Capabilities specific to the `textDocument/documentColor` and the
+	// `textDocument/colorPresentation` request.
+	//
+	// @since 3.6.0
+	ColorProvider *DocumentColorClientCapabilities `json:"colorProvider,omitempty"`
+	// Capabilities specific to the `textDocument/formatting` request.
+	Formatting *DocumentFormattingClientCapabilities `json:"formatting,omitempty"`
+	// Capabilities specific to the `textDocument/rangeFormatting` request.
+	RangeFormatting *DocumentRangeFormattingClientCapabilities `json:"rangeFormatting,omitempty"`
+	// Capabilities specific to the `textDocument/onTypeFormatting` request.
+	OnTypeFormatting *DocumentOnTypeFormattingClientCapabilities `json:"onTypeFormatting,omitempty"`
+	// Capabilities specific to the `textDocument/rename` request.
+	Rename *RenameClientCapabilities `json:"rename,omitempty"`
+	// Capabilities specific to the `textDocument/foldingRange` request.
+	//
+	// @since 3.10.0
+	FoldingRange *FoldingRangeClientCapabilities `json:"foldingRange,omitempty"`
+	// Capabilities specific to the `textDocument/selectionRange` request.
+	//
+	// @since 3.15.0
+	SelectionRange *SelectionRangeClientCapabilities `json:"selectionRange,omitempty"`
+	// Capabilities specific to the `textDocument/publishDiagnostics` notification.
+	PublishDiagnostics *PublishDiagnosticsClientCapabilities `json:"publishDiagnostics,omitempty"`
+	// Capabilities specific to the various call hierarchy requests.
+	//
+	// @since 3.16.0
+	CallHierarchy *CallHierarchyClientCapabilities `json:"callHierarchy,omitempty"`
+	// Capabilities specific to the various semantic token request.
+	//
+	// @since 3.16.0
+	SemanticTokens *SemanticTokensClientCapabilities `json:"semanticTokens,omitempty"`
+	// Capabilities specific to the `textDocument/linkedEditingRange` request.
+	//
+	// @since 3.16.0
+	LinkedEditingRange *LinkedEditingRangeClientCapabilities `json:"linkedEditingRange,omitempty"`
+	// Client capabilities specific to the `textDocument/moniker` request.
+	//
+	// @since 3.16.0
+	Moniker *MonikerClientCapabilities `json:"moniker,omitempty"`
+	// Capabilities specific to the various type hierarchy requests.
+	//
+	// @since 3.17.0
+	TypeHierarchy *TypeHierarchyClientCapabilities `json:"typeHierarchy,omitempty"`
+	// Capabilities specific to the `textDocument/inlineValue` request.
+	//
+	// @since 3.17.0
+	InlineValue *InlineValueClientCapabilities `json:"inlineValue,omitempty"`
+	// Capabilities specific to the `textDocument/inlayHint` request.
+	//
+	// @since 3.17.0
+	InlayHint *InlayHintClientCapabilities `json:"inlayHint,omitempty"`
+	// Capabilities specific to the diagnostic pull model.
+	//
+	// @since 3.17.0
+	Diagnostic *DiagnosticClientCapabilities `json:"diagnostic,omitempty"`
+	// Client capabilities specific to inline completions.
+	//
+	// @since 3.18.0
+	// @proposed
+	InlineCompletion *InlineCompletionClientCapabilities `json:"inlineCompletion,omitempty"`
 }
 
-func (p *ParameterInformationLabelRange) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	items := make([]json.RawMessage, 2)
-	if err := json.Unmarshal(data, &items); err != nil {
-		return err
-	}
-	if len(items) != 2 {
-		return fmt.Errorf("protocol: cannot unmarshal %s into ParameterInformationLabelRange: expected tuple with 2 items, got %d", data, len(items))
-	}
-	var item0 int
-	if err := json.Unmarshal(items[0], &item0); err != nil {
-		return &json.UnmarshalTypeError{
-			Value:  string(items[0]),
-			Type:   reflect.TypeFor[int](),
-			Struct: "ParameterInformationLabelRange",
-			Field:  "Start",
-		}
+// Defines which synchronization capabilities the client supports.
+func (t *TextDocumentClientCapabilities) GetSynchronization() *TextDocumentSyncClientCapabilities {
+	if t == nil {
+		var zero *TextDocumentSyncClientCapabilities
+		return zero
 	}
-	p.Start = item0
-	var item1 int
-	if err := json.Unmarshal(items[1], &item1); err != nil {
-		return &json.UnmarshalTypeError{
-			Value:  string(items[1]),
-			Type:   reflect.TypeFor[int](),
-			Struct: "ParameterInformationLabelRange",
-			Field:  "End",
-		}
+	return t.Synchronization
+}
+
+// Capabilities specific to the `textDocument/completion` request.
+func (t *TextDocumentClientCapabilities) GetCompletion() *CompletionClientCapabilities {
+	if t == nil {
+		var zero *CompletionClientCapabilities
+		return zero
 	}
-	p.End = item1
-	return nil
+	return t.Completion
 }
 
-func (p *ParameterInformationLabelRange) MarshalJSON() ([]byte, error) {
-	return json.Marshal([]any{
-		p.Start,
-		p.End,
-	})
+// Capabilities specific to the `textDocument/hover` request.
+func (t *TextDocumentClientCapabilities) GetHover() *HoverClientCapabilities {
+	if t == nil {
+		var zero *HoverClientCapabilities
+		return zero
+	}
+	return t.Hover
 }
 
-// StringOrParameterInformationLabelRange contains either of the following types:
-//   - [String]
-//   - [*ParameterInformationLabelRange]
-type StringOrParameterInformationLabelRange struct {
-	Value StringOrParameterInformationLabelRangeValue
+// Capabilities specific to the `textDocument/signatureHelp` request.
+func (t *TextDocumentClientCapabilities) GetSignatureHelp() *SignatureHelpClientCapabilities {
+	if t == nil {
+		var zero *SignatureHelpClientCapabilities
+		return zero
+	}
+	return t.SignatureHelp
 }
 
-// StringOrParameterInformationLabelRangeValue is either of the following types:
-//   - [String]
-//   - [*ParameterInformationLabelRange]
+// Capabilities specific to the `textDocument/declaration` request.
 //
-//sumtype:decl
-type StringOrParameterInformationLabelRangeValue interface {
-	isStringOrParameterInformationLabelRangeValue()
+// @since 3.14.0
+func (t *TextDocumentClientCapabilities) GetDeclaration() *DeclarationClientCapabilities {
+	if t == nil {
+		var zero *DeclarationClientCapabilities
+		return zero
+	}
+	return t.Declaration
 }
 
-func (String) isStringOrParameterInformationLabelRangeValue()                          {}
-func (*ParameterInformationLabelRange) isStringOrParameterInformationLabelRangeValue() {}
+// Capabilities specific to the `textDocument/definition` request.
+func (t *TextDocumentClientCapabilities) GetDefinition() *DefinitionClientCapabilities {
+	if t == nil {
+		var zero *DefinitionClientCapabilities
+		return zero
+	}
+	return t.Definition
+}
 
-func (s *StringOrParameterInformationLabelRange) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
+// Capabilities specific to the `textDocument/typeDefinition` request.
+//
+// @since 3.6.0
+func (t *TextDocumentClientCapabilities) GetTypeDefinition() *TypeDefinitionClientCapabilities {
+	if t == nil {
+		var zero *TypeDefinitionClientCapabilities
+		return zero
 	}
-	var stringValue String
-	if err := json.Unmarshal(data, &stringValue); err == nil {
-		s.Value = stringValue
-		return nil
+	return t.TypeDefinition
+}
+
+// Capabilities specific to the `textDocument/implementation` request.
+//
+// @since 3.6.0
+func (t *TextDocumentClientCapabilities) GetImplementation() *ImplementationClientCapabilities {
+	if t == nil {
+		var zero *ImplementationClientCapabilities
+		return zero
 	}
-	var parameterInformationLabelRangeValue *ParameterInformationLabelRange
-	if err := json.Unmarshal(data, &parameterInformationLabelRangeValue); err == nil {
-		s.Value = parameterInformationLabelRangeValue
-		return nil
+	return t.Implementation
+}
+
+// Capabilities specific to the `textDocument/references` request.
+func (t *TextDocumentClientCapabilities) GetReferences() *ReferenceClientCapabilities {
+	if t == nil {
+		var zero *ReferenceClientCapabilities
+		return zero
 	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*StringOrParameterInformationLabelRange](),
+	return t.References
+}
+
+// Capabilities specific to the `textDocument/documentHighlight` request.
+func (t *TextDocumentClientCapabilities) GetDocumentHighlight() *DocumentHighlightClientCapabilities {
+	if t == nil {
+		var zero *DocumentHighlightClientCapabilities
+		return zero
 	}
+	return t.DocumentHighlight
 }
 
-func (s *StringOrParameterInformationLabelRange) MarshalJSON() ([]byte, error) {
-	return json.Marshal(s.Value)
+// Capabilities specific to the `textDocument/documentSymbol` request.
+func (t *TextDocumentClientCapabilities) GetDocumentSymbol() *DocumentSymbolClientCapabilities {
+	if t == nil {
+		var zero *DocumentSymbolClientCapabilities
+		return zero
+	}
+	return t.DocumentSymbol
 }
 
-// Represents a parameter of a callable-signature. A parameter can
-// have a label and a doc-comment.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#parameterInformation
-type ParameterInformation struct {
-	// The label of this parameter information.
-	//
-	// Either a string or an inclusive start and exclusive end offsets within its containing
-	// signature label. (see SignatureInformation.label). The offsets are based on a UTF-16
-	// string representation as `Position` and `Range` does.
-	//
-	// *Note*: a label of type string should be a substring of its containing signature label.
-	// Its intended use case is to highlight the parameter label part in the `SignatureInformation.label`.
-	Label *StringOrParameterInformationLabelRange `json:"label"`
-	// The human-readable doc-comment of this parameter. Will be shown
-	// in the UI but can be omitted.
-	Documentation *StringOrMarkupContent `json:"documentation,omitempty"`
+// Capabilities specific to the `textDocument/codeAction` request.
+func (t *TextDocumentClientCapabilities) GetCodeAction() *CodeActionClientCapabilities {
+	if t == nil {
+		var zero *CodeActionClientCapabilities
+		return zero
+	}
+	return t.CodeAction
 }
 
-// The label of this parameter information.
-//
-// Either a string or an inclusive start and exclusive end offsets within its containing
-// signature label. (see SignatureInformation.label). The offsets are based on a UTF-16
-// string representation as `Position` and `Range` does.
-//
-// *Note*: a label of type string should be a substring of its containing signature label.
-// Its intended use case is to highlight the parameter label part in the `SignatureInformation.label`.
-func (p *ParameterInformation) GetLabel() *StringOrParameterInformationLabelRange {
-	if p == nil {
-		var zero *StringOrParameterInformationLabelRange
+// Capabilities specific to the `textDocument/codeLens` request.
+func (t *TextDocumentClientCapabilities) GetCodeLens() *CodeLensClientCapabilities {
+	if t == nil {
+		var zero *CodeLensClientCapabilities
 		return zero
 	}
-	return p.Label
+	return t.CodeLens
 }
 
-// The human-readable doc-comment of this parameter. Will be shown
-// in the UI but can be omitted.
-func (p *ParameterInformation) GetDocumentation() *StringOrMarkupContent {
-	if p == nil {
-		var zero *StringOrMarkupContent
+// Capabilities specific to the `textDocument/documentLink` request.
+func (t *TextDocumentClientCapabilities) GetDocumentLink() *DocumentLinkClientCapabilities {
+	if t == nil {
+		var zero *DocumentLinkClientCapabilities
 		return zero
 	}
-	return p.Documentation
+	return t.DocumentLink
 }
 
-// Represents the signature of something callable. A signature
-// can have a label, like a function-name, a doc-comment, and
-// a set of parameters.
+// Capabilities specific to the `textDocument/documentColor` and the
+// `textDocument/colorPresentation` request.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#signatureInformation
-type SignatureInformation struct {
-	// The label of this signature. Will be shown in
-	// the UI.
-	Label string `json:"label"`
-	// The human-readable doc-comment of this signature. Will be shown
-	// in the UI but can be omitted.
-	Documentation *StringOrMarkupContent `json:"documentation,omitempty"`
-	// The parameters of this signature.
-	Parameters []*ParameterInformation `json:"parameters,omitempty"`
-	// The index of the active parameter.
-	//
-	// If provided, this is used in place of `SignatureHelp.activeParameter`.
-	//
-	// @since 3.16.0
-	ActiveParameter Optional[int] `json:"activeParameter,omitempty"`
+// @since 3.6.0
+func (t *TextDocumentClientCapabilities) GetColorProvider() *DocumentColorClientCapabilities {
+	if t == nil {
+		var zero *DocumentColorClientCapabilities
+		return zero
+	}
+	return t.ColorProvider
 }
 
-// The label of this signature. Will be shown in
-// the UI.
-func (s *SignatureInformation) GetLabel() string {
-	if s == nil {
-		var zero string
+// Capabilities specific to the `textDocument/formatting` request.
+func (t *TextDocumentClientCapabilities) GetFormatting() *DocumentFormattingClientCapabilities {
+	if t == nil {
+		var zero *DocumentFormattingClientCapabilities
 		return zero
 	}
-	return s.Label
+	return t.Formatting
 }
 
-// The human-readable doc-comment of this signature. Will be shown
-// in the UI but can be omitted.
-func (s *SignatureInformation) GetDocumentation() *StringOrMarkupContent {
-	if s == nil {
-		var zero *StringOrMarkupContent
+// Capabilities specific to the `textDocument/rangeFormatting` request.
+func (t *TextDocumentClientCapabilities) GetRangeFormatting() *DocumentRangeFormattingClientCapabilities {
+	if t == nil {
+		var zero *DocumentRangeFormattingClientCapabilities
 		return zero
 	}
-	return s.Documentation
+	return t.RangeFormatting
 }
 
-// The parameters of this signature.
-func (s *SignatureInformation) GetParameters() []*ParameterInformation {
-	if s == nil {
-		var zero []*ParameterInformation
+// Capabilities specific to the `textDocument/onTypeFormatting` request.
+func (t *TextDocumentClientCapabilities) GetOnTypeFormatting() *DocumentOnTypeFormattingClientCapabilities {
+	if t == nil {
+		var zero *DocumentOnTypeFormattingClientCapabilities
 		return zero
 	}
-	return s.Parameters
+	return t.OnTypeFormatting
 }
 
-// The index of the active parameter.
-//
-// If provided, this is used in place of `SignatureHelp.activeParameter`.
-//
-// @since 3.16.0
-func (s *SignatureInformation) GetActiveParameter() Optional[int] {
-	if s == nil {
-		var zero Optional[int]
+// Capabilities specific to the `textDocument/rename` request.
+func (t *TextDocumentClientCapabilities) GetRename() *RenameClientCapabilities {
+	if t == nil {
+		var zero *RenameClientCapabilities
 		return zero
 	}
-	return s.ActiveParameter
+	return t.Rename
 }
 
-// Signature help represents the signature of something
-// callable. There can be multiple signature but only one
-// active and only one active parameter.
+// Capabilities specific to the `textDocument/foldingRange` request.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#signatureHelp
-type SignatureHelp struct {
-	// One or more signatures.
-	Signatures []*SignatureInformation `json:"signatures"`
-	// The active signature. If omitted or the value lies outside the
-	// range of `signatures` the value defaults to zero or is ignored if
-	// the `SignatureHelp` has no signatures.
-	//
-	// Whenever possible implementors should make an active decision about
-	// the active signature and shouldn't rely on a default value.
-	//
-	// In future version of the protocol this property might become
-	// mandatory to better express this.
-	ActiveSignature Optional[int] `json:"activeSignature,omitempty"`
-	// The active parameter of the active signature. If omitted or the value
-	// lies outside the range of `signatures[activeSignature].parameters`
-	// defaults to 0 if the active signature has parameters. If
-	// the active signature has no parameters it is ignored.
-	// In future version of the protocol this property might become
-	// mandatory to better express the active parameter if the
-	// active signature does have any.
-	ActiveParameter Optional[int] `json:"activeParameter,omitempty"`
-}
-
-// One or more signatures.
-func (s *SignatureHelp) GetSignatures() []*SignatureInformation {
-	if s == nil {
-		var zero []*SignatureInformation
+// @since 3.10.0
+func (t *TextDocumentClientCapabilities) GetFoldingRange() *FoldingRangeClientCapabilities {
+	if t == nil {
+		var zero *FoldingRangeClientCapabilities
 		return zero
 	}
-	return s.Signatures
+	return t.FoldingRange
 }
 
-// The active signature. If omitted or the value lies outside the
-// range of `signatures` the value defaults to zero or is ignored if
-// the `SignatureHelp` has no signatures.
-//
-// Whenever possible implementors should make an active decision about
-// the active signature and shouldn't rely on a default value.
+// Capabilities specific to the `textDocument/selectionRange` request.
 //
-// In future version of the protocol this property might become
-// mandatory to better express this.
-func (s *SignatureHelp) GetActiveSignature() Optional[int] {
-	if s == nil {
-		var zero Optional[int]
+// @since 3.15.0
+func (t *TextDocumentClientCapabilities) GetSelectionRange() *SelectionRangeClientCapabilities {
+	if t == nil {
+		var zero *SelectionRangeClientCapabilities
 		return zero
 	}
-	return s.ActiveSignature
+	return t.SelectionRange
 }
 
-// The active parameter of the active signature. If omitted or the value
-// lies outside the range of `signatures[activeSignature].parameters`
-// defaults to 0 if the active signature has parameters. If
-// the active signature has no parameters it is ignored.
-// In future version of the protocol this property might become
-// mandatory to better express the active parameter if the
-// active signature does have any.
-func (s *SignatureHelp) GetActiveParameter() Optional[int] {
-	if s == nil {
-		var zero Optional[int]
+// Capabilities specific to the `textDocument/publishDiagnostics` notification.
+func (t *TextDocumentClientCapabilities) GetPublishDiagnostics() *PublishDiagnosticsClientCapabilities {
+	if t == nil {
+		var zero *PublishDiagnosticsClientCapabilities
 		return zero
 	}
-	return s.ActiveParameter
+	return t.PublishDiagnostics
 }
 
-// Additional information about the context in which a signature help request was triggered.
+// Capabilities specific to the various call hierarchy requests.
 //
-// @since 3.15.0
+// @since 3.16.0
+func (t *TextDocumentClientCapabilities) GetCallHierarchy() *CallHierarchyClientCapabilities {
+	if t == nil {
+		var zero *CallHierarchyClientCapabilities
+		return zero
+	}
+	return t.CallHierarchy
+}
+
+// Capabilities specific to the various semantic token request.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#signatureHelpContext
-type SignatureHelpContext struct {
-	// Action that caused signature help to be triggered.
-	TriggerKind SignatureHelpTriggerKind `json:"triggerKind"`
-	// Character that caused signature help to be triggered.
-	//
-	// This is undefined when `triggerKind !== SignatureHelpTriggerKind.TriggerCharacter`
-	TriggerCharacter string `json:"triggerCharacter,omitempty"`
-	// `true` if signature help was already showing when it was triggered.
-	//
-	// Retriggers occurs when the signature help is already active and can be caused by actions such as
-	// typing a trigger character, a cursor move, or document content changes.
-	IsRetrigger bool `json:"isRetrigger"`
-	// The currently active `SignatureHelp`.
-	//
-	// The `activeSignatureHelp` has its `SignatureHelp.activeSignature` field updated based on
-	// the user navigating through available signatures.
-	ActiveSignatureHelp *SignatureHelp `json:"activeSignatureHelp,omitempty"`
+// @since 3.16.0
+func (t *TextDocumentClientCapabilities) GetSemanticTokens() *SemanticTokensClientCapabilities {
+	if t == nil {
+		var zero *SemanticTokensClientCapabilities
+		return zero
+	}
+	return t.SemanticTokens
 }
 
-// Action that caused signature help to be triggered.
-func (s *SignatureHelpContext) GetTriggerKind() SignatureHelpTriggerKind {
-	if s == nil {
-		var zero SignatureHelpTriggerKind
+// Capabilities specific to the `textDocument/linkedEditingRange` request.
+//
+// @since 3.16.0
+func (t *TextDocumentClientCapabilities) GetLinkedEditingRange() *LinkedEditingRangeClientCapabilities {
+	if t == nil {
+		var zero *LinkedEditingRangeClientCapabilities
 		return zero
 	}
-	return s.TriggerKind
+	return t.LinkedEditingRange
 }
 
-// Character that caused signature help to be triggered.
+// Client capabilities specific to the `textDocument/moniker` request.
 //
-// This is undefined when `triggerKind !== SignatureHelpTriggerKind.TriggerCharacter`
-func (s *SignatureHelpContext) GetTriggerCharacter() string {
-	if s == nil {
-		var zero string
+// @since 3.16.0
+func (t *TextDocumentClientCapabilities) GetMoniker() *MonikerClientCapabilities {
+	if t == nil {
+		var zero *MonikerClientCapabilities
 		return zero
 	}
-	return s.TriggerCharacter
+	return t.Moniker
 }
 
-// `true` if signature help was already showing when it was triggered.
+// Capabilities specific to the various type hierarchy requests.
 //
-// Retriggers occurs when the signature help is already active and can be caused by actions such as
-// typing a trigger character, a cursor move, or document content changes.
-func (s *SignatureHelpContext) GetIsRetrigger() bool {
-	if s == nil {
-		var zero bool
+// @since 3.17.0
+func (t *TextDocumentClientCapabilities) GetTypeHierarchy() *TypeHierarchyClientCapabilities {
+	if t == nil {
+		var zero *TypeHierarchyClientCapabilities
 		return zero
 	}
-	return s.IsRetrigger
+	return t.TypeHierarchy
 }
 
-// The currently active `SignatureHelp`.
+// Capabilities specific to the `textDocument/inlineValue` request.
 //
-// The `activeSignatureHelp` has its `SignatureHelp.activeSignature` field updated based on
-// the user navigating through available signatures.
-func (s *SignatureHelpContext) GetActiveSignatureHelp() *SignatureHelp {
-	if s == nil {
-		var zero *SignatureHelp
+// @since 3.17.0
+func (t *TextDocumentClientCapabilities) GetInlineValue() *InlineValueClientCapabilities {
+	if t == nil {
+		var zero *InlineValueClientCapabilities
 		return zero
 	}
-	return s.ActiveSignatureHelp
+	return t.InlineValue
 }
 
-// Parameters for a {@link SignatureHelpRequest}.
+// Capabilities specific to the `textDocument/inlayHint` request.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#signatureHelpParams
-type SignatureHelpParams struct {
-	*TextDocumentPositionParams
-	*WorkDoneProgressParams
-	// The signature help context. This is only available if the client specifies
-	// to send this using the client capability `textDocument.signatureHelp.contextSupport === true`
-	//
-	// @since 3.15.0
-	Context *SignatureHelpContext `json:"context,omitempty"`
+// @since 3.17.0
+func (t *TextDocumentClientCapabilities) GetInlayHint() *InlayHintClientCapabilities {
+	if t == nil {
+		var zero *InlayHintClientCapabilities
+		return zero
+	}
+	return t.InlayHint
 }
 
-// The signature help context. This is only available if the client specifies
-// to send this using the client capability `textDocument.signatureHelp.contextSupport === true`
+// Capabilities specific to the diagnostic pull model.
 //
-// @since 3.15.0
-func (s *SignatureHelpParams) GetContext() *SignatureHelpContext {
-	if s == nil {
-		var zero *SignatureHelpContext
+// @since 3.17.0
+func (t *TextDocumentClientCapabilities) GetDiagnostic() *DiagnosticClientCapabilities {
+	if t == nil {
+		var zero *DiagnosticClientCapabilities
 		return zero
 	}
-	return s.Context
+	return t.Diagnostic
 }
 
-// Parameters for a {@link DefinitionRequest}.
+// Client capabilities specific to inline completions.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#definitionParams
-type DefinitionParams struct {
-	*TextDocumentPositionParams
-	*WorkDoneProgressParams
-	*PartialResultParams
+// @since 3.18.0
+// @proposed
+func (t *TextDocumentClientCapabilities) GetInlineCompletion() *InlineCompletionClientCapabilities {
+	if t == nil {
+		var zero *InlineCompletionClientCapabilities
+		return zero
+	}
+	return t.InlineCompletion
 }
 
-// Represents a location inside a resource, such as a line
-// inside a text file.
+// An event describing a change to a text document. If only a text is provided
+// it is considered to be the full content of the document.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#location
-type Location struct {
-	Uri   string `json:"uri"`
-	Range *Range `json:"range"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocumentContentChangeEvent
+type TextDocumentContentChangeEvent = *IncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEvent
+
+// Describes textual changes on a text document. A TextDocumentEdit describes all changes
+// on a document version Si and after they are applied move the document to version Si+1.
+// So the creator of a TextDocumentEdit doesn't need to sort the array of edits or do any
+// kind of ordering. However the edits must be non overlapping.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocumentEdit
+type TextDocumentEdit struct {
+	// The text document to change.
+	TextDocument *OptionalVersionedTextDocumentIdentifier `json:"textDocument"`
+	// The edits to be applied.
+	//
+	// @since 3.16.0 - support for AnnotatedTextEdit. This is guarded using a
+	// client capability.
+	Edits []*TextEditOrAnnotatedTextEdit `json:"edits"`
 }
 
-func (l *Location) GetUri() string {
-	if l == nil {
-		var zero string
+// The text document to change.
+func (t *TextDocumentEdit) GetTextDocument() *OptionalVersionedTextDocumentIdentifier {
+	if t == nil {
+		var zero *OptionalVersionedTextDocumentIdentifier
 		return zero
 	}
-	return l.Uri
+	return t.TextDocument
 }
 
-func (l *Location) GetRange() *Range {
-	if l == nil {
-		var zero *Range
+// The edits to be applied.
+//
+// @since 3.16.0 - support for AnnotatedTextEdit. This is guarded using a
+// client capability.
+func (t *TextDocumentEdit) GetEdits() []*TextEditOrAnnotatedTextEdit {
+	if t == nil {
+		var zero []*TextEditOrAnnotatedTextEdit
 		return zero
 	}
-	return l.Range
+	return t.Edits
 }
 
-type LocationSlice []*Location
-
-// LocationOrLocationSlice contains either of the following types:
-//   - [*Location]
-//   - [LocationSlice]
-type LocationOrLocationSlice struct {
-	Value LocationOrLocationSliceValue
+// TextDocumentEditOrCreateFileOrRenameFileOrDeleteFile contains either of the following types:
+//   - [*TextDocumentEdit]
+//   - [*CreateFile]
+//   - [*RenameFile]
+//   - [*DeleteFile]
+type TextDocumentEditOrCreateFileOrRenameFileOrDeleteFile struct {
+	Value TextDocumentEditOrCreateFileOrRenameFileOrDeleteFileValue
 }
 
-// LocationOrLocationSliceValue is either of the following types:
-//   - [*Location]
-//   - [LocationSlice]
+// TextDocumentEditOrCreateFileOrRenameFileOrDeleteFileValue is either of the following types:
+//   - [*TextDocumentEdit]
+//   - [*CreateFile]
+//   - [*RenameFile]
+//   - [*DeleteFile]
 //
 //sumtype:decl
-type LocationOrLocationSliceValue interface {
-	isLocationOrLocationSliceValue()
+type TextDocumentEditOrCreateFileOrRenameFileOrDeleteFileValue interface {
+	isTextDocumentEditOrCreateFileOrRenameFileOrDeleteFileValue()
 }
 
-func (*Location) isLocationOrLocationSliceValue()     {}
-func (LocationSlice) isLocationOrLocationSliceValue() {}
+func (*TextDocumentEdit) isTextDocumentEditOrCreateFileOrRenameFileOrDeleteFileValue() {}
+func (*CreateFile) isTextDocumentEditOrCreateFileOrRenameFileOrDeleteFileValue()       {}
+func (*RenameFile) isTextDocumentEditOrCreateFileOrRenameFileOrDeleteFileValue()       {}
+func (*DeleteFile) isTextDocumentEditOrCreateFileOrRenameFileOrDeleteFileValue()       {}
 
-func (l *LocationOrLocationSlice) UnmarshalJSON(data []byte) error {
+func (t *TextDocumentEditOrCreateFileOrRenameFileOrDeleteFile) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var locationValue *Location
-	if err := json.Unmarshal(data, &locationValue); err == nil {
-		l.Value = locationValue
+	var textDocumentEditValue *TextDocumentEdit
+	if err := json.Unmarshal(data, &textDocumentEditValue); err == nil {
+		t.Value = textDocumentEditValue
 		return nil
 	}
-	var locationSliceValue LocationSlice
-	if err := json.Unmarshal(data, &locationSliceValue); err == nil {
-		l.Value = locationSliceValue
+	var createFileValue *CreateFile
+	if err := json.Unmarshal(data, &createFileValue); err == nil {
+		t.Value = createFileValue
+		return nil
+	}
+	var renameFileValue *RenameFile
+	if err := json.Unmarshal(data, &renameFileValue); err == nil {
+		t.Value = renameFileValue
+		return nil
+	}
+	var deleteFileValue *DeleteFile
+	if err := json.Unmarshal(data, &deleteFileValue); err == nil {
+		t.Value = deleteFileValue
 		return nil
 	}
 	return &json.UnmarshalTypeError{
 		Value: string(data),
-		Type:  reflect.TypeFor[*LocationOrLocationSlice](),
+		Type:  reflect.TypeFor[*TextDocumentEditOrCreateFileOrRenameFileOrDeleteFile](),
 	}
 }
 
-func (l *LocationOrLocationSlice) MarshalJSON() ([]byte, error) {
-	return json.Marshal(l.Value)
-}
-
-// The definition of a symbol represented as one or many {@link Location locations}.
-// For most programming languages there is only one location at which a symbol is
-// defined.
-//
-// Servers should prefer returning `DefinitionLink` over `Definition` if supported
-// by the client.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#definition
-type Definition = *LocationOrLocationSlice
-
-// Represents the connection of two locations. Provides additional metadata over normal {@link Location locations},
-// including an origin range.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#locationLink
-type LocationLink struct {
-	// Span of the origin of this link.
-	//
-	// Used as the underlined span for mouse interaction. Defaults to the word range at
-	// the definition position.
-	OriginSelectionRange *Range `json:"originSelectionRange,omitempty"`
-	// The target resource identifier of this link.
-	TargetUri string `json:"targetUri"`
-	// The full target range of this link. If the target for example is a symbol then target range is the
-	// range enclosing this symbol not including leading/trailing whitespace but everything else
-	// like comments. This information is typically used to highlight the range in the editor.
-	TargetRange *Range `json:"targetRange"`
-	// The range that should be selected and revealed when this link is being followed, e.g the name of a function.
-	// Must be contained by the `targetRange`. See also `DocumentSymbol#range`
-	TargetSelectionRange *Range `json:"targetSelectionRange"`
+func (t *TextDocumentEditOrCreateFileOrRenameFileOrDeleteFile) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
 }
 
-// Span of the origin of this link.
+// A document filter denotes a document by different properties like
+// the {@link TextDocument.languageId language}, the {@link Uri.scheme scheme} of
+// its resource, or a glob-pattern that is applied to the {@link TextDocument.fileName path}.
 //
-// Used as the underlined span for mouse interaction. Defaults to the word range at
-// the definition position.
-func (l *LocationLink) GetOriginSelectionRange() *Range {
-	if l == nil {
-		var zero *Range
-		return zero
-	}
-	return l.OriginSelectionRange
+// Glob patterns can have the following syntax:
+// - `*` to match one or more characters in a path segment
+// - `?` to match on one character in a path segment
+// - `**` to match any number of path segments, including none
+// - `{}` to group sub patterns into an OR expression. (e.g. `**​/*.{ts,js}` matches all TypeScript and JavaScript files)
+// - `[]` to declare a range of characters to match in a path segment (e.g., `example.[0-9]` to match on `example.0`, `example.1`, …)
+// - `[!...]` to negate a range of characters to match in a path segment (e.g., `example.[!0-9]` to match on `example.a`, `example.b`, but not `example.0`)
+//
+// @sample A language filter that applies to typescript files on disk: `{ language: 'typescript', scheme: 'file' }`
+// @sample A language filter that applies to all package.json paths: `{ language: 'json', pattern: '**package.json' }`
+//
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocumentFilter
+type TextDocumentFilter = *TextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3
+
+type TextDocumentFilterOr1 struct {
+	// A language id, like `typescript`.
+	Language string `json:"language"`
+	// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
+	Scheme string `json:"scheme,omitempty"`
+	// A glob pattern, like **​/*.{ts,js}. See TextDocumentFilter for examples.
+	Pattern string `json:"pattern,omitempty"`
 }
 
-// The target resource identifier of this link.
-func (l *LocationLink) GetTargetUri() string {
-	if l == nil {
-		var zero string
-		return zero
+// A language id, like `typescript`.
+func (t *TextDocumentFilterOr1) GetLanguage() string {
+	if t == nil {
+		return *new(string)
 	}
-	return l.TargetUri
+	return t.Language
 }
 
-// The full target range of this link. If the target for example is a symbol then target range is the
-// range enclosing this symbol not including leading/trailing whitespace but everything else
-// like comments. This information is typically used to highlight the range in the editor.
-func (l *LocationLink) GetTargetRange() *Range {
-	if l == nil {
-		var zero *Range
-		return zero
+// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
+func (t *TextDocumentFilterOr1) GetScheme() string {
+	if t == nil {
+		return *new(string)
 	}
-	return l.TargetRange
+	return t.Scheme
 }
 
-// The range that should be selected and revealed when this link is being followed, e.g the name of a function.
-// Must be contained by the `targetRange`. See also `DocumentSymbol#range`
-func (l *LocationLink) GetTargetSelectionRange() *Range {
-	if l == nil {
-		var zero *Range
-		return zero
+// A glob pattern, like **​/*.{ts,js}. See TextDocumentFilter for examples.
+func (t *TextDocumentFilterOr1) GetPattern() string {
+	if t == nil {
+		return *new(string)
 	}
-	return l.TargetSelectionRange
+	return t.Pattern
 }
 
-// Information about where a symbol is defined.
-//
-// Provides additional metadata over normal {@link Location location} definitions, including the range of
-// the defining symbol
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#definitionLink
-type DefinitionLink = *LocationLink
-
-type DefinitionLinkSlice []DefinitionLink
-
-// DefinitionOrDefinitionLinkSlice contains either of the following types:
-//   - [Definition]
-//   - [DefinitionLinkSlice]
-type DefinitionOrDefinitionLinkSlice struct {
-	Value DefinitionOrDefinitionLinkSliceValue
+// TextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3 contains either of the following types:
+//   - [*TextDocumentFilterOr1]
+//   - [*TextDocumentFilterOr2]
+//   - [*TextDocumentFilterOr3]
+type TextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3 struct {
+	Value TextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3Value
 }
 
-// DefinitionOrDefinitionLinkSliceValue is either of the following types:
-//   - [Definition]
-//   - [DefinitionLinkSlice]
+// TextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3Value is either of the following types:
+//   - [*TextDocumentFilterOr1]
+//   - [*TextDocumentFilterOr2]
+//   - [*TextDocumentFilterOr3]
 //
 //sumtype:decl
-type DefinitionOrDefinitionLinkSliceValue interface {
-	isDefinitionOrDefinitionLinkSliceValue()
+type TextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3Value interface {
+	isTextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3Value()
 }
 
-func (Definition) isDefinitionOrDefinitionLinkSliceValue()          {}
-func (DefinitionLinkSlice) isDefinitionOrDefinitionLinkSliceValue() {}
+func (*TextDocumentFilterOr1) isTextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3Value() {
+}
+func (*TextDocumentFilterOr2) isTextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3Value() {
+}
+func (*TextDocumentFilterOr3) isTextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3Value() {
+}
 
-func (d *DefinitionOrDefinitionLinkSlice) UnmarshalJSON(data []byte) error {
+func (t *TextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var definitionValue Definition
-	if err := json.Unmarshal(data, &definitionValue); err == nil {
-		d.Value = definitionValue
+	var textDocumentFilterOr1Value *TextDocumentFilterOr1
+	if err := json.Unmarshal(data, &textDocumentFilterOr1Value); err == nil {
+		t.Value = textDocumentFilterOr1Value
 		return nil
 	}
-	var definitionLinkSliceValue DefinitionLinkSlice
-	if err := json.Unmarshal(data, &definitionLinkSliceValue); err == nil {
-		d.Value = definitionLinkSliceValue
+	var textDocumentFilterOr2Value *TextDocumentFilterOr2
+	if err := json.Unmarshal(data, &textDocumentFilterOr2Value); err == nil {
+		t.Value = textDocumentFilterOr2Value
+		return nil
+	}
+	var textDocumentFilterOr3Value *TextDocumentFilterOr3
+	if err := json.Unmarshal(data, &textDocumentFilterOr3Value); err == nil {
+		t.Value = textDocumentFilterOr3Value
 		return nil
 	}
 	return &json.UnmarshalTypeError{
 		Value: string(data),
-		Type:  reflect.TypeFor[*DefinitionOrDefinitionLinkSlice](),
+		Type:  reflect.TypeFor[*TextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3](),
 	}
 }
 
-func (d *DefinitionOrDefinitionLinkSlice) MarshalJSON() ([]byte, error) {
-	return json.Marshal(d.Value)
+func (t *TextDocumentFilterOr1OrTextDocumentFilterOr2OrTextDocumentFilterOr3) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
 }
 
-// Value-object that contains additional information when
-// requesting references.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#referenceContext
-type ReferenceContext struct {
-	// Include the declaration of the current symbol.
-	IncludeDeclaration bool `json:"includeDeclaration"`
+type TextDocumentFilterOr2 struct {
+	// A language id, like `typescript`.
+	Language string `json:"language,omitempty"`
+	// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
+	Scheme string `json:"scheme"`
+	// A glob pattern, like **​/*.{ts,js}. See TextDocumentFilter for examples.
+	Pattern string `json:"pattern,omitempty"`
 }
 
-// Include the declaration of the current symbol.
-func (r *ReferenceContext) GetIncludeDeclaration() bool {
-	if r == nil {
-		var zero bool
-		return zero
+// A language id, like `typescript`.
+func (t *TextDocumentFilterOr2) GetLanguage() string {
+	if t == nil {
+		return *new(string)
 	}
-	return r.IncludeDeclaration
+	return t.Language
 }
 
-// Parameters for a {@link ReferencesRequest}.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#referenceParams
-type ReferenceParams struct {
-	*TextDocumentPositionParams
-	*WorkDoneProgressParams
-	*PartialResultParams
-	Context *ReferenceContext `json:"context"`
+// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
+func (t *TextDocumentFilterOr2) GetScheme() string {
+	if t == nil {
+		return *new(string)
+	}
+	return t.Scheme
 }
 
-func (r *ReferenceParams) GetContext() *ReferenceContext {
-	if r == nil {
-		var zero *ReferenceContext
-		return zero
+// A glob pattern, like **​/*.{ts,js}. See TextDocumentFilter for examples.
+func (t *TextDocumentFilterOr2) GetPattern() string {
+	if t == nil {
+		return *new(string)
 	}
-	return r.Context
+	return t.Pattern
 }
 
-// Parameters for a {@link DocumentSymbolRequest}.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentSymbolParams
-type DocumentSymbolParams struct {
-	*WorkDoneProgressParams
-	*PartialResultParams
-	// The text document.
-	TextDocument *TextDocumentIdentifier `json:"textDocument"`
+type TextDocumentFilterOr3 struct {
+	// A language id, like `typescript`.
+	Language string `json:"language,omitempty"`
+	// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
+	Scheme string `json:"scheme,omitempty"`
+	// A glob pattern, like **​/*.{ts,js}. See TextDocumentFilter for examples.
+	Pattern string `json:"pattern"`
 }
 
-// The text document.
-func (d *DocumentSymbolParams) GetTextDocument() *TextDocumentIdentifier {
-	if d == nil {
-		var zero *TextDocumentIdentifier
-		return zero
+// A language id, like `typescript`.
+func (t *TextDocumentFilterOr3) GetLanguage() string {
+	if t == nil {
+		return *new(string)
 	}
-	return d.TextDocument
+	return t.Language
 }
 
-// A base for all symbol information.
+// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
+func (t *TextDocumentFilterOr3) GetScheme() string {
+	if t == nil {
+		return *new(string)
+	}
+	return t.Scheme
+}
+
+// A glob pattern, like **​/*.{ts,js}. See TextDocumentFilter for examples.
+func (t *TextDocumentFilterOr3) GetPattern() string {
+	if t == nil {
+		return *new(string)
+	}
+	return t.Pattern
+}
+
+// TextDocumentFilterOrNotebookCellTextDocumentFilter contains either of the following types:
+//   - [TextDocumentFilter]
+//   - [*NotebookCellTextDocumentFilter]
+type TextDocumentFilterOrNotebookCellTextDocumentFilter struct {
+	Value TextDocumentFilterOrNotebookCellTextDocumentFilterValue
+}
+
+// TextDocumentFilterOrNotebookCellTextDocumentFilterValue is either of the following types:
+//   - [TextDocumentFilter]
+//   - [*NotebookCellTextDocumentFilter]
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#baseSymbolInformation
-type BaseSymbolInformation struct {
-	// The name of this symbol.
-	Name string `json:"name"`
-	// The kind of this symbol.
-	Kind SymbolKind `json:"kind"`
-	// Tags for this symbol.
-	//
-	// @since 3.16.0
-	Tags []SymbolTag `json:"tags,omitempty"`
-	// The name of the symbol containing this symbol. This information is for
-	// user interface purposes (e.g. to render a qualifier in the user interface
-	// if necessary). It can't be used to re-infer a hierarchy for the document
-	// symbols.
-	ContainerName string `json:"containerName,omitempty"`
+//sumtype:decl
+type TextDocumentFilterOrNotebookCellTextDocumentFilterValue interface {
+	isTextDocumentFilterOrNotebookCellTextDocumentFilterValue()
 }
 
-// The name of this symbol.
-func (b *BaseSymbolInformation) GetName() string {
-	if b == nil {
-		var zero string
-		return zero
+func (TextDocumentFilter) isTextDocumentFilterOrNotebookCellTextDocumentFilterValue()              {}
+func (*NotebookCellTextDocumentFilter) isTextDocumentFilterOrNotebookCellTextDocumentFilterValue() {}
+
+func (t *TextDocumentFilterOrNotebookCellTextDocumentFilter) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var textDocumentFilterValue TextDocumentFilter
+	if err := json.Unmarshal(data, &textDocumentFilterValue); err == nil {
+		t.Value = textDocumentFilterValue
+		return nil
+	}
+	var notebookCellTextDocumentFilterValue *NotebookCellTextDocumentFilter
+	if err := json.Unmarshal(data, &notebookCellTextDocumentFilterValue); err == nil {
+		t.Value = notebookCellTextDocumentFilterValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*TextDocumentFilterOrNotebookCellTextDocumentFilter](),
 	}
-	return b.Name
 }
 
-// The kind of this symbol.
-func (b *BaseSymbolInformation) GetKind() SymbolKind {
-	if b == nil {
-		var zero SymbolKind
-		return zero
-	}
-	return b.Kind
+func (t *TextDocumentFilterOrNotebookCellTextDocumentFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
 }
 
-// Tags for this symbol.
+// A literal to identify a text document in the client.
 //
-// @since 3.16.0
-func (b *BaseSymbolInformation) GetTags() []SymbolTag {
-	if b == nil {
-		var zero []SymbolTag
-		return zero
-	}
-	return b.Tags
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocumentIdentifier
+type TextDocumentIdentifier struct {
+	// The text document's uri.
+	Uri string `json:"uri"`
 }
 
-// The name of the symbol containing this symbol. This information is for
-// user interface purposes (e.g. to render a qualifier in the user interface
-// if necessary). It can't be used to re-infer a hierarchy for the document
-// symbols.
-func (b *BaseSymbolInformation) GetContainerName() string {
-	if b == nil {
+// The text document's uri.
+func (t *TextDocumentIdentifier) GetUri() string {
+	if t == nil {
 		var zero string
 		return zero
 	}
-	return b.ContainerName
+	return t.Uri
 }
 
-// Represents information about programming constructs like variables, classes,
-// interfaces etc.
+// An item to transfer a text document from the client to the
+// server.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#symbolInformation
-type SymbolInformation struct {
-	*BaseSymbolInformation
-	// Indicates if this symbol is deprecated.
-	//
-	// Deprecated: Use tags instead
-	Deprecated bool `json:"deprecated,omitempty"`
-	// The location of this symbol. The location's range is used by a tool
-	// to reveal the location in the editor. If the symbol is selected in the
-	// tool the range's start information is used to position the cursor. So
-	// the range usually spans more than the actual symbol's name and does
-	// normally include things like visibility modifiers.
-	//
-	// The range doesn't have to denote a node range in the sense of an abstract
-	// syntax tree. It can therefore not be used to re-construct a hierarchy of
-	// the symbols.
-	Location *Location `json:"location"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocumentItem
+type TextDocumentItem struct {
+	// The text document's uri.
+	Uri string `json:"uri"`
+	// The text document's language identifier.
+	LanguageId string `json:"languageId"`
+	// The version number of this document (it will increase after each
+	// change, including undo/redo).
+	Version int `json:"version"`
+	// The content of the opened text document.
+	Text string `json:"text"`
 }
 
-// Indicates if this symbol is deprecated.
-//
-// Deprecated: Use tags instead
-func (s *SymbolInformation) GetDeprecated() bool {
-	if s == nil {
-		var zero bool
+// The text document's uri.
+func (t *TextDocumentItem) GetUri() string {
+	if t == nil {
+		var zero string
 		return zero
 	}
-	return s.Deprecated
+	return t.Uri
 }
 
-// The location of this symbol. The location's range is used by a tool
-// to reveal the location in the editor. If the symbol is selected in the
-// tool the range's start information is used to position the cursor. So
-// the range usually spans more than the actual symbol's name and does
-// normally include things like visibility modifiers.
-//
-// The range doesn't have to denote a node range in the sense of an abstract
-// syntax tree. It can therefore not be used to re-construct a hierarchy of
-// the symbols.
-func (s *SymbolInformation) GetLocation() *Location {
-	if s == nil {
-		var zero *Location
+// The text document's language identifier.
+func (t *TextDocumentItem) GetLanguageId() string {
+	if t == nil {
+		var zero string
 		return zero
 	}
-	return s.Location
+	return t.LanguageId
 }
 
-type SymbolInformationSlice []*SymbolInformation
-
-// Represents programming constructs like variables, classes, interfaces etc.
-// that appear in a document. Document symbols can be hierarchical and they
-// have two ranges: one that encloses its definition and one that points to
-// its most interesting range, e.g. the range of an identifier.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentSymbol
-type DocumentSymbol struct {
-	// The name of this symbol. Will be displayed in the user interface and therefore must not be
-	// an empty string or a string only consisting of white spaces.
-	Name string `json:"name"`
-	// More detail for this symbol, e.g the signature of a function.
-	Detail string `json:"detail,omitempty"`
-	// The kind of this symbol.
-	Kind SymbolKind `json:"kind"`
-	// Tags for this document symbol.
-	//
-	// @since 3.16.0
-	Tags []SymbolTag `json:"tags,omitempty"`
-	// Indicates if this symbol is deprecated.
-	//
-	// Deprecated: Use tags instead
-	Deprecated bool `json:"deprecated,omitempty"`
-	// The range enclosing this symbol not including leading/trailing whitespace but everything else
-	// like comments. This information is typically used to determine if the clients cursor is
-	// inside the symbol to reveal in the symbol in the UI.
-	Range *Range `json:"range"`
-	// The range that should be selected and revealed when this symbol is being picked, e.g the name of a function.
-	// Must be contained by the `range`.
-	SelectionRange *Range `json:"selectionRange"`
-	// Children of this symbol, e.g. properties of a class.
-	Children []*DocumentSymbol `json:"children,omitempty"`
+// The version number of this document (it will increase after each
+// change, including undo/redo).
+func (t *TextDocumentItem) GetVersion() int {
+	if t == nil {
+		var zero int
+		return zero
+	}
+	return t.Version
 }
 
-// The name of this symbol. Will be displayed in the user interface and therefore must not be
-// an empty string or a string only consisting of white spaces.
-func (d *DocumentSymbol) GetName() string {
-	if d == nil {
+// The content of the opened text document.
+func (t *TextDocumentItem) GetText() string {
+	if t == nil {
 		var zero string
 		return zero
 	}
-	return d.Name
+	return t.Text
 }
 
-// More detail for this symbol, e.g the signature of a function.
-func (d *DocumentSymbol) GetDetail() string {
-	if d == nil {
-		var zero string
+// A parameter literal used in requests to pass a text document and a position inside that
+// document.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocumentPositionParams
+type TextDocumentPositionParams struct {
+	// The text document.
+	TextDocument *TextDocumentIdentifier `json:"textDocument"`
+	// The position inside the text document.
+	Position *Position `json:"position"`
+}
+
+// The text document.
+func (t *TextDocumentPositionParams) GetTextDocument() *TextDocumentIdentifier {
+	if t == nil {
+		var zero *TextDocumentIdentifier
 		return zero
 	}
-	return d.Detail
+	return t.TextDocument
 }
 
-// The kind of this symbol.
-func (d *DocumentSymbol) GetKind() SymbolKind {
-	if d == nil {
-		var zero SymbolKind
+// The position inside the text document.
+func (t *TextDocumentPositionParams) GetPosition() *Position {
+	if t == nil {
+		var zero *Position
 		return zero
 	}
-	return d.Kind
+	return t.Position
 }
 
-// Tags for this document symbol.
+// General text document registration options.
 //
-// @since 3.16.0
-func (d *DocumentSymbol) GetTags() []SymbolTag {
-	if d == nil {
-		var zero []SymbolTag
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocumentRegistrationOptions
+type TextDocumentRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector DocumentSelector `json:"documentSelector"`
+}
+
+// A document selector to identify the scope of the registration. If set to null
+// the document selector provided on the client side will be used.
+func (t *TextDocumentRegistrationOptions) GetDocumentSelector() DocumentSelector {
+	if t == nil {
+		var zero DocumentSelector
 		return zero
 	}
-	return d.Tags
+	return t.DocumentSelector
 }
 
-// Indicates if this symbol is deprecated.
-//
-// Deprecated: Use tags instead
-func (d *DocumentSymbol) GetDeprecated() bool {
-	if d == nil {
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocumentSyncClientCapabilities
+type TextDocumentSyncClientCapabilities struct {
+	// Whether text document synchronization supports dynamic registration.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	// The client supports sending will save notifications.
+	WillSave bool `json:"willSave,omitempty"`
+	// The client supports sending a will save request and
+	// waits for a response providing text edits which will
+	// be applied to the document before it is saved.
+	WillSaveWaitUntil bool `json:"willSaveWaitUntil,omitempty"`
+	// The client supports did save notifications.
+	DidSave bool `json:"didSave,omitempty"`
+}
+
+// Whether text document synchronization supports dynamic registration.
+func (t *TextDocumentSyncClientCapabilities) GetDynamicRegistration() bool {
+	if t == nil {
 		var zero bool
 		return zero
 	}
-	return d.Deprecated
+	return t.DynamicRegistration
 }
 
-// The range enclosing this symbol not including leading/trailing whitespace but everything else
-// like comments. This information is typically used to determine if the clients cursor is
-// inside the symbol to reveal in the symbol in the UI.
-func (d *DocumentSymbol) GetRange() *Range {
-	if d == nil {
-		var zero *Range
+// The client supports sending will save notifications.
+func (t *TextDocumentSyncClientCapabilities) GetWillSave() bool {
+	if t == nil {
+		var zero bool
 		return zero
 	}
-	return d.Range
+	return t.WillSave
 }
 
-// The range that should be selected and revealed when this symbol is being picked, e.g the name of a function.
-// Must be contained by the `range`.
-func (d *DocumentSymbol) GetSelectionRange() *Range {
-	if d == nil {
-		var zero *Range
+// The client supports sending a will save request and
+// waits for a response providing text edits which will
+// be applied to the document before it is saved.
+func (t *TextDocumentSyncClientCapabilities) GetWillSaveWaitUntil() bool {
+	if t == nil {
+		var zero bool
 		return zero
 	}
-	return d.SelectionRange
+	return t.WillSaveWaitUntil
 }
 
-// Children of this symbol, e.g. properties of a class.
-func (d *DocumentSymbol) GetChildren() []*DocumentSymbol {
-	if d == nil {
-		var zero []*DocumentSymbol
+// The client supports did save notifications.
+func (t *TextDocumentSyncClientCapabilities) GetDidSave() bool {
+	if t == nil {
+		var zero bool
 		return zero
 	}
-	return d.Children
+	return t.DidSave
 }
 
-type DocumentSymbolSlice []*DocumentSymbol
+// Defines how the host (editor) should sync
+// document changes to the language server.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocumentSyncKind
+type TextDocumentSyncKind uint32
 
-// SymbolInformationSliceOrDocumentSymbolSlice contains either of the following types:
-//   - [SymbolInformationSlice]
-//   - [DocumentSymbolSlice]
-type SymbolInformationSliceOrDocumentSymbolSlice struct {
-	Value SymbolInformationSliceOrDocumentSymbolSliceValue
-}
+const (
+	// Documents should not be synced at all.
+	TextDocumentSyncKindNone TextDocumentSyncKind = 0
+	// Documents are synced by always sending the full content
+	// of the document.
+	TextDocumentSyncKindFull TextDocumentSyncKind = 1
+	// Documents are synced by sending the full content on open.
+	// After that only incremental updates to the document are
+	// send.
+	TextDocumentSyncKindIncremental TextDocumentSyncKind = 2
+)
 
-// SymbolInformationSliceOrDocumentSymbolSliceValue is either of the following types:
-//   - [SymbolInformationSlice]
-//   - [DocumentSymbolSlice]
-//
-//sumtype:decl
-type SymbolInformationSliceOrDocumentSymbolSliceValue interface {
-	isSymbolInformationSliceOrDocumentSymbolSliceValue()
+var validTextDocumentSyncKindValues = map[uint32]bool{
+	0: true,
+	1: true,
+	2: true,
 }
 
-func (SymbolInformationSlice) isSymbolInformationSliceOrDocumentSymbolSliceValue() {}
-func (DocumentSymbolSlice) isSymbolInformationSliceOrDocumentSymbolSliceValue()    {}
-
-func (s *SymbolInformationSliceOrDocumentSymbolSlice) UnmarshalJSON(data []byte) error {
+func (t *TextDocumentSyncKind) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, []byte("null")) {
 		return nil
 	}
-	var symbolInformationSliceValue SymbolInformationSlice
-	if err := json.Unmarshal(data, &symbolInformationSliceValue); err == nil {
-		s.Value = symbolInformationSliceValue
-		return nil
-	}
-	var documentSymbolSliceValue DocumentSymbolSlice
-	if err := json.Unmarshal(data, &documentSymbolSliceValue); err == nil {
-		s.Value = documentSymbolSliceValue
-		return nil
+	var uint32Value uint32
+	if err := json.Unmarshal(data, &uint32Value); err != nil {
+		return err
 	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*SymbolInformationSliceOrDocumentSymbolSlice](),
+	if !validTextDocumentSyncKindValues[uint32Value] {
+		return fmt.Errorf("cannot unmarshal %v into TextDocumentSyncKind: custom values are not supported", uint32Value)
 	}
-}
+	*t = TextDocumentSyncKind(uint32Value)
 
-func (s *SymbolInformationSliceOrDocumentSymbolSlice) MarshalJSON() ([]byte, error) {
-	return json.Marshal(s.Value)
+	return nil
 }
 
-// Value-object describing what options formatting should use.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#formattingOptions
-type FormattingOptions struct {
-	// Size of a tab in spaces.
-	TabSize int `json:"tabSize"`
-	// Prefer spaces over tabs.
-	InsertSpaces bool `json:"insertSpaces"`
-	// Trim trailing whitespace on a line.
-	//
-	// @since 3.15.0
-	TrimTrailingWhitespace bool `json:"trimTrailingWhitespace,omitempty"`
-	// Insert a newline character at the end of the file if one does not exist.
-	//
-	// @since 3.15.0
-	InsertFinalNewline bool `json:"insertFinalNewline,omitempty"`
-	// Trim all newlines after the final newline at the end of the file.
-	//
-	// @since 3.15.0
-	TrimFinalNewlines bool `json:"trimFinalNewlines,omitempty"`
+func (t TextDocumentSyncKind) MarshalJSON() ([]byte, error) {
+	var uint32Value = uint32(t)
+	if !validTextDocumentSyncKindValues[uint32Value] {
+		return nil, fmt.Errorf("cannot marshal %v into TextDocumentSyncKind: custom values are not supported", uint32Value)
+	}
+	return json.Marshal(uint32Value)
+
 }
 
-// Size of a tab in spaces.
-func (f *FormattingOptions) GetTabSize() int {
-	if f == nil {
-		var zero int
-		return zero
-	}
-	return f.TabSize
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocumentSyncOptions
+type TextDocumentSyncOptions struct {
+	// Open and close notifications are sent to the server. If omitted open close notification should not
+	// be sent.
+	OpenClose bool `json:"openClose,omitempty"`
+	// Change notifications are sent to the server. See TextDocumentSyncKind.None, TextDocumentSyncKind.Full
+	// and TextDocumentSyncKind.Incremental. If omitted it defaults to TextDocumentSyncKind.None.
+	Change TextDocumentSyncKind `json:"change,omitempty"`
+	// If present will save notifications are sent to the server. If omitted the notification should not be
+	// sent.
+	WillSave bool `json:"willSave,omitempty"`
+	// If present will save wait until requests are sent to the server. If omitted the request should not be
+	// sent.
+	WillSaveWaitUntil bool `json:"willSaveWaitUntil,omitempty"`
+	// If present save notifications are sent to the server. If omitted the notification should not be
+	// sent.
+	Save *BooleanOrSaveOptions `json:"save,omitempty"`
 }
 
-// Prefer spaces over tabs.
-func (f *FormattingOptions) GetInsertSpaces() bool {
-	if f == nil {
+// Open and close notifications are sent to the server. If omitted open close notification should not
+// be sent.
+func (t *TextDocumentSyncOptions) GetOpenClose() bool {
+	if t == nil {
 		var zero bool
 		return zero
 	}
-	return f.InsertSpaces
+	return t.OpenClose
 }
 
-// Trim trailing whitespace on a line.
-//
-// @since 3.15.0
-func (f *FormattingOptions) GetTrimTrailingWhitespace() bool {
-	if f == nil {
-		var zero bool
+// Change notifications are sent to the server. See TextDocumentSyncKind.None, TextDocumentSyncKind.Full
+// and TextDocumentSyncKind.Incremental. If omitted it defaults to TextDocumentSyncKind.None.
+func (t *TextDocumentSyncOptions) GetChange() TextDocumentSyncKind {
+	if t == nil {
+		var zero TextDocumentSyncKind
 		return zero
 	}
-	return f.TrimTrailingWhitespace
+	return t.Change
 }
 
-// Insert a newline character at the end of the file if one does not exist.
-//
-// @since 3.15.0
-func (f *FormattingOptions) GetInsertFinalNewline() bool {
-	if f == nil {
+// If present will save notifications are sent to the server. If omitted the notification should not be
+// sent.
+func (t *TextDocumentSyncOptions) GetWillSave() bool {
+	if t == nil {
 		var zero bool
 		return zero
 	}
-	return f.InsertFinalNewline
+	return t.WillSave
 }
 
-// Trim all newlines after the final newline at the end of the file.
-//
-// @since 3.15.0
-func (f *FormattingOptions) GetTrimFinalNewlines() bool {
-	if f == nil {
+// If present will save wait until requests are sent to the server. If omitted the request should not be
+// sent.
+func (t *TextDocumentSyncOptions) GetWillSaveWaitUntil() bool {
+	if t == nil {
 		var zero bool
 		return zero
 	}
-	return f.TrimFinalNewlines
-}
-
-// The parameters of a {@link DocumentFormattingRequest}.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#documentFormattingParams
-type DocumentFormattingParams struct {
-	*WorkDoneProgressParams
-	// The document to format.
-	TextDocument *TextDocumentIdentifier `json:"textDocument"`
-	// The format options.
-	Options *FormattingOptions `json:"options"`
+	return t.WillSaveWaitUntil
 }
 
-// The document to format.
-func (d *DocumentFormattingParams) GetTextDocument() *TextDocumentIdentifier {
-	if d == nil {
-		var zero *TextDocumentIdentifier
+// If present save notifications are sent to the server. If omitted the notification should not be
+// sent.
+func (t *TextDocumentSyncOptions) GetSave() *BooleanOrSaveOptions {
+	if t == nil {
+		var zero *BooleanOrSaveOptions
 		return zero
 	}
-	return d.TextDocument
+	return t.Save
 }
 
-// The format options.
-func (d *DocumentFormattingParams) GetOptions() *FormattingOptions {
-	if d == nil {
-		var zero *FormattingOptions
-		return zero
-	}
-	return d.Options
+// TextDocumentSyncOptionsOrTextDocumentSyncKind contains either of the following types:
+//   - [*TextDocumentSyncOptions]
+//   - [TextDocumentSyncKind]
+type TextDocumentSyncOptionsOrTextDocumentSyncKind struct {
+	Value TextDocumentSyncOptionsOrTextDocumentSyncKindValue
 }
 
-// The parameters of a {@link RenameRequest}.
+// TextDocumentSyncOptionsOrTextDocumentSyncKindValue is either of the following types:
+//   - [*TextDocumentSyncOptions]
+//   - [TextDocumentSyncKind]
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#renameParams
-type RenameParams struct {
-	*WorkDoneProgressParams
-	// The document to rename.
-	TextDocument *TextDocumentIdentifier `json:"textDocument"`
-	// The position at which this request was sent.
-	Position *Position `json:"position"`
-	// The new name of the symbol. If the given name is not valid the
-	// request must return a {@link ResponseError} with an
-	// appropriate message set.
-	NewName string `json:"newName"`
+//sumtype:decl
+type TextDocumentSyncOptionsOrTextDocumentSyncKindValue interface {
+	isTextDocumentSyncOptionsOrTextDocumentSyncKindValue()
 }
 
-// The document to rename.
-func (r *RenameParams) GetTextDocument() *TextDocumentIdentifier {
-	if r == nil {
-		var zero *TextDocumentIdentifier
-		return zero
-	}
-	return r.TextDocument
-}
+func (*TextDocumentSyncOptions) isTextDocumentSyncOptionsOrTextDocumentSyncKindValue() {}
+func (TextDocumentSyncKind) isTextDocumentSyncOptionsOrTextDocumentSyncKindValue()     {}
 
-// The position at which this request was sent.
-func (r *RenameParams) GetPosition() *Position {
-	if r == nil {
-		var zero *Position
-		return zero
+func (t *TextDocumentSyncOptionsOrTextDocumentSyncKind) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
 	}
-	return r.Position
-}
-
-// The new name of the symbol. If the given name is not valid the
-// request must return a {@link ResponseError} with an
-// appropriate message set.
-func (r *RenameParams) GetNewName() string {
-	if r == nil {
-		var zero string
-		return zero
+	var textDocumentSyncOptionsValue *TextDocumentSyncOptions
+	if err := json.Unmarshal(data, &textDocumentSyncOptionsValue); err == nil {
+		t.Value = textDocumentSyncOptionsValue
+		return nil
+	}
+	var textDocumentSyncKindValue TextDocumentSyncKind
+	if err := json.Unmarshal(data, &textDocumentSyncKindValue); err == nil {
+		t.Value = textDocumentSyncKindValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*TextDocumentSyncOptionsOrTextDocumentSyncKind](),
 	}
-	return r.NewName
 }
 
-// A text document identifier to optionally denote a specific version of a text document.
+func (t *TextDocumentSyncOptionsOrTextDocumentSyncKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// A text edit applicable to a text document.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#optionalVersionedTextDocumentIdentifier
-type OptionalVersionedTextDocumentIdentifier struct {
-	*TextDocumentIdentifier
-	// The version number of this document. If a versioned text document identifier
-	// is sent from the server to the client and the file is not open in the editor
-	// (the server has not received an open notification before) the server can send
-	// `null` to indicate that the version is unknown and the content on disk is the
-	// truth (as specified with document content ownership).
-	Version int `json:"version"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textEdit
+type TextEdit struct {
+	// The range of the text document to be manipulated. To insert
+	// text into a document create a range where start === end.
+	Range *Range `json:"range"`
+	// The string to be inserted. For delete operations use an
+	// empty string.
+	NewText string `json:"newText"`
 }
 
-// The version number of this document. If a versioned text document identifier
-// is sent from the server to the client and the file is not open in the editor
-// (the server has not received an open notification before) the server can send
-// `null` to indicate that the version is unknown and the content on disk is the
-// truth (as specified with document content ownership).
-func (o *OptionalVersionedTextDocumentIdentifier) GetVersion() int {
-	if o == nil {
-		var zero int
+// The range of the text document to be manipulated. To insert
+// text into a document create a range where start === end.
+func (t *TextEdit) GetRange() *Range {
+	if t == nil {
+		var zero *Range
 		return zero
 	}
-	return o.Version
-}
-
-// An identifier to refer to a change annotation stored with a workspace edit.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#changeAnnotationIdentifier
-type ChangeAnnotationIdentifier = string
-
-// A special text edit with an additional change annotation.
-//
-// @since 3.16.0.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#annotatedTextEdit
-type AnnotatedTextEdit struct {
-	*TextEdit
-	// The actual identifier of the change annotation
-	AnnotationId ChangeAnnotationIdentifier `json:"annotationId"`
+	return t.Range
 }
 
-// The actual identifier of the change annotation
-func (a *AnnotatedTextEdit) GetAnnotationId() ChangeAnnotationIdentifier {
-	if a == nil {
-		var zero ChangeAnnotationIdentifier
+// The string to be inserted. For delete operations use an
+// empty string.
+func (t *TextEdit) GetNewText() string {
+	if t == nil {
+		var zero string
 		return zero
 	}
-	return a.AnnotationId
+	return t.NewText
 }
 
 // TextEditOrAnnotatedTextEdit contains either of the following types:
@@ -11237,461 +12105,707 @@ func (t *TextEditOrAnnotatedTextEdit) UnmarshalJSON(data []byte) error {
 	}
 }
 
-func (t *TextEditOrAnnotatedTextEdit) MarshalJSON() ([]byte, error) {
-	return json.Marshal(t.Value)
-}
-
-// Describes textual changes on a text document. A TextDocumentEdit describes all changes
-// on a document version Si and after they are applied move the document to version Si+1.
-// So the creator of a TextDocumentEdit doesn't need to sort the array of edits or do any
-// kind of ordering. However the edits must be non overlapping.
+func (t *TextEditOrAnnotatedTextEdit) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// TextEditOrInsertReplaceEdit contains either of the following types:
+//   - [*TextEdit]
+//   - [*InsertReplaceEdit]
+type TextEditOrInsertReplaceEdit struct {
+	Value TextEditOrInsertReplaceEditValue
+}
+
+// TextEditOrInsertReplaceEditValue is either of the following types:
+//   - [*TextEdit]
+//   - [*InsertReplaceEdit]
+//
+//sumtype:decl
+type TextEditOrInsertReplaceEditValue interface {
+	isTextEditOrInsertReplaceEditValue()
+}
+
+func (*TextEdit) isTextEditOrInsertReplaceEditValue()          {}
+func (*InsertReplaceEdit) isTextEditOrInsertReplaceEditValue() {}
+
+func (t *TextEditOrInsertReplaceEdit) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var textEditValue *TextEdit
+	if err := json.Unmarshal(data, &textEditValue); err == nil {
+		t.Value = textEditValue
+		return nil
+	}
+	var insertReplaceEditValue *InsertReplaceEdit
+	if err := json.Unmarshal(data, &insertReplaceEditValue); err == nil {
+		t.Value = insertReplaceEditValue
+		return nil
+	}
+	return &json.UnmarshalTypeError{
+		Value: string(data),
+		Type:  reflect.TypeFor[*TextEditOrInsertReplaceEdit](),
+	}
+}
+
+func (t *TextEditOrInsertReplaceEdit) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#tokenFormat
+type TokenFormat string
+
+const (
+	TokenFormatRelative TokenFormat = "relative"
+)
+
+var validTokenFormatValues = map[string]bool{
+	"relative": true,
+}
+
+func (t *TokenFormat) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var stringValue string
+	if err := json.Unmarshal(data, &stringValue); err != nil {
+		return err
+	}
+	if !validTokenFormatValues[stringValue] {
+		return fmt.Errorf("cannot unmarshal %v into TokenFormat: custom values are not supported", stringValue)
+	}
+	*t = TokenFormat(stringValue)
+
+	return nil
+}
+
+func (t TokenFormat) MarshalJSON() ([]byte, error) {
+	var stringValue = string(t)
+	if !validTokenFormatValues[stringValue] {
+		return nil, fmt.Errorf("cannot marshal %v into TokenFormat: custom values are not supported", stringValue)
+	}
+	return json.Marshal(stringValue)
+
+}
+
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#traceValues
+type TraceValues string
+
+const (
+	// Turn tracing off.
+	TraceValuesOff TraceValues = "off"
+	// Trace messages only.
+	TraceValuesMessages TraceValues = "messages"
+	// Verbose message tracing.
+	TraceValuesVerbose TraceValues = "verbose"
+)
+
+var validTraceValuesValues = map[string]bool{
+	"off":      true,
+	"messages": true,
+	"verbose":  true,
+}
+
+func (t *TraceValues) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var stringValue string
+	if err := json.Unmarshal(data, &stringValue); err != nil {
+		return err
+	}
+	if !validTraceValuesValues[stringValue] {
+		return fmt.Errorf("cannot unmarshal %v into TraceValues: custom values are not supported", stringValue)
+	}
+	*t = TraceValues(stringValue)
+
+	return nil
+}
+
+func (t TraceValues) MarshalJSON() ([]byte, error) {
+	var stringValue = string(t)
+	if !validTraceValuesValues[stringValue] {
+		return nil, fmt.Errorf("cannot marshal %v into TraceValues: custom values are not supported", stringValue)
+	}
+	return json.Marshal(stringValue)
+
+}
+
+// Since 3.6.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#typeDefinitionClientCapabilities
+type TypeDefinitionClientCapabilities struct {
+	// Whether implementation supports dynamic registration. If this is set to `true`
+	// the client supports the new `TypeDefinitionRegistrationOptions` return value
+	// for the corresponding server capability as well.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	// The client supports additional metadata in the form of definition links.
+	//
+	// Since 3.14.0
+	LinkSupport bool `json:"linkSupport,omitempty"`
+}
+
+// Whether implementation supports dynamic registration. If this is set to `true`
+// the client supports the new `TypeDefinitionRegistrationOptions` return value
+// for the corresponding server capability as well.
+func (t *TypeDefinitionClientCapabilities) GetDynamicRegistration() bool {
+	if t == nil {
+		var zero bool
+		return zero
+	}
+	return t.DynamicRegistration
+}
+
+// The client supports additional metadata in the form of definition links.
+//
+// Since 3.14.0
+func (t *TypeDefinitionClientCapabilities) GetLinkSupport() bool {
+	if t == nil {
+		var zero bool
+		return zero
+	}
+	return t.LinkSupport
+}
+
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#typeDefinitionOptions
+type TypeDefinitionOptions struct {
+	*WorkDoneProgressOptions
+}
+
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#typeDefinitionRegistrationOptions
+type TypeDefinitionRegistrationOptions struct {
+	*TextDocumentRegistrationOptions
+	*TypeDefinitionOptions
+	*StaticRegistrationOptions
+}
+
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#typeHierarchyClientCapabilities
+type TypeHierarchyClientCapabilities struct {
+	// Whether implementation supports dynamic registration. If this is set to `true`
+	// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
+	// return value for the corresponding server capability as well.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+}
+
+// Whether implementation supports dynamic registration. If this is set to `true`
+// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
+// return value for the corresponding server capability as well.
+func (t *TypeHierarchyClientCapabilities) GetDynamicRegistration() bool {
+	if t == nil {
+		var zero bool
+		return zero
+	}
+	return t.DynamicRegistration
+}
+
+// @since 3.17.0
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocumentEdit
-type TextDocumentEdit struct {
-	// The text document to change.
-	TextDocument *OptionalVersionedTextDocumentIdentifier `json:"textDocument"`
-	// The edits to be applied.
-	//
-	// @since 3.16.0 - support for AnnotatedTextEdit. This is guarded using a
-	// client capability.
-	Edits []*TextEditOrAnnotatedTextEdit `json:"edits"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#typeHierarchyItem
+type TypeHierarchyItem struct {
+	// The name of this item.
+	Name string `json:"name"`
+	// The kind of this item.
+	Kind SymbolKind `json:"kind"`
+	// Tags for this item.
+	Tags []SymbolTag `json:"tags,omitempty"`
+	// More detail for this item, e.g. the signature of a function.
+	Detail string `json:"detail,omitempty"`
+	// The resource identifier of this item.
+	Uri string `json:"uri"`
+	// The range enclosing this symbol not including leading/trailing whitespace
+	// but everything else, e.g. comments and code.
+	Range *Range `json:"range"`
+	// The range that should be selected and revealed when this symbol is being
+	// picked, e.g. the name of a function. Must be contained by the
+	// {@link TypeHierarchyItem.range `range`}.
+	SelectionRange *Range `json:"selectionRange"`
+	// A data entry field that is preserved between a type hierarchy prepare and
+	// supertypes or subtypes requests. It could also be used to identify the
+	// type hierarchy in the server, helping improve the performance on
+	// resolving supertypes and subtypes.
+	Data LSPAny `json:"data,omitempty"`
 }
 
-// The text document to change.
-func (t *TextDocumentEdit) GetTextDocument() *OptionalVersionedTextDocumentIdentifier {
+// The name of this item.
+func (t *TypeHierarchyItem) GetName() string {
 	if t == nil {
-		var zero *OptionalVersionedTextDocumentIdentifier
+		var zero string
 		return zero
 	}
-	return t.TextDocument
+	return t.Name
 }
 
-// The edits to be applied.
-//
-// @since 3.16.0 - support for AnnotatedTextEdit. This is guarded using a
-// client capability.
-func (t *TextDocumentEdit) GetEdits() []*TextEditOrAnnotatedTextEdit {
+// The kind of this item.
+func (t *TypeHierarchyItem) GetKind() SymbolKind {
 	if t == nil {
-		var zero []*TextEditOrAnnotatedTextEdit
+		var zero SymbolKind
 		return zero
 	}
-	return t.Edits
+	return t.Kind
 }
 
-// A generic resource operation.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#resourceOperation
-type ResourceOperation struct {
-	// The resource operation kind.
-	Kind string `json:"kind"`
-	// An optional annotation identifier describing the operation.
-	//
-	// @since 3.16.0
-	AnnotationId ChangeAnnotationIdentifier `json:"annotationId,omitempty"`
+// Tags for this item.
+func (t *TypeHierarchyItem) GetTags() []SymbolTag {
+	if t == nil {
+		var zero []SymbolTag
+		return zero
+	}
+	return t.Tags
 }
 
-// The resource operation kind.
-func (r *ResourceOperation) GetKind() string {
-	if r == nil {
+// More detail for this item, e.g. the signature of a function.
+func (t *TypeHierarchyItem) GetDetail() string {
+	if t == nil {
 		var zero string
 		return zero
 	}
-	return r.Kind
+	return t.Detail
 }
 
-// An optional annotation identifier describing the operation.
-//
-// @since 3.16.0
-func (r *ResourceOperation) GetAnnotationId() ChangeAnnotationIdentifier {
-	if r == nil {
-		var zero ChangeAnnotationIdentifier
+// The resource identifier of this item.
+func (t *TypeHierarchyItem) GetUri() string {
+	if t == nil {
+		var zero string
 		return zero
 	}
-	return r.AnnotationId
+	return t.Uri
 }
 
-type CreateFileKind struct{}
-
-func (CreateFileKind) String() string {
-	return "create"
+// The range enclosing this symbol not including leading/trailing whitespace
+// but everything else, e.g. comments and code.
+func (t *TypeHierarchyItem) GetRange() *Range {
+	if t == nil {
+		var zero *Range
+		return zero
+	}
+	return t.Range
 }
 
-func (*CreateFileKind) UnmarshalJSON(data []byte) error {
-	var value string
-	if err := json.Unmarshal(data, &value); err != nil {
-		return err
-	}
-	if value != "create" {
-		return fmt.Errorf("cannot unmarshal %v into CreateFileKind: value must be create", value)
+// The range that should be selected and revealed when this symbol is being
+// picked, e.g. the name of a function. Must be contained by the
+// {@link TypeHierarchyItem.range `range`}.
+func (t *TypeHierarchyItem) GetSelectionRange() *Range {
+	if t == nil {
+		var zero *Range
+		return zero
 	}
-	return nil
+	return t.SelectionRange
 }
 
-func (CreateFileKind) MarshalJSON() ([]byte, error) {
-	return json.Marshal("create")
+// A data entry field that is preserved between a type hierarchy prepare and
+// supertypes or subtypes requests. It could also be used to identify the
+// type hierarchy in the server, helping improve the performance on
+// resolving supertypes and subtypes.
+func (t *TypeHierarchyItem) GetData() LSPAny {
+	if t == nil {
+		var zero LSPAny
+		return zero
+	}
+	return t.Data
 }
 
-// Options to create a file.
+type TypeHierarchyItemSlice []*TypeHierarchyItem
+
+// Type hierarchy options used during static registration.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#createFileOptions
-type CreateFileOptions struct {
-	// Overwrite existing file. Overwrite wins over `ignoreIfExists`
-	Overwrite bool `json:"overwrite,omitempty"`
-	// Ignore if exists.
-	IgnoreIfExists bool `json:"ignoreIfExists,omitempty"`
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#typeHierarchyOptions
+type TypeHierarchyOptions struct {
+	*WorkDoneProgressOptions
 }
 
-// Overwrite existing file. Overwrite wins over `ignoreIfExists`
-func (c *CreateFileOptions) GetOverwrite() bool {
-	if c == nil {
-		var zero bool
-		return zero
-	}
-	return c.Overwrite
+// The parameter of a `textDocument/prepareTypeHierarchy` request.
+//
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#typeHierarchyPrepareParams
+type TypeHierarchyPrepareParams struct {
+	*TextDocumentPositionParams
+	*WorkDoneProgressParams
 }
 
-// Ignore if exists.
-func (c *CreateFileOptions) GetIgnoreIfExists() bool {
-	if c == nil {
-		var zero bool
-		return zero
-	}
-	return c.IgnoreIfExists
+// Type hierarchy options used during static or dynamic registration.
+//
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#typeHierarchyRegistrationOptions
+type TypeHierarchyRegistrationOptions struct {
+	*TextDocumentRegistrationOptions
+	*TypeHierarchyOptions
+	*StaticRegistrationOptions
 }
 
-// Create file operation.
+// The parameter of a `typeHierarchy/subtypes` request.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#createFile
-type CreateFile struct {
-	*ResourceOperation
-	// A create
-	Kind CreateFileKind `json:"kind"`
-	// The resource to create.
-	Uri string `json:"uri"`
-	// Additional options
-	Options *CreateFileOptions `json:"options,omitempty"`
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#typeHierarchySubtypesParams
+type TypeHierarchySubtypesParams struct {
+	*WorkDoneProgressParams
+	*PartialResultParams
+	Item *TypeHierarchyItem `json:"item"`
 }
 
-// A create
-func (c *CreateFile) GetKind() CreateFileKind {
-	if c == nil {
-		var zero CreateFileKind
+func (t *TypeHierarchySubtypesParams) GetItem() *TypeHierarchyItem {
+	if t == nil {
+		var zero *TypeHierarchyItem
 		return zero
 	}
-	return c.Kind
+	return t.Item
 }
 
-// The resource to create.
-func (c *CreateFile) GetUri() string {
-	if c == nil {
-		var zero string
-		return zero
-	}
-	return c.Uri
+// The parameter of a `typeHierarchy/supertypes` request.
+//
+// @since 3.17.0
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#typeHierarchySupertypesParams
+type TypeHierarchySupertypesParams struct {
+	*WorkDoneProgressParams
+	*PartialResultParams
+	Item *TypeHierarchyItem `json:"item"`
 }
 
-// Additional options
-func (c *CreateFile) GetOptions() *CreateFileOptions {
-	if c == nil {
-		var zero *CreateFileOptions
+func (t *TypeHierarchySupertypesParams) GetItem() *TypeHierarchyItem {
+	if t == nil {
+		var zero *TypeHierarchyItem
 		return zero
 	}
-	return c.Options
+	return t.Item
 }
 
-type RenameFileKind struct{}
+type Uinteger int
 
-func (RenameFileKind) String() string {
-	return "rename"
+// A text document identifier to denote a specific version of a text document.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#versionedTextDocumentIdentifier
+type VersionedTextDocumentIdentifier struct {
+	*TextDocumentIdentifier
+	// The version number of this document.
+	Version int `json:"version"`
 }
 
-func (*RenameFileKind) UnmarshalJSON(data []byte) error {
-	var value string
-	if err := json.Unmarshal(data, &value); err != nil {
-		return err
-	}
-	if value != "rename" {
-		return fmt.Errorf("cannot unmarshal %v into RenameFileKind: value must be rename", value)
+// The version number of this document.
+func (v *VersionedTextDocumentIdentifier) GetVersion() int {
+	if v == nil {
+		var zero int
+		return zero
 	}
-	return nil
+	return v.Version
 }
 
-func (RenameFileKind) MarshalJSON() ([]byte, error) {
-	return json.Marshal("rename")
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#windowClientCapabilities
+type WindowClientCapabilities struct {
+	// It indicates whether the client supports server initiated
+	// progress using the `window/workDoneProgress/create` request.
+	//
+	// The capability also controls Whether client supports handling
+	// of progress notifications. If set servers are allowed to report a
+	// `workDoneProgress` property in the request specific server
+	// capabilities.
+	//
+	// @since 3.15.0
+	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
+	// Capabilities specific to the showMessage request.
+	//
+	// @since 3.16.0
+	ShowMessage *ShowMessageRequestClientCapabilities `json:"showMessage,omitempty"`
+	// Capabilities specific to the showDocument request.
+	//
+	// @since 3.16.0
+	ShowDocument *ShowDocumentClientCapabilities `json:"showDocument,omitempty"`
 }
 
-// Rename file options
+// It indicates whether the client supports server initiated
+// progress using the `window/workDoneProgress/create` request.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#renameFileOptions
-type RenameFileOptions struct {
-	// Overwrite target if existing. Overwrite wins over `ignoreIfExists`
-	Overwrite bool `json:"overwrite,omitempty"`
-	// Ignores if target exists.
-	IgnoreIfExists bool `json:"ignoreIfExists,omitempty"`
-}
-
-// Overwrite target if existing. Overwrite wins over `ignoreIfExists`
-func (r *RenameFileOptions) GetOverwrite() bool {
-	if r == nil {
-		var zero bool
-		return zero
-	}
-	return r.Overwrite
-}
-
-// Ignores if target exists.
-func (r *RenameFileOptions) GetIgnoreIfExists() bool {
-	if r == nil {
+// The capability also controls Whether client supports handling
+// of progress notifications. If set servers are allowed to report a
+// `workDoneProgress` property in the request specific server
+// capabilities.
+//
+// @since 3.15.0
+func (w *WindowClientCapabilities) GetWorkDoneProgress() bool {
+	if w == nil {
 		var zero bool
 		return zero
 	}
-	return r.IgnoreIfExists
+	return w.WorkDoneProgress
 }
 
-// Rename file operation
+// Capabilities specific to the showMessage request.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#renameFile
-type RenameFile struct {
-	*ResourceOperation
-	// A rename
-	Kind RenameFileKind `json:"kind"`
-	// The old (existing) location.
-	OldUri string `json:"oldUri"`
-	// The new location.
-	NewUri string `json:"newUri"`
-	// Rename options.
-	Options *RenameFileOptions `json:"options,omitempty"`
-}
-
-// A rename
-func (r *RenameFile) GetKind() RenameFileKind {
-	if r == nil {
-		var zero RenameFileKind
+// @since 3.16.0
+func (w *WindowClientCapabilities) GetShowMessage() *ShowMessageRequestClientCapabilities {
+	if w == nil {
+		var zero *ShowMessageRequestClientCapabilities
 		return zero
 	}
-	return r.Kind
+	return w.ShowMessage
 }
 
-// The old (existing) location.
-func (r *RenameFile) GetOldUri() string {
-	if r == nil {
-		var zero string
+// Capabilities specific to the showDocument request.
+//
+// @since 3.16.0
+func (w *WindowClientCapabilities) GetShowDocument() *ShowDocumentClientCapabilities {
+	if w == nil {
+		var zero *ShowDocumentClientCapabilities
 		return zero
 	}
-	return r.OldUri
+	return w.ShowDocument
 }
 
-// The new location.
-func (r *RenameFile) GetNewUri() string {
-	if r == nil {
-		var zero string
-		return zero
-	}
-	return r.NewUri
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#workDoneProgressOptions
+type WorkDoneProgressOptions struct {
+	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
 }
 
-// Rename options.
-func (r *RenameFile) GetOptions() *RenameFileOptions {
-	if r == nil {
-		var zero *RenameFileOptions
+func (w *WorkDoneProgressOptions) GetWorkDoneProgress() bool {
+	if w == nil {
+		var zero bool
 		return zero
 	}
-	return r.Options
+	return w.WorkDoneProgress
 }
 
-type DeleteFileKind struct{}
-
-func (DeleteFileKind) String() string {
-	return "delete"
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#workDoneProgressParams
+type WorkDoneProgressParams struct {
+	// An optional token that a server can use to report work done progress.
+	WorkDoneToken ProgressToken `json:"workDoneToken,omitempty"`
 }
 
-func (*DeleteFileKind) UnmarshalJSON(data []byte) error {
-	var value string
-	if err := json.Unmarshal(data, &value); err != nil {
-		return err
-	}
-	if value != "delete" {
-		return fmt.Errorf("cannot unmarshal %v into DeleteFileKind: value must be delete", value)
+// An optional token that a server can use to report work done progress.
+func (w *WorkDoneProgressParams) GetWorkDoneToken() ProgressToken {
+	if w == nil {
+		var zero ProgressToken
+		return zero
 	}
-	return nil
-}
-
-func (DeleteFileKind) MarshalJSON() ([]byte, error) {
-	return json.Marshal("delete")
+	return w.WorkDoneToken
 }
 
-// Delete file options
+// Workspace specific client capabilities.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#deleteFileOptions
-type DeleteFileOptions struct {
-	// Delete the content recursively if a folder is denoted.
-	Recursive bool `json:"recursive,omitempty"`
-	// Ignore the operation if the file doesn't exist.
-	IgnoreIfNotExists bool `json:"ignoreIfNotExists,omitempty"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#workspaceClientCapabilities
+type WorkspaceClientCapabilities struct {
+	// The client supports applying batch edits
+	// to the workspace by supporting the request
+	// 'workspace/applyEdit'
+	ApplyEdit bool `json:"applyEdit,omitempty"`
+	// Capabilities specific to `WorkspaceEdit`s.
+	WorkspaceEdit *WorkspaceEditClientCapabilities `json:"workspaceEdit,omitempty"`
+	// Capabilities specific to the `workspace/didChangeConfiguration` notification.
+	DidChangeConfiguration *DidChangeConfigurationClientCapabilities `json:"didChangeConfiguration,omitempty"`
+	// Capabilities specific to the `workspace/didChangeWatchedFiles` notification.
+	DidChangeWatchedFiles *DidChangeWatchedFilesClientCapabilities `json:"didChangeWatchedFiles,omitempty"`
+	// Capabilities specific to the `workspace/symbol` request.
+	Symbol *WorkspaceSymbolClientCapabilities `json:"symbol,omitempty"`
+	// Capabilities specific to the `workspace/executeCommand` request.
+	ExecuteCommand *ExecuteCommandClientCapabilities `json:"executeCommand,omitempty"`
+	// The client has support for workspace folders.
+	//
+	// @since 3.6.0
+	WorkspaceFolders bool `json:"workspaceFolders,omitempty"`
+	// The client supports `workspace/configuration` requests.
+	//
+	// @since 3.6.0
+	Configuration bool `json:"configuration,omitempty"`
+	// Capabilities specific to the semantic token requests scoped to the
+	// workspace.
+	//
+	// @since 3.16.0.
+	SemanticTokens *SemanticTokensWorkspaceClientCapabilities `json:"semanticTokens,omitempty"`
+	// Capabilities specific to the code lens requests scoped to the
+	// workspace.
+	//
+	// @since 3.16.0.
+	CodeLens *CodeLensWorkspaceClientCapabilities `json:"codeLens,omitempty"`
+	// The client has support for file notifications/requests for user operations on files.
+	//
+	// Since 3.16.0
+	FileOperations *FileOperationClientCapabilities `json:"fileOperations,omitempty"`
+	// Capabilities specific to the inline values requests scoped to the
+	// workspace.
+	//
+	// @since 3.17.0.
+	InlineValue *InlineValueWorkspaceClientCapabilities `json:"inlineValue,omitempty"`
+	// Capabilities specific to the inlay hint requests scoped to the
+	// workspace.
+	//
+	// @since 3.17.0.
+	InlayHint *InlayHintWorkspaceClientCapabilities `json:"inlayHint,omitempty"`
+	// Capabilities specific to the diagnostic requests scoped to the
+	// workspace.
+	//
+	// @since 3.17.0.
+	Diagnostics *DiagnosticWorkspaceClientCapabilities `json:"diagnostics,omitempty"`
+	// Capabilities specific to the folding range requests scoped to the workspace.
+	//
+	// @since 3.18.0
+	// @proposed
+	FoldingRange *FoldingRangeWorkspaceClientCapabilities `json:"foldingRange,omitempty"`
 }
 
-// Delete the content recursively if a folder is denoted.
-func (d *DeleteFileOptions) GetRecursive() bool {
-	if d == nil {
+// The client supports applying batch edits
+// to the workspace by supporting the request
+// 'workspace/applyEdit'
+func (w *WorkspaceClientCapabilities) GetApplyEdit() bool {
+	if w == nil {
 		var zero bool
 		return zero
 	}
-	return d.Recursive
+	return w.ApplyEdit
 }
 
-// Ignore the operation if the file doesn't exist.
-func (d *DeleteFileOptions) GetIgnoreIfNotExists() bool {
-	if d == nil {
-		var zero bool
+// Capabilities specific to `WorkspaceEdit`s.
+func (w *WorkspaceClientCapabilities) GetWorkspaceEdit() *WorkspaceEditClientCapabilities {
+	if w == nil {
+		var zero *WorkspaceEditClientCapabilities
 		return zero
 	}
-	return d.IgnoreIfNotExists
-}
-
-// Delete file operation
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#deleteFile
-type DeleteFile struct {
-	*ResourceOperation
-	// A delete
-	Kind DeleteFileKind `json:"kind"`
-	// The file to delete.
-	Uri string `json:"uri"`
-	// Delete options.
-	Options *DeleteFileOptions `json:"options,omitempty"`
+	return w.WorkspaceEdit
 }
 
-// A delete
-func (d *DeleteFile) GetKind() DeleteFileKind {
-	if d == nil {
-		var zero DeleteFileKind
+// Capabilities specific to the `workspace/didChangeConfiguration` notification.
+func (w *WorkspaceClientCapabilities) GetDidChangeConfiguration() *DidChangeConfigurationClientCapabilities {
+	if w == nil {
+		var zero *DidChangeConfigurationClientCapabilities
 		return zero
 	}
-	return d.Kind
+	return w.DidChangeConfiguration
 }
 
-// The file to delete.
-func (d *DeleteFile) GetUri() string {
-	if d == nil {
-		var zero string
+// Capabilities specific to the `workspace/didChangeWatchedFiles` notification.
+func (w *WorkspaceClientCapabilities) GetDidChangeWatchedFiles() *DidChangeWatchedFilesClientCapabilities {
+	if w == nil {
+		var zero *DidChangeWatchedFilesClientCapabilities
 		return zero
 	}
-	return d.Uri
+	return w.DidChangeWatchedFiles
 }
 
-// Delete options.
-func (d *DeleteFile) GetOptions() *DeleteFileOptions {
-	if d == nil {
-		var zero *DeleteFileOptions
+// Capabilities specific to the `workspace/symbol` request.
+func (w *WorkspaceClientCapabilities) GetSymbol() *WorkspaceSymbolClientCapabilities {
+	if w == nil {
+		var zero *WorkspaceSymbolClientCapabilities
 		return zero
 	}
-	return d.Options
-}
-
-// TextDocumentEditOrCreateFileOrRenameFileOrDeleteFile contains either of the following types:
-//   - [*TextDocumentEdit]
-//   - [*CreateFile]
-//   - [*RenameFile]
-//   - [*DeleteFile]
-type TextDocumentEditOrCreateFileOrRenameFileOrDeleteFile struct {
-	Value TextDocumentEditOrCreateFileOrRenameFileOrDeleteFileValue
-}
-
-// TextDocumentEditOrCreateFileOrRenameFileOrDeleteFileValue is either of the following types:
-//   - [*TextDocumentEdit]
-//   - [*CreateFile]
-//   - [*RenameFile]
-//   - [*DeleteFile]
-//
-//sumtype:decl
-type TextDocumentEditOrCreateFileOrRenameFileOrDeleteFileValue interface {
-	isTextDocumentEditOrCreateFileOrRenameFileOrDeleteFileValue()
+	return w.Symbol
 }
 
-func (*TextDocumentEdit) isTextDocumentEditOrCreateFileOrRenameFileOrDeleteFileValue() {}
-func (*CreateFile) isTextDocumentEditOrCreateFileOrRenameFileOrDeleteFileValue()       {}
-func (*RenameFile) isTextDocumentEditOrCreateFileOrRenameFileOrDeleteFileValue()       {}
-func (*DeleteFile) isTextDocumentEditOrCreateFileOrRenameFileOrDeleteFileValue()       {}
-
-func (t *TextDocumentEditOrCreateFileOrRenameFileOrDeleteFile) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var textDocumentEditValue *TextDocumentEdit
-	if err := json.Unmarshal(data, &textDocumentEditValue); err == nil {
-		t.Value = textDocumentEditValue
-		return nil
-	}
-	var createFileValue *CreateFile
-	if err := json.Unmarshal(data, &createFileValue); err == nil {
-		t.Value = createFileValue
-		return nil
+// Capabilities specific to the `workspace/executeCommand` request.
+func (w *WorkspaceClientCapabilities) GetExecuteCommand() *ExecuteCommandClientCapabilities {
+	if w == nil {
+		var zero *ExecuteCommandClientCapabilities
+		return zero
 	}
-	var renameFileValue *RenameFile
-	if err := json.Unmarshal(data, &renameFileValue); err == nil {
-		t.Value = renameFileValue
-		return nil
+	return w.ExecuteCommand
+}
+
+// The client has support for workspace folders.
+//
+// @since 3.6.0
+func (w *WorkspaceClientCapabilities) GetWorkspaceFolders() bool {
+	if w == nil {
+		var zero bool
+		return zero
 	}
-	var deleteFileValue *DeleteFile
-	if err := json.Unmarshal(data, &deleteFileValue); err == nil {
-		t.Value = deleteFileValue
-		return nil
+	return w.WorkspaceFolders
+}
+
+// The client supports `workspace/configuration` requests.
+//
+// @since 3.6.0
+func (w *WorkspaceClientCapabilities) GetConfiguration() bool {
+	if w == nil {
+		var zero bool
+		return zero
 	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*TextDocumentEditOrCreateFileOrRenameFileOrDeleteFile](),
+	return w.Configuration
+}
+
+// Capabilities specific to the semantic token requests scoped to the
+// workspace.
+//
+// @since 3.16.0.
+func (w *WorkspaceClientCapabilities) GetSemanticTokens() *SemanticTokensWorkspaceClientCapabilities {
+	if w == nil {
+		var zero *SemanticTokensWorkspaceClientCapabilities
+		return zero
 	}
+	return w.SemanticTokens
 }
 
-func (t *TextDocumentEditOrCreateFileOrRenameFileOrDeleteFile) MarshalJSON() ([]byte, error) {
-	return json.Marshal(t.Value)
+// Capabilities specific to the code lens requests scoped to the
+// workspace.
+//
+// @since 3.16.0.
+func (w *WorkspaceClientCapabilities) GetCodeLens() *CodeLensWorkspaceClientCapabilities {
+	if w == nil {
+		var zero *CodeLensWorkspaceClientCapabilities
+		return zero
+	}
+	return w.CodeLens
 }
 
-// Additional information that describes document changes.
+// The client has support for file notifications/requests for user operations on files.
 //
-// @since 3.16.0
+// Since 3.16.0
+func (w *WorkspaceClientCapabilities) GetFileOperations() *FileOperationClientCapabilities {
+	if w == nil {
+		var zero *FileOperationClientCapabilities
+		return zero
+	}
+	return w.FileOperations
+}
+
+// Capabilities specific to the inline values requests scoped to the
+// workspace.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#changeAnnotation
-type ChangeAnnotation struct {
-	// A human-readable string describing the actual change. The string
-	// is rendered prominent in the user interface.
-	Label string `json:"label"`
-	// A flag which indicates that user confirmation is needed
-	// before applying the change.
-	NeedsConfirmation bool `json:"needsConfirmation,omitempty"`
-	// A human-readable string which is rendered less prominent in
-	// the user interface.
-	Description string `json:"description,omitempty"`
+// @since 3.17.0.
+func (w *WorkspaceClientCapabilities) GetInlineValue() *InlineValueWorkspaceClientCapabilities {
+	if w == nil {
+		var zero *InlineValueWorkspaceClientCapabilities
+		return zero
+	}
+	return w.InlineValue
 }
 
-// A human-readable string describing the actual change. The string
-// is rendered prominent in the user interface.
-func (c *ChangeAnnotation) GetLabel() string {
-	if c == nil {
-		var zero string
+// Capabilities specific to the inlay hint requests scoped to the
+// workspace.
+//
+// @since 3.17.0.
+func (w *WorkspaceClientCapabilities) GetInlayHint() *InlayHintWorkspaceClientCapabilities {
+	if w == nil {
+		var zero *InlayHintWorkspaceClientCapabilities
 		return zero
 	}
-	return c.Label
+	return w.InlayHint
 }
 
-// A flag which indicates that user confirmation is needed
-// before applying the change.
-func (c *ChangeAnnotation) GetNeedsConfirmation() bool {
-	if c == nil {
-		var zero bool
+// Capabilities specific to the diagnostic requests scoped to the
+// workspace.
+//
+// @since 3.17.0.
+func (w *WorkspaceClientCapabilities) GetDiagnostics() *DiagnosticWorkspaceClientCapabilities {
+	if w == nil {
+		var zero *DiagnosticWorkspaceClientCapabilities
 		return zero
 	}
-	return c.NeedsConfirmation
+	return w.Diagnostics
 }
 
-// A human-readable string which is rendered less prominent in
-// the user interface.
-func (c *ChangeAnnotation) GetDescription() string {
-	if c == nil {
-		var zero string
+// Capabilities specific to the folding range requests scoped to the workspace.
+//
+// @since 3.18.0
+// @proposed
+func (w *WorkspaceClientCapabilities) GetFoldingRange() *FoldingRangeWorkspaceClientCapabilities {
+	if w == nil {
+		var zero *FoldingRangeWorkspaceClientCapabilities
 		return zero
 	}
-	return c.Description
+	return w.FoldingRange
 }
 
 // A workspace edit represents changes to many resources managed in the workspace. The edit
@@ -11751,661 +12865,527 @@ func (w *WorkspaceEdit) GetChanges() map[string][]*TextEdit {
 // If a client neither supports `documentChanges` nor `workspace.workspaceEdit.resourceOperations` then
 // only plain `TextEdit`s using the `changes` property are supported.
 func (w *WorkspaceEdit) GetDocumentChanges() []*TextDocumentEditOrCreateFileOrRenameFileOrDeleteFile {
-	if w == nil {
-		var zero []*TextDocumentEditOrCreateFileOrRenameFileOrDeleteFile
-		return zero
-	}
-	return w.DocumentChanges
-}
-
-// A map of change annotations that can be referenced in `AnnotatedTextEdit`s or create, rename and
-// delete file / folder operations.
-//
-// Whether clients honor this property depends on the client capability `workspace.changeAnnotationSupport`.
-//
-// @since 3.16.0
-func (w *WorkspaceEdit) GetChangeAnnotations() map[ChangeAnnotationIdentifier]*ChangeAnnotation {
-	if w == nil {
-		var zero map[ChangeAnnotationIdentifier]*ChangeAnnotation
-		return zero
-	}
-	return w.ChangeAnnotations
-}
-
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#initializedParams
-type InitializedParams struct {
-}
-
-// The message type
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#messageType
-type MessageType uint32
-
-const (
-	// An error message.
-	MessageTypeError MessageType = 1
-	// A warning message.
-	MessageTypeWarning MessageType = 2
-	// An information message.
-	MessageTypeInfo MessageType = 3
-	// A log message.
-	MessageTypeLog MessageType = 4
-	// A debug message.
-	//
-	// @since 3.18.0
-	MessageTypeDebug MessageType = 5
-)
-
-var validMessageTypeValues = map[uint32]bool{
-	1: true,
-	2: true,
-	3: true,
-	4: true,
-	5: true,
-}
-
-func (m *MessageType) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var uint32Value uint32
-	if err := json.Unmarshal(data, &uint32Value); err != nil {
-		return err
-	}
-	if !validMessageTypeValues[uint32Value] {
-		return fmt.Errorf("cannot unmarshal %v into MessageType: custom values are not supported", uint32Value)
-	}
-	*m = MessageType(uint32Value)
-
-	return nil
-}
-
-func (m MessageType) MarshalJSON() ([]byte, error) {
-	var uint32Value = uint32(m)
-	if !validMessageTypeValues[uint32Value] {
-		return nil, fmt.Errorf("cannot marshal %v into MessageType: custom values are not supported", uint32Value)
-	}
-	return json.Marshal(uint32Value)
-
-}
-
-// The log message parameters.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#logMessageParams
-type LogMessageParams struct {
-	// The message type. See {@link MessageType}
-	Type MessageType `json:"type"`
-	// The actual message.
-	Message string `json:"message"`
-}
-
-// The message type. See {@link MessageType}
-func (l *LogMessageParams) GetType() MessageType {
-	if l == nil {
-		var zero MessageType
-		return zero
-	}
-	return l.Type
-}
-
-// The actual message.
-func (l *LogMessageParams) GetMessage() string {
-	if l == nil {
-		var zero string
-		return zero
-	}
-	return l.Message
-}
-
-// An item to transfer a text document from the client to the
-// server.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocumentItem
-type TextDocumentItem struct {
-	// The text document's uri.
-	Uri string `json:"uri"`
-	// The text document's language identifier.
-	LanguageId string `json:"languageId"`
-	// The version number of this document (it will increase after each
-	// change, including undo/redo).
-	Version int `json:"version"`
-	// The content of the opened text document.
-	Text string `json:"text"`
-}
-
-// The text document's uri.
-func (t *TextDocumentItem) GetUri() string {
-	if t == nil {
-		var zero string
+	if w == nil {
+		var zero []*TextDocumentEditOrCreateFileOrRenameFileOrDeleteFile
 		return zero
 	}
-	return t.Uri
+	return w.DocumentChanges
 }
 
-// The text document's language identifier.
-func (t *TextDocumentItem) GetLanguageId() string {
-	if t == nil {
-		var zero string
+// A map of change annotations that can be referenced in `AnnotatedTextEdit`s or create, rename and
+// delete file / folder operations.
+//
+// Whether clients honor this property depends on the client capability `workspace.changeAnnotationSupport`.
+//
+// @since 3.16.0
+func (w *WorkspaceEdit) GetChangeAnnotations() map[ChangeAnnotationIdentifier]*ChangeAnnotation {
+	if w == nil {
+		var zero map[ChangeAnnotationIdentifier]*ChangeAnnotation
 		return zero
 	}
-	return t.LanguageId
+	return w.ChangeAnnotations
 }
 
-// The version number of this document (it will increase after each
-// change, including undo/redo).
-func (t *TextDocumentItem) GetVersion() int {
-	if t == nil {
-		var zero int
-		return zero
-	}
-	return t.Version
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#workspaceEditClientCapabilities
+type WorkspaceEditClientCapabilities struct {
+	// The client supports versioned document changes in `WorkspaceEdit`s
+	DocumentChanges bool `json:"documentChanges,omitempty"`
+	// The resource operations the client supports. Clients should at least
+	// support 'create', 'rename' and 'delete' files and folders.
+	//
+	// @since 3.13.0
+	ResourceOperations []ResourceOperationKind `json:"resourceOperations,omitempty"`
+	// The failure handling strategy of a client if applying the workspace edit
+	// fails.
+	//
+	// @since 3.13.0
+	FailureHandling FailureHandlingKind `json:"failureHandling,omitempty"`
+	// Whether the client normalizes line endings to the client specific
+	// setting.
+	// If set to `true` the client will normalize line ending characters
+	// in a workspace edit to the client-specified new line
+	// character.
+	//
+	// @since 3.16.0
+	NormalizesLineEndings bool `json:"normalizesLineEndings,omitempty"`
+	// Whether the client in general supports change annotations on text edits,
+	// create file, rename file and delete file changes.
+	//
+	// @since 3.16.0
+	ChangeAnnotationSupport *WorkspaceEditClientCapabilitiesChangeAnnotationSupport `json:"changeAnnotationSupport,omitempty"`
 }
 
-// The content of the opened text document.
-func (t *TextDocumentItem) GetText() string {
-	if t == nil {
-		var zero string
+// The client supports versioned document changes in `WorkspaceEdit`s
+func (w *WorkspaceEditClientCapabilities) GetDocumentChanges() bool {
+	if w == nil {
+		var zero bool
 		return zero
 	}
-	return t.Text
+	return w.DocumentChanges
 }
 
-// The parameters sent in an open text document notification
+// The resource operations the client supports. Clients should at least
+// support 'create', 'rename' and 'delete' files and folders.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#didOpenTextDocumentParams
-type DidOpenTextDocumentParams struct {
-	// The document that was opened.
-	TextDocument *TextDocumentItem `json:"textDocument"`
+// @since 3.13.0
+func (w *WorkspaceEditClientCapabilities) GetResourceOperations() []ResourceOperationKind {
+	if w == nil {
+		var zero []ResourceOperationKind
+		return zero
+	}
+	return w.ResourceOperations
 }
 
-// The document that was opened.
-func (d *DidOpenTextDocumentParams) GetTextDocument() *TextDocumentItem {
-	if d == nil {
-		var zero *TextDocumentItem
+// The failure handling strategy of a client if applying the workspace edit
+// fails.
+//
+// @since 3.13.0
+func (w *WorkspaceEditClientCapabilities) GetFailureHandling() FailureHandlingKind {
+	if w == nil {
+		var zero FailureHandlingKind
 		return zero
 	}
-	return d.TextDocument
+	return w.FailureHandling
 }
 
-// A text document identifier to denote a specific version of a text document.
+// Whether the client normalizes line endings to the client specific
+// setting.
+// If set to `true` the client will normalize line ending characters
+// in a workspace edit to the client-specified new line
+// character.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#versionedTextDocumentIdentifier
-type VersionedTextDocumentIdentifier struct {
-	*TextDocumentIdentifier
-	// The version number of this document.
-	Version int `json:"version"`
+// @since 3.16.0
+func (w *WorkspaceEditClientCapabilities) GetNormalizesLineEndings() bool {
+	if w == nil {
+		var zero bool
+		return zero
+	}
+	return w.NormalizesLineEndings
 }
 
-// The version number of this document.
-func (v *VersionedTextDocumentIdentifier) GetVersion() int {
-	if v == nil {
-		var zero int
+// Whether the client in general supports change annotations on text edits,
+// create file, rename file and delete file changes.
+//
+// @since 3.16.0
+func (w *WorkspaceEditClientCapabilities) GetChangeAnnotationSupport() *WorkspaceEditClientCapabilitiesChangeAnnotationSupport {
+	if w == nil {
+		var zero *WorkspaceEditClientCapabilitiesChangeAnnotationSupport
 		return zero
 	}
-	return v.Version
+	return w.ChangeAnnotationSupport
 }
 
-type IncrementalTextDocumentContentChangeEvent struct {
-	// The range of the document that changed.
-	Range *Range `json:"range"`
-	// The optional length of the range that got replaced.
-	//
-	// @deprecated use range instead.
-	RangeLength Optional[int] `json:"rangeLength,omitempty"`
-	// The new text for the provided range.
-	Text string `json:"text"`
+type WorkspaceEditClientCapabilitiesChangeAnnotationSupport struct {
+	// Whether the client groups edits with equal labels into tree nodes,
+	// for instance all edits labelled with "Changes in Strings" would
+	// be a tree node.
+	GroupsOnLabel bool `json:"groupsOnLabel,omitempty"`
 }
 
-// The range of the document that changed.
-func (i *IncrementalTextDocumentContentChangeEvent) GetRange() *Range {
-	if i == nil {
-		return *new(*Range)
+// Whether the client groups edits with equal labels into tree nodes,
+// for instance all edits labelled with "Changes in Strings" would
+// be a tree node.
+func (w *WorkspaceEditClientCapabilitiesChangeAnnotationSupport) GetGroupsOnLabel() bool {
+	if w == nil {
+		return *new(bool)
 	}
-	return i.Range
+	return w.GroupsOnLabel
 }
 
-// The optional length of the range that got replaced.
+// A workspace folder inside a client.
 //
-// @deprecated use range instead.
-func (i *IncrementalTextDocumentContentChangeEvent) GetRangeLength() Optional[int] {
-	if i == nil {
-		return *new(Optional[int])
-	}
-	return i.RangeLength
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#workspaceFolder
+type WorkspaceFolder struct {
+	// The associated URI for this workspace folder.
+	Uri string `json:"uri"`
+	// The name of the workspace folder. Used to refer to this
+	// workspace folder in the user interface.
+	Name string `json:"name"`
 }
 
-// The new text for the provided range.
-func (i *IncrementalTextDocumentContentChangeEvent) GetText() string {
-	if i == nil {
-		return *new(string)
+// The associated URI for this workspace folder.
+func (w *WorkspaceFolder) GetUri() string {
+	if w == nil {
+		var zero string
+		return zero
 	}
-	return i.Text
-}
-
-type FullTextDocumentContentChangeEvent struct {
-	// The new text of the whole document.
-	Text string `json:"text"`
+	return w.Uri
 }
 
-// The new text of the whole document.
-func (f *FullTextDocumentContentChangeEvent) GetText() string {
-	if f == nil {
-		return *new(string)
+// The name of the workspace folder. Used to refer to this
+// workspace folder in the user interface.
+func (w *WorkspaceFolder) GetName() string {
+	if w == nil {
+		var zero string
+		return zero
 	}
-	return f.Text
+	return w.Name
 }
 
-// IncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEvent contains either of the following types:
-//   - [*IncrementalTextDocumentContentChangeEvent]
-//   - [*FullTextDocumentContentChangeEvent]
-type IncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEvent struct {
-	Value IncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEventValue
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#workspaceFoldersInitializeParams
+type WorkspaceFoldersInitializeParams struct {
+	// The workspace folders configured in the client when the server starts.
+	//
+	// This property is only available if the client supports workspace folders.
+	// It can be `null` if the client supports workspace folders but none are
+	// configured.
+	//
+	// @since 3.6.0
+	WorkspaceFolders []*WorkspaceFolder `json:"workspaceFolders,omitempty"`
 }
 
-// IncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEventValue is either of the following types:
-//   - [*IncrementalTextDocumentContentChangeEvent]
-//   - [*FullTextDocumentContentChangeEvent]
+// The workspace folders configured in the client when the server starts.
 //
-//sumtype:decl
-type IncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEventValue interface {
-	isIncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEventValue()
-}
-
-func (*IncrementalTextDocumentContentChangeEvent) isIncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEventValue() {
-}
-func (*FullTextDocumentContentChangeEvent) isIncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEventValue() {
-}
-
-func (i *IncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEvent) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var unmarshalledData map[string]any
-	err := json.Unmarshal(data, &unmarshalledData)
-	if err != nil {
-		return err
-	}
-	fields := slices.Collect(maps.Keys(unmarshalledData))
-	var incrementalTextDocumentContentChangeEventValue *IncrementalTextDocumentContentChangeEvent
-	if slices.Contains(fields, "range") {
-		if err := json.Unmarshal(data, &incrementalTextDocumentContentChangeEventValue); err == nil {
-			i.Value = incrementalTextDocumentContentChangeEventValue
-			return nil
-		}
-	}
-	var fullTextDocumentContentChangeEventValue *FullTextDocumentContentChangeEvent
-	if err := json.Unmarshal(data, &fullTextDocumentContentChangeEventValue); err == nil {
-		i.Value = fullTextDocumentContentChangeEventValue
-		return nil
-	}
-	return &json.UnmarshalTypeError{
-		Value: string(data),
-		Type:  reflect.TypeFor[*IncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEvent](),
+// This property is only available if the client supports workspace folders.
+// It can be `null` if the client supports workspace folders but none are
+// configured.
+//
+// @since 3.6.0
+func (w *WorkspaceFoldersInitializeParams) GetWorkspaceFolders() []*WorkspaceFolder {
+	if w == nil {
+		var zero []*WorkspaceFolder
+		return zero
 	}
+	return w.WorkspaceFolders
 }
 
-func (i *IncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEvent) MarshalJSON() ([]byte, error) {
-	return json.Marshal(i.Value)
-}
-
-// An event describing a change to a text document. If only a text is provided
-// it is considered to be the full content of the document.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#textDocumentContentChangeEvent
-type TextDocumentContentChangeEvent = *IncrementalTextDocumentContentChangeEventOrFullTextDocumentContentChangeEvent
-
-// The change text document notification's parameters.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#didChangeTextDocumentParams
-type DidChangeTextDocumentParams struct {
-	// The document that did change. The version number points
-	// to the version after all provided content changes have
-	// been applied.
-	TextDocument *VersionedTextDocumentIdentifier `json:"textDocument"`
-	// The actual content changes. The content changes describe single state changes
-	// to the document. So if there are two content changes c1 (at array index 0) and
-	// c2 (at array index 1) for a document in state S then c1 moves the document from
-	// S to S' and c2 from S' to S''. So c1 is computed on the state S and c2 is computed
-	// on the state S'.
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#workspaceFoldersServerCapabilities
+type WorkspaceFoldersServerCapabilities struct {
+	// The server has support for workspace folders
+	Supported bool `json:"supported,omitempty"`
+	// Whether the server wants to receive workspace folder
+	// change notifications.
 	//
-	// To mirror the content of a document using change events use the following approach:
-	// - start with the same initial content
-	// - apply the 'textDocument/didChange' notifications in the order you receive them.
-	// - apply the `TextDocumentContentChangeEvent`s in a single notification in the order
-	//   you receive them.
-	ContentChanges []TextDocumentContentChangeEvent `json:"contentChanges"`
+	// If a string is provided the string is treated as an ID
+	// under which the notification is registered on the client
+	// side. The ID can be used to unregister for these events
+	// using the `client/unregisterCapability` request.
+	ChangeNotifications *StringOrBoolean `json:"changeNotifications,omitempty"`
 }
 
-// The document that did change. The version number points
-// to the version after all provided content changes have
-// been applied.
-func (d *DidChangeTextDocumentParams) GetTextDocument() *VersionedTextDocumentIdentifier {
-	if d == nil {
-		var zero *VersionedTextDocumentIdentifier
+// The server has support for workspace folders
+func (w *WorkspaceFoldersServerCapabilities) GetSupported() bool {
+	if w == nil {
+		var zero bool
 		return zero
 	}
-	return d.TextDocument
+	return w.Supported
 }
 
-// The actual content changes. The content changes describe single state changes
-// to the document. So if there are two content changes c1 (at array index 0) and
-// c2 (at array index 1) for a document in state S then c1 moves the document from
-// S to S' and c2 from S' to S”. So c1 is computed on the state S and c2 is computed
-// on the state S'.
+// Whether the server wants to receive workspace folder
+// change notifications.
 //
-// To mirror the content of a document using change events use the following approach:
-//   - start with the same initial content
-//   - apply the 'textDocument/didChange' notifications in the order you receive them.
-//   - apply the `TextDocumentContentChangeEvent`s in a single notification in the order
-//     you receive them.
-func (d *DidChangeTextDocumentParams) GetContentChanges() []TextDocumentContentChangeEvent {
-	if d == nil {
-		var zero []TextDocumentContentChangeEvent
+// If a string is provided the string is treated as an ID
+// under which the notification is registered on the client
+// side. The ID can be used to unregister for these events
+// using the `client/unregisterCapability` request.
+func (w *WorkspaceFoldersServerCapabilities) GetChangeNotifications() *StringOrBoolean {
+	if w == nil {
+		var zero *StringOrBoolean
 		return zero
 	}
-	return d.ContentChanges
+	return w.ChangeNotifications
 }
 
-// The parameters sent in a close text document notification
+// Client capabilities for a {@link WorkspaceSymbolRequest}.
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#didCloseTextDocumentParams
-type DidCloseTextDocumentParams struct {
-	// The document that was closed.
-	TextDocument *TextDocumentIdentifier `json:"textDocument"`
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#workspaceSymbolClientCapabilities
+type WorkspaceSymbolClientCapabilities struct {
+	// Symbol request supports dynamic registration.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	// Specific capabilities for the `SymbolKind` in the `workspace/symbol` request.
+	SymbolKind *WorkspaceSymbolClientCapabilitiesSymbolKind `json:"symbolKind,omitempty"`
+	// The client supports tags on `SymbolInformation`.
+	// Clients supporting tags have to handle unknown tags gracefully.
+	//
+	// @since 3.16.0
+	TagSupport *WorkspaceSymbolClientCapabilitiesTagSupport `json:"tagSupport,omitempty"`
+	// The client support partial workspace symbols. The client will send the
+	// request `workspaceSymbol/resolve` to the server to resolve additional
+	// properties.
+	//
+	// @since 3.17.0
+	ResolveSupport *WorkspaceSymbolClientCapabilitiesResolveSupport `json:"resolveSupport,omitempty"`
 }
 
-// The document that was closed.
-func (d *DidCloseTextDocumentParams) GetTextDocument() *TextDocumentIdentifier {
-	if d == nil {
-		var zero *TextDocumentIdentifier
+// Symbol request supports dynamic registration.
+func (w *WorkspaceSymbolClientCapabilities) GetDynamicRegistration() bool {
+	if w == nil {
+		var zero bool
 		return zero
 	}
-	return d.TextDocument
+	return w.DynamicRegistration
 }
 
-// The diagnostic's severity.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#diagnosticSeverity
-type DiagnosticSeverity uint32
-
-const (
-	// Reports an error.
-	DiagnosticSeverityError DiagnosticSeverity = 1
-	// Reports a warning.
-	DiagnosticSeverityWarning DiagnosticSeverity = 2
-	// Reports an information.
-	DiagnosticSeverityInformation DiagnosticSeverity = 3
-	// Reports a hint.
-	DiagnosticSeverityHint DiagnosticSeverity = 4
-)
-
-var validDiagnosticSeverityValues = map[uint32]bool{
-	1: true,
-	2: true,
-	3: true,
-	4: true,
+// Specific capabilities for the `SymbolKind` in the `workspace/symbol` request.
+func (w *WorkspaceSymbolClientCapabilities) GetSymbolKind() *WorkspaceSymbolClientCapabilitiesSymbolKind {
+	if w == nil {
+		var zero *WorkspaceSymbolClientCapabilitiesSymbolKind
+		return zero
+	}
+	return w.SymbolKind
 }
 
-func (d *DiagnosticSeverity) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) {
-		return nil
-	}
-	var uint32Value uint32
-	if err := json.Unmarshal(data, &uint32Value); err != nil {
-		return err
+// The client supports tags on `SymbolInformation`.
+// Clients supporting tags have to handle unknown tags gracefully.
+//
+// @since 3.16.0
+func (w *WorkspaceSymbolClientCapabilities) GetTagSupport() *WorkspaceSymbolClientCapabilitiesTagSupport {
+	if w == nil {
+		var zero *WorkspaceSymbolClientCapabilitiesTagSupport
+		return zero
 	}
-	if !validDiagnosticSeverityValues[uint32Value] {
-		return fmt.Errorf("cannot unmarshal %v into DiagnosticSeverity: custom values are not supported", uint32Value)
+	return w.TagSupport
+}
+
+// The client support partial workspace symbols. The client will send the
+// request `workspaceSymbol/resolve` to the server to resolve additional
+// properties.
+//
+// @since 3.17.0
+func (w *WorkspaceSymbolClientCapabilities) GetResolveSupport() *WorkspaceSymbolClientCapabilitiesResolveSupport {
+	if w == nil {
+		var zero *WorkspaceSymbolClientCapabilitiesResolveSupport
+		return zero
 	}
-	*d = DiagnosticSeverity(uint32Value)
+	return w.ResolveSupport
+}
 
-	return nil
+type WorkspaceSymbolClientCapabilitiesResolveSupport struct {
+	// The properties that a client can resolve lazily. Usually
+	// `location.range`
+	Properties []string `json:"properties"`
 }
 
-func (d DiagnosticSeverity) MarshalJSON() ([]byte, error) {
-	var uint32Value = uint32(d)
-	if !validDiagnosticSeverityValues[uint32Value] {
-		return nil, fmt.Errorf("cannot marshal %v into DiagnosticSeverity: custom values are not supported", uint32Value)
+// The properties that a client can resolve lazily. Usually
+// `location.range`
+func (w *WorkspaceSymbolClientCapabilitiesResolveSupport) GetProperties() []string {
+	if w == nil {
+		return *new([]string)
 	}
-	return json.Marshal(uint32Value)
-
+	return w.Properties
 }
 
-// Structure to capture a description for an error code.
-//
-// @since 3.16.0
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#codeDescription
-type CodeDescription struct {
-	// An URI to open with more information about the diagnostic error.
-	Href string `json:"href"`
+type WorkspaceSymbolClientCapabilitiesSymbolKind struct {
+	// The symbol kind values the client supports. When this
+	// property exists the client also guarantees that it will
+	// handle values outside its set gracefully and falls back
+	// to a default value when unknown.
+	//
+	// If this property is not present the client only supports
+	// the symbol kinds from `File` to `Array` as defined in
+	// the initial version of the protocol.
+	ValueSet []SymbolKind `json:"valueSet,omitempty"`
 }
 
-// An URI to open with more information about the diagnostic error.
-func (c *CodeDescription) GetHref() string {
-	if c == nil {
-		var zero string
-		return zero
+// The symbol kind values the client supports. When this
+// property exists the client also guarantees that it will
+// handle values outside its set gracefully and falls back
+// to a default value when unknown.
+//
+// If this property is not present the client only supports
+// the symbol kinds from `File` to `Array` as defined in
+// the initial version of the protocol.
+func (w *WorkspaceSymbolClientCapabilitiesSymbolKind) GetValueSet() []SymbolKind {
+	if w == nil {
+		return *new([]SymbolKind)
 	}
-	return c.Href
+	return w.ValueSet
 }
 
-// Represents a related message and source code location for a diagnostic. This should be
-// used to point to code locations that cause or related to a diagnostics, e.g when duplicating
-// a symbol in a scope.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#diagnosticRelatedInformation
-type DiagnosticRelatedInformation struct {
-	// The location of this related diagnostic information.
-	Location *Location `json:"location"`
-	// The message of this related diagnostic information.
-	Message string `json:"message"`
+type WorkspaceSymbolClientCapabilitiesTagSupport struct {
+	// The tags supported by the client.
+	ValueSet []SymbolTag `json:"valueSet"`
 }
 
-// The location of this related diagnostic information.
-func (d *DiagnosticRelatedInformation) GetLocation() *Location {
-	if d == nil {
-		var zero *Location
-		return zero
+// The tags supported by the client.
+func (w *WorkspaceSymbolClientCapabilitiesTagSupport) GetValueSet() []SymbolTag {
+	if w == nil {
+		return *new([]SymbolTag)
 	}
-	return d.Location
+	return w.ValueSet
 }
 
-// The message of this related diagnostic information.
-func (d *DiagnosticRelatedInformation) GetMessage() string {
-	if d == nil {
-		var zero string
+// Server capabilities for a {@link WorkspaceSymbolRequest}.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#workspaceSymbolOptions
+type WorkspaceSymbolOptions struct {
+	*WorkDoneProgressOptions
+	// The server provides support to resolve additional
+	// information for a workspace symbol.
+	//
+	// @since 3.17.0
+	ResolveProvider bool `json:"resolveProvider,omitempty"`
+}
+
+// The server provides support to resolve additional
+// information for a workspace symbol.
+//
+// @since 3.17.0
+func (w *WorkspaceSymbolOptions) GetResolveProvider() bool {
+	if w == nil {
+		var zero bool
 		return zero
 	}
-	return d.Message
+	return w.ResolveProvider
 }
 
-// Represents a diagnostic, such as a compiler error or warning. Diagnostic objects
-// are only valid in the scope of a resource.
+// The initialize parameters
 //
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#diagnostic
-type Diagnostic struct {
-	// The range at which the message applies
-	Range *Range `json:"range"`
-	// The diagnostic's severity. Can be omitted. If omitted it is up to the
-	// client to interpret diagnostics as error, warning, info or hint.
-	Severity DiagnosticSeverity `json:"severity,omitempty"`
-	// The diagnostic's code, which usually appear in the user interface.
-	Code *IntegerOrString `json:"code,omitempty"`
-	// An optional property to describe the error code.
-	// Requires the code field (above) to be present/not null.
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#xInitializeParams
+type XInitializeParams[InitializationOptions any] struct {
+	*WorkDoneProgressParams
+	// The process Id of the parent process that started
+	// the server.
 	//
-	// @since 3.16.0
-	CodeDescription *CodeDescription `json:"codeDescription,omitempty"`
-	// A human-readable string describing the source of this
-	// diagnostic, e.g. 'typescript' or 'super lint'. It usually
-	// appears in the user interface.
-	Source string `json:"source,omitempty"`
-	// The diagnostic's message. It usually appears in the user interface
-	Message string `json:"message"`
-	// Additional metadata about the diagnostic.
+	// Is `null` if the process has not been started by another process.
+	// If the parent process is not alive then the server should exit.
+	ProcessId int `json:"processId"`
+	// Information about the client
 	//
 	// @since 3.15.0
-	Tags []DiagnosticTag `json:"tags,omitempty"`
-	// An array of related diagnostic information, e.g. when symbol-names within
-	// a scope collide all definitions can be marked via this property.
-	RelatedInformation []*DiagnosticRelatedInformation `json:"relatedInformation,omitempty"`
-	// A data entry field that is preserved between a `textDocument/publishDiagnostics`
-	// notification and `textDocument/codeAction` request.
+	ClientInfo *XInitializeParamsClientInfo `json:"clientInfo,omitempty"`
+	// The locale the client is currently showing the user interface
+	// in. This must not necessarily be the locale of the operating
+	// system.
+	//
+	// Uses IETF language tags as the value's syntax
+	// (See https://en.wikipedia.org/wiki/IETF_language_tag)
 	//
 	// @since 3.16.0
-	Data LSPAny `json:"data,omitempty"`
-}
-
-// The range at which the message applies
-func (d *Diagnostic) GetRange() *Range {
-	if d == nil {
-		var zero *Range
-		return zero
-	}
-	return d.Range
+	Locale string `json:"locale,omitempty"`
+	// The rootPath of the workspace. Is null
+	// if no folder is open.
+	//
+	// Deprecated: in favour of rootUri.
+	RootPath string `json:"rootPath,omitempty"`
+	// The rootUri of the workspace. Is null if no
+	// folder is open. If both `rootPath` and `rootUri` are set
+	// `rootUri` wins.
+	//
+	// Deprecated: in favour of workspaceFolders.
+	RootUri string `json:"rootUri"`
+	// The capabilities provided by the client (editor or tool)
+	Capabilities *ClientCapabilities `json:"capabilities"`
+	// User provided initialization options.
+	InitializationOptions InitializationOptions `json:"initializationOptions,omitempty"`
+	// The initial trace setting. If omitted trace is disabled ('off').
+	Trace TraceValues `json:"trace,omitempty"`
 }
 
-// The diagnostic's severity. Can be omitted. If omitted it is up to the
-// client to interpret diagnostics as error, warning, info or hint.
-func (d *Diagnostic) GetSeverity() DiagnosticSeverity {
-	if d == nil {
-		var zero DiagnosticSeverity
+// The process Id of the parent process that started
+// the server.
+//
+// Is `null` if the process has not been started by another process.
+// If the parent process is not alive then the server should exit.
+func (x *XInitializeParams[InitializationOptions]) GetProcessId() int {
+	if x == nil {
+		var zero int
 		return zero
 	}
-	return d.Severity
+	return x.ProcessId
 }
 
-// The diagnostic's code, which usually appear in the user interface.
-func (d *Diagnostic) GetCode() *IntegerOrString {
-	if d == nil {
-		var zero *IntegerOrString
+// Information about the client
+//
+// @since 3.15.0
+func (x *XInitializeParams[InitializationOptions]) GetClientInfo() *XInitializeParamsClientInfo {
+	if x == nil {
+		var zero *XInitializeParamsClientInfo
 		return zero
 	}
-	return d.Code
+	return x.ClientInfo
 }
 
-// An optional property to describe the error code.
-// Requires the code field (above) to be present/not null.
+// The locale the client is currently showing the user interface
+// in. This must not necessarily be the locale of the operating
+// system.
+//
+// Uses IETF language tags as the value's syntax
+// (See https://en.wikipedia.org/wiki/IETF_language_tag)
 //
 // @since 3.16.0
-func (d *Diagnostic) GetCodeDescription() *CodeDescription {
-	if d == nil {
-		var zero *CodeDescription
+func (x *XInitializeParams[InitializationOptions]) GetLocale() string {
+	if x == nil {
+		var zero string
 		return zero
 	}
-	return d.CodeDescription
+	return x.Locale
 }
 
-// A human-readable string describing the source of this
-// diagnostic, e.g. 'typescript' or 'super lint'. It usually
-// appears in the user interface.
-func (d *Diagnostic) GetSource() string {
-	if d == nil {
+// The rootPath of the workspace. Is null
+// if no folder is open.
+//
+// Deprecated: in favour of rootUri.
+func (x *XInitializeParams[InitializationOptions]) GetRootPath() string {
+	if x == nil {
 		var zero string
 		return zero
 	}
-	return d.Source
+	return x.RootPath
 }
 
-// The diagnostic's message. It usually appears in the user interface
-func (d *Diagnostic) GetMessage() string {
-	if d == nil {
+// The rootUri of the workspace. Is null if no
+// folder is open. If both `rootPath` and `rootUri` are set
+// `rootUri` wins.
+//
+// Deprecated: in favour of workspaceFolders.
+func (x *XInitializeParams[InitializationOptions]) GetRootUri() string {
+	if x == nil {
 		var zero string
 		return zero
 	}
-	return d.Message
+	return x.RootUri
 }
 
-// Additional metadata about the diagnostic.
-//
-// @since 3.15.0
-func (d *Diagnostic) GetTags() []DiagnosticTag {
-	if d == nil {
-		var zero []DiagnosticTag
+// The capabilities provided by the client (editor or tool)
+func (x *XInitializeParams[InitializationOptions]) GetCapabilities() *ClientCapabilities {
+	if x == nil {
+		var zero *ClientCapabilities
 		return zero
 	}
-	return d.Tags
+	return x.Capabilities
 }
 
-// An array of related diagnostic information, e.g. when symbol-names within
-// a scope collide all definitions can be marked via this property.
-func (d *Diagnostic) GetRelatedInformation() []*DiagnosticRelatedInformation {
-	if d == nil {
-		var zero []*DiagnosticRelatedInformation
+// User provided initialization options.
+func (x *XInitializeParams[InitializationOptions]) GetInitializationOptions() InitializationOptions {
+	if x == nil {
+		var zero InitializationOptions
 		return zero
 	}
-	return d.RelatedInformation
+	return x.InitializationOptions
 }
 
-// A data entry field that is preserved between a `textDocument/publishDiagnostics`
-// notification and `textDocument/codeAction` request.
-//
-// @since 3.16.0
-func (d *Diagnostic) GetData() LSPAny {
-	if d == nil {
-		var zero LSPAny
+// The initial trace setting. If omitted trace is disabled ('off').
+func (x *XInitializeParams[InitializationOptions]) GetTrace() TraceValues {
+	if x == nil {
+		var zero TraceValues
 		return zero
 	}
-	return d.Data
-}
-
-// The publish diagnostic notification's parameters.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#publishDiagnosticsParams
-type PublishDiagnosticsParams struct {
-	// The URI for which diagnostic information is reported.
-	Uri string `json:"uri"`
-	// Optional the version number of the document the diagnostics are published for.
-	//
-	// @since 3.15.0
-	Version Optional[int] `json:"version,omitempty"`
-	// An array of diagnostic information items.
-	Diagnostics []*Diagnostic `json:"diagnostics"`
+	return x.Trace
 }
 
-// The URI for which diagnostic information is reported.
-func (p *PublishDiagnosticsParams) GetUri() string {
-	if p == nil {
-		var zero string
-		return zero
-	}
-	return p.Uri
+type XInitializeParamsClientInfo struct {
+	// The name of the client as defined by the client.
+	Name string `json:"name"`
+	// The client's version as defined by the client.
+	Version string `json:"version,omitempty"`
 }
 
-// Optional the version number of the document the diagnostics are published for.
-//
-// @since 3.15.0
-func (p *PublishDiagnosticsParams) GetVersion() Optional[int] {
-	if p == nil {
-		var zero Optional[int]
-		return zero
+// The name of the client as defined by the client.
+func (x *XInitializeParamsClientInfo) GetName() string {
+	if x == nil {
+		return *new(string)
 	}
-	return p.Version
+	return x.Name
 }
 
-// An array of diagnostic information items.
-func (p *PublishDiagnosticsParams) GetDiagnostics() []*Diagnostic {
-	if p == nil {
-		var zero []*Diagnostic
-		return zero
+// The client's version as defined by the client.
+func (x *XInitializeParamsClientInfo) GetVersion() string {
+	if x == nil {
+		return *new(string)
 	}
-	return p.Diagnostics
+	return x.Version
 }
 
-// Predefined error codes.
-//
-// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#errorCodes
-type ErrorCodes int32
-
-const (
-	ErrorCodesParseError     ErrorCodes = -32700
-	ErrorCodesInvalidRequest ErrorCodes = -32600
-	ErrorCodesMethodNotFound ErrorCodes = -32601
-	ErrorCodesInvalidParams  ErrorCodes = -32602
-	ErrorCodesInternalError  ErrorCodes = -32603
-	// Error code indicating that a server received a notification or
-	// request before the server has received the `initialize` request.
-	ErrorCodesServerNotInitialized ErrorCodes = -32002
-	ErrorCodesUnknownErrorCode     ErrorCodes = -32001
-)
+type stringLSPAnyMap map[string]LSPAny
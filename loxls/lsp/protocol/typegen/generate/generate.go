@@ -45,7 +45,7 @@ type generator struct {
 	metaModel *metamodel.MetaModel
 	pkg       string
 
-	typeDecls    []string
+	typeDecls    []*typeDecl
 	importedPkgs map[string]struct{}
 	gennedTypes  map[string]bool
 }
@@ -62,13 +62,21 @@ func newGenerator(types []*metamodel.Type, metaModel *metamodel.MetaModel, pkg s
 }
 
 func (g *generator) Source() string {
-	for _, typ := range g.types {
+	// Types and declarations are sorted so that the output doesn't depend on the order that they were collected in.
+	types := slices.Clone(g.types)
+	slices.SortStableFunc(types, compareTypes)
+	for _, typ := range types {
 		if isNullBaseType(typ) {
 			continue
 		}
 		namespace := ""
 		g.genTypeDecl(namespace, typ)
 	}
+	slices.SortStableFunc(g.typeDecls, func(x, y *typeDecl) int { return cmp.Compare(x.Name, y.Name) })
+	typeDeclSrcs := make([]string, len(g.typeDecls))
+	for i, decl := range g.typeDecls {
+		typeDeclSrcs[i] = decl.Src
+	}
 
 	const text = `
 // Code generated by "typegen{{if .args}} {{.args}}{{end}}"; DO NOT EDIT.
@@ -89,12 +97,60 @@ import (
 	data := map[string]any{
 		"args":             strings.Join(os.Args[1:], " "),
 		"package":          g.pkg,
-		"importedPackages": slices.Collect(maps.Keys(g.importedPkgs)),
-		"typeDeclarations": g.typeDecls,
+		"importedPackages": slices.Sorted(maps.Keys(g.importedPkgs)),
+		"typeDeclarations": typeDeclSrcs,
 	}
 	return mustExecuteTemplate(text, data)
 }
 
+// typeDecl is the source of a generated declaration of the named type, along with any associated declarations such as
+// its methods.
+type typeDecl struct {
+	Name string
+	Src  string
+}
+
+func (g *generator) addTypeDecl(name string, src string) {
+	g.typeDecls = append(g.typeDecls, &typeDecl{Name: name, Src: src})
+}
+
+// compareTypes orders types by the name of the type that they reference, then by their kind. Types which aren't
+// references have no name and so come first.
+func compareTypes(x, y *metamodel.Type) int {
+	xName, xKind := typeSortKey(x)
+	yName, yKind := typeSortKey(y)
+	return cmp.Or(cmp.Compare(xName, yName), cmp.Compare(xKind, yKind))
+}
+
+func typeSortKey(typ *metamodel.Type) (name string, kind string) {
+	switch value := typ.Value.(type) {
+	case metamodel.ReferenceType:
+		return value.Name, string(metamodel.TypeKindReference)
+	case metamodel.BaseType:
+		return "", string(metamodel.TypeKindBase)
+	case metamodel.ArrayType:
+		return "", string(metamodel.TypeKindArray)
+	case metamodel.MapType:
+		return "", string(metamodel.TypeKindMap)
+	case metamodel.AndType:
+		return "", string(metamodel.TypeKindAnd)
+	case metamodel.OrType:
+		return "", string(metamodel.TypeKindOr)
+	case metamodel.TupleType:
+		return "", string(metamodel.TypeKindTuple)
+	case metamodel.StructureLiteralType:
+		return "", string(metamodel.TypeKindLiteral)
+	case metamodel.StringLiteralType:
+		return "", string(metamodel.TypeKindStringLiteral)
+	case metamodel.IntegerLiteralType:
+		return "", string(metamodel.TypeKindIntegerLiteral)
+	case metamodel.BooleanLiteralType:
+		return "", string(metamodel.TypeKindBooleanLiteral)
+	default:
+		panic(fmt.Sprintf("unhandled type: %T", value))
+	}
+}
+
 func (g *generator) genTypeDecl(namespace string, typ *metamodel.Type) string {
 	switch typ := typ.Value.(type) {
 	case metamodel.ReferenceType:
@@ -208,7 +264,7 @@ func ({{$receiver}} *{{$.name}}{{$.receiverTypeSuffix}}) Get{{.Name}}() {{.Type}
 		"fields":             fields,
 	}
 	decl := mustExecuteTemplate(text, data)
-	g.typeDecls = append(g.typeDecls, decl)
+	g.addTypeDecl(name, decl)
 
 	return "*" + name
 }
@@ -283,7 +339,7 @@ func (g *generator) genOptionalTypeDecl(wrappedType string) string {
 	`
 	if !g.gennedTypes["Optional"] {
 		g.gennedTypes["Optional"] = true
-		g.typeDecls = append(g.typeDecls, decl)
+		g.addTypeDecl("Optional", decl)
 	}
 	return fmt.Sprintf("Optional[%s]", wrappedType)
 }
@@ -320,7 +376,7 @@ type {{.name}} = {{.type}}
 		"type":    g.genTypeDecl(name, typeAlias.Type),
 	}
 	decl := mustExecuteTemplate(text, data)
-	g.typeDecls = append(g.typeDecls, decl)
+	g.addTypeDecl(name, decl)
 
 	return name
 }
@@ -420,7 +476,7 @@ func ({{$receiver}} {{$.name}}) MarshalJSON() ([]byte, error) {
 `
 	data := map[string]any{"comment": comment, "name": name, "type": typ, "members": members, "supportsCustomValues": enum.SupportsCustomValues}
 	decl := mustExecuteTemplate(text, data)
-	g.typeDecls = append(g.typeDecls, decl)
+	g.addTypeDecl(name, decl)
 
 	return name
 }
@@ -540,7 +596,7 @@ func ({{$receiver}} *{{$.name}}) MarshalJSON() ([]byte, error) {
 		"discriminators": discriminators,
 	}
 	decl := mustExecuteTemplate(text, data)
-	g.typeDecls = append(g.typeDecls, decl)
+	g.addTypeDecl(name, decl)
 
 	return "*" + name
 }
@@ -576,7 +632,7 @@ func (g *generator) genBaseTypeDecl(baseType metamodel.BaseTypes) string {
 	}
 	g.gennedTypes[name] = true
 	decl := fmt.Sprintf("type %s %s", name, typ)
-	g.typeDecls = append(g.typeDecls, decl)
+	g.addTypeDecl(name, decl)
 	return name
 }
 
@@ -614,7 +670,7 @@ func ({{$receiver}} *{{$.name}}) Get{{.Name}}() {{.Type}} {
 `
 	data := map[string]any{"comment": comment, "name": name, "fields": fields}
 	decl := mustExecuteTemplate(text, data)
-	g.typeDecls = append(g.typeDecls, decl)
+	g.addTypeDecl(name, decl)
 
 	return "*" + name
 }
@@ -650,7 +706,7 @@ func ({{.name}}) MarshalJSON() ([]byte, error) {
 `
 	data := map[string]any{"name": name, "value": value}
 	decl := mustExecuteTemplate(text, data)
-	g.typeDecls = append(g.typeDecls, decl)
+	g.addTypeDecl(name, decl)
 
 	return name
 }
@@ -671,7 +727,7 @@ func (g *generator) genSliceDecl(namespace string, elementType *metamodel.Type)
 		return name
 	}
 	g.gennedTypes[name] = true
-	g.typeDecls = append(g.typeDecls, fmt.Sprintf("type %s []%s", name, goElementType))
+	g.addTypeDecl(name, fmt.Sprintf("type %s []%s", name, goElementType))
 	return name
 }
 
@@ -750,7 +806,7 @@ func ({{$receiver}} *{{$.name}}) MarshalJSON() ([]byte, error) {
 `
 	data := map[string]any{"name": name, "fields": fields}
 	decl := mustExecuteTemplate(text, data)
-	g.typeDecls = append(g.typeDecls, decl)
+	g.addTypeDecl(name, decl)
 
 	return "*" + name
 }
@@ -795,7 +851,7 @@ func (g *generator) genMapDecl(namespace string, keyType metamodel.MapKeyType, v
 	}
 	g.gennedTypes[name] = true
 	decl := fmt.Sprintf("type %s map[%s]%s", name, goKeyType, goValueType)
-	g.typeDecls = append(g.typeDecls, decl)
+	g.addTypeDecl(name, decl)
 	return name
 }
 
@@ -805,17 +861,23 @@ func (g *generator) importPkgs(pkgs ...string) {
 	}
 }
 
-func (g *generator) comment(documentation, deprecationMsg string) string {
+// genericDeprecationMsg is used in place of a deprecation message when the meta model doesn't provide one.
+const genericDeprecationMsg = "this should no longer be used."
+
+// comment returns a doc comment containing the given documentation. If the documentation contains a @deprecated tag or
+// deprecationMsg is non-nil, then the comment will end with a "Deprecated: " paragraph so that the use of the
+// documented identifier is flagged by tools like staticcheck.
+func (g *generator) comment(documentation string, deprecationMsg *string) string {
 	comment := documentation
-	if deprecationMsg != "" {
-		if strings.Contains(comment, "@deprecated") {
-			comment = strings.ReplaceAll(comment, "@deprecated", "Deprecated:")
-		} else {
-			if comment != "" {
-				comment += "\n\n"
-			}
-			comment += "Deprecated: " + deprecationMsg
+	if before, after, ok := strings.Cut(comment, "@deprecated"); ok {
+		comment = strings.TrimRight(before, " \n")
+		msg := strings.TrimSpace(after)
+		if msg == "" && deprecationMsg != nil {
+			msg = *deprecationMsg
 		}
+		comment = appendDeprecatedParagraph(comment, msg)
+	} else if deprecationMsg != nil {
+		comment = appendDeprecatedParagraph(comment, *deprecationMsg)
 	}
 	if comment != "" {
 		return "// " + strings.ReplaceAll(comment, "\n", "\n// ")
@@ -824,7 +886,17 @@ func (g *generator) comment(documentation, deprecationMsg string) string {
 	}
 }
 
-func (g *generator) commentForType(name, documentation, deprecationMsg string) string {
+func appendDeprecatedParagraph(comment, msg string) string {
+	if msg == "" {
+		msg = genericDeprecationMsg
+	}
+	if comment != "" {
+		comment += "\n\n"
+	}
+	return comment + "Deprecated: " + msg
+}
+
+func (g *generator) commentForType(name, documentation string, deprecationMsg *string) string {
 	comment := g.comment(documentation, deprecationMsg)
 	versionParts := strings.Split(g.metaModel.MetaData.Version, ".")
 	major, minor := versionParts[0], versionParts[1]
@@ -0,0 +1,213 @@
+package generate_test
+
+import (
+	"encoding/json"
+	"go/format"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/marcuscaisey/lox/loxls/lsp/protocol/typegen/generate"
+	"github.com/marcuscaisey/lox/loxls/lsp/protocol/typegen/metamodel"
+)
+
+const deprecationTestMetaModel = `{
+	"metaData": {"version": "3.17.0"},
+	"requests": [],
+	"notifications": [],
+	"structures": [
+		{
+			"name": "Foo",
+			"documentation": "Foo is a structure.",
+			"properties": [
+				{
+					"name": "bar",
+					"type": {"kind": "base", "name": "string"},
+					"documentation": "The bar of the foo.",
+					"deprecated": "use baz instead."
+				},
+				{
+					"name": "baz",
+					"type": {"kind": "base", "name": "string"},
+					"documentation": "The baz of the foo."
+				},
+				{
+					"name": "qux",
+					"type": {"kind": "base", "name": "string"},
+					"documentation": "The qux of the foo.",
+					"deprecated": ""
+				},
+				{
+					"name": "quux",
+					"type": {"kind": "base", "name": "string"},
+					"documentation": "The quux of the foo.\n@deprecated use baz instead."
+				}
+			]
+		},
+		{
+			"name": "OldFoo",
+			"properties": [],
+			"deprecated": "use Foo instead."
+		}
+	],
+	"enumerations": [
+		{
+			"name": "Kind",
+			"type": {"kind": "base", "name": "string"},
+			"values": [
+				{"name": "New", "value": "new"},
+				{"name": "Old", "value": "old", "deprecated": "use New instead."}
+			]
+		}
+	],
+	"typeAliases": []
+}`
+
+func TestSourceDeprecationComments(t *testing.T) {
+	var metaModel *metamodel.MetaModel
+	if err := json.Unmarshal([]byte(deprecationTestMetaModel), &metaModel); err != nil {
+		t.Fatalf("unmarshalling meta model: %s", err)
+	}
+	types := []*metamodel.Type{
+		{Value: metamodel.ReferenceType{Kind: "reference", Name: "Foo"}},
+		{Value: metamodel.ReferenceType{Kind: "reference", Name: "OldFoo"}},
+		{Value: metamodel.ReferenceType{Kind: "reference", Name: "Kind"}},
+	}
+
+	src := generate.Source(types, metaModel, "protocol")
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		t.Fatalf("formatting generated source: %s\n%s", err, src)
+	}
+	got := string(formatted)
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{
+			name: "deprecated property",
+			want: `
+	// The bar of the foo.
+	//
+	// Deprecated: use baz instead.
+	Bar string ` + "`" + `json:"bar"` + "`",
+		},
+		{
+			name: "non-deprecated property",
+			want: `
+	// The baz of the foo.
+	Baz string ` + "`" + `json:"baz"` + "`",
+		},
+		{
+			name: "deprecated property with empty message",
+			want: `
+	// The qux of the foo.
+	//
+	// Deprecated: this should no longer be used.
+	Qux string ` + "`" + `json:"qux"` + "`",
+		},
+		{
+			name: "property with @deprecated tag",
+			want: `
+	// The quux of the foo.
+	//
+	// Deprecated: use baz instead.
+	Quux string ` + "`" + `json:"quux"` + "`",
+		},
+		{
+			name: "deprecated structure",
+			want: `
+// Deprecated: use Foo instead.
+//
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#oldFoo
+type OldFoo struct {`,
+		},
+		{
+			name: "deprecated enumeration value",
+			want: `
+	// Deprecated: use New instead.
+	KindOld Kind = "old"`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if !strings.Contains(got, test.want) {
+				t.Errorf("generated source does not contain:%s\n\ngenerated source:\n%s", test.want, got)
+			}
+		})
+	}
+}
+
+const partialResultTestMetaModel = `{
+	"metaData": {"version": "3.17.0"},
+	"requests": [
+		{
+			"method": "test/stream",
+			"messageDirection": "clientToServer",
+			"params": {"kind": "reference", "name": "StreamParams"},
+			"result": {"kind": "reference", "name": "StreamResult"},
+			"partialResult": {"kind": "reference", "name": "StreamPartialResult"}
+		}
+	],
+	"notifications": [],
+	"structures": [
+		{"name": "StreamParams", "properties": []},
+		{"name": "StreamResult", "properties": []},
+		{
+			"name": "StreamPartialResult",
+			"properties": [
+				{"name": "items", "type": {"kind": "array", "element": {"kind": "base", "name": "string"}}}
+			]
+		}
+	],
+	"enumerations": [],
+	"typeAliases": []
+}`
+
+func TestSourcePartialResult(t *testing.T) {
+	var metaModel *metamodel.MetaModel
+	if err := json.Unmarshal([]byte(partialResultTestMetaModel), &metaModel); err != nil {
+		t.Fatalf("unmarshalling meta model: %s", err)
+	}
+	types, err := metaModel.MethodTypes([]string{"test/stream"})
+	if err != nil {
+		t.Fatalf("getting method types: %s", err)
+	}
+
+	src := generate.Source(types, metaModel, "protocol")
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		t.Fatalf("formatting generated source: %s\n%s", err, src)
+	}
+	got := string(formatted)
+
+	want := `
+type StreamPartialResult struct {
+	Items []string ` + "`" + `json:"items"` + "`" + `
+}`
+	if !strings.Contains(got, want) {
+		t.Errorf("generated source does not contain:%s\n\ngenerated source:\n%s", want, got)
+	}
+}
+
+func TestSourceDeterministic(t *testing.T) {
+	var metaModel *metamodel.MetaModel
+	if err := json.Unmarshal([]byte(deprecationTestMetaModel), &metaModel); err != nil {
+		t.Fatalf("unmarshalling meta model: %s", err)
+	}
+	types := []*metamodel.Type{
+		{Value: metamodel.ReferenceType{Kind: "reference", Name: "Foo"}},
+		{Value: metamodel.ReferenceType{Kind: "reference", Name: "OldFoo"}},
+		{Value: metamodel.ReferenceType{Kind: "reference", Name: "Kind"}},
+	}
+	reversedTypes := slices.Clone(types)
+	slices.Reverse(reversedTypes)
+
+	first := generate.Source(types, metaModel, "protocol")
+	second := generate.Source(reversedTypes, metaModel, "protocol")
+
+	if first != second {
+		t.Errorf("generated source differs between runs:\n\nfirst run:\n%s\n\nsecond run:\n%s", first, second)
+	}
+}
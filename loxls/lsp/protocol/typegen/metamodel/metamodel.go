@@ -54,7 +54,7 @@ type BooleanLiteralType struct {
 // Enumeration defines an Enumeration.
 type Enumeration struct {
 	// Whether the enumeration is deprecated or not. If deprecated the property contains the deprecation message.
-	Deprecated string `json:"deprecated,omitempty"`
+	Deprecated *string `json:"deprecated,omitempty"`
 	// An optional documentation.
 	Documentation string `json:"documentation,omitempty"`
 	// The name of the enumeration.
@@ -75,7 +75,7 @@ type Enumeration struct {
 // EnumerationEntry defines an enumeration entry.
 type EnumerationEntry struct {
 	// Whether the enum entry is deprecated or not. If deprecated the property contains the deprecation message.
-	Deprecated string `json:"deprecated,omitempty"`
+	Deprecated *string `json:"deprecated,omitempty"`
 	// An optional documentation.
 	Documentation string `json:"documentation,omitempty"`
 	// The name of the enum item.
@@ -235,7 +235,7 @@ type MetaModel struct {
 }
 
 // MethodTypes returns the types associated with the given methods.
-// For a request, the types of the params, result, and error data are returned.
+// For a request, the types of the params, result, partial result, and error data are returned.
 // For a notification, the type of the params is returned.
 func (m *MetaModel) MethodTypes(methods []string) ([]*Type, error) {
 	var types []*Type
@@ -250,6 +250,9 @@ func (m *MetaModel) MethodTypes(methods []string) ([]*Type, error) {
 
 		types = append(types, req.Params.Flatten()...)
 		types = append(types, req.Result)
+		if req.PartialResult != nil {
+			types = append(types, req.PartialResult)
+		}
 		if req.ErrorData != nil {
 			types = append(types, req.ErrorData)
 		}
@@ -305,7 +308,7 @@ func (m *MetaModel) Enumeration(name string) (value *Enumeration, ok bool) {
 // Notification represents a LSP Notification
 type Notification struct {
 	// Whether the notification is deprecated or not. If deprecated the property contains the deprecation message.
-	Deprecated string `json:"deprecated,omitempty"`
+	Deprecated *string `json:"deprecated,omitempty"`
 	// An optional documentation;
 	Documentation string `json:"documentation,omitempty"`
 	// The direction in which this notification is sent in the protocol.
@@ -395,7 +398,7 @@ type OrType struct {
 // Property represents an object Property.
 type Property struct {
 	// Whether the property is deprecated or not. If deprecated the property contains the deprecation message.
-	Deprecated string `json:"deprecated,omitempty"`
+	Deprecated *string `json:"deprecated,omitempty"`
 	// An optional documentation.
 	Documentation string `json:"documentation,omitempty"`
 	// The property name;
@@ -420,7 +423,7 @@ type ReferenceType struct {
 // Request represents a LSP Request
 type Request struct {
 	// Whether the request is deprecated or not. If deprecated the property contains the deprecation message.
-	Deprecated string `json:"deprecated,omitempty"`
+	Deprecated *string `json:"deprecated,omitempty"`
 	// An optional documentation;
 	Documentation string `json:"documentation,omitempty"`
 	// An optional error data type.
@@ -454,7 +457,7 @@ type StringLiteralType struct {
 // Structure defines the Structure of an object literal.
 type Structure struct {
 	// Whether the structure is deprecated or not. If deprecated the property contains the deprecation message.
-	Deprecated string `json:"deprecated,omitempty"`
+	Deprecated *string `json:"deprecated,omitempty"`
 	// An optional documentation;
 	Documentation string `json:"documentation,omitempty"`
 	// Structures extended from. This structures form a polymorphic type hierarchy.
@@ -475,7 +478,7 @@ type Structure struct {
 // StructureLiteral defines an unnamed structure of an object literal.
 type StructureLiteral struct {
 	// Whether the literal is deprecated or not. If deprecated the property contains the deprecation message.
-	Deprecated string `json:"deprecated,omitempty"`
+	Deprecated *string `json:"deprecated,omitempty"`
 	// An optional documentation.
 	Documentation string `json:"documentation,omitempty"`
 	// The properties.
@@ -637,7 +640,7 @@ func (t *Type) MarshalJSON() ([]byte, error) {
 // TypeAlias defines a type alias. (e.g. `type Definition = Location | LocationLink`)
 type TypeAlias struct {
 	// Whether the type alias is deprecated or not. If deprecated the property contains the deprecation message.
-	Deprecated string `json:"deprecated,omitempty"`
+	Deprecated *string `json:"deprecated,omitempty"`
 	// An optional documentation.
 	Documentation string `json:"documentation,omitempty"`
 	// The name of the type alias.
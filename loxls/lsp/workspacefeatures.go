@@ -0,0 +1,78 @@
+package lsp
+
+// This file contains handlers for the methods described under
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#workspaceFeatures.
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/marcuscaisey/lox/loxls/lsp/protocol"
+)
+
+// watchedFilesGlobPattern is the glob pattern of the files that the client is asked to watch.
+const watchedFilesGlobPattern = "**/*.lox"
+
+// registerFileWatcher asks the client to send workspace/didChangeWatchedFiles notifications for Lox files if it
+// supports registering for them dynamically.
+func (h *Handler) registerFileWatcher() error {
+	if !h.capabilities.GetWorkspace().GetDidChangeWatchedFiles().GetDynamicRegistration() {
+		return nil
+	}
+	registerOptions, err := toLSPAny(map[string]any{
+		"watchers": []map[string]any{{"globPattern": watchedFilesGlobPattern}},
+	})
+	if err != nil {
+		return fmt.Errorf("registering file watcher: %s", err)
+	}
+	err = h.client.ClientRegisterCapability(&protocol.RegistrationParams{
+		Registrations: []*protocol.Registration{
+			{
+				Id:              "workspace/didChangeWatchedFiles",
+				Method:          "workspace/didChangeWatchedFiles",
+				RegisterOptions: registerOptions,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("registering file watcher: %s", err)
+	}
+	return nil
+}
+
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#workspace_didChangeWatchedFiles
+func (h *Handler) workspaceDidChangeWatchedFiles(params *protocol.DidChangeWatchedFilesParams) error {
+	for _, change := range params.Changes {
+		if doc, ok := h.docs[change.Uri]; ok && doc.Open {
+			// The client is the source of truth for the contents of open documents.
+			continue
+		}
+		switch change.Type {
+		case protocol.FileChangeTypeCreated, protocol.FileChangeTypeChanged:
+			filename, err := uriToFilename(change.Uri)
+			if err != nil {
+				return fmt.Errorf("workspace/didChangeWatchedFiles: %s", err)
+			}
+			src, err := os.ReadFile(filename)
+			if err != nil {
+				return fmt.Errorf("workspace/didChangeWatchedFiles: %s", err)
+			}
+			if err := h.updateDoc(change.Uri, 0, string(src), false); err != nil {
+				return fmt.Errorf("workspace/didChangeWatchedFiles: %s", err)
+			}
+		case protocol.FileChangeTypeDeleted:
+			if _, ok := h.docs[change.Uri]; !ok {
+				continue
+			}
+			delete(h.docs, change.Uri)
+			err := h.client.TextDocumentPublishDiagnostics(&protocol.PublishDiagnosticsParams{
+				Uri:         change.Uri,
+				Diagnostics: []*protocol.Diagnostic{},
+			})
+			if err != nil {
+				return fmt.Errorf("workspace/didChangeWatchedFiles: %s", err)
+			}
+		}
+	}
+	return nil
+}
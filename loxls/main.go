@@ -2,6 +2,8 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"log/slog"
 	"os"
 
@@ -14,7 +16,11 @@ func main() {
 	logger := slog.New(handler)
 	slog.SetDefault(logger)
 
-	if err := jsonrpc.Serve(os.Stdin, os.Stdout, lsp.NewHandler()); err != nil {
+	if err := jsonrpc.Serve(context.Background(), os.Stdin, os.Stdout, lsp.NewHandler()); err != nil {
+		var exitErr *jsonrpc.ExitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.Code)
+		}
 		slog.Error("Something went wrong", "error", err.Error())
 		os.Exit(1)
 	}
@@ -138,7 +138,7 @@ func translateStdout(stdout []byte) []byte {
 }
 
 func translateStderr(stderr []byte) (int, string, error) {
-	errorRe := regexp.MustCompile(`(?m)^(\d+):\d+: error: (.+)\n(.+)\n(\s*~+)$`)
+	errorRe := regexp.MustCompile(`(?m)^(?:.+:)?(\d+):\d+: +error: (.+)\n(.+)\n(\s*~+)$`)
 	matches := errorRe.FindAllSubmatch(stderr, -1)
 	if len(matches) == 0 {
 		return 0, "", fmt.Errorf("translating stderr: error pattern %q not found:\n%s", errorRe, stderr)